@@ -14,10 +14,13 @@ import (
 
 	"github.com/open-cli-collective/google-readonly/internal/auth"
 	calcmd "github.com/open-cli-collective/google-readonly/internal/cmd/calendar"
+	configcmd "github.com/open-cli-collective/google-readonly/internal/cmd/config"
 	contactscmd "github.com/open-cli-collective/google-readonly/internal/cmd/contacts"
 	drivecmd "github.com/open-cli-collective/google-readonly/internal/cmd/drive"
 	mailcmd "github.com/open-cli-collective/google-readonly/internal/cmd/mail"
 	mecmd "github.com/open-cli-collective/google-readonly/internal/cmd/me"
+	"github.com/open-cli-collective/google-readonly/internal/cmd/refreshcmd"
+	"github.com/open-cli-collective/google-readonly/internal/cmd/versioncmd"
 )
 
 // domainPackages lists the command packages that must follow structural conventions.
@@ -37,6 +40,16 @@ func domainCommands() map[string]*cobra.Command {
 	}
 }
 
+// controlPlaneCommands returns the top-level commands allowed to emit
+// structured envelopes (golden principle §4 carve-outs).
+func controlPlaneCommands() map[string]*cobra.Command {
+	return map[string]*cobra.Command{
+		"config":  configcmd.NewCommand(),
+		"refresh": refreshcmd.NewCommand(),
+		"version": versioncmd.NewCommand(),
+	}
+}
+
 // findModuleRoot walks up from the working directory to locate go.mod.
 func findModuleRoot(t *testing.T) string {
 	t.Helper()
@@ -252,6 +265,87 @@ func TestResourceLeavesHaveNoJSONFlag(t *testing.T) {
 	}
 }
 
+// nativeOutputLeaves lists the resource leaves whose --output selects a
+// format other than text, and the native format each one may emit. See the
+// native-format exemption in docs/golden-principles.md §4.
+var nativeOutputLeaves = map[string]string{
+	"calendar events": "ics",
+	"calendar today":  "ics",
+	"calendar week":   "ics",
+	"mail read":       "eml",
+}
+
+// TestResourceLeafOutputFormatsAreExempt keeps --output format selection on
+// resource leaves to the closed set in nativeOutputLeaves, so it cannot grow
+// into a JSON surface by the back door.
+func TestResourceLeafOutputFormatsAreExempt(t *testing.T) {
+	t.Parallel()
+
+	for name, cmd := range domainCommands() {
+		for _, leaf := range leafCommands(cmd, name) {
+			key := strings.TrimSpace(leaf.path)
+			flag := leaf.cmd.Flags().Lookup("output")
+			if flag == nil || !strings.HasPrefix(flag.Usage, "Output format:") {
+				continue
+			}
+			t.Run(key, func(t *testing.T) {
+				t.Parallel()
+				format, ok := nativeOutputLeaves[key]
+				if !ok {
+					t.Fatalf("resource leaf %q selects an output format but is not in nativeOutputLeaves (see docs/golden-principles.md §4)", key)
+				}
+				if !strings.Contains(flag.Usage, format) {
+					t.Errorf("resource leaf %q --output should offer %q, usage is %q", key, format, flag.Usage)
+				}
+				for _, structured := range []string{"json", "yaml"} {
+					if strings.Contains(strings.ToLower(flag.Usage), structured) {
+						t.Errorf("resource leaf %q --output must not offer %s", key, structured)
+					}
+				}
+			})
+		}
+	}
+}
+
+// TestControlPlaneLeavesHaveUniformOutputFlag verifies that every
+// control-plane leaf emitting a structured envelope offers the same
+// selection: --output/-o text|json|yaml, with --json/-j kept as the
+// shorthand for --output json, and --fields to project the envelope.
+func TestControlPlaneLeavesHaveUniformOutputFlag(t *testing.T) {
+	t.Parallel()
+
+	for name, cmd := range controlPlaneCommands() {
+		for _, leaf := range leafCommands(cmd, name) {
+			key := strings.TrimSpace(leaf.path)
+			jsonFlag := leaf.cmd.Flags().Lookup("json")
+			if jsonFlag == nil {
+				continue
+			}
+			t.Run(key, func(t *testing.T) {
+				t.Parallel()
+				if jsonFlag.Shorthand != "j" {
+					t.Errorf("control-plane leaf %q --json should have shorthand -j, got %q", key, jsonFlag.Shorthand)
+				}
+				flag := leaf.cmd.Flags().Lookup("output")
+				if flag == nil {
+					t.Fatalf("control-plane leaf %q has --json but no --output (see docs/golden-principles.md §4)", key)
+				}
+				if flag.Shorthand != "o" || flag.DefValue != "text" {
+					t.Errorf("control-plane leaf %q --output should be -o defaulting to text, got -%s default %q", key, flag.Shorthand, flag.DefValue)
+				}
+				for _, format := range []string{"text", "json", "yaml"} {
+					if !strings.Contains(flag.Usage, format) {
+						t.Errorf("control-plane leaf %q --output should offer %s, usage is %q", key, format, flag.Usage)
+					}
+				}
+				if leaf.cmd.Flags().Lookup("fields") == nil {
+					t.Errorf("control-plane leaf %q has --output but no --fields", key)
+				}
+			})
+		}
+	}
+}
+
 // TestResourceLeaf_RejectsJSON_EndToEnd is a spot-check complement to the
 // structural walk in TestResourceLeavesHaveNoJSONFlag. It dispatches one
 // representative resource leaf with --json through cobra and asserts the
@@ -325,15 +419,16 @@ func TestAuthPackageDoesNotImportAPIClients(t *testing.T) {
 // they enable non-destructive organizational operations (label, archive, star, etc.)
 // without granting send or delete access.
 var allowedScopes = map[string]bool{
-	"https://www.googleapis.com/auth/gmail.readonly":    true,
-	"https://www.googleapis.com/auth/gmail.modify":      true, // label, archive, star, read/unread (NOT send/delete)
-	"https://www.googleapis.com/auth/calendar.readonly": true,
-	"https://www.googleapis.com/auth/calendar.events":   true, // RSVP, color (NOT calendar settings)
-	"https://www.googleapis.com/auth/contacts.readonly": true,
-	"https://www.googleapis.com/auth/contacts":          true, // group membership, starring (NOT create/delete contacts)
-	"https://www.googleapis.com/auth/userinfo.profile":  true, // read authenticated user's name/email for people/me (NOT contacts list)
-	"https://www.googleapis.com/auth/drive.readonly":    true,
-	"https://www.googleapis.com/auth/drive.metadata":    true, // star/unstar files (NOT file content write)
+	"https://www.googleapis.com/auth/gmail.readonly":          true,
+	"https://www.googleapis.com/auth/gmail.modify":            true, // label, archive, star, read/unread (NOT send/delete)
+	"https://www.googleapis.com/auth/calendar.readonly":       true,
+	"https://www.googleapis.com/auth/calendar.events":         true, // RSVP, color (NOT calendar settings)
+	"https://www.googleapis.com/auth/contacts.readonly":       true,
+	"https://www.googleapis.com/auth/contacts":                true, // group membership, starring (NOT create/delete contacts)
+	"https://www.googleapis.com/auth/contacts.other.readonly": true,
+	"https://www.googleapis.com/auth/userinfo.profile":        true, // read authenticated user's name/email for people/me (NOT contacts list)
+	"https://www.googleapis.com/auth/drive.readonly":          true,
+	"https://www.googleapis.com/auth/drive.metadata":          true, // star/unstar files (NOT file content write)
 }
 
 // TestAllScopesAreNonDestructive verifies that every OAuth scope in auth.AllScopes
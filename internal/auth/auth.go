@@ -3,9 +3,16 @@ package auth
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
+	"io/fs"
 	"net/http"
+	"net/url"
 	"os"
+	"strings"
+	"time"
 
 	"golang.org/x/oauth2"
 	"golang.org/x/oauth2/google"
@@ -14,6 +21,8 @@ import (
 	"google.golang.org/api/gmail/v1"
 	"google.golang.org/api/people/v1"
 
+	"github.com/open-cli-collective/cli-common/credstore"
+
 	"github.com/open-cli-collective/google-readonly/internal/config"
 	"github.com/open-cli-collective/google-readonly/internal/keychain"
 )
@@ -26,12 +35,15 @@ import (
 // The architecture test (TestNoDestructiveAPIMethodsInProductionCode) prevents accidental misuse.
 // Contacts uses the full contacts scope for group management and starring.
 // The contacts scope is a superset of contacts.readonly — it includes all read access.
+// Other Contacts (auto-collected) sit outside it and need contacts.other.readonly.
 // Profile is required for people/me (names, emailAddresses fields) used by `gro me` and init verification.
+// 'gro init --only' requests a subset of these; see ScopesForDomains.
 var AllScopes = []string{
 	gmail.GmailModifyScope,
 	calendar.CalendarReadonlyScope,
 	calendar.CalendarEventsScope,
 	people.ContactsScope,
+	people.ContactsOtherReadonlyScope,
 	people.UserinfoProfileScope,
 	drive.DriveReadonlyScope,
 	drive.DriveMetadataScope,
@@ -39,19 +51,22 @@ var AllScopes = []string{
 
 // ScopeDescriptions maps OAuth scope URLs to human-friendly descriptions.
 var ScopeDescriptions = map[string]string{
-	gmail.GmailModifyScope:         "Gmail Modify — read messages, plus label, archive, star, and mark read/unread. No send or delete access.",
-	gmail.GmailReadonlyScope:       "Gmail Read-Only — read messages and metadata.",
-	calendar.CalendarReadonlyScope: "Calendar Read-Only — read calendars and events.",
-	calendar.CalendarEventsScope:   "Calendar Events — read and update events (RSVP, color). No calendar settings access.",
-	people.ContactsScope:           "Contacts — read contacts and groups, plus manage group membership and starring.",
-	people.ContactsReadonlyScope:   "Contacts Read-Only — read contacts and groups.",
-	people.UserinfoProfileScope:    "Profile — read the authenticated user's name and email address (required for 'gro me').",
-	drive.DriveReadonlyScope:       "Drive Read-Only — read files and metadata.",
-	drive.DriveMetadataScope:       "Drive Metadata — read and update file metadata (star/unstar). No file content write access.",
+	gmail.GmailModifyScope:            "Gmail Modify — read messages, plus label, archive, star, and mark read/unread. No send or delete access.",
+	gmail.GmailReadonlyScope:          "Gmail Read-Only — read messages and metadata.",
+	calendar.CalendarReadonlyScope:    "Calendar Read-Only — read calendars and events.",
+	calendar.CalendarEventsScope:      "Calendar Events — read and update events (RSVP, color). No calendar settings access.",
+	people.ContactsScope:              "Contacts — read contacts and groups, plus manage group membership and starring.",
+	people.ContactsReadonlyScope:      "Contacts Read-Only — read contacts and groups.",
+	people.ContactsOtherReadonlyScope: "Other Contacts Read-Only — read auto-collected contacts (used by 'gro contacts other').",
+	people.UserinfoProfileScope:       "Profile — read the authenticated user's name and email address (required for 'gro me').",
+	drive.DriveReadonlyScope:          "Drive Read-Only — read files and metadata.",
+	drive.DriveMetadataScope:          "Drive Metadata — read and update file metadata (star/unstar). No file content write access.",
 }
 
 // CheckScopesMigration compares the currently required scopes against the
 // previously granted scopes. Returns a non-empty message if re-auth is needed.
+// Only the domains the token was authorized for are checked, so a token
+// from 'gro init --only' is current as long as those domains are.
 func CheckScopesMigration(grantedScopes []string) string {
 	if len(grantedScopes) == 0 {
 		return ""
@@ -62,8 +77,13 @@ func CheckScopesMigration(grantedScopes []string) string {
 		granted[s] = true
 	}
 
+	domains := GrantedDomains(grantedScopes)
+	if len(domains) == 0 {
+		domains = Domains
+	}
+
 	var missing []string
-	for _, required := range AllScopes {
+	for _, required := range ScopesForDomains(domains) {
 		if !granted[required] {
 			missing = append(missing, required)
 		}
@@ -73,7 +93,7 @@ func CheckScopesMigration(grantedScopes []string) string {
 		return ""
 	}
 
-	msg := "This command requires additional permissions.\nYour current token only has read-only access.\n\nRun 'gro init' to re-authenticate with the updated scopes.\n\nNew scopes:\n"
+	msg := "This command requires additional permissions.\nYour current token only has read-only access.\n\nRun '" + InitCommand(domains) + "' to re-authenticate with the updated scopes.\n\nNew scopes:\n"
 	for _, s := range missing {
 		desc := ScopeDescriptions[s]
 		if desc == "" {
@@ -84,9 +104,13 @@ func CheckScopesMigration(grantedScopes []string) string {
 	return msg
 }
 
+// gmailScopes are the scopes any one of which lets gro read mail
+var gmailScopes = []string{gmail.GmailModifyScope, gmail.GmailReadonlyScope, gmail.MailGoogleComScope}
+
 // GetOAuthConfig loads the OAuth client config from the deployment-material
 // OAuth client JSON referenced by config.yml's oauth_client_path (§1.2 — not
-// a secret; lives on disk, never the keyring), with all scopes.
+// a secret; lives on disk, never the keyring), with all scopes. When that
+// file does not exist the JSON is taken from CredentialsEnvVar instead.
 func GetOAuthConfig() (*oauth2.Config, error) {
 	cfg, err := config.LoadConfigForRuntime()
 	if err != nil {
@@ -94,6 +118,14 @@ func GetOAuthConfig() (*oauth2.Config, error) {
 	}
 	path := config.ExpandPath(cfg.OAuthClientPath)
 	b, err := os.ReadFile(path) //nolint:gosec // deployment-material path from config
+	if errors.Is(err, fs.ErrNotExist) {
+		envCfg, ok, envErr := envOAuthConfig()
+		if ok {
+			return envCfg, envErr
+		}
+		return nil, fmt.Errorf("no OAuth client JSON: %s does not exist and %s is not set (run 'gro init', or set %s to the base64-encoded client JSON)",
+			config.ShortenPath(path), CredentialsEnvVar, CredentialsEnvVar)
+	}
 	if err != nil {
 		return nil, fmt.Errorf("unable to read OAuth client JSON %s (run 'gro init'): %w",
 			config.ShortenPath(path), err)
@@ -106,8 +138,17 @@ func GetOAuthConfig() (*oauth2.Config, error) {
 // security/secret-tool shell-out, no token.json fallback). The active
 // credential_ref is captured once here; refreshed tokens persist back to that
 // exact ref via the closure passed to the token source (the sole sanctioned
-// non-ingress keyring write). Returns an actionable error if no token exists.
+// non-ingress keyring write). When the keyring holds no token, or no keyring
+// is set up at all, TokenEnvVar is used and refreshes stay in memory; any
+// other keyring failure is returned rather than papered over. Returns an
+// actionable error if neither has a token. A service account selected by
+// UseServiceAccount replaces all of this.
 func GetHTTPClient(ctx context.Context) (*http.Client, error) {
+	envIdentity = ""
+	if UsingServiceAccount() {
+		return serviceAccountClient(ctx)
+	}
+
 	oauthCfg, err := GetOAuthConfig()
 	if err != nil {
 		return nil, err
@@ -115,32 +156,197 @@ func GetHTTPClient(ctx context.Context) (*http.Client, error) {
 
 	st, err := keychain.Open()
 	if err != nil {
+		if errors.Is(err, credstore.ErrFilePassphraseRequired) {
+			if tok, ok, envErr := EnvToken(); ok {
+				return envClient(ctx, oauthCfg, tok, envErr)
+			}
+		}
 		return nil, err
 	}
 	tok, err := st.Token()
 	if err != nil {
 		_ = st.Close()
+		if !errors.Is(err, keychain.ErrTokenNotFound) {
+			return nil, fmt.Errorf("reading OAuth token: %w", err)
+		}
+		if envTok, ok, envErr := EnvToken(); ok {
+			return envClient(ctx, oauthCfg, envTok, envErr)
+		}
 		return nil, fmt.Errorf("no OAuth token found - please run 'gro init' first: %w", err)
 	}
 	ref := st.Ref()
 	_ = st.Close() // do not hold the Store for the client's lifetime
 
-	persist := func(t *oauth2.Token) error {
-		ps, perr := keychain.OpenRef(ref) // runMigration=false: refresh is not ingress
-		if perr != nil {
-			return perr
+	tokenSource := keychain.NewPersistentTokenSource(ctx, oauthCfg, tok, persistTo(ref))
+	return oauth2.NewClient(ctx, tokenSource), nil
+}
+
+// envClient returns a client for a token from TokenEnvVar, or err if the
+// variable could not be read. There is no keyring entry to persist a
+// refreshed token to.
+func envClient(ctx context.Context, oauthCfg *oauth2.Config, tok *oauth2.Token, err error) (*http.Client, error) {
+	if err != nil {
+		return nil, err
+	}
+	secret := tok.RefreshToken
+	if secret == "" {
+		secret = tok.AccessToken
+	}
+	envIdentity = digest(secret)
+	return oauth2.NewClient(ctx, oauthCfg.TokenSource(ctx, tok)), nil
+}
+
+// ErrNoRefreshToken is returned by RefreshToken when the stored token carries
+// no refresh token, so it cannot be renewed without re-authenticating.
+var ErrNoRefreshToken = errors.New("stored token has no refresh token - run 'gro init' to re-authenticate")
+
+// RefreshToken forces a refresh of the stored token and returns the new one.
+// It goes through the same PersistentTokenSource as GetHTTPClient, so the
+// rotated token is written back to the active credential_ref. The refresh is
+// forced by handing the source a copy of the token marked expired; the stored
+// token itself is only replaced once the refresh succeeds. Unlike a runtime
+// refresh, a failure to persist is returned rather than only warned about.
+func RefreshToken(ctx context.Context) (*oauth2.Token, error) {
+	oauthCfg, err := GetOAuthConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	st, err := keychain.Open()
+	if err != nil {
+		return nil, err
+	}
+	tok, err := st.Token()
+	if err != nil {
+		_ = st.Close()
+		return nil, fmt.Errorf("no OAuth token found - please run 'gro init' first: %w", err)
+	}
+	ref := st.Ref()
+	_ = st.Close()
+
+	if tok.RefreshToken == "" {
+		return nil, ErrNoRefreshToken
+	}
+
+	var persistErr error
+	persist := persistTo(ref)
+	expired := *tok
+	expired.Expiry = time.Unix(1, 0)
+	tokenSource := keychain.NewPersistentTokenSource(ctx, oauthCfg, &expired, func(t *oauth2.Token) error {
+		persistErr = persist(t)
+		return persistErr
+	})
+
+	fresh, err := tokenSource.Token()
+	if err != nil {
+		if isInvalidGrant(err) {
+			return nil, errors.New("refresh token was rejected (invalid_grant: expired or revoked) - run 'gro config clear' then 'gro init' to re-authenticate")
+		}
+		return nil, fmt.Errorf("refreshing token: %w", err)
+	}
+	if persistErr != nil {
+		return nil, fmt.Errorf("saving refreshed token: %w", persistErr)
+	}
+	return fresh, nil
+}
+
+// revokeEndpoint is Google's OAuth token revocation endpoint; a variable so
+// tests can point it at a local server
+var revokeEndpoint = "https://oauth2.googleapis.com/revoke"
+
+// RevokeToken invalidates the stored token's grant on Google's side, then
+// deletes the token from the keyring. The refresh token is revoked when
+// there is one, which ends the whole grant; otherwise the access token is.
+// A token Google no longer accepts (already revoked, or expired) counts as
+// revoked, and alreadyInvalid reports it. When Google cannot be reached or
+// refuses for another reason, the token is kept so the revocation can be
+// retried.
+func RevokeToken(ctx context.Context) (alreadyInvalid bool, err error) {
+	st, err := keychain.Open()
+	if err != nil {
+		return false, err
+	}
+	defer func() { _ = st.Close() }()
+
+	tok, err := st.Token()
+	if err != nil {
+		return false, fmt.Errorf("no OAuth token found - nothing to revoke: %w", err)
+	}
+
+	value := tok.RefreshToken
+	if value == "" {
+		value = tok.AccessToken
+	}
+	alreadyInvalid, err = revoke(ctx, value)
+	if err != nil {
+		return false, err
+	}
+
+	if err := st.DeleteToken(); err != nil {
+		return alreadyInvalid, fmt.Errorf("token revoked, but removing it from the keyring failed: %w", err)
+	}
+	return alreadyInvalid, nil
+}
+
+// revoke posts token to the revocation endpoint. Google answers 200 once
+// the token is revoked and 400 invalid_token for one it does not know.
+func revoke(ctx context.Context, token string) (alreadyInvalid bool, err error) {
+	form := url.Values{"token": {token}}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, revokeEndpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		return false, fmt.Errorf("building revoke request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return false, fmt.Errorf("revoking token: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusOK {
+		return false, nil
+	}
+	var body struct {
+		Error string `json:"error"`
+	}
+	_ = json.NewDecoder(io.LimitReader(resp.Body, 1<<16)).Decode(&body)
+	if resp.StatusCode == http.StatusBadRequest && body.Error == "invalid_token" {
+		return true, nil
+	}
+	if body.Error != "" {
+		return false, fmt.Errorf("revoking token: Google returned %s (%s)", resp.Status, body.Error)
+	}
+	return false, fmt.Errorf("revoking token: Google returned %s", resp.Status)
+}
+
+// persistTo returns a TokenPersister bound to ref. Refresh is not ingress, so
+// the Store is opened without running migration.
+func persistTo(ref string) keychain.TokenPersister {
+	return func(t *oauth2.Token) error {
+		ps, err := keychain.OpenRef(ref)
+		if err != nil {
+			return err
 		}
 		defer func() { _ = ps.Close() }()
 		return ps.SetToken(t)
 	}
+}
 
-	tokenSource := keychain.NewPersistentTokenSource(ctx, oauthCfg, tok, persist)
-	return oauth2.NewClient(ctx, tokenSource), nil
+// isInvalidGrant reports whether err is the token endpoint rejecting the
+// refresh token, which Google does once it is revoked or has expired.
+func isInvalidGrant(err error) bool {
+	var rErr *oauth2.RetrieveError
+	return errors.As(err, &rErr) && rErr.ErrorCode == "invalid_grant"
 }
 
+// AuthState is the state parameter sent with the authorization request and
+// echoed back on the redirect
+const AuthState = "state-token"
+
 // GetAuthURL returns the OAuth authorization URL for the given config
 func GetAuthURL(config *oauth2.Config) string {
-	return config.AuthCodeURL("state-token", oauth2.AccessTypeOffline)
+	return config.AuthCodeURL(AuthState, oauth2.AccessTypeOffline)
 }
 
 // ExchangeAuthCode exchanges an authorization code for a token
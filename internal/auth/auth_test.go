@@ -1,12 +1,21 @@
 package auth
 
 import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
 	"os"
 	"path/filepath"
 	"strings"
 	"testing"
+	"time"
+
+	"golang.org/x/oauth2"
 
 	"github.com/open-cli-collective/google-readonly/internal/config"
+	"github.com/open-cli-collective/google-readonly/internal/credtest"
+	"github.com/open-cli-collective/google-readonly/internal/keychain"
 )
 
 // TestDeprecatedWrappers verifies that auth package wrappers delegate to config package
@@ -101,8 +110,8 @@ func TestDeprecatedWrappers(t *testing.T) {
 
 func TestAllScopes(t *testing.T) {
 	t.Parallel()
-	if len(AllScopes) != 7 {
-		t.Errorf("got length %d, want %d", len(AllScopes), 7)
+	if len(AllScopes) != 8 {
+		t.Errorf("got length %d, want %d", len(AllScopes), 8)
 	}
 	scopeSet := strings.Join(AllScopes, " ")
 	if !strings.Contains(scopeSet, "https://www.googleapis.com/auth/gmail.modify") {
@@ -117,6 +126,9 @@ func TestAllScopes(t *testing.T) {
 	if !strings.Contains(scopeSet, "https://www.googleapis.com/auth/contacts") {
 		t.Errorf("expected AllScopes to contain %q", "https://www.googleapis.com/auth/contacts")
 	}
+	if !strings.Contains(scopeSet, "https://www.googleapis.com/auth/contacts.other.readonly") {
+		t.Errorf("expected AllScopes to contain %q", "https://www.googleapis.com/auth/contacts.other.readonly")
+	}
 	if !strings.Contains(scopeSet, "https://www.googleapis.com/auth/drive.readonly") {
 		t.Errorf("expected AllScopes to contain %q", "https://www.googleapis.com/auth/drive.readonly")
 	}
@@ -171,3 +183,259 @@ func TestCheckScopesMigration_MissingScope(t *testing.T) {
 // exists. The token now lives only in the OS keyring via credstore (§1.1 /
 // §2.3); legacy token.json is handled one-time by internal/keychain's
 // migration and covered by that package's tests.
+
+// seedRefreshEnv writes an OAuth client JSON whose token_uri points at a
+// test server answering with status and body, and stores tok.
+func seedRefreshEnv(t *testing.T, tok *oauth2.Token, status int, body string) {
+	t.Helper()
+	credtest.Setup(t)
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(status)
+		_, _ = w.Write([]byte(body))
+	}))
+	t.Cleanup(srv.Close)
+
+	client := `{"installed":{"client_id":"id","client_secret":"secret","auth_uri":"https://accounts.google.com/o/oauth2/auth","token_uri":"` + srv.URL + `","redirect_uris":["http://localhost"]}}`
+	if err := os.WriteFile(filepath.Join(credtest.ConfigDir(t), config.OAuthClientFile), []byte(client), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	st, err := keychain.OpenNoMigrate()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() { _ = st.Close() }()
+	if err := st.SetToken(tok); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func storedToken(t *testing.T) *oauth2.Token {
+	t.Helper()
+	st, err := keychain.OpenNoMigrate()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() { _ = st.Close() }()
+	tok, err := st.Token()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return tok
+}
+
+func TestRefreshToken(t *testing.T) {
+	valid := &oauth2.Token{AccessToken: "old", RefreshToken: "R", Expiry: time.Now().Add(time.Hour)}
+
+	t.Run("forces a refresh of an unexpired token and persists it", func(t *testing.T) {
+		seedRefreshEnv(t, valid, http.StatusOK, `{"access_token":"new","token_type":"Bearer","expires_in":3600}`)
+
+		tok, err := RefreshToken(context.Background())
+		if err != nil {
+			t.Fatalf("RefreshToken: %v", err)
+		}
+		if tok.AccessToken != "new" || !tok.Expiry.After(time.Now()) {
+			t.Errorf("refreshed token = %+v", tok)
+		}
+
+		stored := storedToken(t)
+		if stored.AccessToken != "new" {
+			t.Errorf("stored access token = %q, want %q", stored.AccessToken, "new")
+		}
+		if stored.RefreshToken != "R" {
+			t.Errorf("refresh token must be kept when the response omits it, got %q", stored.RefreshToken)
+		}
+	})
+
+	t.Run("revoked refresh token", func(t *testing.T) {
+		seedRefreshEnv(t, valid, http.StatusBadRequest, `{"error":"invalid_grant","error_description":"Token has been expired or revoked."}`)
+
+		_, err := RefreshToken(context.Background())
+		if err == nil || !strings.Contains(err.Error(), "invalid_grant") || !strings.Contains(err.Error(), "gro init") {
+			t.Fatalf("want invalid_grant error pointing at gro init, got %v", err)
+		}
+		if stored := storedToken(t); stored.AccessToken != "old" {
+			t.Errorf("a failed refresh must leave the stored token alone, got %q", stored.AccessToken)
+		}
+	})
+
+	t.Run("missing refresh token", func(t *testing.T) {
+		seedRefreshEnv(t, &oauth2.Token{AccessToken: "old"}, http.StatusOK, `{}`)
+
+		_, err := RefreshToken(context.Background())
+		if !errors.Is(err, ErrNoRefreshToken) {
+			t.Fatalf("want ErrNoRefreshToken, got %v", err)
+		}
+	})
+}
+
+func TestRequireScope(t *testing.T) {
+	calendarOnly := []string{
+		"https://www.googleapis.com/auth/calendar.readonly",
+		"https://www.googleapis.com/auth/calendar.events",
+	}
+
+	t.Run("token without a Gmail scope fails the preflight", func(t *testing.T) {
+		credtest.Setup(t)
+		if err := config.SaveConfig(&config.Config{GrantedScopes: calendarOnly}); err != nil {
+			t.Fatalf("SaveConfig: %v", err)
+		}
+
+		err := RequireScope("mail")
+		if !errors.Is(err, ErrNotAuthorized) {
+			t.Fatalf("got %v, want ErrNotAuthorized", err)
+		}
+		if !strings.Contains(err.Error(), "not authorized for Gmail") {
+			t.Errorf("error should name Gmail: %v", err)
+		}
+		if !strings.Contains(err.Error(), "run 'gro init --only mail,calendar'") {
+			t.Errorf("error should point at gro init --only, keeping calendar: %v", err)
+		}
+	})
+
+	t.Run("read-only Gmail scope passes", func(t *testing.T) {
+		credtest.Setup(t)
+		scopes := append([]string{"https://www.googleapis.com/auth/gmail.readonly"}, calendarOnly...)
+		if err := config.SaveConfig(&config.Config{GrantedScopes: scopes}); err != nil {
+			t.Fatalf("SaveConfig: %v", err)
+		}
+		if err := RequireScope("mail"); err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+	})
+
+	t.Run("token without a Drive scope fails the preflight", func(t *testing.T) {
+		credtest.Setup(t)
+		if err := config.SaveConfig(&config.Config{GrantedScopes: calendarOnly}); err != nil {
+			t.Fatalf("SaveConfig: %v", err)
+		}
+		err := RequireScope("drive")
+		if !errors.Is(err, ErrNotAuthorized) {
+			t.Fatalf("got %v, want ErrNotAuthorized", err)
+		}
+		if !strings.Contains(err.Error(), "not authorized for Drive") {
+			t.Errorf("error should name Drive: %v", err)
+		}
+	})
+
+	t.Run("no recorded scopes skips the check", func(t *testing.T) {
+		credtest.Setup(t)
+		if err := RequireScope("mail"); err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+	})
+}
+
+// seedRevokeEnv stores tok and points revokeEndpoint at a server answering
+// with status and body, returning the token values it was sent
+func seedRevokeEnv(t *testing.T, tok *oauth2.Token, status int, body string) *[]string {
+	t.Helper()
+	credtest.Setup(t)
+
+	var sent []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := r.ParseForm(); err != nil {
+			t.Errorf("ParseForm: %v", err)
+		}
+		sent = append(sent, r.PostForm.Get("token"))
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(status)
+		_, _ = w.Write([]byte(body))
+	}))
+	t.Cleanup(srv.Close)
+	orig := revokeEndpoint
+	revokeEndpoint = srv.URL
+	t.Cleanup(func() { revokeEndpoint = orig })
+
+	st, err := keychain.OpenNoMigrate()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() { _ = st.Close() }()
+	if err := st.SetToken(tok); err != nil {
+		t.Fatal(err)
+	}
+	return &sent
+}
+
+func hasStoredToken(t *testing.T) bool {
+	t.Helper()
+	st, err := keychain.OpenNoMigrate()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() { _ = st.Close() }()
+	has, err := st.HasToken()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return has
+}
+
+func TestRevokeToken(t *testing.T) {
+	t.Run("revokes the refresh token and deletes it", func(t *testing.T) {
+		sent := seedRevokeEnv(t, &oauth2.Token{AccessToken: "A", RefreshToken: "R"}, http.StatusOK, `{}`)
+
+		already, err := RevokeToken(context.Background())
+		if err != nil {
+			t.Fatalf("RevokeToken: %v", err)
+		}
+		if already {
+			t.Error("a token Google accepted should not be reported as already invalid")
+		}
+		if len(*sent) != 1 || (*sent)[0] != "R" {
+			t.Errorf("revoked %v, want the refresh token", *sent)
+		}
+		if hasStoredToken(t) {
+			t.Error("token should be deleted after revoking")
+		}
+	})
+
+	t.Run("falls back to the access token", func(t *testing.T) {
+		sent := seedRevokeEnv(t, &oauth2.Token{AccessToken: "A"}, http.StatusOK, `{}`)
+		if _, err := RevokeToken(context.Background()); err != nil {
+			t.Fatalf("RevokeToken: %v", err)
+		}
+		if len(*sent) != 1 || (*sent)[0] != "A" {
+			t.Errorf("revoked %v, want the access token", *sent)
+		}
+	})
+
+	t.Run("already invalid counts as revoked", func(t *testing.T) {
+		seedRevokeEnv(t, &oauth2.Token{AccessToken: "A", RefreshToken: "R"}, http.StatusBadRequest,
+			`{"error":"invalid_token","error_description":"Token expired or revoked"}`)
+
+		already, err := RevokeToken(context.Background())
+		if err != nil {
+			t.Fatalf("RevokeToken: %v", err)
+		}
+		if !already {
+			t.Error("want already invalid")
+		}
+		if hasStoredToken(t) {
+			t.Error("token should be deleted")
+		}
+	})
+
+	t.Run("other failures keep the token", func(t *testing.T) {
+		seedRevokeEnv(t, &oauth2.Token{AccessToken: "A", RefreshToken: "R"}, http.StatusInternalServerError, `{"error":"backend_error"}`)
+
+		_, err := RevokeToken(context.Background())
+		if err == nil || !strings.Contains(err.Error(), "backend_error") {
+			t.Fatalf("want revoke error, got %v", err)
+		}
+		if !hasStoredToken(t) {
+			t.Error("token must be kept so the revocation can be retried")
+		}
+	})
+
+	t.Run("no token", func(t *testing.T) {
+		credtest.Setup(t)
+		_, err := RevokeToken(context.Background())
+		if !errors.Is(err, keychain.ErrTokenNotFound) {
+			t.Fatalf("want ErrTokenNotFound, got %v", err)
+		}
+	})
+}
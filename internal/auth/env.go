@@ -0,0 +1,70 @@
+package auth
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+
+	"golang.org/x/oauth2"
+	"golang.org/x/oauth2/google"
+)
+
+// CredentialsEnvVar holds the base64-encoded OAuth client JSON. It is read
+// only when the client file named by config.yml does not exist, so CI jobs
+// and containers can run gro without writing the file to disk.
+const CredentialsEnvVar = "GRO_CREDENTIALS"
+
+// TokenEnvVar holds a base64-encoded oauth2.Token JSON object. It is read
+// only when the keyring holds no token, or when there is no usable keyring at
+// all (the file backend without a passphrase, as in a container).
+// Refreshed tokens are kept in memory and never written back.
+const TokenEnvVar = "GRO_TOKEN"
+
+// decodeEnv reads and base64-decodes the variable name. ok is false when it
+// is unset or empty. Whitespace is ignored, so the line-wrapped output of
+// base64(1) can be pasted as is.
+func decodeEnv(name string) (data []byte, ok bool, err error) {
+	v := strings.Join(strings.Fields(os.Getenv(name)), "")
+	if v == "" {
+		return nil, false, nil
+	}
+	// Never echo the value: it is a secret (§1.12), the variable name is not
+	data, err = base64.StdEncoding.DecodeString(v)
+	if err != nil {
+		return nil, true, fmt.Errorf("%s is not valid base64: %w", name, err)
+	}
+	return data, true, nil
+}
+
+// envOAuthConfig builds the OAuth config from CredentialsEnvVar. ok is false
+// when the variable is not set.
+func envOAuthConfig() (cfg *oauth2.Config, ok bool, err error) {
+	data, ok, err := decodeEnv(CredentialsEnvVar)
+	if !ok || err != nil {
+		return nil, ok, err
+	}
+	cfg, err = google.ConfigFromJSON(data, AllScopes...)
+	if err != nil {
+		return nil, true, fmt.Errorf("%s is not a valid OAuth client JSON: %w", CredentialsEnvVar, err)
+	}
+	return cfg, true, nil
+}
+
+// EnvToken reads the token from TokenEnvVar, checked the same way
+// 'gro set-credential' checks one. ok is false when the variable is not set.
+func EnvToken() (tok *oauth2.Token, ok bool, err error) {
+	data, ok, err := decodeEnv(TokenEnvVar)
+	if !ok || err != nil {
+		return nil, ok, err
+	}
+	tok = &oauth2.Token{}
+	if err := json.Unmarshal(data, tok); err != nil {
+		return nil, true, fmt.Errorf("%s is not a valid oauth2.Token JSON object: %w", TokenEnvVar, err)
+	}
+	if tok.AccessToken == "" && tok.RefreshToken == "" {
+		return nil, true, fmt.Errorf("%s has neither an access nor a refresh token", TokenEnvVar)
+	}
+	return tok, true, nil
+}
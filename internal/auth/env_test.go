@@ -0,0 +1,248 @@
+package auth
+
+import (
+	"context"
+	"encoding/base64"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"golang.org/x/oauth2"
+
+	"github.com/open-cli-collective/google-readonly/internal/config"
+	"github.com/open-cli-collective/google-readonly/internal/credtest"
+	"github.com/open-cli-collective/google-readonly/internal/keychain"
+)
+
+const envClientJSON = `{"installed":{"client_id":"env-id","client_secret":"secret","auth_uri":"https://accounts.google.com/o/oauth2/auth","token_uri":"https://oauth2.googleapis.com/token","redirect_uris":["http://localhost"]}}`
+
+func b64(s string) string { return base64.StdEncoding.EncodeToString([]byte(s)) }
+
+// setupEnvSource gives a test a hermetic config with no OAuth client file and
+// neither variable set
+func setupEnvSource(t *testing.T) {
+	t.Helper()
+	credtest.Setup(t)
+	t.Setenv(CredentialsEnvVar, "")
+	t.Setenv(TokenEnvVar, "")
+}
+
+func TestGetOAuthConfig_Env(t *testing.T) {
+	t.Run("reads the client JSON from the environment when the file is absent", func(t *testing.T) {
+		setupEnvSource(t)
+		t.Setenv(CredentialsEnvVar, b64(envClientJSON))
+
+		cfg, err := GetOAuthConfig()
+		if err != nil {
+			t.Fatalf("GetOAuthConfig: %v", err)
+		}
+		if cfg.ClientID != "env-id" {
+			t.Errorf("ClientID = %q, want env-id", cfg.ClientID)
+		}
+	})
+
+	t.Run("accepts line-wrapped base64", func(t *testing.T) {
+		setupEnvSource(t)
+		enc := b64(envClientJSON)
+		t.Setenv(CredentialsEnvVar, enc[:40]+"\n"+enc[40:]+"\n")
+
+		if _, err := GetOAuthConfig(); err != nil {
+			t.Fatalf("GetOAuthConfig: %v", err)
+		}
+	})
+
+	t.Run("the file wins over the environment", func(t *testing.T) {
+		setupEnvSource(t)
+		file := strings.Replace(envClientJSON, "env-id", "file-id", 1)
+		if err := os.WriteFile(filepath.Join(credtest.ConfigDir(t), config.OAuthClientFile), []byte(file), 0o600); err != nil {
+			t.Fatal(err)
+		}
+		t.Setenv(CredentialsEnvVar, b64(envClientJSON))
+
+		cfg, err := GetOAuthConfig()
+		if err != nil {
+			t.Fatalf("GetOAuthConfig: %v", err)
+		}
+		if cfg.ClientID != "file-id" {
+			t.Errorf("ClientID = %q, want file-id", cfg.ClientID)
+		}
+	})
+
+	for _, tt := range []struct {
+		name  string
+		value string
+		want  string
+	}{
+		{"neither file nor variable", "", "GRO_CREDENTIALS is not set"},
+		{"not base64", "{not base64}", "GRO_CREDENTIALS is not valid base64"},
+		{"not a client JSON", b64(`{"foo":1}`), "GRO_CREDENTIALS is not a valid OAuth client JSON"},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			setupEnvSource(t)
+			t.Setenv(CredentialsEnvVar, tt.value)
+
+			_, err := GetOAuthConfig()
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Fatalf("want error containing %q, got %v", tt.want, err)
+			}
+			if tt.value != "" && strings.Contains(err.Error(), tt.value) {
+				t.Errorf("error must not echo the variable's value: %v", err)
+			}
+		})
+	}
+}
+
+func TestEnvToken(t *testing.T) {
+	for _, tt := range []struct {
+		name  string
+		value string
+		ok    bool
+		want  string
+	}{
+		{"unset", "", false, ""},
+		{"valid", b64(`{"access_token":"A","refresh_token":"R"}`), true, ""},
+		{"not JSON", b64("nope"), true, "not a valid oauth2.Token JSON object"},
+		{"no tokens", b64(`{"token_type":"Bearer"}`), true, "neither an access nor a refresh token"},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv(TokenEnvVar, tt.value)
+
+			tok, ok, err := EnvToken()
+			if ok != tt.ok {
+				t.Fatalf("ok = %v, want %v", ok, tt.ok)
+			}
+			if tt.want == "" {
+				if err != nil {
+					t.Fatalf("EnvToken: %v", err)
+				}
+				if ok && (tok.AccessToken != "A" || tok.RefreshToken != "R") {
+					t.Errorf("token = %+v", tok)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Fatalf("want error containing %q, got %v", tt.want, err)
+			}
+		})
+	}
+}
+
+func TestGetHTTPClient_EnvToken(t *testing.T) {
+	setupEnvSource(t)
+	t.Setenv(CredentialsEnvVar, b64(envClientJSON))
+	t.Setenv(TokenEnvVar, b64(`{"access_token":"env-access","token_type":"Bearer","expiry":"`+time.Now().Add(time.Hour).Format(time.RFC3339)+`"}`))
+
+	var gotAuth string
+	srv := httptest.NewServer(http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+		gotAuth = r.Header.Get("Authorization")
+	}))
+	t.Cleanup(srv.Close)
+
+	client, err := GetHTTPClient(context.Background())
+	if err != nil {
+		t.Fatalf("GetHTTPClient: %v", err)
+	}
+	resp, err := client.Get(srv.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_ = resp.Body.Close()
+	if gotAuth != "Bearer env-access" {
+		t.Errorf("Authorization = %q, want the token from %s", gotAuth, TokenEnvVar)
+	}
+
+	// A keyring token takes precedence once there is one
+	st, err := keychain.OpenNoMigrate()
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = st.SetToken(&oauth2.Token{AccessToken: "stored", TokenType: "Bearer", Expiry: time.Now().Add(time.Hour)})
+	_ = st.Close()
+	if err != nil {
+		t.Fatal(err)
+	}
+	client, err = GetHTTPClient(context.Background())
+	if err != nil {
+		t.Fatalf("GetHTTPClient: %v", err)
+	}
+	resp, err = client.Get(srv.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_ = resp.Body.Close()
+	if gotAuth != "Bearer stored" {
+		t.Errorf("Authorization = %q, want the keyring token", gotAuth)
+	}
+}
+
+func TestGetHTTPClient_EnvTokenOnlyWithoutStoredToken(t *testing.T) {
+	t.Run("a broken keyring is reported, not replaced by the environment token", func(t *testing.T) {
+		setupEnvSource(t)
+		t.Setenv(CredentialsEnvVar, b64(envClientJSON))
+		t.Setenv(TokenEnvVar, b64(`{"access_token":"env-access"}`))
+		t.Setenv("GOOGLE_READONLY_KEYRING_BACKEND", "floppy")
+
+		if _, err := GetHTTPClient(context.Background()); err == nil {
+			t.Fatal("expected the keyring error, got a client")
+		}
+		if ns := CacheNamespace(); ns != "" {
+			t.Errorf("CacheNamespace() = %q, want the stored account's", ns)
+		}
+	})
+
+	t.Run("no keyring passphrase falls back to the environment token", func(t *testing.T) {
+		setupEnvSource(t)
+		t.Setenv(CredentialsEnvVar, b64(envClientJSON))
+		t.Setenv(TokenEnvVar, b64(`{"access_token":"env-access"}`))
+		t.Setenv("GOOGLE_READONLY_KEYRING_PASSPHRASE", "")
+
+		if _, err := GetHTTPClient(context.Background()); err != nil {
+			t.Fatalf("GetHTTPClient: %v", err)
+		}
+	})
+}
+
+func TestCacheNamespace_EnvToken(t *testing.T) {
+	setupEnvSource(t)
+	t.Setenv(CredentialsEnvVar, b64(envClientJSON))
+
+	namespace := func(tok string) string {
+		t.Helper()
+		t.Setenv(TokenEnvVar, b64(tok))
+		if _, err := GetHTTPClient(context.Background()); err != nil {
+			t.Fatalf("GetHTTPClient: %v", err)
+		}
+		return CacheNamespace()
+	}
+	alice := namespace(`{"access_token":"a","refresh_token":"alice"}`)
+	bob := namespace(`{"access_token":"a","refresh_token":"bob"}`)
+	if alice == "" || bob == "" || alice == bob {
+		t.Errorf("CacheNamespace() = %q and %q, want two distinct non-empty namespaces", alice, bob)
+	}
+	if strings.Contains(alice, "alice") {
+		t.Errorf("CacheNamespace() = %q leaks the token", alice)
+	}
+	if again := namespace(`{"access_token":"b","refresh_token":"alice"}`); again != alice {
+		t.Errorf("CacheNamespace() = %q after an access token refresh, want %q", again, alice)
+	}
+
+	st, err := keychain.OpenNoMigrate()
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = st.SetToken(&oauth2.Token{AccessToken: "stored", TokenType: "Bearer", Expiry: time.Now().Add(time.Hour)})
+	_ = st.Close()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := GetHTTPClient(context.Background()); err != nil {
+		t.Fatalf("GetHTTPClient: %v", err)
+	}
+	if ns := CacheNamespace(); ns != "" {
+		t.Errorf("CacheNamespace() = %q with a stored token, want \"\"", ns)
+	}
+}
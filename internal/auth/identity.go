@@ -0,0 +1,34 @@
+package auth
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"path"
+	"strings"
+)
+
+// envIdentity identifies the TokenEnvVar token GetHTTPClient authenticated
+// with, or is empty when it used the keyring's
+var envIdentity string
+
+// CacheNamespace returns the subdirectory of the response cache that belongs
+// to the identity GetHTTPClient authenticates as, or "" for the profile's
+// stored token. Each impersonated user of a service account gets their own. The cache holds account data (labels, calendars, contact
+// groups, shared drives) whose IDs end up in queries, so another identity
+// in the same profile must never read it. Call it after GetHTTPClient: only
+// then is it known whether the environment token was used.
+func CacheNamespace() string {
+	if UsingServiceAccount() {
+		return path.Join("sa", digest(strings.ToLower(serviceAccount.subject)))
+	}
+	if envIdentity != "" {
+		return path.Join("env", envIdentity)
+	}
+	return ""
+}
+
+// digest names a secret or an address in a path without revealing it
+func digest(s string) string {
+	sum := sha256.Sum256([]byte(s))
+	return hex.EncodeToString(sum[:8])
+}
@@ -0,0 +1,173 @@
+package auth
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"google.golang.org/api/calendar/v3"
+	"google.golang.org/api/drive/v3"
+	"google.golang.org/api/gmail/v1"
+	"google.golang.org/api/people/v1"
+
+	"github.com/open-cli-collective/google-readonly/internal/config"
+)
+
+// Domains are the services 'gro init --only' can authorize, in the order
+// they are listed to users
+var Domains = []string{"mail", "calendar", "contacts", "drive"}
+
+// domainScopes are the scopes gro requests for each domain. Together with
+// the profile scope they make up AllScopes.
+var domainScopes = map[string][]string{
+	"mail":     {gmail.GmailModifyScope},
+	"calendar": {calendar.CalendarReadonlyScope, calendar.CalendarEventsScope},
+	"contacts": {people.ContactsScope, people.ContactsOtherReadonlyScope},
+	"drive":    {drive.DriveReadonlyScope, drive.DriveMetadataScope},
+}
+
+// domainAccess are the scopes any one of which shows a token was authorized
+// for a domain. They include the read-only scopes older versions of gro
+// requested, so such tokens count as authorized and are asked to upgrade
+// rather than told they have no access.
+var domainAccess = map[string][]string{
+	"mail":     gmailScopes,
+	"calendar": {calendar.CalendarReadonlyScope, calendar.CalendarEventsScope, calendar.CalendarScope},
+	"contacts": {people.ContactsScope, people.ContactsReadonlyScope},
+	"drive":    {drive.DriveReadonlyScope, drive.DriveScope},
+}
+
+// domainNames are the product names used in messages about a domain
+var domainNames = map[string]string{
+	"mail":     "Gmail",
+	"calendar": "Calendar",
+	"contacts": "Contacts",
+	"drive":    "Drive",
+}
+
+// ScopesFor returns the scopes gro requests for one domain ("mail",
+// "calendar", "contacts" or "drive"), or nil for an unknown domain
+func ScopesFor(domain string) []string {
+	return slices.Clone(domainScopes[domain])
+}
+
+// ScopesForDomains returns the scopes to request for the given domains. The
+// profile scope is always included: 'gro me' and init's verification need
+// it whatever else is authorized.
+func ScopesForDomains(domains []string) []string {
+	var scopes []string
+	for _, d := range Domains {
+		if slices.Contains(domains, d) {
+			scopes = append(scopes, domainScopes[d]...)
+		}
+	}
+	return append(scopes, people.UserinfoProfileScope)
+}
+
+// ParseDomains parses a comma-separated --only value such as
+// "mail,calendar". An empty value selects every domain.
+func ParseDomains(s string) ([]string, error) {
+	if strings.TrimSpace(s) == "" {
+		return slices.Clone(Domains), nil
+	}
+	var domains []string
+	for _, part := range strings.Split(s, ",") {
+		d := strings.ToLower(strings.TrimSpace(part))
+		if d == "" {
+			continue
+		}
+		if !slices.Contains(Domains, d) {
+			return nil, fmt.Errorf("unknown --only value %q; valid values: %s", part, strings.Join(Domains, ", "))
+		}
+		if !slices.Contains(domains, d) {
+			domains = append(domains, d)
+		}
+	}
+	if len(domains) == 0 {
+		return nil, fmt.Errorf("--only needs at least one of: %s", strings.Join(Domains, ", "))
+	}
+	return domains, nil
+}
+
+// GrantedDomains returns the domains the granted scopes give access to, in
+// the order of Domains
+func GrantedDomains(granted []string) []string {
+	var domains []string
+	for _, d := range Domains {
+		for _, s := range granted {
+			if slices.Contains(domainAccess[d], s) {
+				domains = append(domains, d)
+				break
+			}
+		}
+	}
+	return domains
+}
+
+// InitCommand returns the 'gro init' invocation that authorizes domains:
+// plain 'gro init' for all of them, 'gro init --only ...' for a subset
+func InitCommand(domains []string) string {
+	var only []string
+	for _, d := range Domains {
+		if slices.Contains(domains, d) {
+			only = append(only, d)
+		}
+	}
+	if len(only) == len(Domains) {
+		return "gro init"
+	}
+	return "gro init --only " + strings.Join(only, ",")
+}
+
+// ErrNotAuthorized matches every NotAuthorizedError
+var ErrNotAuthorized = errors.New("not authorized")
+
+// NotAuthorizedError is returned by RequireScope when the recorded scopes
+// show the token was never granted access to a domain
+type NotAuthorizedError struct {
+	Domain string
+	// Granted are the domains the token is authorized for
+	Granted []string
+}
+
+func (e *NotAuthorizedError) Error() string {
+	name := domainNames[e.Domain]
+	return fmt.Sprintf("not authorized for %s: the stored token was granted no %s scope - run '%s' to add it",
+		name, name, InitCommand(append(slices.Clone(e.Granted), e.Domain)))
+}
+
+// Is makes errors.Is(err, ErrNotAuthorized) match any NotAuthorizedError
+func (e *NotAuthorizedError) Is(target error) bool {
+	return target == ErrNotAuthorized
+}
+
+// RequireScope is a domain's preflight, run before its client is created.
+// It fails with a NotAuthorizedError when the scopes recorded by 'gro init'
+// give no access to domain, so a token authorized for other services gets
+// a clear error instead of an opaque 403 from the API. Without a recorded
+// list (no config, or a token from an older gro) there is nothing to check
+// and it returns nil. The recorded scopes belong to the OAuth token, so a
+// service account is not checked either.
+func RequireScope(domain string) error {
+	if UsingServiceAccount() {
+		return nil
+	}
+	cfg, err := config.LoadConfigForRuntime()
+	if err != nil {
+		// GetHTTPClient reports config problems with better context
+		return nil
+	}
+	return checkScope(domain, cfg.GrantedScopes)
+}
+
+func checkScope(domain string, granted []string) error {
+	if len(granted) == 0 {
+		return nil
+	}
+	domains := GrantedDomains(granted)
+	if slices.Contains(domains, domain) {
+		return nil
+	}
+	return &NotAuthorizedError{Domain: domain, Granted: domains}
+}
@@ -0,0 +1,113 @@
+package auth
+
+import (
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestScopesFor(t *testing.T) {
+	t.Parallel()
+	got := ScopesFor("mail")
+	if len(got) != 1 || got[0] != "https://www.googleapis.com/auth/gmail.modify" {
+		t.Errorf("ScopesFor(mail) = %v", got)
+	}
+	if got := ScopesFor("photos"); got != nil {
+		t.Errorf("ScopesFor(photos) = %v, want nil", got)
+	}
+}
+
+func TestScopesForDomains(t *testing.T) {
+	t.Parallel()
+
+	all := ScopesForDomains(Domains)
+	if len(all) != len(AllScopes) {
+		t.Fatalf("every domain gives %d scopes, AllScopes has %d", len(all), len(AllScopes))
+	}
+	for _, s := range AllScopes {
+		if !slices.Contains(all, s) {
+			t.Errorf("AllScopes has %q, which no domain requests", s)
+		}
+	}
+
+	got := ScopesForDomains([]string{"calendar"})
+	want := []string{
+		"https://www.googleapis.com/auth/calendar.readonly",
+		"https://www.googleapis.com/auth/calendar.events",
+		"https://www.googleapis.com/auth/userinfo.profile",
+	}
+	if !slices.Equal(got, want) {
+		t.Errorf("ScopesForDomains(calendar) = %v, want %v", got, want)
+	}
+}
+
+func TestParseDomains(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		in      string
+		want    string
+		wantErr string
+	}{
+		{in: "", want: "mail,calendar,contacts,drive"},
+		{in: "mail", want: "mail"},
+		{in: " Calendar , mail,mail", want: "calendar,mail"},
+		{in: "mail,photos", wantErr: `unknown --only value "photos"`},
+		{in: ",", wantErr: "--only needs at least one of"},
+	}
+	for _, tt := range tests {
+		got, err := ParseDomains(tt.in)
+		if tt.wantErr != "" {
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("ParseDomains(%q) error = %v, want %q", tt.in, err, tt.wantErr)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("ParseDomains(%q): %v", tt.in, err)
+			continue
+		}
+		if strings.Join(got, ",") != tt.want {
+			t.Errorf("ParseDomains(%q) = %v, want %s", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestGrantedDomains(t *testing.T) {
+	t.Parallel()
+	got := GrantedDomains([]string{
+		"https://www.googleapis.com/auth/drive.readonly",
+		"https://www.googleapis.com/auth/gmail.readonly",
+		"https://www.googleapis.com/auth/userinfo.profile",
+	})
+	if strings.Join(got, ",") != "mail,drive" {
+		t.Errorf("GrantedDomains = %v, want [mail drive]", got)
+	}
+}
+
+func TestInitCommand(t *testing.T) {
+	t.Parallel()
+	if got := InitCommand(Domains); got != "gro init" {
+		t.Errorf("InitCommand(all) = %q", got)
+	}
+	if got := InitCommand([]string{"drive", "mail"}); got != "gro init --only mail,drive" {
+		t.Errorf("InitCommand(drive, mail) = %q", got)
+	}
+}
+
+func TestCheckScopesMigration_SubsetIsCurrent(t *testing.T) {
+	t.Parallel()
+	if msg := CheckScopesMigration(ScopesForDomains([]string{"mail"})); msg != "" {
+		t.Errorf("a complete mail-only grant should be current, got %q", msg)
+	}
+
+	msg := CheckScopesMigration([]string{
+		"https://www.googleapis.com/auth/calendar.readonly",
+		"https://www.googleapis.com/auth/userinfo.profile",
+	})
+	if !strings.Contains(msg, "gro init --only calendar") {
+		t.Errorf("expected re-auth for calendar only, got %q", msg)
+	}
+	if strings.Contains(msg, "Gmail") {
+		t.Errorf("domains never granted should not be asked for, got %q", msg)
+	}
+}
@@ -0,0 +1,154 @@
+package auth
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestUseServiceAccount(t *testing.T) {
+	t.Cleanup(func() { _ = UseServiceAccount("", "") })
+
+	for _, tt := range []struct {
+		name, keyFile, subject, want string
+	}{
+		{"neither", "", "", ""},
+		{"both", "key.json", "user@example.com", ""},
+		{"no key", "", "user@example.com", "needs a service account key"},
+		{"no subject", "key.json", "", "needs a user to impersonate"},
+		{"not an address", "key.json", "user", "want a user's email address"},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			err := UseServiceAccount(tt.keyFile, tt.subject)
+			if tt.want == "" {
+				if err != nil {
+					t.Fatalf("UseServiceAccount: %v", err)
+				}
+				if got := UsingServiceAccount(); got != (tt.keyFile != "") {
+					t.Errorf("UsingServiceAccount() = %v", got)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Fatalf("want error containing %q, got %v", tt.want, err)
+			}
+		})
+	}
+}
+
+// writeServiceAccountKey writes a service account key whose token_uri is
+// tokenURL and returns its path
+func writeServiceAccountKey(t *testing.T, tokenURL string) string {
+	t.Helper()
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, err := json.Marshal(map[string]string{
+		"type":           "service_account",
+		"client_email":   "gro@project.iam.gserviceaccount.com",
+		"private_key_id": "kid",
+		"private_key":    string(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der})),
+		"token_uri":      tokenURL,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	path := filepath.Join(t.TempDir(), "key.json")
+	if err := os.WriteFile(path, b, 0o600); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestGetHTTPClient_ServiceAccount(t *testing.T) {
+	setupEnvSource(t)
+	t.Cleanup(func() { _ = UseServiceAccount("", "") })
+
+	var claims struct {
+		Sub   string `json:"sub"`
+		Scope string `json:"scope"`
+	}
+	tokenSrv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := r.ParseForm(); err != nil {
+			t.Error(err)
+		}
+		parts := strings.Split(r.Form.Get("assertion"), ".")
+		if len(parts) == 3 {
+			payload, _ := base64.RawURLEncoding.DecodeString(parts[1])
+			_ = json.Unmarshal(payload, &claims)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"access_token":"sa-access","token_type":"Bearer","expires_in":3600}`))
+	}))
+	t.Cleanup(tokenSrv.Close)
+
+	var gotAuth string
+	apiSrv := httptest.NewServer(http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+		gotAuth = r.Header.Get("Authorization")
+	}))
+	t.Cleanup(apiSrv.Close)
+
+	// No OAuth client JSON and no token: neither is needed
+	if err := UseServiceAccount(writeServiceAccountKey(t, tokenSrv.URL), "user@example.com"); err != nil {
+		t.Fatal(err)
+	}
+	client, err := GetHTTPClient(context.Background())
+	if err != nil {
+		t.Fatalf("GetHTTPClient: %v", err)
+	}
+	resp, err := client.Get(apiSrv.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_ = resp.Body.Close()
+
+	if gotAuth != "Bearer sa-access" {
+		t.Errorf("Authorization = %q, want the service account's token", gotAuth)
+	}
+	if claims.Sub != "user@example.com" {
+		t.Errorf("assertion sub = %q, want the impersonated user", claims.Sub)
+	}
+	if claims.Scope != strings.Join(ServiceAccountScopes, " ") {
+		t.Errorf("assertion scope = %q, want the read-only scopes", claims.Scope)
+	}
+	if err := RequireScope("mail"); err != nil {
+		t.Errorf("RequireScope with a service account: %v", err)
+	}
+}
+
+func TestGetHTTPClient_ServiceAccountBadKey(t *testing.T) {
+	t.Cleanup(func() { _ = UseServiceAccount("", "") })
+
+	if err := UseServiceAccount(filepath.Join(t.TempDir(), "missing.json"), "user@example.com"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := GetHTTPClient(context.Background()); err == nil || !strings.Contains(err.Error(), "reading service account key") {
+		t.Fatalf("want a read error, got %v", err)
+	}
+
+	bad := filepath.Join(t.TempDir(), "bad.json")
+	if err := os.WriteFile(bad, []byte(`{"type":"authorized_user"}`), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if err := UseServiceAccount(bad, "user@example.com"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := GetHTTPClient(context.Background()); err == nil || !strings.Contains(err.Error(), "service account key") {
+		t.Fatalf("want a key error, got %v", err)
+	}
+}
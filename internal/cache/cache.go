@@ -1,4 +1,5 @@
-// Package cache wraps cli-common/cache for gro's Drive metadata cache.
+// Package cache wraps cli-common/cache for gro's cache of slow-changing API
+// metadata and responses.
 //
 // Per cli-common/docs/working-with-state.md §4, gro's cache is disposable
 // state at os.UserCacheDir()/google-readonly (via statedir.Cache). Writes are
@@ -18,7 +19,9 @@ import (
 	"time"
 
 	clicache "github.com/open-cli-collective/cli-common/cache"
+	gmailapi "google.golang.org/api/gmail/v1"
 
+	"github.com/open-cli-collective/google-readonly/internal/auth"
 	"github.com/open-cli-collective/google-readonly/internal/config"
 )
 
@@ -35,6 +38,11 @@ const (
 	// drivesTTL is the §4.4 hard-coded per-resource TTL for shared drives —
 	// same 24-hour default the user-configurable knob previously defaulted to.
 	drivesTTL = "24h"
+	// labelsResource is the cli-common cache resource name for Gmail labels.
+	labelsResource = "labels"
+	// labelsTTL is shorter than drivesTTL because the cached labels carry
+	// message counts, which go stale much sooner than label names.
+	labelsTTL = "1h"
 )
 
 // CachedDrive represents a cached shared drive entry. Public so callers
@@ -50,7 +58,8 @@ type Cache struct {
 }
 
 // New creates a new Cache instance rooted at the OS cache dir (B2b via
-// cli-common/statedir). It also runs a transparent, best-effort one-time
+// cli-common/statedir), in the subdirectory auth.CacheNamespace names for an
+// identity other than the profile's stored token. It also runs a transparent, best-effort one-time
 // relocation of a pre-B2b cache that lived inside the config dir; relocation
 // never fails New (the cache is disposable — it simply repopulates).
 func New() (*Cache, error) {
@@ -59,8 +68,12 @@ func New() (*Cache, error) {
 		return nil, err
 	}
 	migrateLegacyCacheDir(cacheDir)
+	root := cacheDir
+	if ns := auth.CacheNamespace(); ns != "" {
+		root = filepath.Join(cacheDir, filepath.FromSlash(ns))
+	}
 	return &Cache{
-		loc: clicache.Locator{Root: cacheDir, InstanceKey: instanceKey},
+		loc: clicache.Locator{Root: root, InstanceKey: instanceKey},
 	}, nil
 }
 
@@ -126,11 +139,19 @@ func tryCarryLegacyCache(legacy, newDir string) {
 	_ = os.RemoveAll(legacy)
 }
 
+// NoCache makes every read a miss, so callers fetch from the API. Writes
+// still happen, so a --no-cache run also refreshes what it fetched. Set from
+// the global --no-cache flag.
+var NoCache bool
+
 // GetDrives returns cached shared drives, or nil if cache is stale, missing,
 // or corrupt. Corrupt-as-miss preserves the pre-MON-5371 behavior: caches
 // are disposable, so a JSON parse error self-heals on the next API call. I/O
 // errors (read failure, permission denied) propagate.
 func (c *Cache) GetDrives() ([]*CachedDrive, error) {
+	if NoCache {
+		return nil, nil
+	}
 	env, err := clicache.ReadResource[[]*CachedDrive](c.loc, drivesResource)
 	switch {
 	case errors.Is(err, clicache.ErrCacheMiss):
@@ -158,6 +179,92 @@ func (c *Cache) SetDrives(drives []*CachedDrive) error {
 	return nil
 }
 
+// GetLabels returns cached Gmail labels, or nil if the cache is stale,
+// missing, or corrupt. Like GetDrives, only I/O errors propagate. Labels are
+// stored as the API returns them, so *Cache satisfies gmail.LabelCache.
+func (c *Cache) GetLabels() ([]*gmailapi.Label, error) {
+	if NoCache {
+		return nil, nil
+	}
+	env, err := clicache.ReadResource[[]*gmailapi.Label](c.loc, labelsResource)
+	switch {
+	case errors.Is(err, clicache.ErrCacheMiss):
+		return nil, nil
+	case err != nil:
+		var syn *json.SyntaxError
+		var ute *json.UnmarshalTypeError
+		if errors.As(err, &syn) || errors.As(err, &ute) {
+			return nil, nil // corrupt → miss (self-heals on next write)
+		}
+		return nil, fmt.Errorf("reading labels cache: %w", err)
+	}
+
+	if clicache.Classify(env.FetchedAt, env.TTL, nowFn()) == clicache.StatusStale {
+		return nil, nil // stale → miss
+	}
+	return env.Data, nil
+}
+
+// SetLabels atomically writes the labels cache with its hard-coded TTL.
+func (c *Cache) SetLabels(labels []*gmailapi.Label) error {
+	if err := clicache.WriteResource(c.loc, labelsResource, labelsTTL, labels); err != nil {
+		return fmt.Errorf("writing labels cache: %w", err)
+	}
+	return nil
+}
+
+// Get returns the response cached under key while it is fresh. Any problem
+// reading it (missing, stale, corrupt, unreadable) is a miss: the caller
+// fetches from the API and the next Set repairs the entry.
+func (c *Cache) Get(key string) ([]byte, bool) {
+	if NoCache {
+		return nil, false
+	}
+	env, err := clicache.ReadResource[[]byte](c.loc, key)
+	if err != nil {
+		return nil, false
+	}
+	if clicache.Classify(env.FetchedAt, env.TTL, nowFn()) == clicache.StatusStale {
+		return nil, false
+	}
+	return env.Data, true
+}
+
+// Set atomically caches data under key for ttl. Keys are resource names
+// (letters, digits, '.', '_' and '-') and must not clash with the named
+// resources above.
+func (c *Cache) Set(key string, data []byte, ttl time.Duration) error {
+	if err := clicache.WriteResource(c.loc, key, ttl.String(), data); err != nil {
+		return fmt.Errorf("writing %s cache: %w", key, err)
+	}
+	return nil
+}
+
+// GetOrFetch returns the value cached under key, or calls fetch and caches
+// its result for ttl. A nil cache always fetches, and failing to encode or
+// write the result does not fail the call.
+func GetOrFetch[T any](c *Cache, key string, ttl time.Duration, fetch func() (T, error)) (T, error) {
+	if c != nil {
+		if data, ok := c.Get(key); ok {
+			var v T
+			if err := json.Unmarshal(data, &v); err == nil {
+				return v, nil
+			}
+		}
+	}
+
+	v, err := fetch()
+	if err != nil {
+		return v, err
+	}
+	if c != nil {
+		if data, err := json.Marshal(v); err == nil {
+			_ = c.Set(key, data, ttl) // Ignore cache write errors
+		}
+	}
+	return v, nil
+}
+
 // DrivesStatus reports the freshness of the cached drives entry without
 // fetching from the API. Returns (fetchedAt, ttl, status, now). A missing
 // or corrupt envelope returns (time.Time{}, drivesTTL, StatusUninitialized,
@@ -1,14 +1,19 @@
 package cache
 
 import (
+	"context"
+	"encoding/base64"
 	"os"
 	"path/filepath"
 	"testing"
 	"time"
 
 	"github.com/open-cli-collective/cli-common/statedirtest"
+	gmailapi "google.golang.org/api/gmail/v1"
 
+	"github.com/open-cli-collective/google-readonly/internal/auth"
 	"github.com/open-cli-collective/google-readonly/internal/config"
+	"github.com/open-cli-collective/google-readonly/internal/credtest"
 	"github.com/open-cli-collective/google-readonly/internal/testutil"
 )
 
@@ -130,6 +135,167 @@ func TestCache_CorruptedCache(t *testing.T) {
 	})
 }
 
+func TestCache_GetSetLabels(t *testing.T) {
+	hermetic(t)
+	c, err := New()
+	testutil.NoError(t, err)
+	defer c.Clear()
+
+	t.Run("returns nil for missing cache", func(t *testing.T) {
+		labels, err := c.GetLabels()
+		testutil.NoError(t, err)
+		testutil.Nil(t, labels)
+	})
+
+	t.Run("stores and retrieves labels", func(t *testing.T) {
+		testutil.NoError(t, c.SetLabels([]*gmailapi.Label{
+			{Id: "INBOX", Name: "INBOX", Type: "system", MessagesTotal: 10, MessagesUnread: 2},
+			{Id: "Label_1", Name: "Work", Type: "user"},
+		}))
+
+		labels, err := c.GetLabels()
+		testutil.NoError(t, err)
+		testutil.Len(t, labels, 2)
+		testutil.Equal(t, labels[0].Id, "INBOX")
+		testutil.Equal(t, labels[0].MessagesUnread, int64(2))
+		testutil.Equal(t, labels[1].Name, "Work")
+	})
+
+	t.Run("does not disturb the drives resource", func(t *testing.T) {
+		drives, err := c.GetDrives()
+		testutil.NoError(t, err)
+		testutil.Nil(t, drives)
+	})
+
+	t.Run("classifies envelope older than the TTL as miss", func(t *testing.T) {
+		testutil.NoError(t, c.SetLabels([]*gmailapi.Label{{Id: "INBOX", Name: "INBOX"}}))
+
+		origNow := nowFn
+		nowFn = func() time.Time { return time.Now().Add(2 * time.Hour) }
+		defer func() { nowFn = origNow }()
+
+		labels, err := c.GetLabels()
+		testutil.NoError(t, err)
+		testutil.Nil(t, labels)
+	})
+
+	t.Run("malformed JSON treated as miss", func(t *testing.T) {
+		path := filepath.Join(c.loc.Root, c.loc.InstanceKey, labelsResource+".json")
+		testutil.NoError(t, os.WriteFile(path, []byte("not valid json"), 0o600))
+
+		labels, err := c.GetLabels()
+		testutil.NoError(t, err)
+		testutil.Nil(t, labels)
+	})
+}
+
+func TestCache_GetSet(t *testing.T) {
+	hermetic(t)
+	c, err := New()
+	testutil.NoError(t, err)
+	defer c.Clear()
+
+	t.Run("missing key is a miss", func(t *testing.T) {
+		_, ok := c.Get("calendars")
+		testutil.False(t, ok)
+	})
+
+	t.Run("stores and retrieves bytes", func(t *testing.T) {
+		testutil.NoError(t, c.Set("calendars", []byte(`[{"id":"primary"}]`), time.Hour))
+		data, ok := c.Get("calendars")
+		testutil.True(t, ok)
+		testutil.Equal(t, string(data), `[{"id":"primary"}]`)
+	})
+
+	t.Run("expires after the TTL", func(t *testing.T) {
+		testutil.NoError(t, c.Set("calendars", []byte("x"), time.Hour))
+
+		origNow := nowFn
+		nowFn = func() time.Time { return time.Now().Add(2 * time.Hour) }
+		defer func() { nowFn = origNow }()
+
+		_, ok := c.Get("calendars")
+		testutil.False(t, ok)
+	})
+
+	t.Run("corrupt entry is a miss", func(t *testing.T) {
+		path := filepath.Join(c.loc.Root, c.loc.InstanceKey, "calendars.json")
+		testutil.NoError(t, os.WriteFile(path, []byte("not valid json"), 0o600))
+		_, ok := c.Get("calendars")
+		testutil.False(t, ok)
+	})
+
+	t.Run("rejects an unsafe key", func(t *testing.T) {
+		testutil.Error(t, c.Set("../escape", []byte("x"), time.Hour))
+	})
+}
+
+func TestNoCache(t *testing.T) {
+	hermetic(t)
+	c, err := New()
+	testutil.NoError(t, err)
+	defer c.Clear()
+
+	testutil.NoError(t, c.Set("calendars", []byte("x"), time.Hour))
+	testutil.NoError(t, c.SetDrives([]*CachedDrive{{ID: "d1", Name: "Eng"}}))
+	testutil.NoError(t, c.SetLabels([]*gmailapi.Label{{Id: "INBOX", Name: "INBOX"}}))
+
+	NoCache = true
+	defer func() { NoCache = false }()
+
+	_, ok := c.Get("calendars")
+	testutil.False(t, ok)
+	drives, err := c.GetDrives()
+	testutil.NoError(t, err)
+	testutil.Nil(t, drives)
+	labels, err := c.GetLabels()
+	testutil.NoError(t, err)
+	testutil.Nil(t, labels)
+
+	// Writes still land, so a --no-cache run refreshes the cache
+	testutil.NoError(t, c.Set("calendars", []byte("y"), time.Hour))
+	NoCache = false
+	data, ok := c.Get("calendars")
+	testutil.True(t, ok)
+	testutil.Equal(t, string(data), "y")
+}
+
+func TestGetOrFetch(t *testing.T) {
+	hermetic(t)
+	c, err := New()
+	testutil.NoError(t, err)
+	defer c.Clear()
+
+	calls := 0
+	fetch := func() ([]string, error) {
+		calls++
+		return []string{"a", "b"}, nil
+	}
+
+	got, err := GetOrFetch(c, "letters", time.Hour, fetch)
+	testutil.NoError(t, err)
+	testutil.Len(t, got, 2)
+	got, err = GetOrFetch(c, "letters", time.Hour, fetch)
+	testutil.NoError(t, err)
+	testutil.Equal(t, got[1], "b")
+	testutil.Equal(t, calls, 1)
+
+	t.Run("nil cache always fetches", func(t *testing.T) {
+		_, err := GetOrFetch(nil, "letters", time.Hour, fetch)
+		testutil.NoError(t, err)
+		testutil.Equal(t, calls, 2)
+	})
+
+	t.Run("fetch errors are not cached", func(t *testing.T) {
+		_, err := GetOrFetch(c, "failing", time.Hour, func() ([]string, error) {
+			return nil, os.ErrPermission
+		})
+		testutil.Error(t, err)
+		_, ok := c.Get("failing")
+		testutil.False(t, ok)
+	})
+}
+
 func TestCache_DrivesStatus(t *testing.T) {
 	hermetic(t)
 
@@ -339,3 +505,63 @@ func TestMigrateLegacyCacheDir(t *testing.T) {
 		testutil.True(t, os.IsNotExist(statErr))
 	})
 }
+
+func TestNew_EnvTokenIdentitiesDoNotShare(t *testing.T) {
+	credtest.Setup(t)
+	t.Setenv(auth.CredentialsEnvVar, base64.StdEncoding.EncodeToString([]byte(
+		`{"installed":{"client_id":"id","client_secret":"secret","auth_uri":"https://accounts.google.com/o/oauth2/auth","token_uri":"https://oauth2.googleapis.com/token","redirect_uris":["http://localhost"]}}`)))
+
+	// newAs returns the cache the next command sees when GRO_TOKEN holds
+	// refresh token rt
+	newAs := func(rt string) *Cache {
+		t.Helper()
+		t.Setenv(auth.TokenEnvVar, base64.StdEncoding.EncodeToString([]byte(`{"refresh_token":"`+rt+`"}`)))
+		_, err := auth.GetHTTPClient(context.Background())
+		testutil.NoError(t, err)
+		c, err := New()
+		testutil.NoError(t, err)
+		return c
+	}
+
+	alice := newAs("alice")
+	testutil.NoError(t, alice.SetLabels([]*gmailapi.Label{{Id: "Label_1", Name: "Alice"}}))
+
+	labels, err := newAs("bob").GetLabels()
+	testutil.NoError(t, err)
+	testutil.Nil(t, labels)
+
+	labels, err = newAs("alice").GetLabels()
+	testutil.NoError(t, err)
+	testutil.Len(t, labels, 1)
+}
+
+func TestNew_ImpersonatedUsersDoNotShare(t *testing.T) {
+	hermetic(t)
+	t.Cleanup(func() { _ = auth.UseServiceAccount("", "") })
+
+	newAs := func(subject string) *Cache {
+		t.Helper()
+		testutil.NoError(t, auth.UseServiceAccount("key.json", subject))
+		c, err := New()
+		testutil.NoError(t, err)
+		return c
+	}
+
+	alice := newAs("alice@example.com")
+	testutil.NoError(t, alice.SetLabels([]*gmailapi.Label{{Id: "Label_1", Name: "Alice"}}))
+
+	labels, err := newAs("bob@example.com").GetLabels()
+	testutil.NoError(t, err)
+	testutil.Nil(t, labels)
+
+	testutil.NoError(t, auth.UseServiceAccount("", ""))
+	stored, err := New()
+	testutil.NoError(t, err)
+	labels, err = stored.GetLabels()
+	testutil.NoError(t, err)
+	testutil.Nil(t, labels)
+
+	labels, err = newAs("alice@example.com").GetLabels()
+	testutil.NoError(t, err)
+	testutil.Len(t, labels, 1)
+}
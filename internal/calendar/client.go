@@ -42,12 +42,23 @@ func (c *Client) ListCalendars(ctx context.Context) ([]*calendar.CalendarListEnt
 	return resp.Items, nil
 }
 
+// ListEventsOptions controls how events.list expands and orders results.
+type ListEventsOptions struct {
+	// SingleEvents expands recurring events into individual instances.
+	SingleEvents bool
+	// OrderBy is "startTime" (requires SingleEvents) or "updated". Empty
+	// leaves the API's default ordering.
+	OrderBy string
+}
+
 // ListEvents returns events from the specified calendar within the given time range
-func (c *Client) ListEvents(ctx context.Context, calendarID string, timeMin, timeMax string, maxResults int64) ([]*calendar.Event, error) {
+func (c *Client) ListEvents(ctx context.Context, calendarID string, timeMin, timeMax string, maxResults int64, opts ListEventsOptions) ([]*calendar.Event, error) {
 	call := c.service.Events.List(calendarID).
-		SingleEvents(true).
-		OrderBy("startTime")
+		SingleEvents(opts.SingleEvents)
 
+	if opts.OrderBy != "" {
+		call = call.OrderBy(opts.OrderBy)
+	}
 	if timeMin != "" {
 		call = call.TimeMin(timeMin)
 	}
@@ -65,6 +76,25 @@ func (c *Client) ListEvents(ctx context.Context, calendarID string, timeMin, tim
 	return resp.Items, nil
 }
 
+// QueryFreeBusy returns busy intervals for the given calendars between
+// timeMin and timeMax (RFC3339)
+func (c *Client) QueryFreeBusy(ctx context.Context, calendarIDs []string, timeMin, timeMax string) (*FreeBusyResult, error) {
+	items := make([]*calendar.FreeBusyRequestItem, len(calendarIDs))
+	for i, id := range calendarIDs {
+		items[i] = &calendar.FreeBusyRequestItem{Id: id}
+	}
+
+	resp, err := c.service.Freebusy.Query(&calendar.FreeBusyRequest{
+		TimeMin: timeMin,
+		TimeMax: timeMax,
+		Items:   items,
+	}).Context(ctx).Do()
+	if err != nil {
+		return nil, fmt.Errorf("querying free/busy: %w", err)
+	}
+	return ParseFreeBusy(resp, calendarIDs), nil
+}
+
 // GetEvent retrieves a single event by ID
 func (c *Client) GetEvent(ctx context.Context, calendarID, eventID string) (*calendar.Event, error) {
 	event, err := c.service.Events.Get(calendarID, eventID).Context(ctx).Do()
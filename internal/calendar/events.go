@@ -1,6 +1,8 @@
 package calendar
 
 import (
+	"net/url"
+	"strings"
 	"time"
 
 	"google.golang.org/api/calendar/v3"
@@ -17,9 +19,15 @@ type Event struct {
 	Status      string     `json:"status"`
 	HTMLLink    string     `json:"htmlLink,omitempty"`
 	HangoutLink string     `json:"hangoutLink,omitempty"`
-	Organizer   *Person    `json:"organizer,omitempty"`
-	Attendees   []Person   `json:"attendees,omitempty"`
-	AllDay      bool       `json:"allDay"`
+	// Transparency is "opaque" (busy) or "transparent" (free). The API
+	// omits it for opaque events, so empty means busy.
+	Transparency string   `json:"transparency,omitempty"`
+	Organizer    *Person  `json:"organizer,omitempty"`
+	Attendees    []Person `json:"attendees,omitempty"`
+	AllDay       bool     `json:"allDay"`
+	// RecurringEventID is set on occurrences of a recurring event and
+	// names the series they belong to.
+	RecurringEventID string `json:"recurringEventId,omitempty"`
 }
 
 // EventTime represents a date or datetime
@@ -40,24 +48,29 @@ type Person struct {
 
 // CalendarInfo represents a simplified calendar for output
 type CalendarInfo struct {
-	ID          string `json:"id"`
-	Summary     string `json:"summary"`
-	Description string `json:"description,omitempty"`
-	Primary     bool   `json:"primary"`
-	AccessRole  string `json:"accessRole"`
-	TimeZone    string `json:"timeZone,omitempty"`
+	ID              string `json:"id"`
+	Summary         string `json:"summary"`
+	Description     string `json:"description,omitempty"`
+	Primary         bool   `json:"primary"`
+	AccessRole      string `json:"accessRole"`
+	TimeZone        string `json:"timeZone,omitempty"`
+	BackgroundColor string `json:"backgroundColor,omitempty"` // hex, such as "#9fe1e7"
+	Selected        bool   `json:"selected"`                  // shown in the Calendar UI
+	Hidden          bool   `json:"hidden,omitempty"`
 }
 
 // ParseEvent converts a Google Calendar API event to our simplified Event
 func ParseEvent(e *calendar.Event) *Event {
 	event := &Event{
-		ID:          e.Id,
-		Summary:     e.Summary,
-		Description: e.Description,
-		Location:    e.Location,
-		Status:      e.Status,
-		HTMLLink:    e.HtmlLink,
-		HangoutLink: e.HangoutLink,
+		ID:               e.Id,
+		Summary:          e.Summary,
+		Description:      e.Description,
+		Location:         e.Location,
+		Status:           e.Status,
+		HTMLLink:         e.HtmlLink,
+		HangoutLink:      e.HangoutLink,
+		Transparency:     e.Transparency,
+		RecurringEventID: e.RecurringEventId,
 	}
 
 	// Parse start time
@@ -108,15 +121,52 @@ func ParseEvent(e *calendar.Event) *Event {
 // ParseCalendar converts a Google Calendar API calendar entry to our simplified CalendarInfo
 func ParseCalendar(c *calendar.CalendarListEntry) *CalendarInfo {
 	return &CalendarInfo{
-		ID:          c.Id,
-		Summary:     c.Summary,
-		Description: c.Description,
-		Primary:     c.Primary,
-		AccessRole:  c.AccessRole,
-		TimeZone:    c.TimeZone,
+		ID:              c.Id,
+		Summary:         c.Summary,
+		Description:     c.Description,
+		Primary:         c.Primary,
+		AccessRole:      c.AccessRole,
+		TimeZone:        c.TimeZone,
+		BackgroundColor: c.BackgroundColor,
+		Selected:        c.Selected,
+		Hidden:          c.Hidden,
 	}
 }
 
+// IsBusy reports whether the event blocks time on the calendar. Events
+// marked "transparent" (shown as free) do not.
+func (e *Event) IsBusy() bool {
+	return e.Transparency != "transparent"
+}
+
+// HasAttendee reports whether email is among the event's attendees,
+// ignoring case
+func (e *Event) HasAttendee(email string) bool {
+	for _, a := range e.Attendees {
+		if strings.EqualFold(a.Email, email) {
+			return true
+		}
+	}
+	return false
+}
+
+// OrganizedBy reports whether email is the event's organizer, ignoring case
+func (e *Event) OrganizedBy(email string) bool {
+	return e.Organizer != nil && strings.EqualFold(e.Organizer.Email, email)
+}
+
+// SelfStatus returns the signed-in user's response status on the event
+// ("needsAction", "accepted", "declined" or "tentative"), or "" when they are
+// not among its attendees
+func (e *Event) SelfStatus() string {
+	for _, a := range e.Attendees {
+		if a.Self {
+			return a.Status
+		}
+	}
+	return ""
+}
+
 // GetStartTime returns the event start time as a time.Time
 func (e *Event) GetStartTime() (time.Time, error) {
 	if e.Start == nil {
@@ -159,13 +209,29 @@ func (e *Event) FormatStartTime() string {
 
 // FormatTimeRange returns a human-readable time range string
 func (e *Event) FormatTimeRange() string {
+	return e.FormatTimeRangeIn(nil)
+}
+
+// FormatTimeRangeIn is FormatTimeRange with timed events shown in loc
+// rather than their own zone. All-day events name dates, not instants, and
+// are left as they are. A nil loc keeps the event's zone.
+func (e *Event) FormatTimeRangeIn(loc *time.Location) string {
 	start, err := e.GetStartTime()
 	if err != nil {
 		return ""
 	}
+	if loc != nil && !e.AllDay {
+		start = start.In(loc)
+	}
 	end, err := e.GetEndTime()
 	if err != nil {
-		return e.FormatStartTime()
+		if e.AllDay {
+			return start.Format("Mon, Jan 2, 2006")
+		}
+		return start.Format("Mon, Jan 2, 2006 3:04 PM")
+	}
+	if loc != nil && !e.AllDay {
+		end = end.In(loc)
 	}
 
 	if e.AllDay {
@@ -180,3 +246,43 @@ func (e *Event) FormatTimeRange() string {
 	}
 	return start.Format("Mon, Jan 2, 2006 3:04 PM") + " - " + end.Format("Mon, Jan 2, 2006 3:04 PM")
 }
+
+// mapsSearchURL is the Google Maps search endpoint used for directions links.
+const mapsSearchURL = "https://www.google.com/maps/search/?api=1&query="
+
+// virtualLocationHints are substrings that mark a location token as a
+// meeting link rather than a physical place.
+var virtualLocationHints = []string{
+	"://",
+	"meet.google.com",
+	"zoom.us",
+	"teams.microsoft.com",
+	"webex.com",
+}
+
+// DirectionsURL returns a Google Maps link for the event's physical location.
+// Returns an empty string when the event has no location or the location is
+// nothing but a virtual meeting link. Mixed values that carry an address
+// alongside a link still get directions.
+func (e *Event) DirectionsURL() string {
+	location := strings.TrimSpace(e.Location)
+	if location == "" || isMeetingLink(location) {
+		return ""
+	}
+	return mapsSearchURL + url.QueryEscape(location)
+}
+
+// isMeetingLink reports whether location is a single meeting link with no
+// surrounding address text.
+func isMeetingLink(location string) bool {
+	if strings.ContainsAny(location, " \t\n,") {
+		return false
+	}
+	lower := strings.ToLower(location)
+	for _, hint := range virtualLocationHints {
+		if strings.Contains(lower, hint) {
+			return true
+		}
+	}
+	return false
+}
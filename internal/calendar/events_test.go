@@ -3,6 +3,7 @@ package calendar
 import (
 	"strings"
 	"testing"
+	"time"
 
 	"google.golang.org/api/calendar/v3"
 )
@@ -170,6 +171,24 @@ func TestParseEvent(t *testing.T) {
 			t.Errorf("got %v, want %v", got, "https://meet.google.com/abc-defg-hij")
 		}
 	})
+
+	t.Run("parses recurring event occurrence", func(t *testing.T) {
+		t.Parallel()
+		apiEvent := &calendar.Event{
+			Id:               "standup_20260124T090000Z",
+			Summary:          "Standup",
+			RecurringEventId: "standup",
+			Start: &calendar.EventDateTime{
+				DateTime: "2026-01-24T09:00:00Z",
+			},
+		}
+
+		event := ParseEvent(apiEvent)
+
+		if got := event.RecurringEventID; got != "standup" {
+			t.Errorf("got %v, want %v", got, "standup")
+		}
+	})
 }
 
 func TestParseCalendar(t *testing.T) {
@@ -177,12 +196,14 @@ func TestParseCalendar(t *testing.T) {
 	t.Run("parses calendar entry", func(t *testing.T) {
 		t.Parallel()
 		apiCal := &calendar.CalendarListEntry{
-			Id:          "primary",
-			Summary:     "My Calendar",
-			Description: "Personal calendar",
-			Primary:     true,
-			AccessRole:  "owner",
-			TimeZone:    "America/New_York",
+			Id:              "primary",
+			Summary:         "My Calendar",
+			Description:     "Personal calendar",
+			Primary:         true,
+			AccessRole:      "owner",
+			TimeZone:        "America/New_York",
+			BackgroundColor: "#9fe1e7",
+			Selected:        true,
 		}
 
 		cal := ParseCalendar(apiCal)
@@ -205,6 +226,15 @@ func TestParseCalendar(t *testing.T) {
 		if got := cal.TimeZone; got != "America/New_York" {
 			t.Errorf("got %v, want %v", got, "America/New_York")
 		}
+		if got := cal.BackgroundColor; got != "#9fe1e7" {
+			t.Errorf("got %v, want %v", got, "#9fe1e7")
+		}
+		if !cal.Selected {
+			t.Error("Selected: got false, want true")
+		}
+		if cal.Hidden {
+			t.Error("Hidden: got true, want false")
+		}
 	})
 
 	t.Run("parses shared calendar", func(t *testing.T) {
@@ -214,6 +244,7 @@ func TestParseCalendar(t *testing.T) {
 			Summary:    "Team Calendar",
 			Primary:    false,
 			AccessRole: "reader",
+			Hidden:     true,
 		}
 
 		cal := ParseCalendar(apiCal)
@@ -221,6 +252,9 @@ func TestParseCalendar(t *testing.T) {
 		if cal.Primary {
 			t.Error("got true, want false")
 		}
+		if !cal.Hidden {
+			t.Error("Hidden: got false, want true")
+		}
 		if got := cal.AccessRole; got != "reader" {
 			t.Errorf("got %v, want %v", got, "reader")
 		}
@@ -330,3 +364,224 @@ func TestEventFormatTimeRange(t *testing.T) {
 		}
 	})
 }
+
+func TestEventFormatTimeRangeIn(t *testing.T) {
+	t.Parallel()
+	la, err := time.LoadLocation("America/Los_Angeles")
+	if err != nil {
+		t.Fatalf("LoadLocation: %v", err)
+	}
+
+	t.Run("converts timed event", func(t *testing.T) {
+		t.Parallel()
+		event := &Event{
+			Start: &EventTime{DateTime: "2026-01-24T10:00:00-05:00"},
+			End:   &EventTime{DateTime: "2026-01-24T11:00:00-05:00"},
+		}
+
+		want := "Sat, Jan 24, 2026 7:00 AM - 8:00 AM"
+		if got := event.FormatTimeRangeIn(la); got != want {
+			t.Errorf("FormatTimeRangeIn = %q, want %q", got, want)
+		}
+	})
+
+	t.Run("conversion can change the day", func(t *testing.T) {
+		t.Parallel()
+		event := &Event{
+			Start: &EventTime{DateTime: "2026-01-24T01:00:00-05:00"},
+			End:   &EventTime{DateTime: "2026-01-24T02:00:00-05:00"},
+		}
+
+		want := "Fri, Jan 23, 2026 10:00 PM - 11:00 PM"
+		if got := event.FormatTimeRangeIn(la); got != want {
+			t.Errorf("FormatTimeRangeIn = %q, want %q", got, want)
+		}
+	})
+
+	t.Run("leaves all-day event alone", func(t *testing.T) {
+		t.Parallel()
+		event := &Event{
+			AllDay: true,
+			Start:  &EventTime{Date: "2026-01-24"},
+			End:    &EventTime{Date: "2026-01-25"},
+		}
+
+		if got, want := event.FormatTimeRangeIn(la), event.FormatTimeRange(); got != want {
+			t.Errorf("FormatTimeRangeIn = %q, want %q", got, want)
+		}
+	})
+
+	t.Run("nil keeps the event's zone", func(t *testing.T) {
+		t.Parallel()
+		event := &Event{
+			Start: &EventTime{DateTime: "2026-01-24T10:00:00-05:00"},
+			End:   &EventTime{DateTime: "2026-01-24T11:00:00-05:00"},
+		}
+
+		want := "Sat, Jan 24, 2026 10:00 AM - 11:00 AM"
+		if got := event.FormatTimeRangeIn(nil); got != want {
+			t.Errorf("FormatTimeRangeIn = %q, want %q", got, want)
+		}
+	})
+}
+
+func TestEventDirectionsURL(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name     string
+		location string
+		want     string
+	}{
+		{
+			name:     "physical address",
+			location: "1600 Amphitheatre Pkwy, Mountain View, CA",
+			want:     "https://www.google.com/maps/search/?api=1&query=1600+Amphitheatre+Pkwy%2C+Mountain+View%2C+CA",
+		},
+		{
+			name:     "no location",
+			location: "",
+			want:     "",
+		},
+		{
+			name:     "whitespace only",
+			location: "   ",
+			want:     "",
+		},
+		{
+			name:     "meeting URL",
+			location: "https://zoom.us/j/123456789",
+			want:     "",
+		},
+		{
+			name:     "address with meeting link",
+			location: "Room 4, 1 Main St (https://zoom.us/j/1)",
+			want:     "https://www.google.com/maps/search/?api=1&query=Room+4%2C+1+Main+St+%28https%3A%2F%2Fzoom.us%2Fj%2F1%29",
+		},
+		{
+			name:     "meet link without scheme",
+			location: "meet.google.com/abc-defg-hij",
+			want:     "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			event := &Event{Location: tt.location}
+			if got := event.DirectionsURL(); got != tt.want {
+				t.Errorf("DirectionsURL() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestEventSelfStatus(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name      string
+		attendees []*calendar.EventAttendee
+		want      string
+	}{
+		{name: "no attendees", want: ""},
+		{
+			name:      "self not invited",
+			attendees: []*calendar.EventAttendee{{Email: "bob@example.com", ResponseStatus: "accepted"}},
+			want:      "",
+		},
+		{
+			name: "self status among others",
+			attendees: []*calendar.EventAttendee{
+				{Email: "bob@example.com", ResponseStatus: "accepted"},
+				{Email: "me@example.com", Self: true, ResponseStatus: "needsAction"},
+			},
+			want: "needsAction",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			event := ParseEvent(&calendar.Event{Id: "e1", Attendees: tt.attendees})
+			if got := event.SelfStatus(); got != tt.want {
+				t.Errorf("SelfStatus() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestEventHasAttendee(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name      string
+		attendees []*calendar.EventAttendee
+		email     string
+		want      bool
+	}{
+		{name: "no attendees", email: "bob@example.com", want: false},
+		{
+			name:      "matching attendee",
+			attendees: []*calendar.EventAttendee{{Email: "alice@example.com"}, {Email: "bob@example.com"}},
+			email:     "bob@example.com",
+			want:      true,
+		},
+		{
+			name:      "case-insensitive match",
+			attendees: []*calendar.EventAttendee{{Email: "Bob@Example.com"}},
+			email:     "bob@example.COM",
+			want:      true,
+		},
+		{
+			name:      "no matching attendee",
+			attendees: []*calendar.EventAttendee{{Email: "alice@example.com"}},
+			email:     "bob@example.com",
+			want:      false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			event := ParseEvent(&calendar.Event{Id: "e1", Attendees: tt.attendees})
+			if got := event.HasAttendee(tt.email); got != tt.want {
+				t.Errorf("HasAttendee(%q) = %v, want %v", tt.email, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestEventOrganizedBy(t *testing.T) {
+	t.Parallel()
+	event := ParseEvent(&calendar.Event{Id: "e1", Organizer: &calendar.EventOrganizer{Email: "Alice@Example.com"}})
+	if !event.OrganizedBy("alice@example.com") {
+		t.Error("OrganizedBy(alice) = false, want true")
+	}
+	if event.OrganizedBy("bob@example.com") {
+		t.Error("OrganizedBy(bob) = true, want false")
+	}
+	if ParseEvent(&calendar.Event{Id: "e2"}).OrganizedBy("alice@example.com") {
+		t.Error("OrganizedBy on an event without organizer = true, want false")
+	}
+}
+
+func TestEventIsBusy(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name         string
+		transparency string
+		want         bool
+	}{
+		{name: "unset defaults to busy", transparency: "", want: true},
+		{name: "opaque is busy", transparency: "opaque", want: true},
+		{name: "transparent is free", transparency: "transparent", want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			event := ParseEvent(&calendar.Event{Id: "e1", Transparency: tt.transparency})
+			if got := event.IsBusy(); got != tt.want {
+				t.Errorf("IsBusy() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,69 @@
+package calendar
+
+import (
+	"time"
+
+	"google.golang.org/api/calendar/v3"
+)
+
+// FreeBusyResult holds busy intervals for each queried calendar
+type FreeBusyResult struct {
+	TimeMin   string          `json:"timeMin"`
+	TimeMax   string          `json:"timeMax"`
+	Calendars []*CalendarBusy `json:"calendars"`
+}
+
+// CalendarBusy holds the busy intervals for a single calendar
+type CalendarBusy struct {
+	CalendarID string      `json:"calendarId"`
+	Busy       []TimeRange `json:"busy"`
+	Errors     []string    `json:"errors,omitempty"`
+}
+
+// TimeRange is a busy interval
+type TimeRange struct {
+	Start time.Time `json:"start"`
+	End   time.Time `json:"end"`
+}
+
+// ParseFreeBusy converts a freebusy.query response to a FreeBusyResult.
+// Calendars are returned in the order given by calendarIDs; the API keys its
+// response by ID, so map order would otherwise be random. Intervals whose
+// timestamps fail to parse are skipped.
+func ParseFreeBusy(resp *calendar.FreeBusyResponse, calendarIDs []string) *FreeBusyResult {
+	result := &FreeBusyResult{
+		TimeMin: resp.TimeMin,
+		TimeMax: resp.TimeMax,
+	}
+
+	for _, id := range calendarIDs {
+		busy := &CalendarBusy{CalendarID: id}
+		if fb, ok := resp.Calendars[id]; ok {
+			for _, p := range fb.Busy {
+				start, err := time.Parse(time.RFC3339, p.Start)
+				if err != nil {
+					continue
+				}
+				end, err := time.Parse(time.RFC3339, p.End)
+				if err != nil {
+					continue
+				}
+				busy.Busy = append(busy.Busy, TimeRange{Start: start, End: end})
+			}
+			for _, e := range fb.Errors {
+				busy.Errors = append(busy.Errors, e.Reason)
+			}
+		}
+		result.Calendars = append(result.Calendars, busy)
+	}
+
+	return result
+}
+
+// Format returns a human-readable string for the interval
+func (r TimeRange) Format() string {
+	if r.Start.Format("2006-01-02") == r.End.Format("2006-01-02") {
+		return r.Start.Format("Mon, Jan 2, 2006 3:04 PM") + " - " + r.End.Format("3:04 PM")
+	}
+	return r.Start.Format("Mon, Jan 2, 2006 3:04 PM") + " - " + r.End.Format("Mon, Jan 2, 2006 3:04 PM")
+}
@@ -0,0 +1,60 @@
+package calendar
+
+import (
+	"testing"
+
+	"google.golang.org/api/calendar/v3"
+)
+
+func TestParseFreeBusy(t *testing.T) {
+	t.Parallel()
+	resp := &calendar.FreeBusyResponse{
+		TimeMin: "2024-01-01T00:00:00Z",
+		TimeMax: "2024-01-07T23:59:59Z",
+		Calendars: map[string]calendar.FreeBusyCalendar{
+			"work@example.com": {
+				Busy: []*calendar.TimePeriod{
+					{Start: "2024-01-02T09:00:00Z", End: "2024-01-02T10:00:00Z"},
+					{Start: "not-a-time", End: "2024-01-02T12:00:00Z"},
+				},
+			},
+			"primary": {
+				Busy: []*calendar.TimePeriod{
+					{Start: "2024-01-03T14:00:00Z", End: "2024-01-03T15:30:00Z"},
+				},
+			},
+			"other@example.com": {
+				Errors: []*calendar.Error{{Domain: "global", Reason: "notFound"}},
+			},
+		},
+	}
+
+	result := ParseFreeBusy(resp, []string{"primary", "work@example.com", "other@example.com", "missing@example.com"})
+
+	if result.TimeMin != "2024-01-01T00:00:00Z" {
+		t.Errorf("TimeMin = %q", result.TimeMin)
+	}
+	if len(result.Calendars) != 4 {
+		t.Fatalf("got %d calendars, want 4", len(result.Calendars))
+	}
+
+	wantOrder := []string{"primary", "work@example.com", "other@example.com", "missing@example.com"}
+	for i, id := range wantOrder {
+		if got := result.Calendars[i].CalendarID; got != id {
+			t.Errorf("Calendars[%d] = %q, want %q", i, got, id)
+		}
+	}
+
+	if got := len(result.Calendars[0].Busy); got != 1 {
+		t.Errorf("primary busy count = %d, want 1", got)
+	}
+	if got := len(result.Calendars[1].Busy); got != 1 {
+		t.Errorf("work busy count = %d, want 1 (unparseable interval skipped)", got)
+	}
+	if got := result.Calendars[2].Errors; len(got) != 1 || got[0] != "notFound" {
+		t.Errorf("other errors = %v, want [notFound]", got)
+	}
+	if got := len(result.Calendars[3].Busy); got != 0 {
+		t.Errorf("missing busy count = %d, want 0", got)
+	}
+}
@@ -0,0 +1,159 @@
+package calendar
+
+import (
+	"bufio"
+	"io"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// icsProdID identifies gro as the producer of an iCalendar stream
+const icsProdID = "-//open-cli-collective//gro//EN"
+
+// icsLineLimit is the RFC 5545 maximum line length in octets, excluding CRLF
+const icsLineLimit = 75
+
+// WriteICS writes events as an RFC 5545 VCALENDAR with one VEVENT each.
+// stamp is used for every DTSTAMP; callers pass the current time. Timed
+// events are written in UTC and all-day events as DATE values.
+func WriteICS(w io.Writer, events []*Event, stamp time.Time) error {
+	bw := bufio.NewWriter(w)
+	line := func(s string) { writeFoldedLine(bw, s) }
+
+	line("BEGIN:VCALENDAR")
+	line("VERSION:2.0")
+	line("PRODID:" + icsProdID)
+	line("CALSCALE:GREGORIAN")
+	for _, e := range events {
+		writeVEvent(line, e, stamp)
+	}
+	line("END:VCALENDAR")
+
+	return bw.Flush()
+}
+
+// writeVEvent emits one VEVENT block through line
+func writeVEvent(line func(string), e *Event, stamp time.Time) {
+	line("BEGIN:VEVENT")
+	line("UID:" + escapeICSText(e.ID))
+	line("DTSTAMP:" + stamp.UTC().Format("20060102T150405Z"))
+	if v, ok := icsTime(e.Start); ok {
+		line("DTSTART" + v)
+	}
+	if v, ok := icsTime(e.End); ok {
+		line("DTEND" + v)
+	}
+	line("SUMMARY:" + escapeICSText(e.Summary))
+	if e.Location != "" {
+		line("LOCATION:" + escapeICSText(e.Location))
+	}
+	if e.Description != "" {
+		line("DESCRIPTION:" + escapeICSText(e.Description))
+	}
+	if e.HTMLLink != "" {
+		line("URL:" + e.HTMLLink)
+	}
+	if e.Status != "" {
+		line("STATUS:" + strings.ToUpper(e.Status))
+	}
+	if e.IsBusy() {
+		line("TRANSP:OPAQUE")
+	} else {
+		line("TRANSP:TRANSPARENT")
+	}
+	if e.Organizer != nil && e.Organizer.Email != "" {
+		line("ORGANIZER" + icsCommonName(e.Organizer.DisplayName) + ":mailto:" + e.Organizer.Email)
+	}
+	for _, a := range e.Attendees {
+		if a.Email == "" {
+			continue
+		}
+		params := icsCommonName(a.DisplayName)
+		if a.Status != "" {
+			params += ";PARTSTAT=" + icsPartStat(a.Status)
+		}
+		if a.Optional {
+			params += ";ROLE=OPT-PARTICIPANT"
+		}
+		line("ATTENDEE" + params + ":mailto:" + a.Email)
+	}
+	line("END:VEVENT")
+}
+
+// icsTime renders an EventTime as a property suffix (parameters, colon and
+// value), e.g. ";VALUE=DATE:20240115" or ":20240115T100000Z"
+func icsTime(t *EventTime) (string, bool) {
+	if t == nil {
+		return "", false
+	}
+	if t.DateTime != "" {
+		parsed, err := time.Parse(time.RFC3339, t.DateTime)
+		if err != nil {
+			return "", false
+		}
+		return ":" + parsed.UTC().Format("20060102T150405Z"), true
+	}
+	if t.Date != "" {
+		parsed, err := time.Parse("2006-01-02", t.Date)
+		if err != nil {
+			return "", false
+		}
+		return ";VALUE=DATE:" + parsed.Format("20060102"), true
+	}
+	return "", false
+}
+
+// icsCommonName returns a CN parameter for name, or "" when it is empty.
+// Quotes are not allowed inside a quoted parameter value, so they are dropped.
+func icsCommonName(name string) string {
+	if name == "" {
+		return ""
+	}
+	return `;CN="` + strings.ReplaceAll(name, `"`, "") + `"`
+}
+
+// icsPartStat maps a Calendar API responseStatus to an iCalendar PARTSTAT
+func icsPartStat(status string) string {
+	switch status {
+	case "accepted":
+		return "ACCEPTED"
+	case "declined":
+		return "DECLINED"
+	case "tentative":
+		return "TENTATIVE"
+	default:
+		return "NEEDS-ACTION"
+	}
+}
+
+// escapeICSText escapes a TEXT value per RFC 5545 §3.3.11
+func escapeICSText(s string) string {
+	s = strings.ReplaceAll(s, "\r\n", "\n")
+	return strings.NewReplacer(
+		`\`, `\\`,
+		";", `\;`,
+		",", `\,`,
+		"\n", `\n`,
+	).Replace(s)
+}
+
+// writeFoldedLine writes s terminated by CRLF, folding it into continuation
+// lines (CRLF followed by a space) so no line exceeds icsLineLimit octets.
+// Folds never split a UTF-8 sequence.
+func writeFoldedLine(w *bufio.Writer, s string) {
+	limit := icsLineLimit
+	for len(s) > limit {
+		cut := limit
+		for cut > 0 && !utf8.RuneStart(s[cut]) {
+			cut--
+		}
+		_, _ = w.WriteString(s[:cut])
+		_, _ = w.WriteString("\r\n ")
+		s = s[cut:]
+		// Continuation lines lose one octet to the leading space
+		limit = icsLineLimit - 1
+	}
+	_, _ = w.WriteString(s)
+	_, _ = w.WriteString("\r\n")
+}
@@ -0,0 +1,113 @@
+package calendar
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestWriteICS(t *testing.T) {
+	t.Parallel()
+	stamp := time.Date(2024, 1, 10, 8, 30, 0, 0, time.UTC)
+	events := []*Event{
+		{
+			ID:          "evt1",
+			Summary:     "Planning; Q1, draft",
+			Description: "Line one\nLine two",
+			Location:    "Room A",
+			Status:      "confirmed",
+			HTMLLink:    "https://calendar.google.com/event?eid=evt1",
+			Start:       &EventTime{DateTime: "2024-01-15T10:00:00-08:00"},
+			End:         &EventTime{DateTime: "2024-01-15T11:00:00-08:00"},
+			Organizer:   &Person{Email: "org@example.com", DisplayName: "The \"Org\""},
+			Attendees: []Person{
+				{Email: "alice@example.com", DisplayName: "Alice", Status: "accepted"},
+				{Email: "bob@example.com", Optional: true, Status: "needsAction"},
+			},
+		},
+		{
+			ID:           "evt2",
+			Summary:      "Holiday",
+			Start:        &EventTime{Date: "2024-01-16"},
+			End:          &EventTime{Date: "2024-01-17"},
+			Transparency: "transparent",
+			AllDay:       true,
+		},
+	}
+
+	var buf bytes.Buffer
+	if err := WriteICS(&buf, events, stamp); err != nil {
+		t.Fatalf("WriteICS: %v", err)
+	}
+	out := buf.String()
+
+	if !strings.HasPrefix(out, "BEGIN:VCALENDAR\r\nVERSION:2.0\r\n") {
+		t.Errorf("missing VCALENDAR header:\n%s", out)
+	}
+	if !strings.HasSuffix(out, "END:VCALENDAR\r\n") {
+		t.Errorf("missing VCALENDAR footer:\n%s", out)
+	}
+	if got := strings.Count(out, "BEGIN:VEVENT\r\n"); got != 2 {
+		t.Errorf("got %d VEVENT blocks, want 2", got)
+	}
+	if strings.Count(out, "BEGIN:VEVENT") != strings.Count(out, "END:VEVENT") {
+		t.Error("unbalanced VEVENT blocks")
+	}
+
+	for _, want := range []string{
+		"UID:evt1\r\n",
+		"DTSTAMP:20240110T083000Z\r\n",
+		"DTSTART:20240115T180000Z\r\n",
+		"DTEND:20240115T190000Z\r\n",
+		`SUMMARY:Planning\; Q1\, draft` + "\r\n",
+		`DESCRIPTION:Line one\nLine two` + "\r\n",
+		"STATUS:CONFIRMED\r\n",
+		"TRANSP:OPAQUE\r\n",
+		`ORGANIZER;CN="The Org":mailto:org@example.com` + "\r\n",
+		`ATTENDEE;CN="Alice";PARTSTAT=ACCEPTED:mailto:alice@example.com` + "\r\n",
+		"ATTENDEE;PARTSTAT=NEEDS-ACTION;ROLE=OPT-PARTICIPANT:mailto:bob@example.com\r\n",
+		"DTSTART;VALUE=DATE:20240116\r\n",
+		"DTEND;VALUE=DATE:20240117\r\n",
+		"TRANSP:TRANSPARENT\r\n",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("output missing %q in:\n%s", want, out)
+		}
+	}
+}
+
+func TestWriteICS_Empty(t *testing.T) {
+	t.Parallel()
+	var buf bytes.Buffer
+	if err := WriteICS(&buf, nil, time.Now()); err != nil {
+		t.Fatalf("WriteICS: %v", err)
+	}
+	if strings.Contains(buf.String(), "VEVENT") {
+		t.Errorf("empty input should have no VEVENT:\n%s", buf.String())
+	}
+	if !strings.HasSuffix(buf.String(), "END:VCALENDAR\r\n") {
+		t.Errorf("empty calendar must still be complete:\n%s", buf.String())
+	}
+}
+
+func TestWriteFoldedLine(t *testing.T) {
+	t.Parallel()
+	var buf bytes.Buffer
+	long := "DESCRIPTION:" + strings.Repeat("é", 100)
+	if err := WriteICS(&buf, []*Event{{ID: "x", Description: strings.Repeat("é", 100)}}, time.Now()); err != nil {
+		t.Fatalf("WriteICS: %v", err)
+	}
+
+	for _, line := range strings.Split(strings.TrimSuffix(buf.String(), "\r\n"), "\r\n") {
+		if len(line) > icsLineLimit {
+			t.Errorf("line exceeds %d octets (%d): %q", icsLineLimit, len(line), line)
+		}
+	}
+
+	// Unfolding (drop CRLF + space) restores the original property
+	unfolded := strings.ReplaceAll(buf.String(), "\r\n ", "")
+	if !strings.Contains(unfolded, long+"\r\n") {
+		t.Errorf("unfolded output does not contain the original line")
+	}
+}
@@ -0,0 +1,169 @@
+package calendar
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/open-cli-collective/google-readonly/internal/calendar"
+)
+
+// maxAgendaDays bounds --days so one events.list page covers the range
+const maxAgendaDays = 31
+
+func newAgendaCommand() *cobra.Command {
+	var (
+		calendarID string
+		days       int
+		busyOnly   bool
+	)
+
+	cmd := &cobra.Command{
+		Use:   "agenda",
+		Short: "Show upcoming events grouped by day",
+		Long: `Show the events of the next few days, starting today, grouped under a
+header for each day. All-day events come first in their day; an event that
+spans several days is listed under each of them.
+
+Examples:
+  gro calendar agenda
+  gro cal agenda --days 14
+  gro cal agenda --calendar Work --busy-only`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			if days < 1 || days > maxAgendaDays {
+				return fmt.Errorf("--days must be between 1 and %d", maxAgendaDays)
+			}
+
+			client, err := newCalendarClient(cmd.Context())
+			if err != nil {
+				return fmt.Errorf("creating Calendar client: %w", err)
+			}
+
+			id, err := resolveCalendarID(cmd.Context(), client, calendarID)
+			if err != nil {
+				return fmt.Errorf("resolving calendar: %w", err)
+			}
+
+			start, _ := todayBounds(now())
+			end := start.AddDate(0, 0, days)
+			events, err := client.ListEvents(cmd.Context(), id, start.Format(time.RFC3339), end.Format(time.RFC3339), 250, listEventsOptions(true))
+			if err != nil {
+				return err
+			}
+
+			parsed := make([]*calendar.Event, len(events))
+			for i, e := range events {
+				parsed[i] = calendar.ParseEvent(e)
+			}
+			parsed = filterEvents(parsed, EventListOptions{BusyOnly: busyOnly})
+
+			for i, day := range groupByDay(parsed, start, days) {
+				if i > 0 {
+					fmt.Println()
+				}
+				printAgendaDay(day)
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&calendarID, "calendar", "c", "primary", "Calendar ID or name to query")
+	cmd.Flags().IntVarP(&days, "days", "d", 7, "Number of days to show, starting today")
+	cmd.Flags().BoolVar(&busyOnly, "busy-only", false, "Only show events that mark you as busy (skip free/transparent events)")
+
+	return cmd
+}
+
+// agendaDay is one day of an agenda and the events that touch it
+type agendaDay struct {
+	Date   time.Time
+	Events []*calendar.Event
+}
+
+// groupByDay sorts events into the days consecutive days beginning at
+// start, which must be a midnight. An event is listed under every day it
+// overlaps; within a day, all-day events come first, then the rest by
+// start time.
+func groupByDay(events []*calendar.Event, start time.Time, days int) []agendaDay {
+	agenda := make([]agendaDay, days)
+	for i := range agenda {
+		agenda[i].Date = start.AddDate(0, 0, i)
+	}
+
+	for _, e := range events {
+		from, to, ok := eventSpan(e, start.Location())
+		if !ok {
+			continue
+		}
+		for i := range agenda {
+			dayStart, dayEnd := agenda[i].Date, agenda[i].Date.AddDate(0, 0, 1)
+			// A zero-length event still belongs to the day it starts in
+			if from.Before(dayEnd) && (to.After(dayStart) || (from.Equal(to) && !from.Before(dayStart))) {
+				agenda[i].Events = append(agenda[i].Events, e)
+			}
+		}
+	}
+
+	for _, day := range agenda {
+		sort.SliceStable(day.Events, func(a, b int) bool {
+			ea, eb := day.Events[a], day.Events[b]
+			if ea.AllDay != eb.AllDay {
+				return ea.AllDay
+			}
+			sa, _ := ea.GetStartTime()
+			sb, _ := eb.GetStartTime()
+			return sa.Before(sb)
+		})
+	}
+	return agenda
+}
+
+// eventSpan returns when an event starts and ends in loc. All-day dates
+// are midnights in loc, since they name days rather than instants.
+func eventSpan(e *calendar.Event, loc *time.Location) (from, to time.Time, ok bool) {
+	start, err := e.GetStartTime()
+	if err != nil || start.IsZero() {
+		return time.Time{}, time.Time{}, false
+	}
+	end, err := e.GetEndTime()
+	if err != nil || end.IsZero() {
+		end = start
+	}
+	if e.AllDay {
+		start = time.Date(start.Year(), start.Month(), start.Day(), 0, 0, 0, 0, loc)
+		end = time.Date(end.Year(), end.Month(), end.Day(), 0, 0, 0, 0, loc)
+	}
+	return start.In(loc), end.In(loc), true
+}
+
+// printAgendaDay prints a day header such as "Monday, Jan 26" followed by
+// one line per event
+func printAgendaDay(day agendaDay) {
+	fmt.Println(day.Date.Format("Monday, Jan 2"))
+	if len(day.Events) == 0 {
+		fmt.Println("  No events")
+		return
+	}
+	for _, e := range day.Events {
+		fmt.Printf("  %-19s  %s\n", agendaTime(e, day.Date.Location()), e.Summary)
+	}
+}
+
+// agendaTime renders an event's time of day in loc for the agenda
+func agendaTime(e *calendar.Event, loc *time.Location) string {
+	if e.AllDay {
+		return "All day"
+	}
+	start, err := e.GetStartTime()
+	if err != nil {
+		return ""
+	}
+	end, err := e.GetEndTime()
+	if err != nil {
+		return start.In(loc).Format("3:04 PM")
+	}
+	return start.In(loc).Format("3:04 PM") + " - " + end.In(loc).Format("3:04 PM")
+}
@@ -0,0 +1,119 @@
+package calendar
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"google.golang.org/api/calendar/v3"
+
+	calendarapi "github.com/open-cli-collective/google-readonly/internal/calendar"
+	"github.com/open-cli-collective/google-readonly/internal/testutil"
+)
+
+func timedEvent(summary, start, end string) *calendarapi.Event {
+	return &calendarapi.Event{
+		Summary: summary,
+		Start:   &calendarapi.EventTime{DateTime: start},
+		End:     &calendarapi.EventTime{DateTime: end},
+	}
+}
+
+func allDayEvent(summary, start, end string) *calendarapi.Event {
+	return &calendarapi.Event{
+		Summary: summary,
+		Start:   &calendarapi.EventTime{Date: start},
+		End:     &calendarapi.EventTime{Date: end},
+		AllDay:  true,
+	}
+}
+
+// summaries joins the summaries of events with commas
+func summaries(events []*calendarapi.Event) string {
+	names := make([]string, len(events))
+	for i, e := range events {
+		names[i] = e.Summary
+	}
+	return strings.Join(names, ",")
+}
+
+func TestGroupByDay(t *testing.T) {
+	loc := time.FixedZone("UTC-5", -5*60*60)
+	start := time.Date(2026, 1, 26, 0, 0, 0, 0, loc)
+
+	events := []*calendarapi.Event{
+		timedEvent("Standup", "2026-01-26T09:00:00-05:00", "2026-01-26T09:15:00-05:00"),
+		allDayEvent("Offsite", "2026-01-27", "2026-01-29"),
+		timedEvent("Lunch", "2026-01-27T12:00:00-05:00", "2026-01-27T13:00:00-05:00"),
+		timedEvent("Early", "2026-01-27T08:00:00-05:00", "2026-01-27T08:30:00-05:00"),
+		allDayEvent("Holiday", "2026-01-27", "2026-01-28"),
+		timedEvent("Late call", "2026-01-26T23:30:00-05:00", "2026-01-27T00:30:00-05:00"),
+		timedEvent("Out of range", "2026-01-30T09:00:00-05:00", "2026-01-30T10:00:00-05:00"),
+	}
+
+	agenda := groupByDay(events, start, 3)
+	testutil.Len(t, agenda, 3)
+
+	testutil.Equal(t, agenda[0].Date, start)
+	testutil.Equal(t, summaries(agenda[0].Events), "Standup,Late call")
+
+	// All-day events first, then timed ones by start
+	testutil.Equal(t, summaries(agenda[1].Events), "Offsite,Holiday,Late call,Early,Lunch")
+
+	// A multi-day all-day event is listed on every day it covers
+	testutil.Equal(t, summaries(agenda[2].Events), "Offsite")
+}
+
+func TestAgendaCommand_GroupsByDay(t *testing.T) {
+	today, _ := todayBounds(time.Now())
+	tomorrow := today.AddDate(0, 0, 1)
+	at := func(day time.Time, hour int) string {
+		return time.Date(day.Year(), day.Month(), day.Day(), hour, 0, 0, 0, day.Location()).Format(time.RFC3339)
+	}
+
+	var gotMin, gotMax string
+	var gotOpts calendarapi.ListEventsOptions
+	mock := &MockCalendarClient{
+		ListEventsFunc: func(_ context.Context, _, timeMin, timeMax string, _ int64, opts calendarapi.ListEventsOptions) ([]*calendar.Event, error) {
+			gotMin, gotMax, gotOpts = timeMin, timeMax, opts
+			return []*calendar.Event{
+				{Id: "1", Summary: "Review", Start: &calendar.EventDateTime{DateTime: at(today, 14)}, End: &calendar.EventDateTime{DateTime: at(today, 15)}},
+				{Id: "2", Summary: "Birthday", Start: &calendar.EventDateTime{Date: today.Format("2006-01-02")}, End: &calendar.EventDateTime{Date: tomorrow.Format("2006-01-02")}},
+			}, nil
+		},
+	}
+
+	cmd := newAgendaCommand()
+	cmd.SetArgs([]string{"--days", "2"})
+
+	withMockClient(mock, func() {
+		output := testutil.CaptureStdout(t, func() {
+			testutil.NoError(t, cmd.Execute())
+		})
+
+		testutil.Equal(t, output, today.Format("Monday, Jan 2")+"\n"+
+			"  All day              Birthday\n"+
+			"  2:00 PM - 3:00 PM    Review\n"+
+			"\n"+
+			tomorrow.Format("Monday, Jan 2")+"\n"+
+			"  No events\n")
+	})
+
+	testutil.Equal(t, gotMin, today.Format(time.RFC3339))
+	testutil.Equal(t, gotMax, today.AddDate(0, 0, 2).Format(time.RFC3339))
+	testutil.True(t, gotOpts.SingleEvents)
+}
+
+func TestAgendaCommand_InvalidDays(t *testing.T) {
+	for _, days := range []string{"0", "32"} {
+		cmd := newAgendaCommand()
+		cmd.SetArgs([]string{"--days", days})
+
+		withMockClient(&MockCalendarClient{}, func() {
+			err := cmd.Execute()
+			testutil.Error(t, err)
+			testutil.Contains(t, err.Error(), "--days must be between 1 and 31")
+		})
+	}
+}
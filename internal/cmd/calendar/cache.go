@@ -0,0 +1,41 @@
+package calendar
+
+import (
+	"context"
+	"time"
+
+	calendarv3 "google.golang.org/api/calendar/v3"
+
+	"github.com/open-cli-collective/google-readonly/internal/cache"
+)
+
+const (
+	// calendarsCacheKey names the cached calendar list
+	calendarsCacheKey = "calendars"
+	// calendarsTTL is how long the calendar list is cached. Subscribing to
+	// or creating a calendar is rare, and --no-cache fetches it anyway.
+	calendarsTTL = 6 * time.Hour
+)
+
+// cachingClient serves ListCalendars, which every --calendar name lookup
+// calls, from the response cache
+type cachingClient struct {
+	CalendarClient
+	cache *cache.Cache
+}
+
+// withResponseCache wraps client with the response cache. The cache is
+// disposable, so when it cannot be opened the client is used as is.
+func withResponseCache(client CalendarClient) CalendarClient {
+	c, err := cache.New()
+	if err != nil {
+		return client
+	}
+	return &cachingClient{CalendarClient: client, cache: c}
+}
+
+func (c *cachingClient) ListCalendars(ctx context.Context) ([]*calendarv3.CalendarListEntry, error) {
+	return cache.GetOrFetch(c.cache, calendarsCacheKey, calendarsTTL, func() ([]*calendarv3.CalendarListEntry, error) {
+		return c.CalendarClient.ListCalendars(ctx)
+	})
+}
@@ -0,0 +1,48 @@
+package calendar
+
+import (
+	"context"
+	"testing"
+
+	"github.com/open-cli-collective/cli-common/statedirtest"
+	"google.golang.org/api/calendar/v3"
+
+	"github.com/open-cli-collective/google-readonly/internal/cache"
+	"github.com/open-cli-collective/google-readonly/internal/testutil"
+)
+
+func TestWithResponseCache_ListCalendars(t *testing.T) {
+	statedirtest.Hermetic(t)
+
+	calls := 0
+	mock := &MockCalendarClient{
+		ListCalendarsFunc: func(_ context.Context) ([]*calendar.CalendarListEntry, error) {
+			calls++
+			return []*calendar.CalendarListEntry{{Id: "team@group.calendar.google.com", Summary: "Team"}}, nil
+		},
+	}
+	client := withResponseCache(mock)
+
+	for range 2 {
+		cals, err := client.ListCalendars(context.Background())
+		testutil.NoError(t, err)
+		testutil.Len(t, cals, 1)
+		testutil.Equal(t, cals[0].Summary, "Team")
+	}
+	testutil.Equal(t, calls, 1)
+
+	// Name resolution goes through the same cached list
+	id, err := resolveCalendarID(context.Background(), client, "team")
+	testutil.NoError(t, err)
+	testutil.Equal(t, id, "team@group.calendar.google.com")
+	testutil.Equal(t, calls, 1)
+
+	t.Run("--no-cache fetches again", func(t *testing.T) {
+		cache.NoCache = true
+		defer func() { cache.NoCache = false }()
+
+		_, err := client.ListCalendars(context.Background())
+		testutil.NoError(t, err)
+		testutil.Equal(t, calls, 2)
+	})
+}
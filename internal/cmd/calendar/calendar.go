@@ -19,23 +19,34 @@ This command group provides Calendar functionality:
 - get: View a single event's details
 - today: Show today's events
 - week: Show this week's events
+- agenda: Show upcoming events grouped by day
+- freebusy: Show busy time blocks across calendars
 - rsvp: Update your RSVP status on an event
 - color: Set event color
 
+--timezone shows event times in another zone, and makes "today" and "this
+week" that zone's. All-day events are dates and stay as they are.
+
 Examples:
   gro calendar list
   gro cal events --max 20
   gro cal today
+  gro cal freebusy --calendar primary,work@example.com
   gro calendar get <event-id>
   gro cal rsvp <event-id> accept
-  gro cal color <event-id> tomato`,
+  gro cal color <event-id> tomato
+  gro cal week --timezone America/Los_Angeles`,
 	}
 
+	cmd.PersistentFlags().Var(&zoneValue{}, "timezone", "Show event times in this IANA time zone (e.g. America/Los_Angeles)")
+
 	cmd.AddCommand(newListCommand())
 	cmd.AddCommand(newEventsCommand())
 	cmd.AddCommand(newGetCommand())
 	cmd.AddCommand(newTodayCommand())
 	cmd.AddCommand(newWeekCommand())
+	cmd.AddCommand(newAgendaCommand())
+	cmd.AddCommand(newFreeBusyCommand())
 	cmd.AddCommand(newRSVPCommand())
 	cmd.AddCommand(newColorCommand())
 
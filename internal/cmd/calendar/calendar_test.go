@@ -1,8 +1,11 @@
 package calendar
 
 import (
+	"context"
 	"testing"
 
+	"github.com/open-cli-collective/google-readonly/internal/config"
+	"github.com/open-cli-collective/google-readonly/internal/credtest"
 	"github.com/open-cli-collective/google-readonly/internal/testutil"
 )
 
@@ -40,6 +43,7 @@ func TestCalendarCommand(t *testing.T) {
 		testutil.SliceContains(t, names, "get")
 		testutil.SliceContains(t, names, "today")
 		testutil.SliceContains(t, names, "week")
+		testutil.SliceContains(t, names, "freebusy")
 	})
 }
 
@@ -105,6 +109,18 @@ func TestEventsCommand(t *testing.T) {
 		testutil.Equal(t, flag.Shorthand, "c")
 		testutil.Equal(t, flag.DefValue, "primary")
 	})
+
+	t.Run("has single-events flag", func(t *testing.T) {
+		flag := cmd.Flags().Lookup("single-events")
+		testutil.NotNil(t, flag)
+		testutil.Equal(t, flag.DefValue, "true")
+	})
+
+	t.Run("has busy-only flag", func(t *testing.T) {
+		flag := cmd.Flags().Lookup("busy-only")
+		testutil.NotNil(t, flag)
+		testutil.Equal(t, flag.DefValue, "false")
+	})
 }
 
 func TestGetCommand(t *testing.T) {
@@ -131,6 +147,12 @@ func TestGetCommand(t *testing.T) {
 		testutil.Equal(t, flag.Shorthand, "c")
 		testutil.Equal(t, flag.DefValue, "primary")
 	})
+
+	t.Run("has directions flag", func(t *testing.T) {
+		flag := cmd.Flags().Lookup("directions")
+		testutil.NotNil(t, flag)
+		testutil.Equal(t, flag.DefValue, "false")
+	})
 }
 
 func TestTodayCommand(t *testing.T) {
@@ -153,6 +175,18 @@ func TestTodayCommand(t *testing.T) {
 		testutil.NotNil(t, flag)
 	})
 
+	t.Run("has single-events flag", func(t *testing.T) {
+		flag := cmd.Flags().Lookup("single-events")
+		testutil.NotNil(t, flag)
+		testutil.Equal(t, flag.DefValue, "true")
+	})
+
+	t.Run("has busy-only flag", func(t *testing.T) {
+		flag := cmd.Flags().Lookup("busy-only")
+		testutil.NotNil(t, flag)
+		testutil.Equal(t, flag.DefValue, "false")
+	})
+
 	t.Run("has short description", func(t *testing.T) {
 		testutil.NotEmpty(t, cmd.Short)
 		testutil.Contains(t, cmd.Short, "today")
@@ -179,8 +213,62 @@ func TestWeekCommand(t *testing.T) {
 		testutil.NotNil(t, flag)
 	})
 
+	t.Run("has single-events flag", func(t *testing.T) {
+		flag := cmd.Flags().Lookup("single-events")
+		testutil.NotNil(t, flag)
+		testutil.Equal(t, flag.DefValue, "true")
+	})
+
+	t.Run("has busy-only flag", func(t *testing.T) {
+		flag := cmd.Flags().Lookup("busy-only")
+		testutil.NotNil(t, flag)
+		testutil.Equal(t, flag.DefValue, "false")
+	})
+
 	t.Run("has short description", func(t *testing.T) {
 		testutil.NotEmpty(t, cmd.Short)
 		testutil.Contains(t, cmd.Short, "week")
 	})
 }
+
+func TestFreeBusyCommand(t *testing.T) {
+	cmd := newFreeBusyCommand()
+
+	t.Run("has correct use", func(t *testing.T) {
+		testutil.Equal(t, cmd.Use, "freebusy")
+	})
+
+	t.Run("requires no arguments", func(t *testing.T) {
+		err := cmd.Args(cmd, []string{})
+		testutil.NoError(t, err)
+
+		err = cmd.Args(cmd, []string{"extra"})
+		testutil.Error(t, err)
+	})
+
+	t.Run("has calendar flag", func(t *testing.T) {
+		flag := cmd.Flags().Lookup("calendar")
+		testutil.NotNil(t, flag)
+		testutil.Equal(t, flag.Shorthand, "c")
+		testutil.Equal(t, flag.DefValue, "[primary]")
+	})
+
+	t.Run("has from and to flags", func(t *testing.T) {
+		testutil.NotNil(t, cmd.Flags().Lookup("from"))
+		testutil.NotNil(t, cmd.Flags().Lookup("to"))
+	})
+}
+
+func TestClientFactory_CalendarScopePreflight(t *testing.T) {
+	credtest.Setup(t)
+	err := config.SaveConfig(&config.Config{
+		GrantedScopes: []string{"https://www.googleapis.com/auth/gmail.modify"},
+	})
+	testutil.NoError(t, err)
+
+	client, err := ClientFactory(context.Background())
+	testutil.Error(t, err)
+	testutil.True(t, client == nil)
+	testutil.Contains(t, err.Error(), "not authorized for Calendar")
+	testutil.Contains(t, err.Error(), "gro init --only mail,calendar")
+}
@@ -0,0 +1,115 @@
+package calendar
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/open-cli-collective/google-readonly/internal/calendar"
+	"github.com/open-cli-collective/google-readonly/internal/format"
+)
+
+// groupRecurring groups occurrences that share a RecurringEventID. Each
+// group sits at the position of its first occurrence; events that are not
+// part of a series stay in groups of one.
+func groupRecurring(events []*calendar.Event) [][]*calendar.Event {
+	var groups [][]*calendar.Event
+	index := make(map[string]int)
+	for _, e := range events {
+		if e.RecurringEventID != "" {
+			if i, ok := index[e.RecurringEventID]; ok {
+				groups[i] = append(groups[i], e)
+				continue
+			}
+			index[e.RecurringEventID] = len(groups)
+		}
+		groups = append(groups, []*calendar.Event{e})
+	}
+	return groups
+}
+
+// printRecurringSummary prints several occurrences of one recurring event as
+// a single list entry with the occurrence start times summarized
+func printRecurringSummary(occurrences []*calendar.Event) {
+	first := occurrences[0]
+
+	label := fmt.Sprintf("%d occurrences", len(occurrences))
+	if cadence := recurrenceCadence(occurrences); cadence != "" {
+		label = cadence + ", " + label
+	}
+
+	starts := make([]string, 0, len(occurrences))
+	for _, e := range occurrences {
+		starts = append(starts, formatOccurrence(e))
+	}
+
+	fmt.Printf("ID: %s\n", first.ID)
+	fmt.Printf("Summary: %s (%s)\n", first.Summary, label)
+	fmt.Printf("When: %s\n", formatEventTime(first))
+	fmt.Printf("Occurrences: %s\n", strings.Join(starts, ", "))
+
+	if first.Location != "" {
+		fmt.Printf("Location: %s\n", first.Location)
+	}
+
+	if first.HangoutLink != "" {
+		fmt.Printf("Meet: %s\n", first.HangoutLink)
+	}
+
+	fmt.Println("---")
+}
+
+// formatOccurrence renders the start of one occurrence compactly
+func formatOccurrence(e *calendar.Event) string {
+	start, err := e.GetStartTime()
+	if err != nil || start.IsZero() {
+		return "?"
+	}
+	if e.AllDay {
+		return format.Date(start, "Mon Jan 2")
+	}
+	return format.Date(inDisplayZone(start), "Mon Jan 2 3:04 PM")
+}
+
+// recurrenceCadence infers "daily", "weekdays" or "weekly" from the gaps
+// between occurrence dates. Instances do not carry the series' RRULE, so
+// this is a description of what was returned, not of the rule itself.
+func recurrenceCadence(occurrences []*calendar.Event) string {
+	if len(occurrences) < 2 {
+		return ""
+	}
+
+	days := make([]time.Time, 0, len(occurrences))
+	for _, e := range occurrences {
+		t, err := e.GetStartTime()
+		if err != nil || t.IsZero() {
+			return ""
+		}
+		days = append(days, time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC))
+	}
+
+	daily, weekly, weekdays := true, true, true
+	for i, d := range days {
+		if d.Weekday() == time.Saturday || d.Weekday() == time.Sunday {
+			weekdays = false
+		}
+		if i == 0 {
+			continue
+		}
+		gap := int(d.Sub(days[i-1]).Hours() / 24)
+		daily = daily && gap == 1
+		weekly = weekly && gap == 7
+		weekdays = weekdays && (gap == 1 || gap == 3 && d.Weekday() == time.Monday)
+	}
+
+	switch {
+	case daily:
+		return "daily"
+	case weekdays:
+		return "weekdays"
+	case weekly:
+		return "weekly"
+	default:
+		return ""
+	}
+}
@@ -0,0 +1,67 @@
+package calendar
+
+import (
+	"testing"
+
+	"github.com/open-cli-collective/google-readonly/internal/calendar"
+	"github.com/open-cli-collective/google-readonly/internal/testutil"
+)
+
+// occurrencesOn builds occurrences of one series starting at 9:00 on each date
+func occurrencesOn(dates ...string) []*calendar.Event {
+	events := make([]*calendar.Event, len(dates))
+	for i, d := range dates {
+		events[i] = &calendar.Event{
+			ID:               "series_" + d,
+			RecurringEventID: "series",
+			Start:            &calendar.EventTime{DateTime: d + "T09:00:00Z"},
+		}
+	}
+	return events
+}
+
+func TestGroupRecurring(t *testing.T) {
+	single := &calendar.Event{ID: "single"}
+	a1 := &calendar.Event{ID: "a1", RecurringEventID: "a"}
+	b1 := &calendar.Event{ID: "b1", RecurringEventID: "b"}
+	a2 := &calendar.Event{ID: "a2", RecurringEventID: "a"}
+
+	groups := groupRecurring([]*calendar.Event{a1, single, b1, a2})
+
+	testutil.Len(t, groups, 3)
+	testutil.Len(t, groups[0], 2)
+	testutil.Equal(t, groups[0][1].ID, "a2")
+	testutil.Equal(t, groups[1][0].ID, "single")
+	testutil.Equal(t, groups[2][0].ID, "b1")
+}
+
+func TestRecurrenceCadence(t *testing.T) {
+	tests := []struct {
+		name  string
+		dates []string
+		want  string
+	}{
+		{"daily", []string{"2026-01-05", "2026-01-06", "2026-01-07"}, "daily"},
+		{"weekdays across a weekend", []string{"2026-01-08", "2026-01-09", "2026-01-12"}, "weekdays"},
+		{"weekly", []string{"2026-01-05", "2026-01-12", "2026-01-19"}, "weekly"},
+		{"irregular", []string{"2026-01-05", "2026-01-07", "2026-01-12"}, ""},
+		{"weekend gap not on monday", []string{"2026-01-06", "2026-01-09"}, ""},
+		{"single occurrence", []string{"2026-01-05"}, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			testutil.Equal(t, recurrenceCadence(occurrencesOn(tt.dates...)), tt.want)
+		})
+	}
+}
+
+func TestFormatOccurrence(t *testing.T) {
+	timed := occurrencesOn("2026-01-05")[0]
+	testutil.Equal(t, formatOccurrence(timed), "Mon Jan 5 9:00 AM")
+
+	allDay := &calendar.Event{AllDay: true, Start: &calendar.EventTime{Date: "2026-01-05"}}
+	testutil.Equal(t, formatOccurrence(allDay), "Mon Jan 5")
+
+	testutil.Equal(t, formatOccurrence(&calendar.Event{}), "?")
+}
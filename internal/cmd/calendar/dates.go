@@ -1,19 +1,9 @@
 package calendar
 
 import (
-	"fmt"
 	"time"
 )
 
-// parseDate parses a date string in YYYY-MM-DD format
-func parseDate(dateStr string) (time.Time, error) {
-	t, err := time.Parse("2006-01-02", dateStr)
-	if err != nil {
-		return time.Time{}, fmt.Errorf("invalid date format (use YYYY-MM-DD): %w", err)
-	}
-	return t, nil
-}
-
 // endOfDay returns the time at 23:59:59 on the given day
 func endOfDay(t time.Time) time.Time {
 	return t.Add(24*time.Hour - time.Second)
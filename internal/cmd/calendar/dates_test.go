@@ -7,93 +7,6 @@ import (
 	"github.com/open-cli-collective/google-readonly/internal/testutil"
 )
 
-func TestParseDate(t *testing.T) {
-	t.Parallel()
-	tests := []struct {
-		name    string
-		input   string
-		wantErr bool
-		want    time.Time
-	}{
-		{
-			name:    "valid date",
-			input:   "2026-01-24",
-			wantErr: false,
-			want:    time.Date(2026, 1, 24, 0, 0, 0, 0, time.UTC),
-		},
-		{
-			name:    "valid date leap year",
-			input:   "2024-02-29",
-			wantErr: false,
-			want:    time.Date(2024, 2, 29, 0, 0, 0, 0, time.UTC),
-		},
-		{
-			name:    "valid date year start",
-			input:   "2026-01-01",
-			wantErr: false,
-			want:    time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC),
-		},
-		{
-			name:    "valid date year end",
-			input:   "2026-12-31",
-			wantErr: false,
-			want:    time.Date(2026, 12, 31, 0, 0, 0, 0, time.UTC),
-		},
-		{
-			name:    "invalid format - slash separator",
-			input:   "2026/01/24",
-			wantErr: true,
-		},
-		{
-			name:    "invalid format - wrong order",
-			input:   "24-01-2026",
-			wantErr: true,
-		},
-		{
-			name:    "invalid format - missing leading zero",
-			input:   "2026-1-24",
-			wantErr: true,
-		},
-		{
-			name:    "invalid date - month 13",
-			input:   "2026-13-01",
-			wantErr: true,
-		},
-		{
-			name:    "invalid date - day 32",
-			input:   "2026-01-32",
-			wantErr: true,
-		},
-		{
-			name:    "invalid format - empty",
-			input:   "",
-			wantErr: true,
-		},
-		{
-			name:    "invalid format - text",
-			input:   "tomorrow",
-			wantErr: true,
-		},
-	}
-
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			t.Parallel()
-			result, err := parseDate(tt.input)
-
-			if tt.wantErr {
-				testutil.Error(t, err)
-				testutil.Contains(t, err.Error(), "invalid date format")
-			} else {
-				testutil.NoError(t, err)
-				testutil.Equal(t, result.Year(), tt.want.Year())
-				testutil.Equal(t, result.Month(), tt.want.Month())
-				testutil.Equal(t, result.Day(), tt.want.Day())
-			}
-		})
-	}
-}
-
 func TestEndOfDay(t *testing.T) {
 	t.Parallel()
 	tests := []struct {
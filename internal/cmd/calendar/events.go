@@ -5,14 +5,24 @@ import (
 	"time"
 
 	"github.com/spf13/cobra"
+
+	"github.com/open-cli-collective/google-readonly/internal/format"
 )
 
 func newEventsCommand() *cobra.Command {
 	var (
-		calendarID string
-		maxResults int64
-		from       string
-		to         string
+		calendarID   string
+		singleEvents bool
+		busyOnly     bool
+		collapse     bool
+		merge        bool
+		selfStatus   string
+		attendee     string
+		organizer    string
+		output       string
+		maxResults   int64
+		from         string
+		to           string
 	)
 
 	cmd := &cobra.Command{
@@ -20,8 +30,11 @@ func newEventsCommand() *cobra.Command {
 		Short: "List calendar events",
 		Long: `List events from a calendar.
 
-By default, shows upcoming events from the primary calendar.
-Use --from and --to flags to specify a date range.
+By default, shows upcoming events from the primary calendar. The calendar can
+be given as an ID or as its name from 'gro calendar list'.
+Use --from and --to flags to specify a date range. --attendee and
+--organizer keep only the events with that person among the attendees or as
+the organizer; emails match regardless of case.
 
 Date format: YYYY-MM-DD (e.g., 2026-01-24)
 
@@ -29,7 +42,12 @@ Examples:
   gro calendar events
   gro cal events --max 20
   gro cal events --from 2026-01-01 --to 2026-01-31
-  gro calendar events work@group.calendar.google.com`,
+  gro calendar events work@group.calendar.google.com
+  gro cal events --calendar "Team Calendar"
+  gro cal events --from 2026-03-01 --to 2026-03-31 --output ics > march.ics
+  gro cal events --self-status needsAction    # invitations you haven't answered
+  gro cal events --from 2026-03-01 --to 2026-03-31 --attendee bob@example.com
+  gro cal events --organizer alice@example.com`,
 		Args: cobra.MaximumNArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			calID := calendarID
@@ -46,7 +64,7 @@ Examples:
 			var timeMin, timeMax string
 
 			if from != "" {
-				t, err := parseDate(from)
+				t, err := format.ParseDate(from)
 				if err != nil {
 					return fmt.Errorf("invalid --from date: %w", err)
 				}
@@ -57,7 +75,7 @@ Examples:
 			}
 
 			if to != "" {
-				t, err := parseDate(to)
+				t, err := format.ParseDate(to)
 				if err != nil {
 					return fmt.Errorf("invalid --to date: %w", err)
 				}
@@ -66,17 +84,33 @@ Examples:
 			}
 
 			return listAndPrintEvents(cmd.Context(), client, EventListOptions{
-				CalendarID:   calID,
-				TimeMin:      timeMin,
-				TimeMax:      timeMax,
-				MaxResults:   maxResults,
-				Header:       "", // Will be generated based on count
-				EmptyMessage: "No events found.",
+				CalendarID:        calID,
+				TimeMin:           timeMin,
+				TimeMax:           timeMax,
+				MaxResults:        maxResults,
+				SingleEvents:      singleEvents,
+				BusyOnly:          busyOnly,
+				CollapseRecurring: collapse,
+				MergeAdjacent:     merge,
+				SelfStatus:        selfStatus,
+				Attendee:          attendee,
+				Organizer:         organizer,
+				Output:            output,
+				Header:            "", // Will be generated based on count
+				EmptyMessage:      "No events found.",
 			})
 		},
 	}
 
-	cmd.Flags().StringVarP(&calendarID, "calendar", "c", "primary", "Calendar ID to query")
+	cmd.Flags().StringVarP(&calendarID, "calendar", "c", "primary", "Calendar ID or name to query")
+	cmd.Flags().BoolVar(&singleEvents, "single-events", true, "Expand recurring events into individual occurrences")
+	cmd.Flags().BoolVar(&busyOnly, "busy-only", false, "Only show events that mark you as busy (skip free/transparent events)")
+	cmd.Flags().BoolVar(&collapse, "collapse-recurring", false, "Show each recurring event once with its occurrences summarized")
+	cmd.Flags().BoolVar(&merge, "merge-adjacent", false, "Show back-to-back events with the same title as one span")
+	cmd.Flags().StringVar(&selfStatus, "self-status", "", "Only show events where your response is needsAction, accepted, declined or tentative")
+	cmd.Flags().StringVar(&attendee, "attendee", "", "Only show events with this attendee email")
+	cmd.Flags().StringVar(&organizer, "organizer", "", "Only show events organized by this email")
+	cmd.Flags().StringVarP(&output, "output", "o", outputText, "Output format: text or ics (iCalendar)")
 	cmd.Flags().Int64VarP(&maxResults, "max", "m", 10, "Maximum number of events to return")
 	cmd.Flags().StringVar(&from, "from", "", "Start date (YYYY-MM-DD)")
 	cmd.Flags().StringVar(&to, "to", "", "End date (YYYY-MM-DD)")
@@ -3,29 +3,115 @@ package calendar
 import (
 	"context"
 	"fmt"
+	"os"
+	"slices"
+	"strings"
+	"time"
 
 	"github.com/open-cli-collective/google-readonly/internal/calendar"
 )
 
+// Output formats accepted by --output on the event-listing commands
+const (
+	outputText = "text"
+	outputICS  = "ics"
+)
+
 // EventListOptions configures how events are listed and displayed.
 type EventListOptions struct {
-	CalendarID   string
-	TimeMin      string // RFC3339 format
-	TimeMax      string // RFC3339 format
-	MaxResults   int64
-	Header       string // Header message to print (empty to show count-based header)
-	EmptyMessage string // Message when no events found
+	CalendarID        string // Calendar ID or name; names are resolved via ListCalendars
+	TimeMin           string // RFC3339 format
+	TimeMax           string // RFC3339 format
+	MaxResults        int64
+	SingleEvents      bool   // Expand recurring events into individual instances
+	BusyOnly          bool   // Drop events marked as free (transparent)
+	CollapseRecurring bool   // Print each recurring event's occurrences as one entry (needs SingleEvents)
+	SelfStatus        string // Keep only events where your response status is this (empty for all)
+	Attendee          string // Keep only events with this attendee email (empty for all)
+	Organizer         string // Keep only events organized by this email (empty for all)
+	MergeAdjacent     bool   // Print back-to-back events with the same summary as one entry
+	Summary           bool   // Print one line with the event count and the next event instead of the list
+	Output            string // outputText (default when empty) or outputICS
+	Header            string // Header message to print (empty to show count-based header)
+	EmptyMessage      string // Message when no events found
+}
+
+// selfStatuses are the attendee response statuses accepted by --self-status
+var selfStatuses = []string{"needsAction", "accepted", "declined", "tentative"}
+
+// listEventsOptions returns the events.list options for the given expansion
+// mode. The API only accepts orderBy=startTime for expanded instances, so
+// unexpanded listings fall back to the API's default ordering.
+func listEventsOptions(singleEvents bool) calendar.ListEventsOptions {
+	if singleEvents {
+		return calendar.ListEventsOptions{SingleEvents: true, OrderBy: "startTime"}
+	}
+	return calendar.ListEventsOptions{}
 }
 
 // listAndPrintEvents fetches events and prints them according to the options.
 // This is a shared helper used by today, week, and events commands.
 func listAndPrintEvents(ctx context.Context, client CalendarClient, opts EventListOptions) error {
-	events, err := client.ListEvents(ctx, opts.CalendarID, opts.TimeMin, opts.TimeMax, opts.MaxResults)
+	if opts.CollapseRecurring && !opts.SingleEvents {
+		return fmt.Errorf("--collapse-recurring requires --single-events")
+	}
+	if opts.MergeAdjacent && !opts.SingleEvents {
+		// Only expanded instances come back ordered by start time
+		return fmt.Errorf("--merge-adjacent requires --single-events")
+	}
+	if opts.MergeAdjacent && opts.CollapseRecurring {
+		return fmt.Errorf("--merge-adjacent cannot be combined with --collapse-recurring")
+	}
+	if opts.Summary && (opts.CollapseRecurring || opts.MergeAdjacent || opts.Output == outputICS) {
+		return fmt.Errorf("--summary cannot be combined with --collapse-recurring, --merge-adjacent or --output ics")
+	}
+	switch opts.Output {
+	case "", outputText:
+	case outputICS:
+		if opts.CollapseRecurring {
+			return fmt.Errorf("--collapse-recurring cannot be combined with --output ics")
+		}
+		if opts.MergeAdjacent {
+			return fmt.Errorf("--merge-adjacent cannot be combined with --output ics")
+		}
+	default:
+		return fmt.Errorf("invalid --output %q: must be %s or %s", opts.Output, outputText, outputICS)
+	}
+	if opts.SelfStatus != "" && !slices.Contains(selfStatuses, opts.SelfStatus) {
+		return fmt.Errorf("invalid --self-status %q: must be one of %s", opts.SelfStatus, strings.Join(selfStatuses, ", "))
+	}
+
+	calendarID, err := resolveCalendarID(ctx, client, opts.CalendarID)
+	if err != nil {
+		return fmt.Errorf("resolving calendar: %w", err)
+	}
+
+	events, err := client.ListEvents(ctx, calendarID, opts.TimeMin, opts.TimeMax, opts.MaxResults, listEventsOptions(opts.SingleEvents))
 	if err != nil {
 		return err
 	}
 
-	if len(events) == 0 {
+	parsedEvents := make([]*calendar.Event, len(events))
+	for i, e := range events {
+		parsedEvents[i] = calendar.ParseEvent(e)
+	}
+	parsedEvents = filterEvents(parsedEvents, opts)
+
+	if opts.Summary {
+		fmt.Println(summaryLine(parsedEvents, now()))
+		return nil
+	}
+
+	// iCalendar output is always a complete VCALENDAR, even when empty, so
+	// it can be redirected straight into a file.
+	if opts.Output == outputICS {
+		if err := calendar.WriteICS(os.Stdout, parsedEvents, time.Now()); err != nil {
+			return fmt.Errorf("writing iCalendar: %w", err)
+		}
+		return nil
+	}
+
+	if len(parsedEvents) == 0 {
 		if opts.EmptyMessage != "" {
 			fmt.Println(opts.EmptyMessage)
 		} else {
@@ -34,15 +120,32 @@ func listAndPrintEvents(ctx context.Context, client CalendarClient, opts EventLi
 		return nil
 	}
 
-	parsedEvents := make([]*calendar.Event, len(events))
-	for i, e := range events {
-		parsedEvents[i] = calendar.ParseEvent(e)
-	}
-
 	if opts.Header != "" {
 		fmt.Printf("%s\n\n", opts.Header)
 	} else {
-		fmt.Printf("Found %d event(s):\n\n", len(events))
+		fmt.Printf("Found %d event(s):\n\n", len(parsedEvents))
+	}
+
+	if opts.MergeAdjacent {
+		for _, group := range mergeAdjacent(parsedEvents) {
+			if len(group) == 1 {
+				printEventSummary(group[0])
+			} else {
+				printMergedSummary(group)
+			}
+		}
+		return nil
+	}
+
+	if opts.CollapseRecurring {
+		for _, group := range groupRecurring(parsedEvents) {
+			if len(group) == 1 {
+				printEventSummary(group[0])
+			} else {
+				printRecurringSummary(group)
+			}
+		}
+		return nil
 	}
 
 	for _, event := range parsedEvents {
@@ -51,3 +154,57 @@ func listAndPrintEvents(ctx context.Context, client CalendarClient, opts EventLi
 
 	return nil
 }
+
+// filterEvents applies the client-side filters in opts to events
+func filterEvents(events []*calendar.Event, opts EventListOptions) []*calendar.Event {
+	filtered := make([]*calendar.Event, 0, len(events))
+	for _, e := range events {
+		if opts.BusyOnly && !e.IsBusy() {
+			continue
+		}
+		if opts.SelfStatus != "" && e.SelfStatus() != opts.SelfStatus {
+			continue
+		}
+		if opts.Attendee != "" && !e.HasAttendee(opts.Attendee) {
+			continue
+		}
+		if opts.Organizer != "" && !e.OrganizedBy(opts.Organizer) {
+			continue
+		}
+		filtered = append(filtered, e)
+	}
+	return filtered
+}
+
+// summaryLine condenses events into one line such as "3 events, next:
+// Standup at 10:00 AM". The next event is the first timed one starting
+// after now; all-day events only count.
+func summaryLine(events []*calendar.Event, now time.Time) string {
+	count := fmt.Sprintf("%d events", len(events))
+	if len(events) == 1 {
+		count = "1 event"
+	}
+
+	var next *calendar.Event
+	var nextStart time.Time
+	for _, e := range events {
+		if e.AllDay {
+			continue
+		}
+		start, err := e.GetStartTime()
+		if err != nil || !start.After(now) {
+			continue
+		}
+		if next == nil || start.Before(nextStart) {
+			next, nextStart = e, start
+		}
+	}
+
+	if next == nil {
+		if len(events) == 0 {
+			return count
+		}
+		return count + ", none upcoming"
+	}
+	return fmt.Sprintf("%s, next: %s at %s", count, next.Summary, nextStart.In(now.Location()).Format("3:04 PM"))
+}
@@ -0,0 +1,94 @@
+package calendar
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/open-cli-collective/google-readonly/internal/format"
+)
+
+func newFreeBusyCommand() *cobra.Command {
+	var (
+		calendars []string
+		from      string
+		to        string
+	)
+
+	cmd := &cobra.Command{
+		Use:   "freebusy",
+		Short: "Show busy time blocks across calendars",
+		Long: `Show busy time blocks for one or more calendars.
+
+Uses the free/busy endpoint, so it works for calendars where you can see
+availability but not event details. By default, queries the primary
+calendar for the next 7 days starting today.
+
+Date format: YYYY-MM-DD (e.g., 2026-01-24)
+
+Examples:
+  gro calendar freebusy
+  gro cal freebusy --from 2026-01-05 --to 2026-01-09
+  gro cal freebusy --calendar primary,work@example.com`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			if len(calendars) == 0 {
+				return fmt.Errorf("at least one --calendar is required")
+			}
+
+			var start, end time.Time
+			if from != "" {
+				t, err := format.ParseDate(from)
+				if err != nil {
+					return fmt.Errorf("invalid --from date: %w", err)
+				}
+				start = t
+			} else {
+				start, _ = todayBounds(now())
+			}
+
+			if to != "" {
+				t, err := format.ParseDate(to)
+				if err != nil {
+					return fmt.Errorf("invalid --to date: %w", err)
+				}
+				end = endOfDay(t)
+			} else {
+				end = endOfDay(start.AddDate(0, 0, 6))
+			}
+
+			if end.Before(start) {
+				return fmt.Errorf("--to must not be before --from")
+			}
+
+			client, err := newCalendarClient(cmd.Context())
+			if err != nil {
+				return fmt.Errorf("creating Calendar client: %w", err)
+			}
+
+			ids, err := resolveCalendarIDs(cmd.Context(), client, calendars)
+			if err != nil {
+				return fmt.Errorf("resolving calendar: %w", err)
+			}
+
+			// The client already says what failed
+			result, err := client.QueryFreeBusy(cmd.Context(), ids, start.Format(time.RFC3339), end.Format(time.RFC3339))
+			if err != nil {
+				return err
+			}
+
+			fmt.Printf("Busy times (%s - %s):\n\n", start.Format("Mon, Jan 2"), end.Format("Mon, Jan 2, 2006"))
+			for _, busy := range result.Calendars {
+				printCalendarBusy(busy)
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().StringSliceVarP(&calendars, "calendar", "c", []string{"primary"}, "Calendar IDs or names to query (comma-separated)")
+	cmd.Flags().StringVar(&from, "from", "", "Start date (YYYY-MM-DD, default today)")
+	cmd.Flags().StringVar(&to, "to", "", "End date (YYYY-MM-DD, default 6 days after start)")
+
+	return cmd
+}
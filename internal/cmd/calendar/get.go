@@ -11,6 +11,7 @@ import (
 func newGetCommand() *cobra.Command {
 	var (
 		calendarID string
+		directions bool
 	)
 
 	cmd := &cobra.Command{
@@ -19,10 +20,12 @@ func newGetCommand() *cobra.Command {
 		Long: `Get the full details of a calendar event.
 
 Shows summary, time, location, description, attendees, and meeting links.
+Use --directions to include a Google Maps link for the event's location.
 
 Examples:
   gro calendar get abc123xyz
-  gro cal get abc123xyz --calendar work@group.calendar.google.com`,
+  gro cal get abc123xyz --calendar work@group.calendar.google.com
+  gro cal get abc123xyz --directions`,
 		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			eventID := args[0]
@@ -38,12 +41,16 @@ Examples:
 			}
 
 			parsedEvent := calendar.ParseEvent(event)
-			printEvent(parsedEvent, true)
+			printEvent(parsedEvent, EventPrintOptions{
+				ShowDescription: true,
+				ShowDirections:  directions,
+			})
 			return nil
 		},
 	}
 
 	cmd.Flags().StringVarP(&calendarID, "calendar", "c", "primary", "Calendar ID containing the event")
+	cmd.Flags().BoolVar(&directions, "directions", false, "Show a Google Maps directions link for the event location")
 
 	return cmd
 }
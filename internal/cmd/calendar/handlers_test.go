@@ -3,10 +3,14 @@ package calendar
 import (
 	"context"
 	"errors"
+	"strings"
 	"testing"
+	"time"
 
+	"github.com/spf13/cobra"
 	"google.golang.org/api/calendar/v3"
 
+	calendarapi "github.com/open-cli-collective/google-readonly/internal/calendar"
 	"github.com/open-cli-collective/google-readonly/internal/testutil"
 )
 
@@ -45,6 +49,30 @@ func TestListCommand_Success(t *testing.T) {
 	})
 }
 
+func TestListCommand_Selected(t *testing.T) {
+	cals := testutil.SampleCalendars()
+	cals[0].Selected = true
+	mock := &MockCalendarClient{
+		ListCalendarsFunc: func(_ context.Context) ([]*calendar.CalendarListEntry, error) {
+			return cals, nil
+		},
+	}
+
+	cmd := newListCommand()
+	cmd.SetArgs([]string{"--selected"})
+
+	withMockClient(mock, func() {
+		output := testutil.CaptureStdout(t, func() {
+			err := cmd.Execute()
+			testutil.NoError(t, err)
+		})
+
+		testutil.Contains(t, output, "Found 1 calendar(s)")
+		testutil.Contains(t, output, "primary@example.com")
+		testutil.NotContains(t, output, "work@example.com")
+	})
+}
+
 func TestListCommand_Empty(t *testing.T) {
 	mock := &MockCalendarClient{
 		ListCalendarsFunc: func(_ context.Context) ([]*calendar.CalendarListEntry, error) {
@@ -92,7 +120,7 @@ func TestListCommand_ClientCreationError(t *testing.T) {
 
 func TestEventsCommand_Success(t *testing.T) {
 	mock := &MockCalendarClient{
-		ListEventsFunc: func(_ context.Context, calendarID, _, _ string, _ int64) ([]*calendar.Event, error) {
+		ListEventsFunc: func(_ context.Context, calendarID, _, _ string, _ int64, _ calendarapi.ListEventsOptions) ([]*calendar.Event, error) {
 			testutil.Equal(t, calendarID, "primary")
 			return []*calendar.Event{testutil.SampleEvent("event1")}, nil
 		},
@@ -114,7 +142,7 @@ func TestEventsCommand_Success(t *testing.T) {
 func TestEventsCommand_WithDateRange(t *testing.T) {
 	var capturedTimeMin, capturedTimeMax string
 	mock := &MockCalendarClient{
-		ListEventsFunc: func(_ context.Context, _, timeMin, timeMax string, _ int64) ([]*calendar.Event, error) {
+		ListEventsFunc: func(_ context.Context, _, timeMin, timeMax string, _ int64, _ calendarapi.ListEventsOptions) ([]*calendar.Event, error) {
 			capturedTimeMin = timeMin
 			capturedTimeMax = timeMax
 			return []*calendar.Event{}, nil
@@ -137,6 +165,58 @@ func TestEventsCommand_WithDateRange(t *testing.T) {
 	})
 }
 
+func TestEventsCommand_CalendarName(t *testing.T) {
+	mock := &MockCalendarClient{
+		ListCalendarsFunc: func(_ context.Context) ([]*calendar.CalendarListEntry, error) {
+			return []*calendar.CalendarListEntry{
+				{Id: "primary@example.com", Summary: "Personal", Primary: true},
+				{Id: "team@group.calendar.google.com", Summary: "Team Calendar"},
+			}, nil
+		},
+		ListEventsFunc: func(_ context.Context, calendarID, _, _ string, _ int64, _ calendarapi.ListEventsOptions) ([]*calendar.Event, error) {
+			testutil.Equal(t, calendarID, "team@group.calendar.google.com")
+			return []*calendar.Event{testutil.SampleEvent("event1")}, nil
+		},
+	}
+
+	cmd := newEventsCommand()
+	cmd.SetArgs([]string{"--calendar", "team calendar"})
+
+	withMockClient(mock, func() {
+		output := testutil.CaptureStdout(t, func() {
+			err := cmd.Execute()
+			testutil.NoError(t, err)
+		})
+
+		testutil.Contains(t, output, "Test Meeting")
+	})
+}
+
+func TestTodayCommand_AmbiguousCalendarName(t *testing.T) {
+	mock := &MockCalendarClient{
+		ListCalendarsFunc: func(_ context.Context) ([]*calendar.CalendarListEntry, error) {
+			return []*calendar.CalendarListEntry{
+				{Id: "a@group.calendar.google.com", Summary: "Holidays"},
+				{Id: "b@group.calendar.google.com", Summary: "holidays"},
+			}, nil
+		},
+		ListEventsFunc: func(_ context.Context, _, _, _ string, _ int64, _ calendarapi.ListEventsOptions) ([]*calendar.Event, error) {
+			t.Fatal("ListEvents should not be called for an ambiguous calendar name")
+			return nil, nil
+		},
+	}
+
+	cmd := newTodayCommand()
+	cmd.SetArgs([]string{"--calendar", "Holidays"})
+
+	withMockClient(mock, func() {
+		err := cmd.Execute()
+		testutil.Error(t, err)
+		testutil.Contains(t, err.Error(), "resolving calendar")
+		testutil.Contains(t, err.Error(), "ambiguous")
+	})
+}
+
 func TestEventsCommand_InvalidFromDate(t *testing.T) {
 	cmd := newEventsCommand()
 	cmd.SetArgs([]string{"--from", "invalid-date"})
@@ -183,6 +263,48 @@ func TestGetCommand_Success(t *testing.T) {
 	})
 }
 
+func TestGetCommand_Directions(t *testing.T) {
+	mock := &MockCalendarClient{
+		GetEventFunc: func(_ context.Context, _, eventID string) (*calendar.Event, error) {
+			return testutil.SampleEvent(eventID), nil
+		},
+	}
+
+	cmd := newGetCommand()
+	cmd.SetArgs([]string{"event123", "--directions"})
+
+	withMockClient(mock, func() {
+		output := testutil.CaptureStdout(t, func() {
+			err := cmd.Execute()
+			testutil.NoError(t, err)
+		})
+
+		testutil.Contains(t, output, "Directions: https://www.google.com/maps/search/?api=1&query=Conference+Room+A")
+	})
+}
+
+func TestGetCommand_DirectionsNoLocation(t *testing.T) {
+	mock := &MockCalendarClient{
+		GetEventFunc: func(_ context.Context, _, eventID string) (*calendar.Event, error) {
+			event := testutil.SampleEvent(eventID)
+			event.Location = ""
+			return event, nil
+		},
+	}
+
+	cmd := newGetCommand()
+	cmd.SetArgs([]string{"event123", "--directions"})
+
+	withMockClient(mock, func() {
+		output := testutil.CaptureStdout(t, func() {
+			err := cmd.Execute()
+			testutil.NoError(t, err)
+		})
+
+		testutil.NotContains(t, output, "Directions:")
+	})
+}
+
 func TestGetCommand_NotFound(t *testing.T) {
 	mock := &MockCalendarClient{
 		GetEventFunc: func(_ context.Context, _, _ string) (*calendar.Event, error) {
@@ -202,7 +324,7 @@ func TestGetCommand_NotFound(t *testing.T) {
 
 func TestTodayCommand_Success(t *testing.T) {
 	mock := &MockCalendarClient{
-		ListEventsFunc: func(_ context.Context, _, _, _ string, _ int64) ([]*calendar.Event, error) {
+		ListEventsFunc: func(_ context.Context, _, _, _ string, _ int64, _ calendarapi.ListEventsOptions) ([]*calendar.Event, error) {
 			return []*calendar.Event{testutil.SampleEvent("today_event")}, nil
 		},
 	}
@@ -221,7 +343,7 @@ func TestTodayCommand_Success(t *testing.T) {
 
 func TestWeekCommand_Success(t *testing.T) {
 	mock := &MockCalendarClient{
-		ListEventsFunc: func(_ context.Context, _, _, _ string, _ int64) ([]*calendar.Event, error) {
+		ListEventsFunc: func(_ context.Context, _, _, _ string, _ int64, _ calendarapi.ListEventsOptions) ([]*calendar.Event, error) {
 			return []*calendar.Event{
 				testutil.SampleEvent("week_event1"),
 				testutil.SampleEvent("week_event2"),
@@ -241,3 +363,606 @@ func TestWeekCommand_Success(t *testing.T) {
 		testutil.Contains(t, output, "Test Meeting")
 	})
 }
+
+func TestWeekCommand_SingleEventsDefault(t *testing.T) {
+	var captured calendarapi.ListEventsOptions
+	mock := &MockCalendarClient{
+		ListEventsFunc: func(_ context.Context, _, _, _ string, _ int64, opts calendarapi.ListEventsOptions) ([]*calendar.Event, error) {
+			captured = opts
+			return []*calendar.Event{}, nil
+		},
+	}
+
+	cmd := newWeekCommand()
+
+	withMockClient(mock, func() {
+		testutil.CaptureStdout(t, func() {
+			err := cmd.Execute()
+			testutil.NoError(t, err)
+		})
+
+		testutil.True(t, captured.SingleEvents)
+		testutil.Equal(t, captured.OrderBy, "startTime")
+	})
+}
+
+func TestTodayCommand_SingleEventsDefault(t *testing.T) {
+	var captured calendarapi.ListEventsOptions
+	mock := &MockCalendarClient{
+		ListEventsFunc: func(_ context.Context, _, _, _ string, _ int64, opts calendarapi.ListEventsOptions) ([]*calendar.Event, error) {
+			captured = opts
+			return []*calendar.Event{}, nil
+		},
+	}
+
+	cmd := newTodayCommand()
+
+	withMockClient(mock, func() {
+		testutil.CaptureStdout(t, func() {
+			err := cmd.Execute()
+			testutil.NoError(t, err)
+		})
+
+		testutil.True(t, captured.SingleEvents)
+		testutil.Equal(t, captured.OrderBy, "startTime")
+	})
+}
+
+func TestEventsCommand_SingleEventsDisabled(t *testing.T) {
+	var captured calendarapi.ListEventsOptions
+	mock := &MockCalendarClient{
+		ListEventsFunc: func(_ context.Context, _, _, _ string, _ int64, opts calendarapi.ListEventsOptions) ([]*calendar.Event, error) {
+			captured = opts
+			return []*calendar.Event{}, nil
+		},
+	}
+
+	cmd := newEventsCommand()
+	cmd.SetArgs([]string{"--single-events=false"})
+
+	withMockClient(mock, func() {
+		testutil.CaptureStdout(t, func() {
+			err := cmd.Execute()
+			testutil.NoError(t, err)
+		})
+
+		testutil.False(t, captured.SingleEvents)
+		testutil.Equal(t, captured.OrderBy, "")
+	})
+}
+
+func TestFreeBusyCommand_Success(t *testing.T) {
+	var gotIDs []string
+	var gotMin, gotMax string
+	mock := &MockCalendarClient{
+		QueryFreeBusyFunc: func(_ context.Context, calendarIDs []string, timeMin, timeMax string) (*calendarapi.FreeBusyResult, error) {
+			gotIDs = calendarIDs
+			gotMin, gotMax = timeMin, timeMax
+			return &calendarapi.FreeBusyResult{
+				Calendars: []*calendarapi.CalendarBusy{
+					{
+						CalendarID: "primary",
+						Busy: []calendarapi.TimeRange{{
+							Start: time.Date(2024, 1, 2, 9, 0, 0, 0, time.UTC),
+							End:   time.Date(2024, 1, 2, 10, 0, 0, 0, time.UTC),
+						}},
+					},
+					{CalendarID: "work@example.com"},
+				},
+			}, nil
+		},
+	}
+
+	cmd := newFreeBusyCommand()
+	cmd.SetArgs([]string{"--from", "2024-01-01", "--to", "2024-01-07", "--calendar", "primary,work@example.com"})
+
+	withMockClient(mock, func() {
+		output := testutil.CaptureStdout(t, func() {
+			err := cmd.Execute()
+			testutil.NoError(t, err)
+		})
+
+		testutil.Equal(t, len(gotIDs), 2)
+		testutil.Equal(t, gotIDs[1], "work@example.com")
+		testutil.Contains(t, gotMin, "2024-01-01")
+		testutil.Contains(t, gotMax, "2024-01-07")
+		testutil.Contains(t, output, "Calendar: primary")
+		testutil.Contains(t, output, "Tue, Jan 2, 2024 9:00 AM - 10:00 AM")
+		testutil.Contains(t, output, "Calendar: work@example.com")
+		testutil.Contains(t, output, "No busy time")
+	})
+}
+
+func TestFreeBusyCommand_InvalidRange(t *testing.T) {
+	cmd := newFreeBusyCommand()
+	cmd.SetArgs([]string{"--from", "2024-01-07", "--to", "2024-01-01"})
+
+	withMockClient(&MockCalendarClient{}, func() {
+		err := cmd.Execute()
+		testutil.Error(t, err)
+		testutil.Contains(t, err.Error(), "--to must not be before --from")
+	})
+}
+
+func TestFreeBusyCommand_APIError(t *testing.T) {
+	mock := &MockCalendarClient{
+		QueryFreeBusyFunc: func(_ context.Context, _ []string, _, _ string) (*calendarapi.FreeBusyResult, error) {
+			// As the real client wraps it
+			return nil, errors.New("querying free/busy: API error")
+		},
+	}
+
+	cmd := newFreeBusyCommand()
+	cmd.SetArgs([]string{})
+
+	withMockClient(mock, func() {
+		err := cmd.Execute()
+		testutil.Error(t, err)
+		testutil.Equal(t, err.Error(), "querying free/busy: API error")
+	})
+}
+
+func TestFreeBusyCommand_ListsCalendarsOnce(t *testing.T) {
+	listed := 0
+	var gotIDs []string
+	mock := &MockCalendarClient{
+		ListCalendarsFunc: func(_ context.Context) ([]*calendar.CalendarListEntry, error) {
+			listed++
+			return []*calendar.CalendarListEntry{
+				{Id: "team@group.calendar.google.com", Summary: "Team"},
+				{Id: "ooo@group.calendar.google.com", Summary: "Out of Office"},
+			}, nil
+		},
+		QueryFreeBusyFunc: func(_ context.Context, calendarIDs []string, _, _ string) (*calendarapi.FreeBusyResult, error) {
+			gotIDs = calendarIDs
+			return &calendarapi.FreeBusyResult{}, nil
+		},
+	}
+
+	cmd := newFreeBusyCommand()
+	cmd.SetArgs([]string{"--calendar", "Team,primary,out of office"})
+
+	withMockClient(mock, func() {
+		testutil.CaptureStdout(t, func() {
+			testutil.NoError(t, cmd.Execute())
+		})
+	})
+
+	testutil.Equal(t, listed, 1)
+	testutil.Equal(t, strings.Join(gotIDs, ","), "team@group.calendar.google.com,primary,ooo@group.calendar.google.com")
+}
+
+func TestWeekCommand_BusyOnly(t *testing.T) {
+	mock := &MockCalendarClient{
+		ListEventsFunc: func(_ context.Context, _, _, _ string, _ int64, _ calendarapi.ListEventsOptions) ([]*calendar.Event, error) {
+			busy := testutil.SampleEvent("busy_event")
+			busy.Summary = "Design Review"
+			free := testutil.SampleEvent("free_event")
+			free.Summary = "Public Holiday"
+			free.Transparency = "transparent"
+			return []*calendar.Event{busy, free}, nil
+		},
+	}
+
+	cmd := newWeekCommand()
+	cmd.SetArgs([]string{"--busy-only"})
+
+	withMockClient(mock, func() {
+		output := testutil.CaptureStdout(t, func() {
+			err := cmd.Execute()
+			testutil.NoError(t, err)
+		})
+
+		testutil.Contains(t, output, "Design Review")
+		testutil.NotContains(t, output, "Public Holiday")
+	})
+}
+
+func TestEventsCommand_BusyOnlyAllFree(t *testing.T) {
+	mock := &MockCalendarClient{
+		ListEventsFunc: func(_ context.Context, _, _, _ string, _ int64, _ calendarapi.ListEventsOptions) ([]*calendar.Event, error) {
+			free := testutil.SampleEvent("free_event")
+			free.Transparency = "transparent"
+			return []*calendar.Event{free}, nil
+		},
+	}
+
+	cmd := newEventsCommand()
+	cmd.SetArgs([]string{"--busy-only"})
+
+	withMockClient(mock, func() {
+		output := testutil.CaptureStdout(t, func() {
+			err := cmd.Execute()
+			testutil.NoError(t, err)
+		})
+
+		testutil.Contains(t, output, "No events found.")
+	})
+}
+
+func TestEventsCommand_SelfStatus(t *testing.T) {
+	withSelf := func(id, summary, status string) *calendar.Event {
+		e := testutil.SampleEvent(id)
+		e.Summary = summary
+		e.Attendees = []*calendar.EventAttendee{
+			{Email: "organizer@example.com", ResponseStatus: "accepted"},
+			{Email: "me@example.com", Self: true, ResponseStatus: status},
+		}
+		return e
+	}
+	mock := &MockCalendarClient{
+		ListEventsFunc: func(_ context.Context, _, _, _ string, _ int64, _ calendarapi.ListEventsOptions) ([]*calendar.Event, error) {
+			solo := testutil.SampleEvent("solo")
+			solo.Summary = "Focus Time"
+			return []*calendar.Event{
+				withSelf("e1", "Unanswered Sync", "needsAction"),
+				withSelf("e2", "Accepted Review", "accepted"),
+				withSelf("e3", "Declined Offsite", "declined"),
+				solo,
+			}, nil
+		},
+	}
+
+	t.Run("needsAction keeps only unanswered events", func(t *testing.T) {
+		cmd := newEventsCommand()
+		cmd.SetArgs([]string{"--self-status", "needsAction"})
+
+		withMockClient(mock, func() {
+			output := testutil.CaptureStdout(t, func() {
+				err := cmd.Execute()
+				testutil.NoError(t, err)
+			})
+
+			testutil.Contains(t, output, "Found 1 event(s)")
+			testutil.Contains(t, output, "Unanswered Sync")
+			testutil.NotContains(t, output, "Accepted Review")
+			testutil.NotContains(t, output, "Declined Offsite")
+			testutil.NotContains(t, output, "Focus Time")
+		})
+	})
+
+	t.Run("rejects an unknown status", func(t *testing.T) {
+		cmd := newEventsCommand()
+		cmd.SetArgs([]string{"--self-status", "maybe"})
+
+		withMockClient(mock, func() {
+			err := cmd.Execute()
+			testutil.Error(t, err)
+			testutil.Contains(t, err.Error(), `invalid --self-status "maybe"`)
+		})
+	})
+}
+
+func TestEventsCommand_AttendeeAndOrganizer(t *testing.T) {
+	event := func(id, summary, organizer string, attendees ...string) *calendar.Event {
+		e := &calendar.Event{
+			Id:      id,
+			Summary: summary,
+			Start:   &calendar.EventDateTime{DateTime: "2026-03-02T10:00:00Z"},
+			End:     &calendar.EventDateTime{DateTime: "2026-03-02T11:00:00Z"},
+		}
+		if organizer != "" {
+			e.Organizer = &calendar.EventOrganizer{Email: organizer}
+		}
+		for _, a := range attendees {
+			e.Attendees = append(e.Attendees, &calendar.EventAttendee{Email: a})
+		}
+		return e
+	}
+	var gotMin, gotMax string
+	mock := &MockCalendarClient{
+		ListEventsFunc: func(_ context.Context, _, timeMin, timeMax string, _ int64, _ calendarapi.ListEventsOptions) ([]*calendar.Event, error) {
+			gotMin, gotMax = timeMin, timeMax
+			return []*calendar.Event{
+				event("e1", "Sync with Bob", "alice@example.com", "alice@example.com", "Bob@Example.com"),
+				event("e2", "Team Lunch", "carol@example.com", "carol@example.com", "dave@example.com"),
+				event("e3", "Focus Time", ""),
+			}, nil
+		},
+	}
+
+	tests := []struct {
+		name    string
+		args    []string
+		want    []string
+		notWant []string
+	}{
+		{"attendee matches ignoring case", []string{"--attendee", "bob@example.com"}, []string{"Sync with Bob"}, []string{"Team Lunch", "Focus Time"}},
+		{"organizer", []string{"--organizer", "CAROL@example.com"}, []string{"Team Lunch"}, []string{"Sync with Bob", "Focus Time"}},
+		{"both must match", []string{"--attendee", "bob@example.com", "--organizer", "carol@example.com"}, []string{"No events found."}, []string{"Sync with Bob", "Team Lunch"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cmd := newEventsCommand()
+			cmd.SetArgs(append([]string{"--from", "2026-03-01", "--to", "2026-03-31"}, tt.args...))
+
+			withMockClient(mock, func() {
+				output := testutil.CaptureStdout(t, func() {
+					testutil.NoError(t, cmd.Execute())
+				})
+				for _, s := range tt.want {
+					testutil.Contains(t, output, s)
+				}
+				for _, s := range tt.notWant {
+					testutil.NotContains(t, output, s)
+				}
+			})
+			testutil.Contains(t, gotMin, "2026-03-01")
+			testutil.Contains(t, gotMax, "2026-03-31")
+		})
+	}
+}
+
+func TestEventsCommand_MergeAdjacent(t *testing.T) {
+	timed := func(id, summary string, startHour, endHour int) *calendar.Event {
+		start := time.Date(2026, 1, 5, startHour, 0, 0, 0, time.UTC)
+		end := time.Date(2026, 1, 5, endHour, 0, 0, 0, time.UTC)
+		return &calendar.Event{
+			Id:      id,
+			Summary: summary,
+			Start:   &calendar.EventDateTime{DateTime: start.Format(time.RFC3339)},
+			End:     &calendar.EventDateTime{DateTime: end.Format(time.RFC3339)},
+		}
+	}
+	mock := &MockCalendarClient{
+		ListEventsFunc: func(_ context.Context, _, _, _ string, _ int64, _ calendarapi.ListEventsOptions) ([]*calendar.Event, error) {
+			return []*calendar.Event{
+				timed("focus_1", "Focus", 9, 10),
+				timed("focus_2", "Focus", 10, 11),
+				timed("focus_3", "Focus", 11, 12),
+				timed("review", "Design Review", 12, 13),
+			}, nil
+		},
+	}
+
+	t.Run("combines the matching adjacent blocks only", func(t *testing.T) {
+		cmd := newEventsCommand()
+		cmd.SetArgs([]string{"--merge-adjacent"})
+
+		withMockClient(mock, func() {
+			output := testutil.CaptureStdout(t, func() {
+				err := cmd.Execute()
+				testutil.NoError(t, err)
+			})
+
+			testutil.Contains(t, output, "Summary: Focus (3 blocks)")
+			testutil.Contains(t, output, "9:00 AM - 12:00 PM")
+			testutil.Contains(t, output, "Summary: Design Review\n")
+			testutil.NotContains(t, output, "focus_2")
+			testutil.Equal(t, strings.Count(output, "---"), 2)
+		})
+	})
+
+	t.Run("rejects --collapse-recurring", func(t *testing.T) {
+		cmd := newEventsCommand()
+		cmd.SetArgs([]string{"--merge-adjacent", "--collapse-recurring"})
+
+		withMockClient(mock, func() {
+			err := cmd.Execute()
+			testutil.Error(t, err)
+			testutil.Contains(t, err.Error(), "cannot be combined with --collapse-recurring")
+		})
+	})
+}
+
+// standupOccurrences returns n daily 9:00 occurrences of one recurring event
+// starting Monday 2026-01-05
+func standupOccurrences(n int) []*calendar.Event {
+	events := make([]*calendar.Event, n)
+	for i := range events {
+		day := time.Date(2026, 1, 5+i, 9, 0, 0, 0, time.UTC)
+		events[i] = &calendar.Event{
+			Id:               "standup_" + day.Format("20060102"),
+			Summary:          "Standup",
+			RecurringEventId: "standup",
+			Start:            &calendar.EventDateTime{DateTime: day.Format(time.RFC3339)},
+			End:              &calendar.EventDateTime{DateTime: day.Add(15 * time.Minute).Format(time.RFC3339)},
+		}
+	}
+	return events
+}
+
+func TestWeekCommand_CollapseRecurring(t *testing.T) {
+	mock := &MockCalendarClient{
+		ListEventsFunc: func(_ context.Context, _, _, _ string, _ int64, _ calendarapi.ListEventsOptions) ([]*calendar.Event, error) {
+			events := standupOccurrences(5)
+			review := testutil.SampleEvent("review")
+			review.Summary = "Design Review"
+			return append(events, review), nil
+		},
+	}
+
+	cmd := newWeekCommand()
+	cmd.SetArgs([]string{"--collapse-recurring"})
+
+	withMockClient(mock, func() {
+		output := testutil.CaptureStdout(t, func() {
+			err := cmd.Execute()
+			testutil.NoError(t, err)
+		})
+
+		testutil.Contains(t, output, "Summary: Standup (daily, 5 occurrences)")
+		testutil.Contains(t, output, "Occurrences: Mon Jan 5 9:00 AM, Tue Jan 6 9:00 AM, Wed Jan 7 9:00 AM, Thu Jan 8 9:00 AM, Fri Jan 9 9:00 AM")
+		testutil.Equal(t, strings.Count(output, "Summary: Standup"), 1)
+		testutil.Contains(t, output, "Summary: Design Review")
+	})
+}
+
+func TestEventsCommand_CollapseRecurringWithoutExpansion(t *testing.T) {
+	cmd := newEventsCommand()
+	cmd.SetArgs([]string{"--collapse-recurring", "--single-events=false"})
+
+	withMockClient(&MockCalendarClient{}, func() {
+		err := cmd.Execute()
+		testutil.Error(t, err)
+		testutil.Contains(t, err.Error(), "requires --single-events")
+	})
+}
+
+func TestEventsCommand_OutputICS(t *testing.T) {
+	mock := &MockCalendarClient{
+		ListEventsFunc: func(_ context.Context, _, _, _ string, _ int64, _ calendarapi.ListEventsOptions) ([]*calendar.Event, error) {
+			return []*calendar.Event{testutil.SampleEvent("event1"), testutil.SampleEvent("event2")}, nil
+		},
+	}
+
+	cmd := newEventsCommand()
+	cmd.SetArgs([]string{"--output", "ics"})
+
+	withMockClient(mock, func() {
+		output := testutil.CaptureStdout(t, func() {
+			err := cmd.Execute()
+			testutil.NoError(t, err)
+		})
+
+		testutil.True(t, strings.HasPrefix(output, "BEGIN:VCALENDAR\r\n"))
+		testutil.True(t, strings.HasSuffix(output, "END:VCALENDAR\r\n"))
+		testutil.Equal(t, strings.Count(output, "BEGIN:VEVENT\r\n"), 2)
+		testutil.Equal(t, strings.Count(output, "END:VEVENT\r\n"), 2)
+		testutil.Contains(t, output, "UID:event1\r\n")
+		testutil.Contains(t, output, "UID:event2\r\n")
+		testutil.Contains(t, output, "SUMMARY:Test Meeting\r\n")
+		testutil.Contains(t, output, "DTSTART:20240115T180000Z\r\n")
+		testutil.Contains(t, output, "DTEND:20240115T190000Z\r\n")
+		testutil.Contains(t, output, "LOCATION:Conference Room A\r\n")
+		testutil.NotContains(t, output, "Found 2 event(s)")
+	})
+}
+
+func TestEventsCommand_OutputICSEmpty(t *testing.T) {
+	cmd := newEventsCommand()
+	cmd.SetArgs([]string{"-o", "ics"})
+
+	withMockClient(&MockCalendarClient{}, func() {
+		output := testutil.CaptureStdout(t, func() {
+			err := cmd.Execute()
+			testutil.NoError(t, err)
+		})
+
+		testutil.NotContains(t, output, "No events")
+		testutil.NotContains(t, output, "VEVENT")
+		testutil.Contains(t, output, "END:VCALENDAR")
+	})
+}
+
+func TestEventsCommand_OutputValidation(t *testing.T) {
+	t.Run("unknown format", func(t *testing.T) {
+		cmd := newEventsCommand()
+		cmd.SetArgs([]string{"--output", "json"})
+
+		withMockClient(&MockCalendarClient{}, func() {
+			err := cmd.Execute()
+			testutil.Error(t, err)
+			testutil.Contains(t, err.Error(), `invalid --output "json"`)
+		})
+	})
+
+	t.Run("ics with collapse-recurring", func(t *testing.T) {
+		cmd := newEventsCommand()
+		cmd.SetArgs([]string{"--output", "ics", "--collapse-recurring"})
+
+		withMockClient(&MockCalendarClient{}, func() {
+			err := cmd.Execute()
+			testutil.Error(t, err)
+			testutil.Contains(t, err.Error(), "cannot be combined with --output ics")
+		})
+	})
+}
+
+func TestTodayAndWeekCommands_OutputICS(t *testing.T) {
+	for name, newCmd := range map[string]func() *cobra.Command{
+		"today": newTodayCommand,
+		"week":  newWeekCommand,
+	} {
+		t.Run(name, func(t *testing.T) {
+			mock := &MockCalendarClient{
+				ListEventsFunc: func(_ context.Context, _, _, _ string, _ int64, _ calendarapi.ListEventsOptions) ([]*calendar.Event, error) {
+					return []*calendar.Event{testutil.SampleEvent("event1")}, nil
+				},
+			}
+
+			cmd := newCmd()
+			cmd.SetArgs([]string{"--output", "ics"})
+
+			withMockClient(mock, func() {
+				output := testutil.CaptureStdout(t, func() {
+					err := cmd.Execute()
+					testutil.NoError(t, err)
+				})
+
+				testutil.Equal(t, strings.Count(output, "BEGIN:VEVENT\r\n"), 1)
+				testutil.NotContains(t, output, "events (")
+			})
+		})
+	}
+}
+
+func TestSummaryLine(t *testing.T) {
+	loc := time.FixedZone("UTC-5", -5*60*60)
+	now := time.Date(2026, 1, 26, 9, 30, 0, 0, loc)
+	event := func(summary, start string) *calendarapi.Event {
+		return &calendarapi.Event{Summary: summary, Start: &calendarapi.EventTime{DateTime: start}}
+	}
+	holiday := &calendarapi.Event{Summary: "Holiday", AllDay: true, Start: &calendarapi.EventTime{Date: "2026-01-26"}}
+
+	tests := []struct {
+		name   string
+		events []*calendarapi.Event
+		want   string
+	}{
+		{"no events", nil, "0 events"},
+		{"one event", []*calendarapi.Event{event("Standup", "2026-01-26T10:00:00-05:00")}, "1 event, next: Standup at 10:00 AM"},
+		{
+			name: "soonest future event",
+			events: []*calendarapi.Event{
+				holiday,
+				event("Breakfast", "2026-01-26T08:00:00-05:00"),
+				event("Review", "2026-01-26T15:00:00-05:00"),
+				event("Standup", "2026-01-26T10:00:00-05:00"),
+			},
+			want: "4 events, next: Standup at 10:00 AM",
+		},
+		{"converts to now's zone", []*calendarapi.Event{event("Call", "2026-01-26T17:00:00Z")}, "1 event, next: Call at 12:00 PM"},
+		{"nothing left", []*calendarapi.Event{holiday, event("Breakfast", "2026-01-26T08:00:00-05:00")}, "2 events, none upcoming"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			testutil.Equal(t, summaryLine(tt.events, now), tt.want)
+		})
+	}
+}
+
+func TestTodayCommand_Summary(t *testing.T) {
+	start := time.Now().Add(time.Hour)
+	mock := &MockCalendarClient{
+		ListEventsFunc: func(_ context.Context, _, _, _ string, _ int64, _ calendarapi.ListEventsOptions) ([]*calendar.Event, error) {
+			return []*calendar.Event{{
+				Id:      "e1",
+				Summary: "Standup",
+				Start:   &calendar.EventDateTime{DateTime: start.Format(time.RFC3339)},
+				End:     &calendar.EventDateTime{DateTime: start.Add(15 * time.Minute).Format(time.RFC3339)},
+			}}, nil
+		},
+	}
+
+	cmd := newTodayCommand()
+	cmd.SetArgs([]string{"--summary"})
+
+	withMockClient(mock, func() {
+		output := testutil.CaptureStdout(t, func() {
+			testutil.NoError(t, cmd.Execute())
+		})
+		testutil.Equal(t, output, "1 event, next: Standup at "+start.Format("3:04 PM")+"\n")
+	})
+}
+
+func TestTodayCommand_SummaryWithICS(t *testing.T) {
+	cmd := newTodayCommand()
+	cmd.SetArgs([]string{"--summary", "--output", "ics"})
+
+	withMockClient(&MockCalendarClient{}, func() {
+		err := cmd.Execute()
+		testutil.Error(t, err)
+		testutil.Contains(t, err.Error(), "--summary cannot be combined")
+	})
+}
@@ -9,15 +9,20 @@ import (
 )
 
 func newListCommand() *cobra.Command {
+	var selectedOnly bool
+
 	cmd := &cobra.Command{
 		Use:   "list",
 		Short: "List all calendars",
 		Long: `List all calendars the user has access to.
 
-Shows primary calendar, shared calendars, and subscribed calendars.
+Shows primary calendar, shared calendars, and subscribed calendars, with
+each calendar's color and whether it is selected (its events shown in the
+Calendar UI) or hidden from the calendar list.
 
 Examples:
-  gro calendar list`,
+  gro calendar list
+  gro calendar list --selected`,
 		Args: cobra.NoArgs,
 		RunE: func(cmd *cobra.Command, _ []string) error {
 			client, err := newCalendarClient(cmd.Context())
@@ -30,24 +35,31 @@ Examples:
 				return fmt.Errorf("listing calendars: %w", err)
 			}
 
-			if len(calendars) == 0 {
-				fmt.Println("No calendars found.")
-				return nil
+			calInfos := make([]*calendar.CalendarInfo, 0, len(calendars))
+			for _, c := range calendars {
+				if selectedOnly && !c.Selected {
+					continue
+				}
+				calInfos = append(calInfos, calendar.ParseCalendar(c))
 			}
 
-			calInfos := make([]*calendar.CalendarInfo, len(calendars))
-			for i, c := range calendars {
-				calInfos[i] = calendar.ParseCalendar(c)
+			if len(calInfos) == 0 {
+				if selectedOnly {
+					fmt.Println("No selected calendars found.")
+				} else {
+					fmt.Println("No calendars found.")
+				}
+				return nil
 			}
 
-			fmt.Printf("Found %d calendar(s):\n\n", len(calendars))
-			for _, cal := range calInfos {
-				printCalendar(cal)
-			}
+			fmt.Printf("Found %d calendar(s):\n\n", len(calInfos))
+			printCalendarTable(calInfos)
 
 			return nil
 		},
 	}
 
+	cmd.Flags().BoolVar(&selectedOnly, "selected", false, "Only list calendars selected in the Calendar UI")
+
 	return cmd
 }
@@ -0,0 +1,58 @@
+package calendar
+
+import (
+	"fmt"
+
+	"github.com/open-cli-collective/google-readonly/internal/calendar"
+)
+
+// mergeAdjacent groups runs of consecutive timed events that share a summary
+// and follow each other with no gap, as time-blocked calendars produce.
+// Events must already be sorted by start time. All-day events and events
+// whose times do not parse always stay in groups of one.
+func mergeAdjacent(events []*calendar.Event) [][]*calendar.Event {
+	var groups [][]*calendar.Event
+	for _, e := range events {
+		if n := len(groups); n > 0 && continuesBlock(groups[n-1][len(groups[n-1])-1], e) {
+			groups[n-1] = append(groups[n-1], e)
+			continue
+		}
+		groups = append(groups, []*calendar.Event{e})
+	}
+	return groups
+}
+
+// continuesBlock reports whether next starts exactly when prev ends and
+// carries the same summary
+func continuesBlock(prev, next *calendar.Event) bool {
+	if prev.AllDay || next.AllDay || prev.Summary != next.Summary {
+		return false
+	}
+	end, err := prev.GetEndTime()
+	if err != nil {
+		return false
+	}
+	start, err := next.GetStartTime()
+	if err != nil {
+		return false
+	}
+	return start.Equal(end)
+}
+
+// printMergedSummary prints a run of adjacent blocks as one entry spanning
+// the start of the first to the end of the last
+func printMergedSummary(blocks []*calendar.Event) {
+	first, last := blocks[0], blocks[len(blocks)-1]
+	span := *first
+	span.End = last.End
+
+	fmt.Printf("ID: %s\n", first.ID)
+	fmt.Printf("Summary: %s (%d blocks)\n", first.Summary, len(blocks))
+	fmt.Printf("When: %s\n", formatEventTime(&span))
+
+	if first.Location != "" {
+		fmt.Printf("Location: %s\n", first.Location)
+	}
+
+	fmt.Println("---")
+}
@@ -0,0 +1,81 @@
+package calendar
+
+import (
+	"testing"
+
+	"github.com/open-cli-collective/google-readonly/internal/calendar"
+	"github.com/open-cli-collective/google-readonly/internal/testutil"
+)
+
+// block builds a timed event on 2026-01-05 from start to end (HH:MM, UTC)
+func block(id, summary, start, end string) *calendar.Event {
+	return &calendar.Event{
+		ID:      id,
+		Summary: summary,
+		Start:   &calendar.EventTime{DateTime: "2026-01-05T" + start + ":00Z"},
+		End:     &calendar.EventTime{DateTime: "2026-01-05T" + end + ":00Z"},
+	}
+}
+
+func TestMergeAdjacent(t *testing.T) {
+	t.Run("merges only matching adjacent blocks", func(t *testing.T) {
+		groups := mergeAdjacent([]*calendar.Event{
+			block("f1", "Focus", "09:00", "10:00"),
+			block("f2", "Focus", "10:00", "11:00"),
+			block("f3", "Focus", "11:00", "12:00"),
+			block("l1", "Lunch", "12:00", "13:00"),
+		})
+
+		testutil.Len(t, groups, 2)
+		testutil.Len(t, groups[0], 3)
+		testutil.Equal(t, groups[0][2].ID, "f3")
+		testutil.Len(t, groups[1], 1)
+		testutil.Equal(t, groups[1][0].ID, "l1")
+	})
+
+	t.Run("a gap breaks the run", func(t *testing.T) {
+		groups := mergeAdjacent([]*calendar.Event{
+			block("f1", "Focus", "09:00", "10:00"),
+			block("f2", "Focus", "10:15", "11:00"),
+		})
+		testutil.Len(t, groups, 2)
+	})
+
+	t.Run("a different title in between breaks the run", func(t *testing.T) {
+		groups := mergeAdjacent([]*calendar.Event{
+			block("f1", "Focus", "09:00", "10:00"),
+			block("s1", "Standup", "10:00", "10:15"),
+			block("f2", "Focus", "10:15", "11:00"),
+		})
+		testutil.Len(t, groups, 3)
+	})
+
+	t.Run("all-day events never merge", func(t *testing.T) {
+		day := func(id, date, end string) *calendar.Event {
+			return &calendar.Event{
+				ID: id, Summary: "Offsite", AllDay: true,
+				Start: &calendar.EventTime{Date: date},
+				End:   &calendar.EventTime{Date: end},
+			}
+		}
+		groups := mergeAdjacent([]*calendar.Event{
+			day("d1", "2026-01-05", "2026-01-06"),
+			day("d2", "2026-01-06", "2026-01-07"),
+		})
+		testutil.Len(t, groups, 2)
+	})
+}
+
+func TestPrintMergedSummary(t *testing.T) {
+	output := testutil.CaptureStdout(t, func() {
+		printMergedSummary([]*calendar.Event{
+			block("f1", "Focus", "09:00", "10:00"),
+			block("f2", "Focus", "10:00", "11:00"),
+			block("f3", "Focus", "11:00", "12:00"),
+		})
+	})
+
+	testutil.Contains(t, output, "ID: f1\n")
+	testutil.Contains(t, output, "Summary: Focus (3 blocks)\n")
+	testutil.Contains(t, output, "When: Mon, Jan 5, 2026 9:00 AM - 12:00 PM\n")
+}
@@ -4,13 +4,16 @@ import (
 	"context"
 
 	"google.golang.org/api/calendar/v3"
+
+	calendarapi "github.com/open-cli-collective/google-readonly/internal/calendar"
 )
 
 // MockCalendarClient is a configurable mock for CalendarClient.
 type MockCalendarClient struct {
 	ListCalendarsFunc func(ctx context.Context) ([]*calendar.CalendarListEntry, error)
-	ListEventsFunc    func(ctx context.Context, calendarID, timeMin, timeMax string, maxResults int64) ([]*calendar.Event, error)
+	ListEventsFunc    func(ctx context.Context, calendarID, timeMin, timeMax string, maxResults int64, opts calendarapi.ListEventsOptions) ([]*calendar.Event, error)
 	GetEventFunc      func(ctx context.Context, calendarID, eventID string) (*calendar.Event, error)
+	QueryFreeBusyFunc func(ctx context.Context, calendarIDs []string, timeMin, timeMax string) (*calendarapi.FreeBusyResult, error)
 	RSVPEventFunc     func(ctx context.Context, calendarID, eventID, response string) error
 	SetEventColorFunc func(ctx context.Context, calendarID, eventID, colorID string) error
 }
@@ -25,9 +28,9 @@ func (m *MockCalendarClient) ListCalendars(ctx context.Context) ([]*calendar.Cal
 	return nil, nil
 }
 
-func (m *MockCalendarClient) ListEvents(ctx context.Context, calendarID, timeMin, timeMax string, maxResults int64) ([]*calendar.Event, error) {
+func (m *MockCalendarClient) ListEvents(ctx context.Context, calendarID, timeMin, timeMax string, maxResults int64, opts calendarapi.ListEventsOptions) ([]*calendar.Event, error) {
 	if m.ListEventsFunc != nil {
-		return m.ListEventsFunc(ctx, calendarID, timeMin, timeMax, maxResults)
+		return m.ListEventsFunc(ctx, calendarID, timeMin, timeMax, maxResults, opts)
 	}
 	return nil, nil
 }
@@ -39,6 +42,13 @@ func (m *MockCalendarClient) GetEvent(ctx context.Context, calendarID, eventID s
 	return nil, nil
 }
 
+func (m *MockCalendarClient) QueryFreeBusy(ctx context.Context, calendarIDs []string, timeMin, timeMax string) (*calendarapi.FreeBusyResult, error) {
+	if m.QueryFreeBusyFunc != nil {
+		return m.QueryFreeBusyFunc(ctx, calendarIDs, timeMin, timeMax)
+	}
+	return nil, nil
+}
+
 func (m *MockCalendarClient) RSVPEvent(ctx context.Context, calendarID, eventID, response string) error {
 	if m.RSVPEventFunc != nil {
 		return m.RSVPEventFunc(ctx, calendarID, eventID, response)
@@ -3,25 +3,40 @@ package calendar
 import (
 	"context"
 	"fmt"
+	"strings"
+	"time"
 
 	calendarv3 "google.golang.org/api/calendar/v3"
 
+	"github.com/open-cli-collective/google-readonly/internal/auth"
 	"github.com/open-cli-collective/google-readonly/internal/calendar"
+	"github.com/open-cli-collective/google-readonly/internal/color"
+	"github.com/open-cli-collective/google-readonly/internal/format"
+	"github.com/open-cli-collective/google-readonly/internal/table"
 )
 
 // CalendarClient defines the interface for Calendar client operations used by calendar commands.
 type CalendarClient interface {
 	ListCalendars(ctx context.Context) ([]*calendarv3.CalendarListEntry, error)
-	ListEvents(ctx context.Context, calendarID string, timeMin, timeMax string, maxResults int64) ([]*calendarv3.Event, error)
+	ListEvents(ctx context.Context, calendarID string, timeMin, timeMax string, maxResults int64, opts calendar.ListEventsOptions) ([]*calendarv3.Event, error)
 	GetEvent(ctx context.Context, calendarID, eventID string) (*calendarv3.Event, error)
+	QueryFreeBusy(ctx context.Context, calendarIDs []string, timeMin, timeMax string) (*calendar.FreeBusyResult, error)
 	RSVPEvent(ctx context.Context, calendarID, eventID, response string) error
 	SetEventColor(ctx context.Context, calendarID, eventID, colorID string) error
 }
 
 // ClientFactory is the function used to create Calendar clients.
-// Override in tests to inject mocks.
+// Override in tests to inject mocks. It fails before any API call when the
+// token was never granted a Calendar scope.
 var ClientFactory = func(ctx context.Context) (CalendarClient, error) {
-	return calendar.NewClient(ctx)
+	if err := auth.RequireScope("calendar"); err != nil {
+		return nil, err
+	}
+	client, err := calendar.NewClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return withResponseCache(client), nil
 }
 
 // newCalendarClient creates a new calendar client
@@ -29,14 +44,25 @@ func newCalendarClient(ctx context.Context) (CalendarClient, error) {
 	return ClientFactory(ctx)
 }
 
+// EventPrintOptions controls which optional fields printEvent includes
+type EventPrintOptions struct {
+	ShowDescription bool
+	ShowDirections  bool
+}
+
 // printEvent prints a single event in text format
-func printEvent(event *calendar.Event, showDescription bool) {
+func printEvent(event *calendar.Event, opts EventPrintOptions) {
 	fmt.Printf("ID: %s\n", event.ID)
 	fmt.Printf("Summary: %s\n", event.Summary)
-	fmt.Printf("When: %s\n", event.FormatTimeRange())
+	fmt.Printf("When: %s\n", formatEventTime(event))
 
 	if event.Location != "" {
 		fmt.Printf("Location: %s\n", event.Location)
+		if opts.ShowDirections {
+			if link := event.DirectionsURL(); link != "" {
+				fmt.Printf("Directions: %s\n", link)
+			}
+		}
 	}
 
 	if event.HangoutLink != "" {
@@ -56,7 +82,7 @@ func printEvent(event *calendar.Event, showDescription bool) {
 		for _, a := range event.Attendees {
 			status := ""
 			if a.Status != "" {
-				status = fmt.Sprintf(" (%s)", a.Status)
+				status = fmt.Sprintf(" (%s)", color.ResponseStatus(a.Status))
 			}
 			if a.DisplayName != "" {
 				fmt.Printf("  - %s <%s>%s\n", a.DisplayName, a.Email, status)
@@ -66,7 +92,7 @@ func printEvent(event *calendar.Event, showDescription bool) {
 		}
 	}
 
-	if showDescription && event.Description != "" {
+	if opts.ShowDescription && event.Description != "" {
 		fmt.Println()
 		fmt.Println("--- Description ---")
 		fmt.Println(event.Description)
@@ -77,7 +103,7 @@ func printEvent(event *calendar.Event, showDescription bool) {
 func printEventSummary(event *calendar.Event) {
 	fmt.Printf("ID: %s\n", event.ID)
 	fmt.Printf("Summary: %s\n", event.Summary)
-	fmt.Printf("When: %s\n", event.FormatTimeRange())
+	fmt.Printf("When: %s\n", formatEventTime(event))
 
 	if event.Location != "" {
 		fmt.Printf("Location: %s\n", event.Location)
@@ -90,20 +116,107 @@ func printEventSummary(event *calendar.Event) {
 	fmt.Println("---")
 }
 
-// printCalendar prints a calendar entry
-func printCalendar(cal *calendar.CalendarInfo) {
-	primary := ""
-	if cal.Primary {
-		primary = " (primary)"
+// printCalendarTable prints calendars as a table, one row per calendar. The
+// primary calendar is marked after its name, so the ID column stays
+// pasteable.
+func printCalendarTable(cals []*calendar.CalendarInfo) {
+	tbl := table.New(
+		table.Column{Header: "ID"},
+		table.Column{Header: "NAME", MaxWidth: 40, Shrink: true},
+		table.Column{Header: "ACCESS"},
+		table.Column{Header: "TIMEZONE"},
+		table.Column{Header: "DESCRIPTION", MaxWidth: 40, Shrink: true},
+		table.Column{Header: "COLOR"},
+		table.Column{Header: "STATE"},
+	)
+	for _, cal := range cals {
+		name := cal.Summary
+		if cal.Primary {
+			name += " (primary)"
+		}
+		tbl.AddRow(cal.ID, name, cal.AccessRole, orDash(cal.TimeZone), orDash(cal.Description),
+			orDash(color.Swatch(cal.BackgroundColor)), calendarState(cal))
+	}
+	tbl.Print()
+}
+
+// calendarState describes whether a calendar is selected (its events shown
+// in the Calendar UI) and whether it is hidden from the calendar list
+func calendarState(cal *calendar.CalendarInfo) string {
+	var states []string
+	if cal.Selected {
+		states = append(states, "selected")
+	}
+	if cal.Hidden {
+		states = append(states, "hidden")
+	}
+	if len(states) == 0 {
+		return "-"
+	}
+	return strings.Join(states, ",")
+}
+
+// orDash returns s, or "-" for an empty cell
+func orDash(s string) string {
+	if s == "" {
+		return "-"
+	}
+	return s
+}
+
+// printCalendarBusy prints the busy intervals for one calendar
+func printCalendarBusy(busy *calendar.CalendarBusy) {
+	fmt.Printf("Calendar: %s\n", busy.CalendarID)
+	for _, e := range busy.Errors {
+		fmt.Printf("  Error: %s\n", e)
 	}
-	fmt.Printf("ID: %s%s\n", cal.ID, primary)
-	fmt.Printf("Name: %s\n", cal.Summary)
-	if cal.Description != "" {
-		fmt.Printf("Description: %s\n", cal.Description)
+	if len(busy.Busy) == 0 && len(busy.Errors) == 0 {
+		fmt.Println("  No busy time")
 	}
-	fmt.Printf("Access: %s\n", cal.AccessRole)
-	if cal.TimeZone != "" {
-		fmt.Printf("Timezone: %s\n", cal.TimeZone)
+	for _, r := range busy.Busy {
+		fmt.Printf("  %s\n", formatBusyRange(r))
 	}
 	fmt.Println("---")
 }
+
+// formatEventTime renders an event's time range in the --timezone zone.
+// Without a --date-format layout it uses the event's own human-readable
+// format.
+func formatEventTime(event *calendar.Event) string {
+	if format.DateLayout == "" {
+		return event.FormatTimeRangeIn(displayZone)
+	}
+
+	start, err := event.GetStartTime()
+	if err != nil {
+		return ""
+	}
+	end, err := event.GetEndTime()
+	if err != nil {
+		return format.Date(start, "")
+	}
+
+	if event.AllDay {
+		// All-day end dates are exclusive
+		end = end.AddDate(0, 0, -1)
+		if sameDay(start, end) {
+			return format.Date(start, "") + " (all day)"
+		}
+		return format.Date(start, "") + " - " + format.Date(end, "") + " (all day)"
+	}
+	return format.Date(inDisplayZone(start), "") + " - " + format.Date(inDisplayZone(end), "")
+}
+
+// formatBusyRange renders a free/busy interval, honouring --date-format
+func formatBusyRange(r calendar.TimeRange) string {
+	r = calendar.TimeRange{Start: inDisplayZone(r.Start), End: inDisplayZone(r.End)}
+	if format.DateLayout == "" {
+		return r.Format()
+	}
+	return format.Date(r.Start, "") + " - " + format.Date(r.End, "")
+}
+
+// sameDay reports whether a and b fall on the same calendar date
+func sameDay(a, b time.Time) bool {
+	return a.Format("2006-01-02") == b.Format("2006-01-02")
+}
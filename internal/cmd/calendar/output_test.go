@@ -8,6 +8,7 @@ import (
 	"testing"
 
 	"github.com/open-cli-collective/google-readonly/internal/calendar"
+	"github.com/open-cli-collective/google-readonly/internal/format"
 	"github.com/open-cli-collective/google-readonly/internal/testutil"
 )
 
@@ -16,6 +17,7 @@ func TestPrintEvent(t *testing.T) {
 		name            string
 		event           *calendar.Event
 		showDescription bool
+		showDirections  bool
 		wantContains    []string
 		wantNotContains []string
 	}{
@@ -49,6 +51,33 @@ func TestPrintEvent(t *testing.T) {
 				"Location: 123 Main St",
 			},
 		},
+		{
+			name: "event with directions",
+			event: &calendar.Event{
+				ID:       "event457",
+				Summary:  "Offsite",
+				Location: "123 Main St",
+				Start:    &calendar.EventTime{DateTime: "2026-01-24T10:00:00Z"},
+				End:      &calendar.EventTime{DateTime: "2026-01-24T11:00:00Z"},
+			},
+			showDirections: true,
+			wantContains: []string{
+				"Location: 123 Main St\nDirections: https://www.google.com/maps/search/?api=1&query=123+Main+St\n",
+			},
+		},
+		{
+			name: "event with directions hidden",
+			event: &calendar.Event{
+				ID:       "event458",
+				Summary:  "Offsite",
+				Location: "123 Main St",
+				Start:    &calendar.EventTime{DateTime: "2026-01-24T10:00:00Z"},
+				End:      &calendar.EventTime{DateTime: "2026-01-24T11:00:00Z"},
+			},
+			wantNotContains: []string{
+				"Directions:",
+			},
+		},
 		{
 			name: "event with hangout link",
 			event: &calendar.Event{
@@ -157,7 +186,7 @@ func TestPrintEvent(t *testing.T) {
 			r, w, _ := os.Pipe()
 			os.Stdout = w
 
-			printEvent(tt.event, tt.showDescription)
+			printEvent(tt.event, EventPrintOptions{ShowDescription: tt.showDescription, ShowDirections: tt.showDirections})
 
 			w.Close()
 			os.Stdout = oldStdout
@@ -250,125 +279,21 @@ func TestPrintEventSummary(t *testing.T) {
 	}
 }
 
-func TestPrintCalendar(t *testing.T) {
-	tests := []struct {
-		name         string
-		cal          *calendar.CalendarInfo
-		wantContains []string
-	}{
-		{
-			name: "primary calendar",
-			cal: &calendar.CalendarInfo{
-				ID:         "primary",
-				Summary:    "My Calendar",
-				Primary:    true,
-				AccessRole: "owner",
-				TimeZone:   "America/Los_Angeles",
-			},
-			wantContains: []string{
-				"ID: primary (primary)",
-				"Name: My Calendar",
-				"Access: owner",
-				"Timezone: America/Los_Angeles",
-				"---",
-			},
-		},
-		{
-			name: "secondary calendar with description",
-			cal: &calendar.CalendarInfo{
-				ID:          "work@group.calendar.google.com",
-				Summary:     "Work Calendar",
-				Description: "Team events and meetings",
-				Primary:     false,
-				AccessRole:  "writer",
-				TimeZone:    "America/New_York",
-			},
-			wantContains: []string{
-				"ID: work@group.calendar.google.com",
-				"Name: Work Calendar",
-				"Description: Team events and meetings",
-				"Access: writer",
-			},
-		},
-		{
-			name: "calendar without description or timezone",
-			cal: &calendar.CalendarInfo{
-				ID:         "holidays@google.com",
-				Summary:    "Holidays",
-				Primary:    false,
-				AccessRole: "reader",
-			},
-			wantContains: []string{
-				"ID: holidays@google.com",
-				"Name: Holidays",
-				"Access: reader",
-			},
-		},
-	}
-
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			// Capture stdout
-			oldStdout := os.Stdout
-			r, w, _ := os.Pipe()
-			os.Stdout = w
-
-			printCalendar(tt.cal)
-
-			w.Close()
-			os.Stdout = oldStdout
-
-			var buf bytes.Buffer
-			io.Copy(&buf, r)
-
-			output := buf.String()
-
-			for _, want := range tt.wantContains {
-				testutil.Contains(t, output, want)
-			}
-
-			// Check that non-primary calendars don't have "(primary)"
-			if !tt.cal.Primary {
-				testutil.NotContains(t, output, "(primary)")
-			}
-
-			// Check that empty descriptions aren't printed
-			if tt.cal.Description == "" {
-				testutil.NotContains(t, output, "Description:")
-			}
-
-			// Check that empty timezones aren't printed
-			if tt.cal.TimeZone == "" {
-				testutil.NotContains(t, output, "Timezone:")
-			}
+func TestPrintCalendarTable(t *testing.T) {
+	output := testutil.CaptureStdout(t, func() {
+		printCalendarTable([]*calendar.CalendarInfo{
+			{ID: "primary", Summary: "My Calendar", Primary: true, AccessRole: "owner", TimeZone: "America/Los_Angeles", BackgroundColor: "#9fe1e7", Selected: true},
+			{ID: "work@group.calendar.google.com", Summary: "Work Calendar", Description: "Team events and meetings", AccessRole: "writer", TimeZone: "America/New_York", Selected: true, Hidden: true},
+			{ID: "holidays@google.com", Summary: "Holidays", AccessRole: "reader"},
 		})
-	}
-}
-
-func TestPrintCalendarNoPrimary(t *testing.T) {
-	// Capture stdout
-	oldStdout := os.Stdout
-	r, w, _ := os.Pipe()
-	os.Stdout = w
-
-	printCalendar(&calendar.CalendarInfo{
-		ID:         "other@google.com",
-		Summary:    "Other",
-		Primary:    false,
-		AccessRole: "reader",
 	})
 
-	w.Close()
-	os.Stdout = oldStdout
-
-	var buf bytes.Buffer
-	io.Copy(&buf, r)
-
-	output := buf.String()
-
-	// Should have the ID without "(primary)"
-	testutil.Contains(t, output, "ID: other@google.com")
-	testutil.NotContains(t, output, "(primary)")
+	lines := strings.Split(strings.TrimSpace(output), "\n")
+	testutil.Len(t, lines, 4)
+	testutil.Equal(t, lines[0], "ID                              NAME                   ACCESS  TIMEZONE             DESCRIPTION               COLOR    STATE")
+	testutil.Equal(t, lines[1], "primary                         My Calendar (primary)  owner   America/Los_Angeles  -                         #9fe1e7  selected")
+	testutil.Equal(t, lines[2], "work@group.calendar.google.com  Work Calendar          writer  America/New_York     Team events and meetings  -        selected,hidden")
+	testutil.Equal(t, lines[3], "holidays@google.com             Holidays               reader  -                    -                         -        -")
 }
 
 func TestPrintAttendeeWithoutStatus(t *testing.T) {
@@ -385,7 +310,7 @@ func TestPrintAttendeeWithoutStatus(t *testing.T) {
 		Attendees: []calendar.Person{
 			{Email: "alice@example.com", DisplayName: "Alice"}, // No status
 		},
-	}, false)
+	}, EventPrintOptions{})
 
 	w.Close()
 	os.Stdout = oldStdout
@@ -404,3 +329,34 @@ func TestPrintAttendeeWithoutStatus(t *testing.T) {
 		}
 	}
 }
+
+func TestFormatEventTime(t *testing.T) {
+	timed := &calendar.Event{
+		Start: &calendar.EventTime{DateTime: "2024-03-07T14:05:00Z"},
+		End:   &calendar.EventTime{DateTime: "2024-03-07T15:00:00Z"},
+	}
+	allDay := &calendar.Event{
+		Start:  &calendar.EventTime{Date: "2024-03-07"},
+		End:    &calendar.EventTime{Date: "2024-03-08"},
+		AllDay: true,
+	}
+
+	t.Run("uses event format by default", func(t *testing.T) {
+		testutil.Equal(t, formatEventTime(timed), timed.FormatTimeRange())
+	})
+
+	t.Run("applies configured layout", func(t *testing.T) {
+		format.DateLayout = "02/01/2006 15:04"
+		defer func() { format.DateLayout = "" }()
+		testutil.Equal(t, formatEventTime(timed), "07/03/2024 14:05 - 07/03/2024 15:00")
+		testutil.Equal(t, formatEventTime(allDay), "07/03/2024 00:00 (all day)")
+	})
+
+	t.Run("applies configured layout to busy ranges", func(t *testing.T) {
+		format.DateLayout = "2006-01-02 15:04"
+		defer func() { format.DateLayout = "" }()
+		start, _ := timed.GetStartTime()
+		end, _ := timed.GetEndTime()
+		testutil.Equal(t, formatBusyRange(calendar.TimeRange{Start: start, End: end}), "2024-03-07 14:05 - 2024-03-07 15:00")
+	})
+}
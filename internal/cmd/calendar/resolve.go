@@ -0,0 +1,75 @@
+package calendar
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	calendarv3 "google.golang.org/api/calendar/v3"
+)
+
+// resolveCalendarID maps a --calendar value to a calendar ID. "primary" and
+// anything that looks like a calendar ID (they all contain '@') pass through
+// unchanged; otherwise the value is matched case-insensitively against the
+// summaries returned by ListCalendars. Ambiguous names are an error so a
+// query never silently lands on the wrong calendar.
+func resolveCalendarID(ctx context.Context, client CalendarClient, value string) (string, error) {
+	ids, err := resolveCalendarIDs(ctx, client, []string{value})
+	if err != nil {
+		return "", err
+	}
+	return ids[0], nil
+}
+
+// resolveCalendarIDs resolves several --calendar values like
+// resolveCalendarID, listing the calendars at most once for all of them
+func resolveCalendarIDs(ctx context.Context, client CalendarClient, values []string) ([]string, error) {
+	var calendars []*calendarv3.CalendarListEntry
+	listed := false
+
+	ids := make([]string, len(values))
+	for i, value := range values {
+		if isCalendarID(value) {
+			ids[i] = value
+			continue
+		}
+		if !listed {
+			var err error
+			calendars, err = client.ListCalendars(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("listing calendars: %w", err)
+			}
+			listed = true
+		}
+		id, err := matchCalendarName(calendars, value)
+		if err != nil {
+			return nil, err
+		}
+		ids[i] = id
+	}
+	return ids, nil
+}
+
+// isCalendarID reports whether a --calendar value is used as given
+func isCalendarID(value string) bool {
+	return value == "" || value == "primary" || strings.Contains(value, "@")
+}
+
+// matchCalendarName returns the ID of the one calendar whose summary is value
+func matchCalendarName(calendars []*calendarv3.CalendarListEntry, value string) (string, error) {
+	var matches []string
+	for _, c := range calendars {
+		if strings.EqualFold(c.Summary, value) {
+			matches = append(matches, c.Id)
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		return "", fmt.Errorf("no calendar found matching %q (run 'gro calendar list' to see available calendars)", value)
+	case 1:
+		return matches[0], nil
+	default:
+		return "", fmt.Errorf("calendar name %q is ambiguous, use one of: %s", value, strings.Join(matches, ", "))
+	}
+}
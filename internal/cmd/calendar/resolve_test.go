@@ -0,0 +1,72 @@
+package calendar
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"google.golang.org/api/calendar/v3"
+
+	"github.com/open-cli-collective/google-readonly/internal/testutil"
+)
+
+func TestResolveCalendarID(t *testing.T) {
+	t.Parallel()
+	calendars := []*calendar.CalendarListEntry{
+		{Id: "me@example.com", Summary: "Personal", Primary: true},
+		{Id: "team@group.calendar.google.com", Summary: "Team Calendar"},
+		{Id: "a@group.calendar.google.com", Summary: "Holidays"},
+		{Id: "b@group.calendar.google.com", Summary: "holidays"},
+	}
+
+	tests := []struct {
+		name       string
+		value      string
+		want       string
+		wantErr    string
+		wantListed bool
+	}{
+		{name: "primary passes through", value: "primary", want: "primary"},
+		{name: "calendar ID passes through", value: "work@example.com", want: "work@example.com"},
+		{name: "name resolves to ID", value: "Team Calendar", want: "team@group.calendar.google.com", wantListed: true},
+		{name: "name match is case-insensitive", value: "team calendar", want: "team@group.calendar.google.com", wantListed: true},
+		{name: "unknown name", value: "Nope", wantErr: "no calendar found matching", wantListed: true},
+		{name: "ambiguous name", value: "Holidays", wantErr: "ambiguous", wantListed: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			listed := false
+			mock := &MockCalendarClient{
+				ListCalendarsFunc: func(_ context.Context) ([]*calendar.CalendarListEntry, error) {
+					listed = true
+					return calendars, nil
+				},
+			}
+
+			got, err := resolveCalendarID(context.Background(), mock, tt.value)
+			testutil.Equal(t, listed, tt.wantListed)
+			if tt.wantErr != "" {
+				testutil.Error(t, err)
+				testutil.Contains(t, err.Error(), tt.wantErr)
+				return
+			}
+			testutil.NoError(t, err)
+			testutil.Equal(t, got, tt.want)
+		})
+	}
+}
+
+func TestResolveCalendarID_ListError(t *testing.T) {
+	t.Parallel()
+	mock := &MockCalendarClient{
+		ListCalendarsFunc: func(_ context.Context) ([]*calendar.CalendarListEntry, error) {
+			return nil, errors.New("API error")
+		},
+	}
+
+	_, err := resolveCalendarID(context.Background(), mock, "Team Calendar")
+	testutil.Error(t, err)
+	testutil.Contains(t, err.Error(), "listing calendars")
+}
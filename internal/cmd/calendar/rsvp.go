@@ -5,6 +5,8 @@ import (
 	"strings"
 
 	"github.com/spf13/cobra"
+
+	"github.com/open-cli-collective/google-readonly/internal/log"
 )
 
 // validResponses maps user-friendly input to Google Calendar API response values.
@@ -55,7 +57,7 @@ Examples:
 				return fmt.Errorf("updating RSVP: %w", err)
 			}
 
-			fmt.Printf("RSVP'd '%s' to event %s.\n", apiResponse, eventID)
+			log.Status("RSVP'd '%s' to event %s.", apiResponse, eventID)
 			return nil
 		},
 	}
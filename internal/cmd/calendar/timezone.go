@@ -0,0 +1,52 @@
+package calendar
+
+import (
+	"fmt"
+	"time"
+
+	// Embed the zone database so --timezone works where the OS has none,
+	// such as Windows
+	_ "time/tzdata"
+)
+
+// displayZone is the --timezone location event times are shown in. Nil
+// keeps each event's own zone, and local time for "today" and "this week".
+var displayZone *time.Location
+
+// zoneValue is the --timezone flag. The IANA name is loaded as it is set,
+// so an unknown zone fails flag parsing like any other bad value.
+type zoneValue struct {
+	name string
+}
+
+func (z *zoneValue) String() string { return z.name }
+func (z *zoneValue) Type() string   { return "zone" }
+
+func (z *zoneValue) Set(name string) error {
+	if name == "" {
+		return fmt.Errorf("time zone name is empty")
+	}
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		return fmt.Errorf("unknown time zone %q (want an IANA name such as America/Los_Angeles)", name)
+	}
+	z.name = name
+	displayZone = loc
+	return nil
+}
+
+// inDisplayZone converts t to the --timezone zone, if one was given
+func inDisplayZone(t time.Time) time.Time {
+	if displayZone == nil {
+		return t
+	}
+	return t.In(displayZone)
+}
+
+// now returns the current time in the --timezone zone, or local time
+func now() time.Time {
+	if displayZone == nil {
+		return time.Now()
+	}
+	return time.Now().In(displayZone)
+}
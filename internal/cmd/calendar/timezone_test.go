@@ -0,0 +1,81 @@
+package calendar
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"google.golang.org/api/calendar/v3"
+
+	"github.com/open-cli-collective/google-readonly/internal/testutil"
+)
+
+// resetDisplayZone restores the default of showing each event in its own
+// zone once the test ends
+func resetDisplayZone(t *testing.T) {
+	t.Helper()
+	t.Cleanup(func() { displayZone = nil })
+}
+
+func TestZoneValue(t *testing.T) {
+	resetDisplayZone(t)
+
+	var z zoneValue
+	testutil.NoError(t, z.Set("America/Los_Angeles"))
+	testutil.Equal(t, z.String(), "America/Los_Angeles")
+	testutil.Equal(t, displayZone.String(), "America/Los_Angeles")
+
+	err := z.Set("Mars/Olympus_Mons")
+	testutil.Error(t, err)
+	testutil.Contains(t, err.Error(), `unknown time zone "Mars/Olympus_Mons"`)
+
+	testutil.Error(t, z.Set(""))
+}
+
+func TestInDisplayZone(t *testing.T) {
+	resetDisplayZone(t)
+	instant := time.Date(2026, 1, 24, 10, 0, 0, 0, time.FixedZone("EST", -5*60*60))
+
+	testutil.Equal(t, inDisplayZone(instant), instant)
+
+	la, err := time.LoadLocation("America/Los_Angeles")
+	testutil.NoError(t, err)
+	displayZone = la
+	testutil.Equal(t, inDisplayZone(instant).Format("15:04 MST"), "07:00 PST")
+}
+
+func TestCalendarCommand_Timezone(t *testing.T) {
+	resetDisplayZone(t)
+	mock := &MockCalendarClient{
+		GetEventFunc: func(_ context.Context, _, eventID string) (*calendar.Event, error) {
+			return &calendar.Event{
+				Id:      eventID,
+				Summary: "Planning",
+				Start:   &calendar.EventDateTime{DateTime: "2026-01-24T10:00:00-05:00"},
+				End:     &calendar.EventDateTime{DateTime: "2026-01-24T11:00:00-05:00"},
+			}, nil
+		},
+	}
+
+	cmd := NewCommand()
+	cmd.SetArgs([]string{"get", "event123", "--timezone", "America/Los_Angeles"})
+
+	withMockClient(mock, func() {
+		output := testutil.CaptureStdout(t, func() {
+			testutil.NoError(t, cmd.Execute())
+		})
+		testutil.Contains(t, output, "When: Sat, Jan 24, 2026 7:00 AM - 8:00 AM")
+	})
+}
+
+func TestCalendarCommand_UnknownTimezone(t *testing.T) {
+	resetDisplayZone(t)
+	cmd := NewCommand()
+	cmd.SetArgs([]string{"today", "--timezone", "Nowhere/Special"})
+
+	withMockClient(&MockCalendarClient{}, func() {
+		err := cmd.Execute()
+		testutil.Error(t, err)
+		testutil.Contains(t, err.Error(), `unknown time zone "Nowhere/Special"`)
+	})
+}
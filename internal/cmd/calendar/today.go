@@ -9,7 +9,13 @@ import (
 
 func newTodayCommand() *cobra.Command {
 	var (
-		calendarID string
+		calendarID   string
+		singleEvents bool
+		busyOnly     bool
+		collapse     bool
+		merge        bool
+		summary      bool
+		output       string
 	)
 
 	cmd := &cobra.Command{
@@ -19,8 +25,12 @@ func newTodayCommand() *cobra.Command {
 
 This is a shortcut for: gro calendar events --from <today> --to <today>
 
+--summary prints a single line with the number of events and the next one
+to start, such as "3 events, next: Standup at 10:00 AM", for status bars.
+
 Examples:
   gro calendar today
+  gro cal today --summary
   gro cal today --calendar work@group.calendar.google.com`,
 		Args: cobra.NoArgs,
 		RunE: func(cmd *cobra.Command, _ []string) error {
@@ -29,21 +39,33 @@ Examples:
 				return fmt.Errorf("creating Calendar client: %w", err)
 			}
 
-			now := time.Now()
+			now := now()
 			startOfDay, endOfDayTime := todayBounds(now)
 
 			return listAndPrintEvents(cmd.Context(), client, EventListOptions{
-				CalendarID:   calendarID,
-				TimeMin:      startOfDay.Format(time.RFC3339),
-				TimeMax:      endOfDayTime.Format(time.RFC3339),
-				MaxResults:   50,
-				Header:       fmt.Sprintf("Today's events (%s):", now.Format("Mon, Jan 2, 2006")),
-				EmptyMessage: "No events today.",
+				CalendarID:        calendarID,
+				TimeMin:           startOfDay.Format(time.RFC3339),
+				TimeMax:           endOfDayTime.Format(time.RFC3339),
+				MaxResults:        50,
+				SingleEvents:      singleEvents,
+				BusyOnly:          busyOnly,
+				CollapseRecurring: collapse,
+				MergeAdjacent:     merge,
+				Summary:           summary,
+				Output:            output,
+				Header:            fmt.Sprintf("Today's events (%s):", now.Format("Mon, Jan 2, 2006")),
+				EmptyMessage:      "No events today.",
 			})
 		},
 	}
 
-	cmd.Flags().StringVarP(&calendarID, "calendar", "c", "primary", "Calendar ID to query")
+	cmd.Flags().StringVarP(&calendarID, "calendar", "c", "primary", "Calendar ID or name to query")
+	cmd.Flags().BoolVar(&singleEvents, "single-events", true, "Expand recurring events into individual occurrences")
+	cmd.Flags().BoolVar(&busyOnly, "busy-only", false, "Only show events that mark you as busy (skip free/transparent events)")
+	cmd.Flags().BoolVar(&collapse, "collapse-recurring", false, "Show each recurring event once with its occurrences summarized")
+	cmd.Flags().BoolVar(&merge, "merge-adjacent", false, "Show back-to-back events with the same title as one span")
+	cmd.Flags().BoolVar(&summary, "summary", false, "Print one line with the event count and the next event")
+	cmd.Flags().StringVarP(&output, "output", "o", outputText, "Output format: text or ics (iCalendar)")
 
 	return cmd
 }
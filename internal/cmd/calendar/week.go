@@ -9,7 +9,12 @@ import (
 
 func newWeekCommand() *cobra.Command {
 	var (
-		calendarID string
+		calendarID   string
+		singleEvents bool
+		busyOnly     bool
+		collapse     bool
+		merge        bool
+		output       string
 	)
 
 	cmd := &cobra.Command{
@@ -21,7 +26,8 @@ This is a shortcut for: gro calendar events --from <monday> --to <sunday>
 
 Examples:
   gro calendar week
-  gro cal week --calendar work@group.calendar.google.com`,
+  gro cal week --calendar work@group.calendar.google.com
+  gro cal week --collapse-recurring`,
 		Args: cobra.NoArgs,
 		RunE: func(cmd *cobra.Command, _ []string) error {
 			client, err := newCalendarClient(cmd.Context())
@@ -29,14 +35,19 @@ Examples:
 				return fmt.Errorf("creating Calendar client: %w", err)
 			}
 
-			now := time.Now()
+			now := now()
 			startOfWeek, endOfWeek := weekBounds(now)
 
 			return listAndPrintEvents(cmd.Context(), client, EventListOptions{
-				CalendarID: calendarID,
-				TimeMin:    startOfWeek.Format(time.RFC3339),
-				TimeMax:    endOfWeek.Format(time.RFC3339),
-				MaxResults: 100,
+				CalendarID:        calendarID,
+				TimeMin:           startOfWeek.Format(time.RFC3339),
+				TimeMax:           endOfWeek.Format(time.RFC3339),
+				MaxResults:        100,
+				SingleEvents:      singleEvents,
+				BusyOnly:          busyOnly,
+				CollapseRecurring: collapse,
+				MergeAdjacent:     merge,
+				Output:            output,
 				Header: fmt.Sprintf("This week's events (%s - %s):",
 					startOfWeek.Format("Mon, Jan 2"),
 					endOfWeek.Format("Mon, Jan 2, 2006")),
@@ -45,7 +56,12 @@ Examples:
 		},
 	}
 
-	cmd.Flags().StringVarP(&calendarID, "calendar", "c", "primary", "Calendar ID to query")
+	cmd.Flags().StringVarP(&calendarID, "calendar", "c", "primary", "Calendar ID or name to query")
+	cmd.Flags().BoolVar(&singleEvents, "single-events", true, "Expand recurring events into individual occurrences")
+	cmd.Flags().BoolVar(&busyOnly, "busy-only", false, "Only show events that mark you as busy (skip free/transparent events)")
+	cmd.Flags().BoolVar(&collapse, "collapse-recurring", false, "Show each recurring event once with its occurrences summarized")
+	cmd.Flags().BoolVar(&merge, "merge-adjacent", false, "Show back-to-back events with the same title as one span")
+	cmd.Flags().StringVarP(&output, "output", "o", outputText, "Output format: text or ics (iCalendar)")
 
 	return cmd
 }
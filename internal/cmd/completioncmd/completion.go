@@ -0,0 +1,79 @@
+// Package completioncmd implements `gro completion`, which prints a shell
+// completion script generated from the command tree.
+package completioncmd
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/spf13/cobra"
+)
+
+// shells is the closed set of shells cobra can generate a script for
+var shells = []string{"bash", "zsh", "fish", "powershell"}
+
+// NewCommand returns the completion command. It replaces the default one
+// cobra would otherwise add, so the install steps live in its help.
+func NewCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "completion [bash|zsh|fish|powershell]",
+		Short: "Generate a shell completion script",
+		Long: `Generate a completion script for gro and print it to stdout.
+
+Besides commands and flags, the script completes Gmail label names for
+--label and the label/unlabel commands, and file types for
+gro drive list --type and gro drive search --type. Label completion asks
+Gmail for the current labels, so it needs a working gro init.
+
+Bash (requires bash-completion v2):
+  source <(gro completion bash)
+  # permanently, Linux
+  gro completion bash | sudo tee /etc/bash_completion.d/gro > /dev/null
+  # permanently, macOS with Homebrew
+  gro completion bash > $(brew --prefix)/etc/bash_completion.d/gro
+
+Zsh:
+  source <(gro completion zsh)
+  # permanently; ~/.zshrc needs fpath=(~/.zsh/completions $fpath)
+  # and autoload -Uz compinit && compinit
+  mkdir -p ~/.zsh/completions
+  gro completion zsh > ~/.zsh/completions/_gro
+
+Fish:
+  gro completion fish | source
+  # permanently
+  gro completion fish > ~/.config/fish/completions/gro.fish
+
+PowerShell:
+  gro completion powershell | Out-String | Invoke-Expression
+  # permanently
+  gro completion powershell >> $PROFILE`,
+		Args:                  cobra.MatchAll(cobra.ExactArgs(1), cobra.OnlyValidArgs),
+		ValidArgs:             shells,
+		DisableFlagsInUseLine: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return writeScript(cmd.Root(), cmd.OutOrStdout(), args[0])
+		},
+	}
+}
+
+// writeScript generates the completion script for shell from root's tree
+func writeScript(root *cobra.Command, w io.Writer, shell string) error {
+	var err error
+	switch shell {
+	case "bash":
+		err = root.GenBashCompletionV2(w, true)
+	case "zsh":
+		err = root.GenZshCompletion(w)
+	case "fish":
+		err = root.GenFishCompletion(w, true)
+	case "powershell":
+		err = root.GenPowerShellCompletionWithDesc(w)
+	default:
+		return fmt.Errorf("unsupported shell %q (valid: bash, zsh, fish, powershell)", shell)
+	}
+	if err != nil {
+		return fmt.Errorf("generating %s completion: %w", shell, err)
+	}
+	return nil
+}
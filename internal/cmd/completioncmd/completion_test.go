@@ -0,0 +1,49 @@
+package completioncmd
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/spf13/cobra"
+
+	"github.com/open-cli-collective/google-readonly/internal/testutil"
+)
+
+// newTree mounts the completion command under a stand-in root, the way
+// root.go registers it
+func newTree() *cobra.Command {
+	root := &cobra.Command{Use: "gro"}
+	root.AddCommand(NewCommand())
+	return root
+}
+
+func TestCompletionCommand(t *testing.T) {
+	for _, shell := range shells {
+		t.Run(shell, func(t *testing.T) {
+			root := newTree()
+			var out bytes.Buffer
+			root.SetOut(&out)
+			root.SetArgs([]string{"completion", shell})
+
+			testutil.NoError(t, root.Execute())
+			testutil.Contains(t, out.String(), "gro")
+			testutil.True(t, out.Len() > 100)
+		})
+	}
+}
+
+func TestCompletionCommandArgs(t *testing.T) {
+	cmd := NewCommand()
+
+	testutil.Error(t, cmd.Args(cmd, []string{}))
+	testutil.Error(t, cmd.Args(cmd, []string{"tcsh"}))
+	testutil.Error(t, cmd.Args(cmd, []string{"bash", "zsh"}))
+	testutil.NoError(t, cmd.Args(cmd, []string{"fish"}))
+}
+
+func TestCompletionLongHelpHasInstallSteps(t *testing.T) {
+	cmd := NewCommand()
+	for _, want := range []string{"source <(gro completion bash)", "_gro", "gro.fish", "Invoke-Expression"} {
+		testutil.Contains(t, cmd.Long, want)
+	}
+}
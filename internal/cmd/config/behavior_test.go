@@ -2,12 +2,15 @@ package config
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
+	"errors"
 	"io"
 	"os"
 	"path/filepath"
 	"strings"
 	"testing"
+	"time"
 
 	"golang.org/x/oauth2"
 
@@ -15,6 +18,7 @@ import (
 	appconfig "github.com/open-cli-collective/google-readonly/internal/config"
 	"github.com/open-cli-collective/google-readonly/internal/credtest"
 	"github.com/open-cli-collective/google-readonly/internal/keychain"
+	"github.com/open-cli-collective/google-readonly/internal/output"
 )
 
 const clientSecretSentinel = "SENTINEL-CLIENT-SECRET-7f3a"
@@ -65,7 +69,7 @@ func TestRunShowReportsState(t *testing.T) {
 	seedTokenAndClient(t)
 
 	out := capture(t, func() {
-		if err := runShow(false, false); err != nil {
+		if err := runShow(output.Options{Format: output.FormatText}, false); err != nil {
 			t.Errorf("runShow: %v", err)
 		}
 	})
@@ -76,7 +80,7 @@ func TestRunShowReportsState(t *testing.T) {
 	}
 
 	jsonOut := capture(t, func() {
-		if err := runShow(true, true); err != nil {
+		if err := runShow(output.Options{Format: output.FormatJSON}, true); err != nil {
 			t.Errorf("runShow json: %v", err)
 		}
 	})
@@ -122,7 +126,7 @@ func TestRunShowReportsKeyringBackendSelector(t *testing.T) {
 	}
 
 	out := capture(t, func() {
-		if err := runShow(false, false); err != nil {
+		if err := runShow(output.Options{Format: output.FormatText}, false); err != nil {
 			t.Errorf("runShow: %v", err)
 		}
 	})
@@ -131,7 +135,7 @@ func TestRunShowReportsKeyringBackendSelector(t *testing.T) {
 	}
 
 	jsonOut := capture(t, func() {
-		if err := runShow(true, false); err != nil {
+		if err := runShow(output.Options{Format: output.FormatJSON}, false); err != nil {
 			t.Errorf("runShow json: %v", err)
 		}
 	})
@@ -152,7 +156,7 @@ func TestRunShowOmitsKeyringBackendWhenUnset(t *testing.T) {
 	// Do NOT call SaveConfig with Keyring set; default config has it empty.
 
 	out := capture(t, func() {
-		if err := runShow(false, false); err != nil {
+		if err := runShow(output.Options{Format: output.FormatText}, false); err != nil {
 			t.Errorf("runShow: %v", err)
 		}
 	})
@@ -161,7 +165,7 @@ func TestRunShowOmitsKeyringBackendWhenUnset(t *testing.T) {
 	}
 
 	jsonOut := capture(t, func() {
-		if err := runShow(true, false); err != nil {
+		if err := runShow(output.Options{Format: output.FormatJSON}, false); err != nil {
 			t.Errorf("runShow json: %v", err)
 		}
 	})
@@ -300,3 +304,356 @@ func TestRunClearSemantics(t *testing.T) {
 		}
 	})
 }
+
+func TestRunProfiles(t *testing.T) {
+	credtest.Setup(t)
+	t.Cleanup(func() { _ = appconfig.SetProfile("") })
+
+	if err := appconfig.SetProfile("work"); err != nil {
+		t.Fatal(err)
+	}
+	out := capture(t, func() {
+		if err := runProfiles(); err != nil {
+			t.Errorf("runProfiles: %v", err)
+		}
+	})
+	want := "  default\n* work (not set up; run 'gro init --profile work')\n"
+	if out != want {
+		t.Errorf("before setup:\n%q\nwant\n%q", out, want)
+	}
+
+	if _, err := appconfig.GetConfigDir(); err != nil {
+		t.Fatal(err)
+	}
+	out = capture(t, func() {
+		if err := runProfiles(); err != nil {
+			t.Errorf("runProfiles: %v", err)
+		}
+	})
+	if want := "  default\n* work\n"; out != want {
+		t.Errorf("after setup:\n%q\nwant\n%q", out, want)
+	}
+}
+
+func TestNamedProfileHasSeparateToken(t *testing.T) {
+	seedTokenAndClient(t)
+	t.Cleanup(func() { _ = appconfig.SetProfile("") })
+
+	if err := appconfig.SetProfile("work"); err != nil {
+		t.Fatal(err)
+	}
+	out := capture(t, func() {
+		if err := runShow(output.Options{Format: output.FormatText}, false); err != nil {
+			t.Errorf("runShow: %v", err)
+		}
+	})
+	for _, want := range []string{"Profile:             work", "google-readonly/work", "OAuth token:         not configured", "gro init --profile work"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("show under profile missing %q in:\n%s", want, out)
+		}
+	}
+	// The shared OAuth client JSON seeded for the default profile is found.
+	if !strings.Contains(out, "sha256:") {
+		t.Errorf("profile should share the OAuth client JSON:\n%s", out)
+	}
+}
+
+func TestRunStatus(t *testing.T) {
+	now := time.Date(2024, 1, 15, 10, 0, 0, 0, time.UTC)
+	origNow := statusNow
+	statusNow = func() time.Time { return now }
+	t.Cleanup(func() { statusNow = origNow })
+
+	seedToken := func(t *testing.T, tok *oauth2.Token) {
+		t.Helper()
+		credtest.Setup(t)
+		st, err := keychain.OpenNoMigrate()
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer func() { _ = st.Close() }()
+		if err := st.SetToken(tok); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	t.Run("reports expiry, refresh token and recorded scopes", func(t *testing.T) {
+		seedToken(t, &oauth2.Token{AccessToken: "A", RefreshToken: "R", Expiry: now.Add(45 * time.Minute)})
+		if err := appconfig.SaveConfig(&appconfig.Config{
+			CredentialRef: appconfig.DefaultCredentialRef,
+			GrantedScopes: []string{"https://www.googleapis.com/auth/gmail.readonly"},
+		}); err != nil {
+			t.Fatal(err)
+		}
+
+		out := capture(t, func() {
+			if err := runStatus(output.Options{Format: output.FormatText}); err != nil {
+				t.Errorf("runStatus: %v", err)
+			}
+		})
+		for _, want := range []string{
+			"Access token expiry: 2024-01-15T10:45:00Z (in 45m0s)",
+			"Refresh token:       present",
+			"Granted scopes:\n  - https://www.googleapis.com/auth/gmail.readonly\n",
+		} {
+			if !strings.Contains(out, want) {
+				t.Errorf("status missing %q in:\n%s", want, out)
+			}
+		}
+
+		jsonOut := capture(t, func() {
+			if err := runStatus(output.Options{Format: output.FormatJSON}); err != nil {
+				t.Errorf("runStatus json: %v", err)
+			}
+		})
+		var st tokenStatus
+		if err := json.Unmarshal([]byte(jsonOut), &st); err != nil {
+			t.Fatalf("status --json not valid JSON: %v\n%s", err, jsonOut)
+		}
+		if !st.TokenPresent || !st.RefreshTokenPresent || st.AccessTokenExpired || st.ScopesSource != "granted" {
+			t.Errorf("json status wrong: %+v", st)
+		}
+		if st.AccessTokenExpiry == nil || !st.AccessTokenExpiry.Equal(now.Add(45*time.Minute)) {
+			t.Errorf("AccessTokenExpiry = %v", st.AccessTokenExpiry)
+		}
+		if strings.Contains(jsonOut, `"A"`) || strings.Contains(jsonOut, `"R"`) {
+			t.Errorf("status must never include the token value")
+		}
+
+		fieldsOut := capture(t, func() {
+			opts := output.Options{Format: output.FormatJSON, Fields: []string{"profile", "token_present"}}
+			if err := runStatus(opts); err != nil {
+				t.Errorf("runStatus --fields: %v", err)
+			}
+		})
+		if fieldsOut != "{\n  \"profile\": \"default\",\n  \"token_present\": true\n}\n" {
+			t.Errorf("status --fields output = %q", fieldsOut)
+		}
+
+		yamlOut := capture(t, func() {
+			if err := runStatus(output.Options{Format: output.FormatYAML}); err != nil {
+				t.Errorf("runStatus yaml: %v", err)
+			}
+		})
+		for _, want := range []string{"profile: default\n", "token_present: true\n", "scopes_source: granted\n",
+			"scopes:\n  - https://www.googleapis.com/auth/gmail.readonly\n"} {
+			if !strings.Contains(yamlOut, want) {
+				t.Errorf("status yaml missing %q in:\n%s", want, yamlOut)
+			}
+		}
+	})
+
+	t.Run("expired token without refresh token or recorded scopes", func(t *testing.T) {
+		seedToken(t, &oauth2.Token{AccessToken: "A", Expiry: now.Add(-time.Hour)})
+
+		out := capture(t, func() {
+			if err := runStatus(output.Options{Format: output.FormatText}); err != nil {
+				t.Errorf("runStatus: %v", err)
+			}
+		})
+		for _, want := range []string{
+			"(expired)",
+			"Refresh token:       not configured",
+			"none recorded; showing what gro requests",
+			"gro init",
+		} {
+			if !strings.Contains(out, want) {
+				t.Errorf("status missing %q in:\n%s", want, out)
+			}
+		}
+	})
+
+	t.Run("no token suggests init", func(t *testing.T) {
+		credtest.Setup(t)
+
+		out := capture(t, func() {
+			if err := runStatus(output.Options{Format: output.FormatText}); err != nil {
+				t.Errorf("runStatus: %v", err)
+			}
+		})
+		if !strings.Contains(out, "No token is stored. Run 'gro init' to authenticate.") {
+			t.Errorf("status without token:\n%s", out)
+		}
+		if strings.Contains(out, "Access token expiry") {
+			t.Errorf("status without token must not report an expiry:\n%s", out)
+		}
+
+		jsonOut := capture(t, func() {
+			if err := runStatus(output.Options{Format: output.FormatJSON}); err != nil {
+				t.Errorf("runStatus json: %v", err)
+			}
+		})
+		if !strings.Contains(jsonOut, `"token_present": false`) || strings.Contains(jsonOut, `"scopes"`) {
+			t.Errorf("json status without token: %s", jsonOut)
+		}
+	})
+}
+
+func TestRunRefresh(t *testing.T) {
+	now := time.Date(2024, 1, 15, 10, 0, 0, 0, time.UTC)
+	origNow, origRefresh := statusNow, refreshTokenFn
+	statusNow = func() time.Time { return now }
+	t.Cleanup(func() { statusNow, refreshTokenFn = origNow, origRefresh })
+
+	t.Run("reports the new expiry", func(t *testing.T) {
+		credtest.Setup(t)
+		refreshTokenFn = func(context.Context) (*oauth2.Token, error) {
+			return &oauth2.Token{AccessToken: "new", Expiry: now.Add(time.Hour)}, nil
+		}
+
+		out := capture(t, func() {
+			if err := runRefresh(context.Background(), output.Options{Format: output.FormatText}); err != nil {
+				t.Errorf("runRefresh: %v", err)
+			}
+		})
+		want := "Token refreshed.\nAccess token expiry: 2024-01-15T11:00:00Z (in 1h0m0s)\n"
+		if out != want {
+			t.Errorf("refresh output:\n%q\nwant\n%q", out, want)
+		}
+
+		jsonOut := capture(t, func() {
+			if err := runRefresh(context.Background(), output.Options{Format: output.FormatJSON}); err != nil {
+				t.Errorf("runRefresh json: %v", err)
+			}
+		})
+		var res refreshResult
+		if err := json.Unmarshal([]byte(jsonOut), &res); err != nil {
+			t.Fatalf("refresh --json not valid JSON: %v\n%s", err, jsonOut)
+		}
+		if res.Profile != appconfig.DefaultProfile || !res.AccessTokenExpiry.Equal(now.Add(time.Hour)) {
+			t.Errorf("json result wrong: %+v", res)
+		}
+		if strings.Contains(jsonOut, `"new"`) {
+			t.Errorf("refresh must never include the token value")
+		}
+	})
+
+	t.Run("passes refresh errors through", func(t *testing.T) {
+		credtest.Setup(t)
+		refreshTokenFn = func(context.Context) (*oauth2.Token, error) {
+			return nil, errors.New("refresh token was rejected")
+		}
+
+		err := runRefresh(context.Background(), output.Options{Format: output.FormatText})
+		if err == nil || !strings.Contains(err.Error(), "rejected") {
+			t.Fatalf("want refresh error, got %v", err)
+		}
+	})
+}
+
+func TestRunValidate(t *testing.T) {
+	t.Run("reports an unknown backend and an unsupported timezone key", func(t *testing.T) {
+		credtest.Setup(t)
+		dir := credtest.ConfigDir(t)
+		cfg := "timezone: Nowhere/Atlantis\nkeyring:\n  backend: floppy\n"
+		if err := os.WriteFile(filepath.Join(dir, appconfig.ConfigFileYAML), []byte(cfg), 0o600); err != nil {
+			t.Fatal(err)
+		}
+
+		var runErr error
+		out := capture(t, func() { runErr = runValidate(output.Options{Format: output.FormatText}) })
+		if runErr == nil || !strings.Contains(runErr.Error(), "2 problem(s)") {
+			t.Errorf("want a 2-problem error, got %v", runErr)
+		}
+		for _, want := range []string{
+			`  keyring.backend: "floppy" is not a known backend`,
+			"  timezone: unknown key; gro ignores it",
+		} {
+			if !strings.Contains(out, want) {
+				t.Errorf("validate output missing %q in:\n%s", want, out)
+			}
+		}
+
+		jsonOut := capture(t, func() { _ = runValidate(output.Options{Format: output.FormatJSON}) })
+		var res validateResult
+		if err := json.Unmarshal([]byte(jsonOut), &res); err != nil {
+			t.Fatalf("validate --json not valid JSON: %v\n%s", err, jsonOut)
+		}
+		if res.Valid || len(res.Problems) != 2 {
+			t.Errorf("json result wrong: %+v", res)
+		}
+	})
+
+	t.Run("retired key warns but passes", func(t *testing.T) {
+		credtest.Setup(t)
+		dir := credtest.ConfigDir(t)
+		if err := os.WriteFile(filepath.Join(dir, appconfig.ConfigFileYAML), []byte("cache_ttl_hours: 24\n"), 0o600); err != nil {
+			t.Fatal(err)
+		}
+
+		out := capture(t, func() {
+			if err := runValidate(output.Options{Format: output.FormatText}); err != nil {
+				t.Errorf("runValidate: %v", err)
+			}
+		})
+		for _, want := range []string{"config.yml: OK\n", "  warning: cache_ttl_hours: no longer used"} {
+			if !strings.Contains(out, want) {
+				t.Errorf("validate output missing %q in:\n%s", want, out)
+			}
+		}
+
+		jsonOut := capture(t, func() { _ = runValidate(output.Options{Format: output.FormatJSON}) })
+		var res validateResult
+		if err := json.Unmarshal([]byte(jsonOut), &res); err != nil {
+			t.Fatalf("validate --json not valid JSON: %v\n%s", err, jsonOut)
+		}
+		if !res.Valid || len(res.Problems) != 0 || len(res.Warnings) != 1 {
+			t.Errorf("json result wrong: %+v", res)
+		}
+	})
+
+	t.Run("missing config.yml is valid", func(t *testing.T) {
+		credtest.Setup(t)
+
+		out := capture(t, func() {
+			if err := runValidate(output.Options{Format: output.FormatText}); err != nil {
+				t.Errorf("runValidate: %v", err)
+			}
+		})
+		if !strings.HasSuffix(out, "config.yml: OK\n") {
+			t.Errorf("validate output: %q", out)
+		}
+
+		jsonOut := capture(t, func() { _ = runValidate(output.Options{Format: output.FormatJSON}) })
+		if !strings.Contains(jsonOut, `"problems": []`) {
+			t.Errorf("json problems must be an empty array: %s", jsonOut)
+		}
+	})
+}
+
+func TestRunRevoke(t *testing.T) {
+	orig := revokeTokenFn
+	t.Cleanup(func() { revokeTokenFn = orig })
+
+	t.Run("reports a revoked token", func(t *testing.T) {
+		revokeTokenFn = func(context.Context) (bool, error) { return false, nil }
+		out := capture(t, func() {
+			if err := runRevoke(context.Background()); err != nil {
+				t.Errorf("runRevoke: %v", err)
+			}
+		})
+		if !strings.Contains(out, "Revoked the OAuth token at Google") {
+			t.Errorf("unexpected output: %q", out)
+		}
+	})
+
+	t.Run("reports a token Google had already dropped", func(t *testing.T) {
+		revokeTokenFn = func(context.Context) (bool, error) { return true, nil }
+		out := capture(t, func() {
+			if err := runRevoke(context.Background()); err != nil {
+				t.Errorf("runRevoke: %v", err)
+			}
+		})
+		if !strings.Contains(out, "expired or already revoked") {
+			t.Errorf("unexpected output: %q", out)
+		}
+	})
+
+	t.Run("passes revoke errors through", func(t *testing.T) {
+		revokeTokenFn = func(context.Context) (bool, error) { return false, errors.New("revoking token: dial tcp: refused") }
+		err := runRevoke(context.Background())
+		if err == nil || !strings.Contains(err.Error(), "refused") {
+			t.Fatalf("want revoke error, got %v", err)
+		}
+	})
+}
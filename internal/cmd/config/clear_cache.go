@@ -0,0 +1,41 @@
+package config
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/open-cli-collective/google-readonly/internal/cache"
+	"github.com/open-cli-collective/google-readonly/internal/config"
+	"github.com/open-cli-collective/google-readonly/internal/log"
+)
+
+func newClearCacheCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "clear-cache",
+		Short: "Remove gro's cached API data (active profile)",
+		Long: `Remove everything gro has cached for the active profile: shared drives,
+Gmail labels, calendars and contact groups. Each is fetched again from the
+API the next time it is needed. The token and config.yml are not touched;
+other profiles keep their caches.
+
+To skip the cache for a single command instead, use the global --no-cache
+flag.`,
+		Args: cobra.NoArgs,
+		RunE: func(_ *cobra.Command, _ []string) error {
+			return runClearCache()
+		},
+	}
+}
+
+func runClearCache() error {
+	c, err := cache.New()
+	if err != nil {
+		return fmt.Errorf("initializing cache: %w", err)
+	}
+	if err := c.Clear(); err != nil {
+		return fmt.Errorf("clearing cache %s: %w", config.ShortenPath(c.GetDir()), err)
+	}
+	log.Status("Cleared the cache at %s.", config.ShortenPath(c.GetDir()))
+	return nil
+}
@@ -0,0 +1,49 @@
+package config
+
+import (
+	"testing"
+	"time"
+
+	gmailapi "google.golang.org/api/gmail/v1"
+
+	"github.com/open-cli-collective/google-readonly/internal/cache"
+	appconfig "github.com/open-cli-collective/google-readonly/internal/config"
+	"github.com/open-cli-collective/google-readonly/internal/credtest"
+	"github.com/open-cli-collective/google-readonly/internal/testutil"
+)
+
+func TestRunClearCache(t *testing.T) {
+	credtest.Setup(t)
+
+	c, err := cache.New()
+	testutil.NoError(t, err)
+	testutil.NoError(t, c.SetDrives([]*cache.CachedDrive{{ID: "d1", Name: "Eng"}}))
+	testutil.NoError(t, c.SetLabels([]*gmailapi.Label{{Id: "INBOX", Name: "INBOX"}}))
+	testutil.NoError(t, c.Set("calendars", []byte("[]"), time.Hour))
+
+	out := capture(t, func() { testutil.NoError(t, runClearCache()) })
+	testutil.Contains(t, out, "Cleared the cache at")
+
+	drives, err := c.GetDrives()
+	testutil.NoError(t, err)
+	testutil.Nil(t, drives)
+	labels, err := c.GetLabels()
+	testutil.NoError(t, err)
+	testutil.Nil(t, labels)
+	_, ok := c.Get("calendars")
+	testutil.False(t, ok)
+
+	t.Run("leaves other profiles alone", func(t *testing.T) {
+		testutil.NoError(t, appconfig.SetProfile("work"))
+		t.Cleanup(func() { _ = appconfig.SetProfile("") })
+		work, err := cache.New()
+		testutil.NoError(t, err)
+		testutil.NoError(t, work.Set("calendars", []byte("[]"), time.Hour))
+
+		testutil.NoError(t, appconfig.SetProfile(""))
+		_ = capture(t, func() { testutil.NoError(t, runClearCache()) })
+
+		_, ok := work.Get("calendars")
+		testutil.True(t, ok)
+	})
+}
@@ -0,0 +1,173 @@
+package config
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/open-cli-collective/google-readonly/internal/auth"
+	appconfig "github.com/open-cli-collective/google-readonly/internal/config"
+	"github.com/open-cli-collective/google-readonly/internal/credtest"
+)
+
+// withReauthorize records the services clear --domain re-authorizes for
+// instead of running the consent flow, and stubs out the revocation
+func withReauthorize(t *testing.T) *[]string {
+	t.Helper()
+	var got []string
+	origReauth, origRevoke := reauthorizeFn, revokeTokenFn
+	reauthorizeFn = func(_ context.Context, domains []string) error {
+		got = domains
+		return nil
+	}
+	revokeTokenFn = func(context.Context) (bool, error) { return false, nil }
+	t.Cleanup(func() { reauthorizeFn, revokeTokenFn = origReauth, origRevoke })
+	return &got
+}
+
+func saveGranted(t *testing.T, domains ...string) {
+	t.Helper()
+	if err := appconfig.SaveConfig(&appconfig.Config{GrantedScopes: auth.ScopesForDomains(domains)}); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestRunClearDomain(t *testing.T) {
+	t.Run("re-authorizes for the remaining services", func(t *testing.T) {
+		credtest.Setup(t)
+		saveGranted(t, "mail", "calendar", "drive")
+		got := withReauthorize(t)
+
+		out := capture(t, func() {
+			if err := runClearDomain(context.Background(), "mail", false); err != nil {
+				t.Errorf("runClearDomain: %v", err)
+			}
+		})
+		if strings.Join(*got, ",") != "calendar,drive" {
+			t.Errorf("re-authorized for %v, want [calendar drive]", *got)
+		}
+		if !strings.Contains(out, "Re-authorizing for calendar, drive to drop mail") {
+			t.Errorf("unexpected output: %q", out)
+		}
+	})
+
+	t.Run("revokes the old grant before the consent flow", func(t *testing.T) {
+		credtest.Setup(t)
+		saveGranted(t, "mail", "drive")
+		withReauthorize(t)
+		var steps []string
+		revokeTokenFn = func(context.Context) (bool, error) {
+			steps = append(steps, "revoke")
+			return false, nil
+		}
+		reauthorizeFn = func(context.Context, []string) error {
+			steps = append(steps, "reauthorize")
+			return nil
+		}
+
+		capture(t, func() {
+			if err := runClearDomain(context.Background(), "mail", false); err != nil {
+				t.Errorf("runClearDomain: %v", err)
+			}
+		})
+		if strings.Join(steps, ",") != "revoke,reauthorize" {
+			t.Errorf("steps = %v, want revoke then reauthorize", steps)
+		}
+	})
+
+	t.Run("failed revocation stops before the consent flow", func(t *testing.T) {
+		credtest.Setup(t)
+		saveGranted(t, "mail", "drive")
+		got := withReauthorize(t)
+		revokeTokenFn = func(context.Context) (bool, error) { return false, errors.New("dial tcp: refused") }
+
+		err := runClearDomain(context.Background(), "mail", false)
+		if err == nil || !strings.Contains(err.Error(), "revoking the current grant: dial tcp: refused") {
+			t.Fatalf("got %v", err)
+		}
+		if *got != nil {
+			t.Errorf("must not re-authorize, got %v", *got)
+		}
+	})
+
+	t.Run("failed consent says how to recover", func(t *testing.T) {
+		credtest.Setup(t)
+		saveGranted(t, "mail", "calendar", "drive")
+		withReauthorize(t)
+		reauthorizeFn = func(context.Context, []string) error { return errors.New("consent cancelled") }
+
+		var err error
+		capture(t, func() { err = runClearDomain(context.Background(), "mail", false) })
+		if err == nil || !strings.Contains(err.Error(), "run 'gro init --only calendar,drive'") {
+			t.Fatalf("got %v", err)
+		}
+	})
+
+	t.Run("unrecorded scopes count as every service", func(t *testing.T) {
+		credtest.Setup(t)
+		got := withReauthorize(t)
+		if err := runClearDomain(context.Background(), "drive", false); err != nil {
+			t.Fatalf("runClearDomain: %v", err)
+		}
+		if strings.Join(*got, ",") != "mail,calendar,contacts" {
+			t.Errorf("re-authorized for %v", *got)
+		}
+	})
+
+	t.Run("dry run changes nothing", func(t *testing.T) {
+		credtest.Setup(t)
+		saveGranted(t, "mail", "calendar")
+		got := withReauthorize(t)
+		revokeTokenFn = func(context.Context) (bool, error) {
+			t.Error("dry run must not revoke")
+			return false, nil
+		}
+
+		out := capture(t, func() {
+			if err := runClearDomain(context.Background(), "calendar", true); err != nil {
+				t.Errorf("runClearDomain: %v", err)
+			}
+		})
+		if *got != nil {
+			t.Errorf("dry run must not re-authorize, got %v", *got)
+		}
+		if !strings.Contains(out, "Would revoke: the current OAuth grant at Google\nWould re-authorize for: mail (dropping calendar)") {
+			t.Errorf("unexpected output: %q", out)
+		}
+	})
+
+	for _, tt := range []struct {
+		name, domain, wantErr string
+		granted               []string
+	}{
+		{"unknown service", "photos", `unknown --domain "photos"`, []string{"mail"}},
+		{"service not granted", "drive", "not authorized for drive", []string{"mail", "calendar"}},
+		{"last service", "mail", "only service authorized", []string{"mail"}},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			credtest.Setup(t)
+			saveGranted(t, tt.granted...)
+			got := withReauthorize(t)
+
+			err := runClearDomain(context.Background(), tt.domain, false)
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Fatalf("got %v, want error containing %q", err, tt.wantErr)
+			}
+			if *got != nil {
+				t.Errorf("must not re-authorize, got %v", *got)
+			}
+		})
+	}
+}
+
+func TestConfigClear_DomainAndAllExclusive(t *testing.T) {
+	credtest.Setup(t)
+	withReauthorize(t)
+	cmd := newClearCommand()
+	cmd.SetArgs([]string{"--all", "--domain", "mail"})
+	err := cmd.Execute()
+	if err == nil || !strings.Contains(err.Error(), "none of the others can be") {
+		t.Fatalf("expected mutually exclusive error, got %v", err)
+	}
+}
@@ -2,17 +2,23 @@
 package config
 
 import (
+	"context"
 	"crypto/sha256"
 	"encoding/hex"
 	"encoding/json"
 	"fmt"
 	"os"
 	"path/filepath"
+	"slices"
+	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
 
 	"github.com/open-cli-collective/cli-common/credstore"
 
+	"github.com/open-cli-collective/google-readonly/internal/auth"
+	"github.com/open-cli-collective/google-readonly/internal/cmd/initcmd"
 	"github.com/open-cli-collective/google-readonly/internal/config"
 	"github.com/open-cli-collective/google-readonly/internal/gmail"
 	"github.com/open-cli-collective/google-readonly/internal/keychain"
@@ -58,6 +64,10 @@ func configFilesForClear() ([]string, error) {
 // macOS/Windows "old != new" branch without OS-specific paths.
 var configFilesForClearFn = configFilesForClear
 
+// reauthorizeFn is the package-var test seam for the consent flow that
+// clear --domain runs
+var reauthorizeFn = initcmd.Reauthorize
+
 // NewCommand returns the config command with subcommands.
 func NewCommand() *cobra.Command {
 	cmd := &cobra.Command{
@@ -68,11 +78,20 @@ func NewCommand() *cobra.Command {
 	cmd.AddCommand(newShowCommand())
 	cmd.AddCommand(newTestCommand())
 	cmd.AddCommand(newClearCommand())
+	cmd.AddCommand(newRevokeCommand())
+	cmd.AddCommand(newClearCacheCommand())
+	cmd.AddCommand(newProfilesCommand())
+	cmd.AddCommand(newStatusCommand())
+	cmd.AddCommand(newRefreshCommand())
+	cmd.AddCommand(newValidateCommand())
 	return cmd
 }
 
 func newShowCommand() *cobra.Command {
-	var jsonOut, verbose bool
+	var (
+		format  formatFlags
+		verbose bool
+	)
 	cmd := &cobra.Command{
 		Use:   "show",
 		Short: "Display configuration status",
@@ -82,10 +101,14 @@ credential ref, whether the OAuth token is present, and the OAuth client JSON
 never shown. --verbose inlines the OAuth client JSON contents.`,
 		Args: cobra.NoArgs,
 		RunE: func(_ *cobra.Command, _ []string) error {
-			return runShow(jsonOut, verbose)
+			opts, err := format.resolve()
+			if err != nil {
+				return err
+			}
+			return runShow(opts, verbose)
 		},
 	}
-	cmd.Flags().BoolVarP(&jsonOut, "json", "j", false, "Emit JSON")
+	format.register(cmd)
 	cmd.Flags().BoolVar(&verbose, "verbose", false, "Inline the OAuth client JSON contents")
 	return cmd
 }
@@ -104,30 +127,201 @@ installer/runtime smoke check: it runs the same path as a real API command
 
 func newClearCommand() *cobra.Command {
 	var all, dryRun bool
+	var domain string
 	cmd := &cobra.Command{
 		Use:   "clear",
 		Short: "Remove the stored OAuth token (active profile)",
 		Long: `Remove the stored OAuth token under the active credential_ref,
 forcing re-authentication (§1.7). --all also removes config.yml and the Drive
 metadata cache. --dry-run reports what would be removed without removing it.
-The OAuth client JSON (deployment material) is never removed.`,
+The OAuth client JSON (deployment material) is never removed.
+
+--domain drops one service (mail, calendar, contacts or drive) and keeps the
+rest. A token is a single OAuth grant, so this runs the consent flow again
+for the remaining services and replaces the token: it needs a browser
+round-trip, like 'gro init --only'. The old grant is revoked at Google
+first: Google revokes an app's whole grant at once, so revoking it after the
+new token is saved would revoke the new token too, and leaving it would keep
+the dropped service's access alive. If the consent flow then fails, run
+'gro init --only' for the remaining services.`,
 		Args: cobra.NoArgs,
-		RunE: func(_ *cobra.Command, _ []string) error {
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			if domain != "" {
+				return runClearDomain(cmd.Context(), domain, dryRun)
+			}
 			return runClear(all, dryRun)
 		},
 	}
 	cmd.Flags().BoolVar(&all, "all", false, "Also remove config.yml and the Drive metadata cache")
 	cmd.Flags().BoolVar(&dryRun, "dry-run", false, "Report what would be removed; remove nothing")
+	cmd.Flags().StringVar(&domain, "domain", "", "Drop one service (mail, calendar, contacts, drive) by re-authorizing for the rest")
+	cmd.MarkFlagsMutuallyExclusive("all", "domain")
+	return cmd
+}
+
+func newRevokeCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "revoke",
+		Short: "Revoke the OAuth token at Google, then remove it",
+		Long: `Revoke the stored OAuth token with Google's revocation endpoint, so
+the grant is invalidated server-side, then remove it from the keyring like
+'gro config clear'. 'clear' only deletes the local copy and leaves the grant
+active; use revoke when the token may have leaked or you are done with gro.
+
+A token Google already rejects (expired or revoked) is treated as revoked
+and removed. If Google cannot be reached, the token is kept so the command
+can be retried.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			return runRevoke(cmd.Context())
+		},
+	}
+}
+
+func newProfilesCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "profiles",
+		Short: "List account profiles",
+		Long: `List the known account profiles and mark the active one with '*'.
+
+A profile is selected with --profile/-p or the GRO_PROFILE environment
+variable. Each named profile has its own config.yml under
+<configdir>/profiles/<name> and its own token in the keyring; the OAuth
+client JSON is shared. Create one by running 'gro init --profile <name>'.`,
+		Args: cobra.NoArgs,
+		RunE: func(_ *cobra.Command, _ []string) error {
+			return runProfiles()
+		},
+	}
+}
+
+func newStatusCommand() *cobra.Command {
+	var format formatFlags
+	cmd := &cobra.Command{
+		Use:   "status",
+		Short: "Show token expiry and granted scopes",
+		Long: `Show the stored OAuth token's state for the active profile: the
+keyring backend, when the access token expires, whether a refresh token is
+present, and the scopes it was granted. The token value is never shown.
+
+Granted scopes are the ones recorded by 'gro init'. When none were recorded
+(a token from an older gro), the scopes gro requests are shown instead and
+marked as such.`,
+		Args: cobra.NoArgs,
+		RunE: func(_ *cobra.Command, _ []string) error {
+			opts, err := format.resolve()
+			if err != nil {
+				return err
+			}
+			return runStatus(opts)
+		},
+	}
+	format.register(cmd)
+	return cmd
+}
+
+func newRefreshCommand() *cobra.Command {
+	var format formatFlags
+	cmd := &cobra.Command{
+		Use:   "refresh",
+		Short: "Force an OAuth access token refresh",
+		Long: `Exchange the stored refresh token for a new access token now, rather
+than waiting for it to expire mid-command, and report the new expiry. Useful
+before a long batch job. The refreshed token is written back to the keyring
+under the active credential_ref.
+
+Fails with a pointer to 'gro init' if there is no refresh token or Google
+rejects it as expired or revoked. This refreshes credentials only; 'gro
+refresh' is the separate command that refreshes the metadata cache.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			opts, err := format.resolve()
+			if err != nil {
+				return err
+			}
+			return runRefresh(cmd.Context(), opts)
+		},
+	}
+	format.register(cmd)
+	return cmd
+}
+
+func newValidateCommand() *cobra.Command {
+	var format formatFlags
+	cmd := &cobra.Command{
+		Use:   "validate",
+		Short: "Check config.yml for invalid values",
+		Long: `Check every key in the active profile's config.yml and report each
+problem: an unknown keyring backend, a date_format that is neither a preset
+nor a Go layout, a malformed credential_ref, a missing OAuth client JSON, and
+so on. Keys gro does not understand are reported too, since they are
+otherwise ignored without a word. Keys older releases used, which gro still
+reads and ignores, are shown as warnings.
+
+Exits non-zero when any problem is found; warnings alone do not fail. A
+missing config.yml is valid.`,
+		Args: cobra.NoArgs,
+		RunE: func(_ *cobra.Command, _ []string) error {
+			opts, err := format.resolve()
+			if err != nil {
+				return err
+			}
+			return runValidate(opts)
+		},
+	}
+	format.register(cmd)
 	return cmd
 }
 
+// formatFlags are the --output/-o, --json/-j and --fields flags shared by
+// the config subcommands that emit a structured envelope
+type formatFlags struct {
+	output string
+	json   bool
+	fields string
+}
+
+func (f *formatFlags) register(cmd *cobra.Command) {
+	cmd.Flags().StringVarP(&f.output, "output", "o", output.FormatText, "Output format: text, json or yaml")
+	cmd.Flags().BoolVarP(&f.json, "json", "j", false, "Emit JSON (shorthand for --output json)")
+	cmd.Flags().StringVar(&f.fields, "fields", "", "Comma-separated fields to keep in json/yaml output")
+}
+
+func (f *formatFlags) resolve() (output.Options, error) {
+	return output.ResolveOptions(f.output, f.json, f.fields)
+}
+
+func runProfiles() error {
+	profiles, err := config.ListProfiles()
+	if err != nil {
+		return fmt.Errorf("listing profiles: %w", err)
+	}
+
+	active := config.ActiveProfile()
+	known := false
+	for _, name := range profiles {
+		marker := " "
+		if name == active {
+			marker = "*"
+			known = true
+		}
+		fmt.Printf("%s %s\n", marker, name)
+	}
+	if !known {
+		fmt.Printf("* %s (not set up; run 'gro init --profile %s')\n", active, active)
+	}
+	return nil
+}
+
 // showStatus is the §1.6 non-secret view: never the token value, not even a
 // masked prefix.
 type showStatus struct {
+	Profile                string `json:"profile"`
 	CredentialRef          string `json:"credential_ref"`
 	Backend                string `json:"backend"`
 	BackendSource          string `json:"backend_source"`
 	KeyringBackend         string `json:"keyring_backend,omitempty"` // selector from config.yml (keyring.backend)
+	DateFormat             string `json:"date_format,omitempty"`     // from config.yml (date_format)
 	PassphraseSource       string `json:"passphrase_source,omitempty"`
 	OAuthTokenPresent      bool   `json:"oauth_token_present"`
 	OAuthClientPath        string `json:"oauth_client_path"`
@@ -136,7 +330,7 @@ type showStatus struct {
 	OAuthClientContents    string `json:"oauth_client_contents,omitempty"`
 }
 
-func runShow(jsonOut, verbose bool) error {
+func runShow(opts output.Options, verbose bool) error {
 	cfg, err := config.LoadConfigForRuntime()
 	if err != nil {
 		return err
@@ -157,10 +351,12 @@ func runShow(jsonOut, verbose bool) error {
 	}
 	backend, src := st.Backend()
 	status := showStatus{
+		Profile:            config.ActiveProfile(),
 		CredentialRef:      st.Ref(),
 		Backend:            string(backend),
 		BackendSource:      string(src),
 		KeyringBackend:     cfg.Keyring.Backend, // selector value from config.yml; "" if unset
+		DateFormat:         cfg.DateFormat,
 		OAuthTokenPresent:  hasTok,
 		OAuthClientPath:    config.ShortenPath(cfg.OAuthClientPath),
 		OAuthClientPresent: false,
@@ -176,15 +372,19 @@ func runShow(jsonOut, verbose bool) error {
 		}
 	}
 
-	if jsonOut {
-		return output.JSONStdout(status)
+	if opts.Structured() {
+		return opts.Print(os.Stdout, status)
 	}
 
+	fmt.Printf("Profile:             %s\n", status.Profile)
 	fmt.Printf("Credential ref:      %s\n", status.CredentialRef)
 	fmt.Printf("Backend:             %s (%s)\n", status.Backend, status.BackendSource)
 	if status.KeyringBackend != "" {
 		fmt.Printf("keyring.backend:     %s (config.yml)\n", status.KeyringBackend)
 	}
+	if status.DateFormat != "" {
+		fmt.Printf("date_format:         %s (config.yml)\n", status.DateFormat)
+	}
 	if status.PassphraseSource != "" {
 		fmt.Printf("Passphrase:          %s\n", status.PassphraseSource)
 	}
@@ -200,7 +400,226 @@ func runShow(jsonOut, verbose bool) error {
 	}
 	if !status.OAuthTokenPresent || !status.OAuthClientPresent {
 		fmt.Println()
-		fmt.Println("Run 'gro init' to complete setup.")
+		if status.Profile != config.DefaultProfile {
+			fmt.Printf("Run 'gro init --profile %s' to complete setup.\n", status.Profile)
+		} else {
+			fmt.Println("Run 'gro init' to complete setup.")
+		}
+	}
+	return nil
+}
+
+// tokenStatus is the `config status` view. Like showStatus it never carries
+// the token value. Expiry is a pointer so a token without one omits cleanly.
+type tokenStatus struct {
+	Profile             string     `json:"profile"`
+	CredentialRef       string     `json:"credential_ref"`
+	Backend             string     `json:"backend"`
+	TokenPresent        bool       `json:"token_present"`
+	AccessTokenExpiry   *time.Time `json:"access_token_expiry,omitempty"`
+	AccessTokenExpired  bool       `json:"access_token_expired"`
+	RefreshTokenPresent bool       `json:"refresh_token_present"`
+	Scopes              []string   `json:"scopes,omitempty"`
+	ScopesSource        string     `json:"scopes_source,omitempty"` // "granted" or "requested"
+}
+
+// statusNow is the clock runStatus compares the expiry against. A var so
+// tests can pin it.
+var statusNow = time.Now
+
+func runStatus(opts output.Options) error {
+	cfg, err := config.LoadConfigForRuntime()
+	if err != nil {
+		return err
+	}
+
+	// OpenNoMigrate: status is a read-only diagnostic like config show and
+	// must stay usable during an unresolved §1.8 conflict.
+	st, err := keychain.OpenNoMigrate()
+	if err != nil {
+		return err
+	}
+	defer func() { _ = st.Close() }()
+
+	hasTok, err := st.HasToken()
+	if err != nil {
+		return fmt.Errorf("checking stored token: %w", err)
+	}
+	backend, _ := st.Backend()
+	status := tokenStatus{
+		Profile:       config.ActiveProfile(),
+		CredentialRef: st.Ref(),
+		Backend:       string(backend),
+		TokenPresent:  hasTok,
+	}
+	if hasTok {
+		tok, err := st.Token()
+		if err != nil {
+			return fmt.Errorf("reading stored token: %w", err)
+		}
+		if !tok.Expiry.IsZero() {
+			expiry := tok.Expiry
+			status.AccessTokenExpiry = &expiry
+			status.AccessTokenExpired = !expiry.After(statusNow())
+		}
+		status.RefreshTokenPresent = tok.RefreshToken != ""
+		// Recorded scopes are authoritative; without a record the best we
+		// can say is what gro asks for, so label it that way.
+		if len(cfg.GrantedScopes) > 0 {
+			status.Scopes, status.ScopesSource = cfg.GrantedScopes, "granted"
+		} else {
+			status.Scopes, status.ScopesSource = auth.AllScopes, "requested"
+		}
+	}
+
+	if opts.Structured() {
+		return opts.Print(os.Stdout, status)
+	}
+
+	fmt.Printf("Profile:             %s\n", status.Profile)
+	fmt.Printf("Credential ref:      %s\n", status.CredentialRef)
+	fmt.Printf("Backend:             %s\n", status.Backend)
+	if !status.TokenPresent {
+		fmt.Println("OAuth token:         not configured")
+		fmt.Println()
+		if status.Profile != config.DefaultProfile {
+			fmt.Printf("No token is stored. Run 'gro init --profile %s' to authenticate.\n", status.Profile)
+		} else {
+			fmt.Println("No token is stored. Run 'gro init' to authenticate.")
+		}
+		return nil
+	}
+
+	fmt.Println("OAuth token:         present")
+	switch {
+	case status.AccessTokenExpiry == nil:
+		fmt.Println("Access token expiry: unknown")
+	case status.AccessTokenExpired:
+		fmt.Printf("Access token expiry: %s (expired)\n", status.AccessTokenExpiry.Format(time.RFC3339))
+	default:
+		left := status.AccessTokenExpiry.Sub(statusNow()).Round(time.Minute)
+		fmt.Printf("Access token expiry: %s (in %s)\n", status.AccessTokenExpiry.Format(time.RFC3339), left)
+	}
+	fmt.Printf("Refresh token:       %s\n", presence(status.RefreshTokenPresent))
+	if status.ScopesSource == "granted" {
+		fmt.Println("Granted scopes:")
+	} else {
+		fmt.Println("Scopes (none recorded; showing what gro requests):")
+	}
+	for _, s := range status.Scopes {
+		fmt.Printf("  - %s\n", s)
+	}
+	if !status.RefreshTokenPresent {
+		fmt.Println()
+		fmt.Println("Without a refresh token gro cannot renew access; run 'gro init' once it expires.")
+	}
+	return nil
+}
+
+// revokeTokenFn is the package-var test seam for the OAuth revocation.
+var revokeTokenFn = auth.RevokeToken
+
+func runRevoke(ctx context.Context) error {
+	alreadyInvalid, err := revokeTokenFn(ctx)
+	if err != nil {
+		return err
+	}
+	if alreadyInvalid {
+		fmt.Println("Google no longer accepted the token (expired or already revoked); removed it from the keyring.")
+	} else {
+		fmt.Println("Revoked the OAuth token at Google and removed it from the keyring.")
+	}
+	fmt.Println("Run 'gro init' to authenticate again.")
+	return nil
+}
+
+// refreshTokenFn is the package-var test seam for the OAuth refresh.
+var refreshTokenFn = auth.RefreshToken
+
+// refreshResult is the `config refresh --output json|yaml` envelope
+type refreshResult struct {
+	Profile           string    `json:"profile"`
+	AccessTokenExpiry time.Time `json:"access_token_expiry"`
+}
+
+func runRefresh(ctx context.Context, opts output.Options) error {
+	// Reject a bad --fields before the refresh rewrites the stored token
+	if _, err := output.Project(refreshResult{}, opts.Fields); err != nil {
+		return err
+	}
+
+	tok, err := refreshTokenFn(ctx)
+	if err != nil {
+		return err
+	}
+
+	result := refreshResult{Profile: config.ActiveProfile(), AccessTokenExpiry: tok.Expiry}
+	if opts.Structured() {
+		return opts.Print(os.Stdout, result)
+	}
+
+	fmt.Println("Token refreshed.")
+	if tok.Expiry.IsZero() {
+		fmt.Println("Access token expiry: unknown")
+		return nil
+	}
+	left := tok.Expiry.Sub(statusNow()).Round(time.Minute)
+	fmt.Printf("Access token expiry: %s (in %s)\n", tok.Expiry.Format(time.RFC3339), left)
+	return nil
+}
+
+// validateResult is the `config validate --output json|yaml` envelope
+type validateResult struct {
+	Path     string    `json:"path"`
+	Valid    bool      `json:"valid"`
+	Problems []problem `json:"problems"`
+	Warnings []problem `json:"warnings"`
+}
+
+func runValidate(opts output.Options) error {
+	path, problems, warnings, err := validateConfigFile()
+	if err != nil {
+		return err
+	}
+
+	result := validateResult{
+		Path:     config.ShortenPath(path),
+		Valid:    len(problems) == 0,
+		Problems: problems,
+		Warnings: warnings,
+	}
+	if result.Problems == nil {
+		result.Problems = []problem{}
+	}
+	if result.Warnings == nil {
+		result.Warnings = []problem{}
+	}
+
+	if opts.Structured() {
+		if err := opts.Print(os.Stdout, result); err != nil {
+			return err
+		}
+	} else {
+		if result.Valid {
+			fmt.Printf("%s: OK\n", result.Path)
+		} else {
+			fmt.Printf("%s:\n", result.Path)
+			for _, p := range problems {
+				fmt.Printf("  %s: %s\n", p.Key, p.Reason)
+			}
+		}
+		for _, w := range warnings {
+			fmt.Printf("  warning: %s: %s\n", w.Key, w.Reason)
+		}
+	}
+
+	if !result.Valid {
+		err := fmt.Errorf("config.yml has %d problem(s)", len(problems))
+		if opts.Structured() {
+			// The envelope already lists the problems
+			return output.Reported(err)
+		}
+		return err
 	}
 	return nil
 }
@@ -254,6 +673,54 @@ func runTest(cmd *cobra.Command, _ []string) error {
 	return nil
 }
 
+// runClearDomain drops authorization for domain by revoking the stored grant
+// and re-authorizing for the other services it covered. A token without
+// recorded scopes is taken to cover every service.
+func runClearDomain(ctx context.Context, domain string, dryRun bool) error {
+	if !slices.Contains(auth.Domains, domain) {
+		return fmt.Errorf("unknown --domain %q; valid values: %s", domain, strings.Join(auth.Domains, ", "))
+	}
+
+	cfg, err := config.LoadConfigForRuntime()
+	if err != nil {
+		return fmt.Errorf("loading config: %w", err)
+	}
+	granted := auth.Domains
+	if len(cfg.GrantedScopes) > 0 {
+		granted = auth.GrantedDomains(cfg.GrantedScopes)
+	}
+	if !slices.Contains(granted, domain) {
+		return fmt.Errorf("the stored token is not authorized for %s; nothing to drop", domain)
+	}
+	remaining := slices.DeleteFunc(slices.Clone(granted), func(d string) bool { return d == domain })
+	if len(remaining) == 0 {
+		return fmt.Errorf("%s is the only service authorized; run 'gro config clear' to remove the token", domain)
+	}
+
+	if dryRun {
+		fmt.Println("Would revoke: the current OAuth grant at Google")
+		fmt.Printf("Would re-authorize for: %s (dropping %s)\n", strings.Join(remaining, ", "), domain)
+		fmt.Println()
+		fmt.Println("--dry-run: nothing was changed.")
+		return nil
+	}
+
+	// Revoke before the consent flow: Google revokes the whole grant, so
+	// doing it afterwards would take the new token down with the old one
+	if _, err := revokeTokenFn(ctx); err != nil {
+		return fmt.Errorf("revoking the current grant: %w", err)
+	}
+	fmt.Println("Revoked the current OAuth grant at Google.")
+
+	fmt.Printf("Re-authorizing for %s to drop %s. This needs the consent page in a browser.\n\n",
+		strings.Join(remaining, ", "), domain)
+	if err := reauthorizeFn(ctx, remaining); err != nil {
+		return fmt.Errorf("%w\nthe old grant is already revoked; run 'gro init --only %s' to authorize again",
+			err, strings.Join(remaining, ","))
+	}
+	return nil
+}
+
 func runClear(all, dryRun bool) error {
 	// Resolve scrub targets BEFORE opening the keyring (§6.6 pattern 7):
 	// `--all` is the user's primary recovery path and must not itself be
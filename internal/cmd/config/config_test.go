@@ -28,6 +28,11 @@ func TestConfigCommand(t *testing.T) {
 		testutil.SliceContains(t, names, "show")
 		testutil.SliceContains(t, names, "test")
 		testutil.SliceContains(t, names, "clear")
+		testutil.SliceContains(t, names, "clear-cache")
+		testutil.SliceContains(t, names, "profiles")
+		testutil.SliceContains(t, names, "status")
+		testutil.SliceContains(t, names, "refresh")
+		testutil.SliceContains(t, names, "validate")
 	})
 }
 
@@ -61,6 +66,28 @@ func TestConfigShowCommand(t *testing.T) {
 	})
 }
 
+func TestConfigStatusCommand(t *testing.T) {
+	cmd := newStatusCommand()
+
+	t.Run("has correct use", func(t *testing.T) {
+		testutil.Equal(t, cmd.Use, "status")
+	})
+
+	t.Run("requires no arguments", func(t *testing.T) {
+		err := cmd.Args(cmd, []string{})
+		testutil.NoError(t, err)
+
+		err = cmd.Args(cmd, []string{"extra"})
+		testutil.Error(t, err)
+	})
+
+	t.Run("declares --json (control-plane carve-out per #144)", func(t *testing.T) {
+		flag := cmd.Flags().Lookup("json")
+		testutil.NotNil(t, flag)
+		testutil.Equal(t, flag.Shorthand, "j")
+	})
+}
+
 func TestConfigTestCommand(t *testing.T) {
 	cmd := newTestCommand()
 
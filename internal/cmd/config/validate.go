@@ -0,0 +1,210 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/open-cli-collective/cli-common/credstore"
+
+	"github.com/open-cli-collective/google-readonly/internal/config"
+	"github.com/open-cli-collective/google-readonly/internal/format"
+)
+
+// problem is one config.yml key that failed validation
+type problem struct {
+	Key    string `json:"key"`
+	Reason string `json:"reason"`
+}
+
+// keyValidators holds the check for every key config.yml understands, keyed
+// by its dotted path (nested mappings are joined with "."). Each validator
+// receives the raw decoded YAML value. A key missing here is unknown and is
+// reported as such: yaml.v3 ignores unknown fields on load, so a misspelt or
+// unsupported key would otherwise do nothing without a word.
+var keyValidators = map[string]func(v any) error{
+	"credential_ref":    validateCredentialRef,
+	"oauth_client_path": validateOAuthClientPath,
+	"granted_scopes":    validateGrantedScopes,
+	"keyring.backend":   validateKeyringBackend,
+	"date_format":       validateDateFormat,
+}
+
+// retiredKeys are keys older releases read that are now ignored, with the
+// reason shown to the user. The loader still accepts them, so they are
+// warnings rather than problems: a config that loads fine passes validation.
+var retiredKeys = map[string]string{
+	"cache_ttl_hours": "no longer used; cache TTLs are fixed per resource",
+}
+
+// scopePrefix is the prefix every Google OAuth scope gro requests shares
+const scopePrefix = "https://www.googleapis.com/auth/"
+
+// validateConfigFile validates the active profile's config.yml and returns
+// its path with the problems and warnings found. A missing file has neither:
+// every key then takes its default. Non-creating.
+func validateConfigFile() (string, []problem, []problem, error) {
+	path, err := config.GetConfigPathNoCreate()
+	if err != nil {
+		return "", nil, nil, err
+	}
+	data, err := os.ReadFile(path) //nolint:gosec // path from the resolved config dir
+	if os.IsNotExist(err) {
+		return path, nil, nil, nil
+	}
+	if err != nil {
+		return path, nil, nil, fmt.Errorf("read config %s: %w", config.ShortenPath(path), err)
+	}
+	problems, warnings, err := validateConfigYAML(data)
+	if err != nil {
+		return path, nil, nil, fmt.Errorf("parse config %s: %w", config.ShortenPath(path), err)
+	}
+	return path, problems, warnings, nil
+}
+
+// validateConfigYAML checks every key in a config.yml document against
+// keyValidators and returns the problems, then the warnings for retired
+// keys, each sorted by key. An error means the document is not a YAML
+// mapping at all.
+func validateConfigYAML(data []byte) ([]problem, []problem, error) {
+	var doc map[string]any
+	if err := yaml.Unmarshal(data, &doc); err != nil {
+		return nil, nil, err
+	}
+
+	var problems, warnings []problem
+	for key, value := range flattenYAML("", doc) {
+		if reason, ok := retiredKeys[key]; ok {
+			warnings = append(warnings, problem{Key: key, Reason: reason})
+			continue
+		}
+		validate, ok := keyValidators[key]
+		if !ok {
+			problems = append(problems, problem{Key: key, Reason: unknownKeyReason(key)})
+			continue
+		}
+		if err := validate(value); err != nil {
+			problems = append(problems, problem{Key: key, Reason: err.Error()})
+		}
+	}
+	sortProblems(problems)
+	sortProblems(warnings)
+	return problems, warnings, nil
+}
+
+func sortProblems(problems []problem) {
+	sort.Slice(problems, func(i, j int) bool { return problems[i].Key < problems[j].Key })
+}
+
+// flattenYAML maps each leaf of a decoded YAML mapping to its dotted path
+func flattenYAML(prefix string, m map[string]any) map[string]any {
+	out := map[string]any{}
+	for k, v := range m {
+		key := k
+		if prefix != "" {
+			key = prefix + "." + k
+		}
+		if nested, ok := v.(map[string]any); ok {
+			for nk, nv := range flattenYAML(key, nested) {
+				out[nk] = nv
+			}
+			continue
+		}
+		out[key] = v
+	}
+	return out
+}
+
+// unknownKeyReason explains an unknown key, pointing out a known mapping that
+// was given a scalar (e.g. "keyring: file" instead of "keyring: {backend: file}")
+func unknownKeyReason(key string) string {
+	for known := range keyValidators {
+		if strings.HasPrefix(known, key+".") {
+			return fmt.Sprintf("must be a mapping (e.g. %s)", known)
+		}
+	}
+	return "unknown key; gro ignores it"
+}
+
+// asString returns v as a string, rejecting any non-string YAML value
+func asString(v any) (string, error) {
+	switch s := v.(type) {
+	case string:
+		return s, nil
+	case nil:
+		return "", nil
+	default:
+		return "", fmt.Errorf("must be a string, got %v", v)
+	}
+}
+
+func validateCredentialRef(v any) error {
+	s, err := asString(v)
+	if err != nil || s == "" {
+		return err
+	}
+	if _, _, err := credstore.ParseRef(s); err != nil {
+		return fmt.Errorf("invalid credential ref %q: %w", s, err)
+	}
+	return nil
+}
+
+func validateOAuthClientPath(v any) error {
+	s, err := asString(v)
+	if err != nil || s == "" {
+		return err
+	}
+	path := config.ExpandPath(s)
+	info, err := os.Stat(path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return fmt.Errorf("%s does not exist", config.ShortenPath(path))
+		}
+		return err
+	}
+	if info.IsDir() {
+		return fmt.Errorf("%s is a directory", config.ShortenPath(path))
+	}
+	return nil
+}
+
+func validateGrantedScopes(v any) error {
+	if v == nil {
+		return nil
+	}
+	list, ok := v.([]any)
+	if !ok {
+		return fmt.Errorf("must be a list of scope URLs, got %v", v)
+	}
+	for _, item := range list {
+		s, ok := item.(string)
+		if !ok || !strings.HasPrefix(s, scopePrefix) {
+			return fmt.Errorf("%v is not a Google OAuth scope (want %s...)", item, scopePrefix)
+		}
+	}
+	return nil
+}
+
+func validateKeyringBackend(v any) error {
+	s, err := asString(v)
+	if err != nil || s == "" {
+		return err
+	}
+	if _, err := credstore.ParseBackend(s); err != nil {
+		return fmt.Errorf("%q is not a known backend (valid: %s)", s, strings.Join(credstore.ValidBackendNames(), ", "))
+	}
+	return nil
+}
+
+func validateDateFormat(v any) error {
+	s, err := asString(v)
+	if err != nil {
+		return err
+	}
+	_, err = format.ParseDateLayout(s)
+	return err
+}
@@ -0,0 +1,168 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	appconfig "github.com/open-cli-collective/google-readonly/internal/config"
+	"github.com/open-cli-collective/google-readonly/internal/credtest"
+)
+
+func problemFor(problems []problem, key string) (problem, bool) {
+	for _, p := range problems {
+		if p.Key == key {
+			return p, true
+		}
+	}
+	return problem{}, false
+}
+
+func TestValidateConfigYAML(t *testing.T) {
+	t.Run("valid config has no problems", func(t *testing.T) {
+		client := filepath.Join(t.TempDir(), appconfig.OAuthClientFile)
+		if err := os.WriteFile(client, []byte("{}"), 0o600); err != nil {
+			t.Fatal(err)
+		}
+		doc := "credential_ref: google-readonly/default\n" +
+			"oauth_client_path: " + client + "\n" +
+			"granted_scopes:\n  - https://www.googleapis.com/auth/gmail.readonly\n" +
+			"keyring:\n  backend: file\n" +
+			"date_format: iso\n"
+
+		problems, _, err := validateConfigYAML([]byte(doc))
+		if err != nil {
+			t.Fatalf("validateConfigYAML: %v", err)
+		}
+		if len(problems) != 0 {
+			t.Errorf("want no problems, got %+v", problems)
+		}
+	})
+
+	t.Run("reports every bad key", func(t *testing.T) {
+		doc := "timezone: Mars/Olympus_Mons\n" +
+			"keyring:\n  backend: floppy\n" +
+			"date_format: tuesday\n" +
+			"credential_ref: no-slash\n" +
+			"oauth_client_path: " + filepath.Join(t.TempDir(), "missing.json") + "\n" +
+			"granted_scopes: [gmail.readonly]\n"
+
+		problems, _, err := validateConfigYAML([]byte(doc))
+		if err != nil {
+			t.Fatalf("validateConfigYAML: %v", err)
+		}
+
+		want := map[string]string{
+			"timezone":          "unknown key",
+			"keyring.backend":   `"floppy" is not a known backend`,
+			"date_format":       "invalid date format",
+			"credential_ref":    "invalid credential ref",
+			"oauth_client_path": "does not exist",
+			"granted_scopes":    "not a Google OAuth scope",
+		}
+		if len(problems) != len(want) {
+			t.Errorf("got %d problems, want %d: %+v", len(problems), len(want), problems)
+		}
+		for key, reason := range want {
+			p, ok := problemFor(problems, key)
+			if !ok {
+				t.Errorf("no problem reported for %s", key)
+				continue
+			}
+			if !strings.Contains(p.Reason, reason) {
+				t.Errorf("%s: reason %q does not contain %q", key, p.Reason, reason)
+			}
+		}
+		for i := 1; i < len(problems); i++ {
+			if problems[i-1].Key > problems[i].Key {
+				t.Errorf("problems not sorted by key: %+v", problems)
+				break
+			}
+		}
+	})
+
+	t.Run("retired key is a warning", func(t *testing.T) {
+		problems, warnings, err := validateConfigYAML([]byte("cache_ttl_hours: 24\ndate_format: iso\n"))
+		if err != nil {
+			t.Fatalf("validateConfigYAML: %v", err)
+		}
+		if len(problems) != 0 {
+			t.Errorf("want no problems, got %+v", problems)
+		}
+		w, ok := problemFor(warnings, "cache_ttl_hours")
+		if !ok || !strings.Contains(w.Reason, "no longer used") {
+			t.Errorf("want a cache_ttl_hours warning, got %+v", warnings)
+		}
+	})
+
+	t.Run("scalar where a mapping is expected", func(t *testing.T) {
+		problems, _, err := validateConfigYAML([]byte("keyring: file\n"))
+		if err != nil {
+			t.Fatalf("validateConfigYAML: %v", err)
+		}
+		p, ok := problemFor(problems, "keyring")
+		if !ok || !strings.Contains(p.Reason, "must be a mapping (e.g. keyring.backend)") {
+			t.Errorf("got %+v", problems)
+		}
+	})
+
+	t.Run("wrong value type", func(t *testing.T) {
+		problems, _, err := validateConfigYAML([]byte("date_format: [iso]\n"))
+		if err != nil {
+			t.Fatalf("validateConfigYAML: %v", err)
+		}
+		p, ok := problemFor(problems, "date_format")
+		if !ok || !strings.Contains(p.Reason, "must be a string") {
+			t.Errorf("got %+v", problems)
+		}
+	})
+
+	t.Run("not a mapping", func(t *testing.T) {
+		if _, _, err := validateConfigYAML([]byte("- a\n- b\n")); err == nil {
+			t.Error("want an error for a non-mapping document")
+		}
+	})
+}
+
+func TestValidateConfigFile(t *testing.T) {
+	t.Run("missing file is valid", func(t *testing.T) {
+		credtest.Setup(t)
+		path, problems, _, err := validateConfigFile()
+		if err != nil {
+			t.Fatalf("validateConfigFile: %v", err)
+		}
+		if filepath.Base(path) != appconfig.ConfigFileYAML || len(problems) != 0 {
+			t.Errorf("got path %q problems %+v", path, problems)
+		}
+		if _, err := os.Stat(filepath.Dir(path)); !os.IsNotExist(err) {
+			t.Errorf("validateConfigFile must not create the config dir (stat err=%v)", err)
+		}
+	})
+
+	t.Run("reads the active profile's config.yml", func(t *testing.T) {
+		credtest.Setup(t)
+		t.Cleanup(func() { _ = appconfig.SetProfile("") })
+		if err := appconfig.SetProfile("work"); err != nil {
+			t.Fatal(err)
+		}
+		dir, err := appconfig.GetConfigDir()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(filepath.Join(dir, appconfig.ConfigFileYAML), []byte("keyring:\n  backend: floppy\n"), 0o600); err != nil {
+			t.Fatal(err)
+		}
+
+		path, problems, _, err := validateConfigFile()
+		if err != nil {
+			t.Fatalf("validateConfigFile: %v", err)
+		}
+		if !strings.Contains(path, filepath.Join(appconfig.ProfilesDir, "work")) {
+			t.Errorf("path %q is not the work profile's", path)
+		}
+		if _, ok := problemFor(problems, "keyring.backend"); !ok {
+			t.Errorf("want keyring.backend problem, got %+v", problems)
+		}
+	})
+}
@@ -0,0 +1,45 @@
+package contacts
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"google.golang.org/api/people/v1"
+
+	"github.com/open-cli-collective/google-readonly/internal/cache"
+)
+
+// contactGroupsTTL is how long the contact group list is cached. It is
+// short because the list carries member counts.
+const contactGroupsTTL = time.Hour
+
+// cachingClient serves the first page of ListContactGroups from the
+// response cache
+type cachingClient struct {
+	ContactsClient
+	cache *cache.Cache
+}
+
+// withResponseCache wraps client with the response cache. The cache is
+// disposable, so when it cannot be opened the client is used as is.
+func withResponseCache(client ContactsClient) ContactsClient {
+	c, err := cache.New()
+	if err != nil {
+		return client
+	}
+	return &cachingClient{ContactsClient: client, cache: c}
+}
+
+// ListContactGroups caches the first page per page size, since the page
+// size changes the response. Later pages go to the API: their tokens are
+// short-lived.
+func (c *cachingClient) ListContactGroups(ctx context.Context, pageToken string, pageSize int64) (*people.ListContactGroupsResponse, error) {
+	if pageToken != "" {
+		return c.ContactsClient.ListContactGroups(ctx, pageToken, pageSize)
+	}
+	key := fmt.Sprintf("contact-groups-%d", pageSize)
+	return cache.GetOrFetch(c.cache, key, contactGroupsTTL, func() (*people.ListContactGroupsResponse, error) {
+		return c.ContactsClient.ListContactGroups(ctx, pageToken, pageSize)
+	})
+}
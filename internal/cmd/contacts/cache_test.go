@@ -0,0 +1,49 @@
+package contacts
+
+import (
+	"context"
+	"testing"
+
+	"github.com/open-cli-collective/cli-common/statedirtest"
+	"google.golang.org/api/people/v1"
+
+	"github.com/open-cli-collective/google-readonly/internal/testutil"
+)
+
+func TestWithResponseCache_ListContactGroups(t *testing.T) {
+	statedirtest.Hermetic(t)
+
+	var calls []int64
+	mock := &MockContactsClient{
+		ListContactGroupsFunc: func(_ context.Context, _ string, pageSize int64) (*people.ListContactGroupsResponse, error) {
+			calls = append(calls, pageSize)
+			return &people.ListContactGroupsResponse{
+				ContactGroups: []*people.ContactGroup{{ResourceName: "contactGroups/1", Name: "Family", MemberCount: 4}},
+				NextPageToken: "next",
+			}, nil
+		},
+	}
+	client := withResponseCache(mock)
+	ctx := context.Background()
+
+	for range 2 {
+		resp, err := client.ListContactGroups(ctx, "", 30)
+		testutil.NoError(t, err)
+		testutil.Len(t, resp.ContactGroups, 1)
+		testutil.Equal(t, resp.ContactGroups[0].MemberCount, int64(4))
+		testutil.Equal(t, resp.NextPageToken, "next")
+	}
+	testutil.Len(t, calls, 1)
+
+	// Another page size is another request
+	_, err := client.ListContactGroups(ctx, "", 50)
+	testutil.NoError(t, err)
+	testutil.Len(t, calls, 2)
+
+	// Later pages are never cached
+	for range 2 {
+		_, err := client.ListContactGroups(ctx, "next", 30)
+		testutil.NoError(t, err)
+	}
+	testutil.Len(t, calls, 4)
+}
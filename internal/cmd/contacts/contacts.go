@@ -18,17 +18,22 @@ group membership management and starring.
 
 The short alias 'ppl' can be used instead of 'contacts':
   gro ppl list
+  gro ppl other
   gro ppl search "John"
   gro ppl get <resource-name>
   gro ppl groups
+  gro ppl dedupe
   gro ppl star <contact-id>
   gro ppl add-to-group "Friends" <contact-id>`,
 	}
 
 	cmd.AddCommand(newListCommand())
+	cmd.AddCommand(newOtherCommand())
 	cmd.AddCommand(newSearchCommand())
 	cmd.AddCommand(newGetCommand())
 	cmd.AddCommand(newGroupsCommand())
+	cmd.AddCommand(newDedupeCommand())
+	cmd.AddCommand(newExportCommand())
 	cmd.AddCommand(newAddToGroupCommand())
 	cmd.AddCommand(newRemoveFromGroupCommand())
 	cmd.AddCommand(newStarCommand())
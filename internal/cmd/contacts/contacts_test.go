@@ -38,6 +38,9 @@ func TestContactsCommand(t *testing.T) {
 		testutil.SliceContains(t, names, "search")
 		testutil.SliceContains(t, names, "get")
 		testutil.SliceContains(t, names, "groups")
+		testutil.SliceContains(t, names, "dedupe")
+		testutil.SliceContains(t, names, "other")
+		testutil.SliceContains(t, names, "export")
 		testutil.SliceContains(t, names, "add-to-group")
 		testutil.SliceContains(t, names, "remove-from-group")
 		testutil.SliceContains(t, names, "star")
@@ -78,13 +81,19 @@ func TestListCommand(t *testing.T) {
 		testutil.NotNil(t, flag)
 		testutil.Equal(t, flag.DefValue, "false")
 	})
+
+	t.Run("has group flag", func(t *testing.T) {
+		flag := cmd.Flags().Lookup("group")
+		testutil.NotNil(t, flag)
+		testutil.Equal(t, flag.Shorthand, "g")
+	})
 }
 
 func TestSearchCommand(t *testing.T) {
 	cmd := newSearchCommand()
 
 	t.Run("has correct use", func(t *testing.T) {
-		testutil.Equal(t, cmd.Use, "search <query>")
+		testutil.Equal(t, cmd.Use, "search [query]")
 	})
 
 	t.Run("requires exactly one argument", func(t *testing.T) {
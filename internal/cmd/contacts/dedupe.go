@@ -0,0 +1,64 @@
+package contacts
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/open-cli-collective/google-readonly/internal/contacts"
+)
+
+func newDedupeCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "dedupe",
+		Short: "Find likely duplicate contacts",
+		Long: `Find contacts that are probably the same person.
+
+Fetches every contact and groups those sharing an email address or phone
+number. Emails are compared case-insensitively and phone numbers ignore
+spaces and punctuation. Matches are transitive, so a cluster can join
+contacts through different shared values.
+
+This only reports clusters; nothing is merged or changed.
+
+Examples:
+  gro contacts dedupe
+  gro ppl dedupe`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			client, err := newContactsClient(cmd.Context())
+			if err != nil {
+				return fmt.Errorf("creating Contacts client: %w", err)
+			}
+
+			all, err := listAllContacts(cmd.Context(), client)
+			if err != nil {
+				return err
+			}
+
+			clusters := contacts.FindDuplicates(all)
+			if len(clusters) == 0 {
+				fmt.Printf("No likely duplicates among %d contact(s).\n", len(all))
+				return nil
+			}
+
+			fmt.Printf("Found %d cluster(s) of likely duplicates among %d contact(s):\n\n", len(clusters), len(all))
+			for _, c := range clusters {
+				printDuplicateCluster(c)
+			}
+			return nil
+		},
+	}
+
+	return cmd
+}
+
+// printDuplicateCluster prints the shared values and members of a cluster
+func printDuplicateCluster(cluster contacts.DuplicateCluster) {
+	fmt.Printf("Shared: %s\n", strings.Join(cluster.Shared, ", "))
+	for _, c := range cluster.Contacts {
+		fmt.Printf("  %s  %s\n", c.ResourceName, c.GetDisplayName())
+	}
+	fmt.Println("---")
+}
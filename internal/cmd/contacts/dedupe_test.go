@@ -0,0 +1,98 @@
+package contacts
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"google.golang.org/api/people/v1"
+
+	"github.com/open-cli-collective/google-readonly/internal/testutil"
+)
+
+func TestDedupeCommand_PagesAndReportsClusters(t *testing.T) {
+	var tokens []string
+	mock := &MockContactsClient{
+		ListContactsFunc: func(_ context.Context, pageToken string, pageSize int64) (*people.ListConnectionsResponse, error) {
+			tokens = append(tokens, pageToken)
+			testutil.Equal(t, pageSize, int64(connectionsPageSize))
+			if pageToken == "" {
+				other := testutil.SamplePerson("people/c2")
+				other.EmailAddresses = []*people.EmailAddress{{Value: "other@example.com"}}
+				other.PhoneNumbers = nil
+				return &people.ListConnectionsResponse{
+					Connections:   []*people.Person{testutil.SamplePerson("people/c1"), other},
+					NextPageToken: "page2",
+				}, nil
+			}
+			dup := testutil.SamplePerson("people/c3")
+			dup.EmailAddresses = []*people.EmailAddress{{Value: "JOHN@example.com"}}
+			dup.PhoneNumbers = []*people.PhoneNumber{{Value: "+1 (555) 123 4567"}}
+			return &people.ListConnectionsResponse{Connections: []*people.Person{dup}}, nil
+		},
+	}
+
+	cmd := newDedupeCommand()
+
+	withMockClient(mock, func() {
+		output := testutil.CaptureStdout(t, func() {
+			err := cmd.Execute()
+			testutil.NoError(t, err)
+		})
+
+		testutil.Equal(t, len(tokens), 2)
+		testutil.Equal(t, tokens[1], "page2")
+		testutil.Contains(t, output, "1 cluster(s) of likely duplicates among 3 contact(s)")
+		testutil.Contains(t, output, "Shared: john@example.com, +15551234567")
+		testutil.Contains(t, output, "people/c1")
+		testutil.Contains(t, output, "people/c3")
+		testutil.NotContains(t, output, "people/c2")
+	})
+}
+
+func TestDedupeCommand_NoDuplicates(t *testing.T) {
+	mock := &MockContactsClient{
+		ListContactsFunc: func(_ context.Context, _ string, _ int64) (*people.ListConnectionsResponse, error) {
+			return &people.ListConnectionsResponse{
+				Connections: []*people.Person{testutil.SamplePerson("people/c1")},
+			}, nil
+		},
+	}
+
+	cmd := newDedupeCommand()
+
+	withMockClient(mock, func() {
+		output := testutil.CaptureStdout(t, func() {
+			err := cmd.Execute()
+			testutil.NoError(t, err)
+		})
+
+		testutil.Contains(t, output, "No likely duplicates among 1 contact(s)")
+	})
+}
+
+func TestDedupeCommand_APIError(t *testing.T) {
+	mock := &MockContactsClient{
+		ListContactsFunc: func(_ context.Context, _ string, _ int64) (*people.ListConnectionsResponse, error) {
+			return nil, errors.New("API error")
+		},
+	}
+
+	cmd := newDedupeCommand()
+
+	withMockClient(mock, func() {
+		err := cmd.Execute()
+		testutil.Error(t, err)
+		testutil.Contains(t, err.Error(), "listing contacts")
+	})
+}
+
+func TestDedupeCommand_ClientCreationError(t *testing.T) {
+	cmd := newDedupeCommand()
+
+	withFailingClientFactory(func() {
+		err := cmd.Execute()
+		testutil.Error(t, err)
+		testutil.Contains(t, err.Error(), "creating Contacts client")
+	})
+}
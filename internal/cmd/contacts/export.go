@@ -0,0 +1,79 @@
+package contacts
+
+import (
+	"encoding/csv"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/open-cli-collective/google-readonly/internal/contacts"
+)
+
+func newExportCommand() *cobra.Command {
+	var (
+		csvOutput  bool
+		maxColumns int
+	)
+
+	cmd := &cobra.Command{
+		Use:   "export",
+		Short: "Export all contacts",
+		Long: `Export every contact to stdout for re-importing elsewhere.
+
+--csv writes the column layout of a Google Contacts CSV export (Name,
+Given Name, Family Name, E-mail 1 - Value, Phone 1 - Value,
+Organization 1 - Name, ...), which Google Contacts and Outlook can import.
+Emails, phones, addresses, organizations and websites each get
+--max-columns numbered columns; values beyond that are left out with a
+warning on stderr.
+
+Examples:
+  gro contacts export --csv > contacts.csv
+  gro contacts export --csv --max-columns 5 > contacts.csv`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			if !csvOutput {
+				return fmt.Errorf("choose an export format: --csv")
+			}
+			if maxColumns < 1 {
+				return fmt.Errorf("--max-columns must be at least 1")
+			}
+
+			client, err := newContactsClient(cmd.Context())
+			if err != nil {
+				return fmt.Errorf("creating Contacts client: %w", err)
+			}
+
+			all, err := listAllContacts(cmd.Context(), client)
+			if err != nil {
+				return err
+			}
+
+			w := csv.NewWriter(os.Stdout)
+			if err := w.Write(contacts.CSVHeader(maxColumns)); err != nil {
+				return fmt.Errorf("writing CSV: %w", err)
+			}
+			for _, c := range all {
+				if dropped := contacts.CSVOverflow(c, maxColumns); len(dropped) > 0 {
+					fmt.Fprintf(os.Stderr, "Warning: %s (%s): left out %s beyond --max-columns %d\n",
+						c.GetDisplayName(), c.ResourceName, strings.Join(dropped, ", "), maxColumns)
+				}
+				if err := w.Write(contacts.CSVRecord(c, maxColumns)); err != nil {
+					return fmt.Errorf("writing CSV: %w", err)
+				}
+			}
+			w.Flush()
+			if err := w.Error(); err != nil {
+				return fmt.Errorf("writing CSV: %w", err)
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().BoolVar(&csvOutput, "csv", false, "Write contacts as Google Contacts compatible CSV")
+	cmd.Flags().IntVar(&maxColumns, "max-columns", 3, "Numbered columns per repeated field (emails, phones, ...)")
+
+	return cmd
+}
@@ -0,0 +1,105 @@
+package contacts
+
+import (
+	"context"
+	"encoding/csv"
+	"errors"
+	"strings"
+	"testing"
+
+	"google.golang.org/api/people/v1"
+
+	"github.com/open-cli-collective/google-readonly/internal/testutil"
+)
+
+func TestExportCommand_CSV(t *testing.T) {
+	mock := &MockContactsClient{
+		ListContactsFunc: func(_ context.Context, pageToken string, _ int64) (*people.ListConnectionsResponse, error) {
+			if pageToken == "" {
+				return &people.ListConnectionsResponse{
+					Connections:   []*people.Person{testutil.SamplePerson("people/c1")},
+					NextPageToken: "page2",
+				}, nil
+			}
+			p := testutil.SamplePerson("people/c2")
+			p.EmailAddresses = []*people.EmailAddress{
+				{Value: "a@example.com"}, {Value: "b@example.com"}, {Value: "c@example.com"},
+			}
+			return &people.ListConnectionsResponse{Connections: []*people.Person{p}}, nil
+		},
+	}
+
+	cmd := newExportCommand()
+	cmd.SetArgs([]string{"--csv", "--max-columns", "2"})
+
+	withMockClient(mock, func() {
+		output := testutil.CaptureStdout(t, func() {
+			err := cmd.Execute()
+			testutil.NoError(t, err)
+		})
+
+		rows, err := csv.NewReader(strings.NewReader(output)).ReadAll()
+		testutil.NoError(t, err)
+		testutil.Len(t, rows, 3)
+
+		header := rows[0]
+		testutil.Equal(t, header[0], "Name")
+		testutil.SliceContains(t, header, "E-mail 2 - Value")
+		testutil.False(t, strings.Contains(strings.Join(header, ","), "E-mail 3"))
+
+		col := func(name string) int {
+			for i, h := range header {
+				if h == name {
+					return i
+				}
+			}
+			t.Fatalf("missing column %q", name)
+			return -1
+		}
+		testutil.Equal(t, rows[1][col("Name")], "John Doe")
+		testutil.Equal(t, rows[1][col("E-mail 1 - Value")], "john@example.com")
+		testutil.Equal(t, rows[1][col("Phone 1 - Value")], "+1-555-123-4567")
+		testutil.Equal(t, rows[1][col("Organization 1 - Name")], "Acme Corp")
+		testutil.Equal(t, rows[2][col("E-mail 2 - Value")], "b@example.com")
+	})
+}
+
+func TestExportCommand_Validation(t *testing.T) {
+	tests := []struct {
+		name    string
+		args    []string
+		wantErr string
+	}{
+		{name: "no format", args: nil, wantErr: "choose an export format: --csv"},
+		{name: "zero columns", args: []string{"--csv", "--max-columns", "0"}, wantErr: "--max-columns must be at least 1"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cmd := newExportCommand()
+			cmd.SetArgs(tt.args)
+			withMockClient(&MockContactsClient{}, func() {
+				err := cmd.Execute()
+				testutil.Error(t, err)
+				testutil.Contains(t, err.Error(), tt.wantErr)
+			})
+		})
+	}
+}
+
+func TestExportCommand_APIError(t *testing.T) {
+	mock := &MockContactsClient{
+		ListContactsFunc: func(_ context.Context, _ string, _ int64) (*people.ListConnectionsResponse, error) {
+			return nil, errors.New("API error")
+		},
+	}
+
+	cmd := newExportCommand()
+	cmd.SetArgs([]string{"--csv"})
+
+	withMockClient(mock, func() {
+		err := cmd.Execute()
+		testutil.Error(t, err)
+		testutil.Contains(t, err.Error(), "listing contacts")
+	})
+}
@@ -3,6 +3,7 @@ package contacts
 import (
 	"context"
 	"errors"
+	"fmt"
 	"testing"
 
 	"google.golang.org/api/people/v1"
@@ -328,3 +329,213 @@ func TestSearchCommand_IDsOutput(t *testing.T) {
 		testutil.NotContains(t, output, "John Doe")
 	})
 }
+
+// personInGroups returns a sample person that belongs to the given groups
+func personInGroups(resourceName string, groups ...string) *people.Person {
+	p := testutil.SamplePerson(resourceName)
+	for _, g := range groups {
+		p.Memberships = append(p.Memberships, &people.Membership{
+			ContactGroupMembership: &people.ContactGroupMembership{ContactGroupResourceName: g},
+		})
+	}
+	return p
+}
+
+func TestListCommand_Group(t *testing.T) {
+	var tokens []string
+	mock := &MockContactsClient{
+		ResolveGroupNameFunc: func(_ context.Context, name string) (string, error) {
+			testutil.Equal(t, name, "Friends")
+			return "contactGroups/friends", nil
+		},
+		ListContactsFunc: func(_ context.Context, pageToken string, _ int64) (*people.ListConnectionsResponse, error) {
+			tokens = append(tokens, pageToken)
+			if pageToken == "" {
+				return &people.ListConnectionsResponse{
+					Connections: []*people.Person{
+						personInGroups("people/c1", "contactGroups/myContacts", "contactGroups/friends"),
+						personInGroups("people/c2", "contactGroups/myContacts"),
+					},
+					NextPageToken: "page2",
+				}, nil
+			}
+			return &people.ListConnectionsResponse{
+				Connections: []*people.Person{personInGroups("people/c3", "contactGroups/friends")},
+			}, nil
+		},
+	}
+
+	cmd := newListCommand()
+	cmd.SetArgs([]string{"--group", "Friends", "--ids"})
+
+	withMockClient(mock, func() {
+		output := testutil.CaptureStdout(t, func() {
+			err := cmd.Execute()
+			testutil.NoError(t, err)
+		})
+
+		testutil.Equal(t, output, "people/c1\npeople/c3\n")
+		testutil.Len(t, tokens, 2)
+	})
+}
+
+func TestListCommand_GroupStopsAtMax(t *testing.T) {
+	calls := 0
+	mock := &MockContactsClient{
+		ResolveGroupNameFunc: func(_ context.Context, _ string) (string, error) {
+			return "contactGroups/friends", nil
+		},
+		ListContactsFunc: func(_ context.Context, _ string, _ int64) (*people.ListConnectionsResponse, error) {
+			calls++
+			return &people.ListConnectionsResponse{
+				Connections: []*people.Person{
+					personInGroups("people/c1", "contactGroups/friends"),
+					personInGroups("people/c2", "contactGroups/friends"),
+				},
+				NextPageToken: "more",
+			}, nil
+		},
+	}
+
+	cmd := newListCommand()
+	cmd.SetArgs([]string{"--group", "Friends", "--max", "1"})
+
+	withMockClient(mock, func() {
+		output := testutil.CaptureStdout(t, func() {
+			err := cmd.Execute()
+			testutil.NoError(t, err)
+		})
+
+		testutil.Contains(t, output, "Found 1 contact(s)")
+		testutil.NotContains(t, output, "people/c2")
+		testutil.Equal(t, calls, 1)
+	})
+}
+
+func TestListCommand_GroupNotFound(t *testing.T) {
+	mock := &MockContactsClient{
+		ResolveGroupNameFunc: func(_ context.Context, name string) (string, error) {
+			return "", fmt.Errorf("group not found: %s", name)
+		},
+	}
+
+	cmd := newListCommand()
+	cmd.SetArgs([]string{"--group", "Nope"})
+
+	withMockClient(mock, func() {
+		err := cmd.Execute()
+		testutil.Error(t, err)
+		testutil.Contains(t, err.Error(), "resolving group: group not found: Nope")
+	})
+}
+
+// personWith returns a sample person with the given email and phone
+func personWith(resourceName, email, phone string) *people.Person {
+	p := testutil.SamplePerson(resourceName)
+	p.EmailAddresses = []*people.EmailAddress{{Value: email}}
+	p.PhoneNumbers = []*people.PhoneNumber{{Value: phone}}
+	return p
+}
+
+func TestSearchCommand_Email(t *testing.T) {
+	mock := &MockContactsClient{
+		SearchContactsFunc: func(_ context.Context, query string, _ int64) (*people.SearchResponse, error) {
+			testutil.Equal(t, query, "john@example.com")
+			return &people.SearchResponse{
+				Results: []*people.SearchResult{
+					{Person: personWith("people/c1", "john@example.com.au", "555-0001")},
+					{Person: personWith("people/c2", "John@Example.com", "555-0002")},
+					{Person: personWith("people/c3", "big.john@example.com", "555-0003")},
+				},
+			}, nil
+		},
+	}
+
+	cmd := newSearchCommand()
+	cmd.SetArgs([]string{"--email", "JOHN@example.com", "--ids"})
+
+	withMockClient(mock, func() {
+		output := testutil.CaptureStdout(t, func() {
+			err := cmd.Execute()
+			testutil.NoError(t, err)
+		})
+
+		testutil.Equal(t, output, "people/c2\n")
+	})
+}
+
+func TestSearchCommand_Phone(t *testing.T) {
+	mock := &MockContactsClient{
+		SearchContactsFunc: func(_ context.Context, query string, _ int64) (*people.SearchResponse, error) {
+			testutil.Equal(t, query, "+15551234567")
+			return &people.SearchResponse{
+				Results: []*people.SearchResult{
+					{Person: personWith("people/c1", "a@example.com", "+1 555 123 4567 ext 9")},
+					{Person: personWith("people/c2", "b@example.com", "+1-555-123-4567")},
+				},
+			}, nil
+		},
+	}
+
+	cmd := newSearchCommand()
+	cmd.SetArgs([]string{"--phone", "+1 (555) 123-4567"})
+
+	withMockClient(mock, func() {
+		output := testutil.CaptureStdout(t, func() {
+			err := cmd.Execute()
+			testutil.NoError(t, err)
+		})
+
+		testutil.Contains(t, output, "Found 1 contact(s)")
+		testutil.Contains(t, output, "people/c2")
+		testutil.NotContains(t, output, "people/c1")
+	})
+}
+
+func TestSearchCommand_ExactNoMatch(t *testing.T) {
+	mock := &MockContactsClient{
+		SearchContactsFunc: func(_ context.Context, _ string, _ int64) (*people.SearchResponse, error) {
+			return &people.SearchResponse{
+				Results: []*people.SearchResult{
+					{Person: personWith("people/c1", "johnny@example.com", "555-0001")},
+				},
+			}, nil
+		},
+	}
+
+	cmd := newSearchCommand()
+	cmd.SetArgs([]string{"--email", "john@example.com"})
+
+	withMockClient(mock, func() {
+		output := testutil.CaptureStdout(t, func() {
+			err := cmd.Execute()
+			testutil.NoError(t, err)
+		})
+
+		testutil.Contains(t, output, `No contacts found matching "john@example.com"`)
+	})
+}
+
+func TestSearchCommand_ExactFlagValidation(t *testing.T) {
+	tests := []struct {
+		name    string
+		args    []string
+		wantErr string
+	}{
+		{name: "query with --email", args: []string{"John", "--email", "j@example.com"}, wantErr: "unknown command"},
+		{name: "--email with --phone", args: []string{"--email", "j@example.com", "--phone", "555"}, wantErr: "none of the others can be"},
+		{name: "phone without digits", args: []string{"--phone", "n/a"}, wantErr: "contains no digits"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cmd := newSearchCommand()
+			cmd.SetArgs(tt.args)
+			withMockClient(&MockContactsClient{}, func() {
+				err := cmd.Execute()
+				testutil.Error(t, err)
+				testutil.Contains(t, err.Error(), tt.wantErr)
+			})
+		})
+	}
+}
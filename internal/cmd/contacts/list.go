@@ -1,17 +1,25 @@
 package contacts
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/spf13/cobra"
+	"google.golang.org/api/people/v1"
 
 	"github.com/open-cli-collective/google-readonly/internal/contacts"
+	"github.com/open-cli-collective/google-readonly/internal/progress"
 )
 
+// connectionsPageSize is the largest page the People API returns for
+// connections, used when every contact has to be scanned
+const connectionsPageSize = 1000
+
 func newListCommand() *cobra.Command {
 	var (
 		maxResults int64
 		idsOutput  bool
+		group      string
 	)
 
 	cmd := &cobra.Command{
@@ -19,11 +27,14 @@ func newListCommand() *cobra.Command {
 		Short: "List all contacts",
 		Long: `List all contacts from your Google Contacts.
 
-Contacts are sorted by last name.
+Contacts are sorted by last name. --group limits the list to members of a
+contact group, matched by name (case-insensitively if there is no exact
+match).
 
 Examples:
   gro contacts list
   gro contacts list --max 50
+  gro contacts list --group Friends
   gro ppl list --ids | gro contacts star --stdin`,
 		Args: cobra.NoArgs,
 		RunE: func(cmd *cobra.Command, _ []string) error {
@@ -32,12 +43,21 @@ Examples:
 				return fmt.Errorf("creating Contacts client: %w", err)
 			}
 
-			resp, err := client.ListContacts(cmd.Context(), "", maxResults)
-			if err != nil {
-				return fmt.Errorf("listing contacts: %w", err)
+			var connections []*people.Person
+			if group != "" {
+				connections, err = listGroupMembers(cmd.Context(), client, group, maxResults)
+				if err != nil {
+					return err
+				}
+			} else {
+				resp, err := client.ListContacts(cmd.Context(), "", maxResults)
+				if err != nil {
+					return fmt.Errorf("listing contacts: %w", err)
+				}
+				connections = resp.Connections
 			}
 
-			if len(resp.Connections) == 0 {
+			if len(connections) == 0 {
 				if !idsOutput {
 					fmt.Println("No contacts found.")
 				}
@@ -45,21 +65,19 @@ Examples:
 			}
 
 			if idsOutput {
-				for _, p := range resp.Connections {
+				for _, p := range connections {
 					fmt.Println(p.ResourceName)
 				}
 				return nil
 			}
 
-			parsedContacts := make([]*contacts.Contact, len(resp.Connections))
-			for i, p := range resp.Connections {
+			parsedContacts := make([]*contacts.Contact, len(connections))
+			for i, p := range connections {
 				parsedContacts[i] = contacts.ParseContact(p)
 			}
 
-			fmt.Printf("Found %d contact(s):\n\n", len(resp.Connections))
-			for _, contact := range parsedContacts {
-				printContactSummary(contact)
-			}
+			fmt.Printf("Found %d contact(s):\n\n", len(connections))
+			printContactTable(parsedContacts)
 
 			return nil
 		},
@@ -67,6 +85,64 @@ Examples:
 
 	cmd.Flags().Int64VarP(&maxResults, "max", "m", 10, "Maximum number of contacts to return")
 	cmd.Flags().BoolVar(&idsOutput, "ids", false, "Output only resource names, one per line")
+	cmd.Flags().StringVarP(&group, "group", "g", "", "Only list members of this contact group")
 
 	return cmd
 }
+
+// listGroupMembers returns up to maxResults contacts that belong to the
+// named group. Connections are paged through and filtered on their
+// memberships, so the result keeps the last-name order of list.
+func listGroupMembers(ctx context.Context, client ContactsClient, group string, maxResults int64) ([]*people.Person, error) {
+	groupResourceName, err := client.ResolveGroupName(ctx, group)
+	if err != nil {
+		return nil, fmt.Errorf("resolving group: %w", err)
+	}
+
+	var members []*people.Person
+	pageToken := ""
+	tally := progress.Count("contacts")
+	defer tally.Done()
+	for {
+		resp, err := client.ListContacts(ctx, pageToken, connectionsPageSize)
+		if err != nil {
+			return nil, fmt.Errorf("listing contacts: %w", err)
+		}
+		tally.Add(len(resp.Connections))
+		for _, p := range resp.Connections {
+			if !contacts.ParseContact(p).InGroup(groupResourceName) {
+				continue
+			}
+			members = append(members, p)
+			if int64(len(members)) >= maxResults {
+				return members, nil
+			}
+		}
+		if resp.NextPageToken == "" {
+			return members, nil
+		}
+		pageToken = resp.NextPageToken
+	}
+}
+
+// listAllContacts pages through every connection of the account
+func listAllContacts(ctx context.Context, client ContactsClient) ([]*contacts.Contact, error) {
+	var all []*contacts.Contact
+	pageToken := ""
+	tally := progress.Count("contacts")
+	defer tally.Done()
+	for {
+		resp, err := client.ListContacts(ctx, pageToken, connectionsPageSize)
+		if err != nil {
+			return nil, fmt.Errorf("listing contacts: %w", err)
+		}
+		for _, p := range resp.Connections {
+			all = append(all, contacts.ParseContact(p))
+		}
+		tally.Add(len(resp.Connections))
+		if resp.NextPageToken == "" {
+			return all, nil
+		}
+		pageToken = resp.NextPageToken
+	}
+}
@@ -9,6 +9,7 @@ import (
 // MockContactsClient is a configurable mock for ContactsClient.
 type MockContactsClient struct {
 	ListContactsFunc      func(ctx context.Context, pageToken string, pageSize int64) (*people.ListConnectionsResponse, error)
+	ListOtherContactsFunc func(ctx context.Context, pageToken string, pageSize int64) (*people.ListOtherContactsResponse, error)
 	SearchContactsFunc    func(ctx context.Context, query string, pageSize int64) (*people.SearchResponse, error)
 	GetContactFunc        func(ctx context.Context, resourceName string) (*people.Person, error)
 	ListContactGroupsFunc func(ctx context.Context, pageToken string, pageSize int64) (*people.ListContactGroupsResponse, error)
@@ -28,6 +29,13 @@ func (m *MockContactsClient) ListContacts(ctx context.Context, pageToken string,
 	return nil, nil
 }
 
+func (m *MockContactsClient) ListOtherContacts(ctx context.Context, pageToken string, pageSize int64) (*people.ListOtherContactsResponse, error) {
+	if m.ListOtherContactsFunc != nil {
+		return m.ListOtherContactsFunc(ctx, pageToken, pageSize)
+	}
+	return nil, nil
+}
+
 func (m *MockContactsClient) SearchContacts(ctx context.Context, query string, pageSize int64) (*people.SearchResponse, error) {
 	if m.SearchContactsFunc != nil {
 		return m.SearchContactsFunc(ctx, query, pageSize)
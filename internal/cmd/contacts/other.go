@@ -0,0 +1,100 @@
+package contacts
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"google.golang.org/api/people/v1"
+
+	"github.com/open-cli-collective/google-readonly/internal/contacts"
+	"github.com/open-cli-collective/google-readonly/internal/progress"
+)
+
+func newOtherCommand() *cobra.Command {
+	var (
+		maxResults int64
+		idsOutput  bool
+	)
+
+	cmd := &cobra.Command{
+		Use:   "other",
+		Short: "List auto-collected Other Contacts",
+		Long: `List your "Other Contacts": people Google saved automatically, for
+example from mail you exchanged, who are not in your contacts list.
+
+Only names and email addresses are available for these. Pages are fetched
+until --max contacts have been listed.
+
+Examples:
+  gro contacts other
+  gro contacts other --max 200
+  gro ppl other --ids`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			client, err := newContactsClient(cmd.Context())
+			if err != nil {
+				return fmt.Errorf("creating Contacts client: %w", err)
+			}
+
+			others, err := listOtherContacts(cmd.Context(), client, maxResults)
+			if err != nil {
+				return err
+			}
+
+			if len(others) == 0 {
+				if !idsOutput {
+					fmt.Println("No other contacts found.")
+				}
+				return nil
+			}
+
+			if idsOutput {
+				for _, p := range others {
+					fmt.Println(p.ResourceName)
+				}
+				return nil
+			}
+
+			fmt.Printf("Found %d other contact(s):\n\n", len(others))
+			parsed := make([]*contacts.Contact, len(others))
+			for i, p := range others {
+				parsed[i] = contacts.ParseContact(p)
+			}
+			printContactTable(parsed)
+
+			return nil
+		},
+	}
+
+	cmd.Flags().Int64VarP(&maxResults, "max", "m", 10, "Maximum number of contacts to return")
+	cmd.Flags().BoolVar(&idsOutput, "ids", false, "Output only resource names, one per line")
+
+	return cmd
+}
+
+// listOtherContacts pages through Other Contacts until maxResults have been
+// collected or there are no more pages
+func listOtherContacts(ctx context.Context, client ContactsClient, maxResults int64) ([]*people.Person, error) {
+	var others []*people.Person
+	pageToken := ""
+	tally := progress.Count("other contacts")
+	defer tally.Done()
+	for int64(len(others)) < maxResults {
+		pageSize := min(maxResults-int64(len(others)), connectionsPageSize)
+		resp, err := client.ListOtherContacts(ctx, pageToken, pageSize)
+		if err != nil {
+			return nil, fmt.Errorf("listing other contacts: %w", err)
+		}
+		others = append(others, resp.OtherContacts...)
+		tally.Add(len(resp.OtherContacts))
+		if resp.NextPageToken == "" {
+			break
+		}
+		pageToken = resp.NextPageToken
+	}
+	if int64(len(others)) > maxResults {
+		others = others[:maxResults]
+	}
+	return others, nil
+}
@@ -0,0 +1,120 @@
+package contacts
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"google.golang.org/api/people/v1"
+
+	"github.com/open-cli-collective/google-readonly/internal/testutil"
+)
+
+func otherContact(resourceName, name, email string) *people.Person {
+	return &people.Person{
+		ResourceName:   resourceName,
+		Names:          []*people.Name{{DisplayName: name}},
+		EmailAddresses: []*people.EmailAddress{{Value: email}},
+	}
+}
+
+func TestOtherCommand_Success(t *testing.T) {
+	mock := &MockContactsClient{
+		ListOtherContactsFunc: func(_ context.Context, _ string, pageSize int64) (*people.ListOtherContactsResponse, error) {
+			testutil.Equal(t, pageSize, int64(10))
+			return &people.ListOtherContactsResponse{
+				OtherContacts: []*people.Person{
+					otherContact("otherContacts/c1", "Pat Lee", "pat@example.com"),
+				},
+			}, nil
+		},
+	}
+
+	cmd := newOtherCommand()
+
+	withMockClient(mock, func() {
+		output := testutil.CaptureStdout(t, func() {
+			err := cmd.Execute()
+			testutil.NoError(t, err)
+		})
+
+		testutil.Contains(t, output, "Found 1 other contact(s)")
+		testutil.Contains(t, output, "otherContacts/c1")
+		testutil.Contains(t, output, "Pat Lee")
+		testutil.Contains(t, output, "pat@example.com")
+	})
+}
+
+func TestOtherCommand_PagesUntilMax(t *testing.T) {
+	var sizes []int64
+	mock := &MockContactsClient{
+		ListOtherContactsFunc: func(_ context.Context, pageToken string, pageSize int64) (*people.ListOtherContactsResponse, error) {
+			sizes = append(sizes, pageSize)
+			if pageToken == "" {
+				return &people.ListOtherContactsResponse{
+					OtherContacts: []*people.Person{
+						otherContact("otherContacts/c1", "A", "a@example.com"),
+						otherContact("otherContacts/c2", "B", "b@example.com"),
+					},
+					NextPageToken: "page2",
+				}, nil
+			}
+			return &people.ListOtherContactsResponse{
+				OtherContacts: []*people.Person{
+					otherContact("otherContacts/c3", "C", "c@example.com"),
+				},
+				NextPageToken: "page3",
+			}, nil
+		},
+	}
+
+	cmd := newOtherCommand()
+	cmd.SetArgs([]string{"--max", "3", "--ids"})
+
+	withMockClient(mock, func() {
+		output := testutil.CaptureStdout(t, func() {
+			err := cmd.Execute()
+			testutil.NoError(t, err)
+		})
+
+		testutil.Equal(t, output, "otherContacts/c1\notherContacts/c2\notherContacts/c3\n")
+		testutil.Len(t, sizes, 2)
+		testutil.Equal(t, sizes[0], int64(3))
+		testutil.Equal(t, sizes[1], int64(1))
+	})
+}
+
+func TestOtherCommand_Empty(t *testing.T) {
+	mock := &MockContactsClient{
+		ListOtherContactsFunc: func(_ context.Context, _ string, _ int64) (*people.ListOtherContactsResponse, error) {
+			return &people.ListOtherContactsResponse{}, nil
+		},
+	}
+
+	cmd := newOtherCommand()
+
+	withMockClient(mock, func() {
+		output := testutil.CaptureStdout(t, func() {
+			err := cmd.Execute()
+			testutil.NoError(t, err)
+		})
+
+		testutil.Contains(t, output, "No other contacts found")
+	})
+}
+
+func TestOtherCommand_APIError(t *testing.T) {
+	mock := &MockContactsClient{
+		ListOtherContactsFunc: func(_ context.Context, _ string, _ int64) (*people.ListOtherContactsResponse, error) {
+			return nil, errors.New("API error")
+		},
+	}
+
+	cmd := newOtherCommand()
+
+	withMockClient(mock, func() {
+		err := cmd.Execute()
+		testutil.Error(t, err)
+		testutil.Contains(t, err.Error(), "listing other contacts")
+	})
+}
@@ -6,12 +6,15 @@ import (
 
 	"google.golang.org/api/people/v1"
 
+	"github.com/open-cli-collective/google-readonly/internal/auth"
 	"github.com/open-cli-collective/google-readonly/internal/contacts"
+	"github.com/open-cli-collective/google-readonly/internal/table"
 )
 
 // ContactsClient defines the interface for Contacts client operations used by contacts commands.
 type ContactsClient interface {
 	ListContacts(ctx context.Context, pageToken string, pageSize int64) (*people.ListConnectionsResponse, error)
+	ListOtherContacts(ctx context.Context, pageToken string, pageSize int64) (*people.ListOtherContactsResponse, error)
 	SearchContacts(ctx context.Context, query string, pageSize int64) (*people.SearchResponse, error)
 	GetContact(ctx context.Context, resourceName string) (*people.Person, error)
 	ListContactGroups(ctx context.Context, pageToken string, pageSize int64) (*people.ListContactGroupsResponse, error)
@@ -22,9 +25,17 @@ type ContactsClient interface {
 }
 
 // ClientFactory is the function used to create Contacts clients.
-// Override in tests to inject mocks.
+// Override in tests to inject mocks. It fails before any API call when the
+// token was never granted a Contacts scope.
 var ClientFactory = func(ctx context.Context) (ContactsClient, error) {
-	return contacts.NewClient(ctx)
+	if err := auth.RequireScope("contacts"); err != nil {
+		return nil, err
+	}
+	client, err := contacts.NewClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return withResponseCache(client), nil
 }
 
 // newContactsClient creates a new contacts client
@@ -135,24 +146,29 @@ func printContact(contact *contacts.Contact, showDetails bool) {
 	}
 }
 
-// printContactSummary prints a brief contact summary for list views
-func printContactSummary(contact *contacts.Contact) {
-	fmt.Printf("ID: %s\n", contact.ResourceName)
-	fmt.Printf("Name: %s\n", contact.GetDisplayName())
-
-	if email := contact.GetPrimaryEmail(); email != "" {
-		fmt.Printf("Email: %s\n", email)
-	}
-
-	if phone := contact.GetPrimaryPhone(); phone != "" {
-		fmt.Printf("Phone: %s\n", phone)
+// printContactTable prints contacts as a table for list views, one row per
+// contact with its primary email and phone
+func printContactTable(list []*contacts.Contact) {
+	tbl := table.New(
+		table.Column{Header: "ID"},
+		table.Column{Header: "NAME", MaxWidth: 40, Shrink: true},
+		table.Column{Header: "EMAIL", Shrink: true},
+		table.Column{Header: "PHONE"},
+		table.Column{Header: "ORGANIZATION", MaxWidth: 30, Shrink: true},
+	)
+	for _, c := range list {
+		tbl.AddRow(c.ResourceName, orDash(c.GetDisplayName()), orDash(c.GetPrimaryEmail()),
+			orDash(c.GetPrimaryPhone()), orDash(c.GetOrganization()))
 	}
+	tbl.Print()
+}
 
-	if org := contact.GetOrganization(); org != "" {
-		fmt.Printf("Organization: %s\n", org)
+// orDash returns s, or "-" for an empty cell
+func orDash(s string) string {
+	if s == "" {
+		return "-"
 	}
-
-	fmt.Println("---")
+	return s
 }
 
 // printContactGroup prints a contact group
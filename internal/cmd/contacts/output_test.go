@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"io"
 	"os"
+	"strings"
 	"testing"
 
 	"github.com/open-cli-collective/google-readonly/internal/contacts"
@@ -189,65 +190,28 @@ func TestPrintContact(t *testing.T) {
 	}
 }
 
-func TestPrintContactSummary(t *testing.T) {
-	tests := []struct {
-		name         string
-		contact      *contacts.Contact
-		wantContains []string
-	}{
-		{
-			name: "basic summary",
-			contact: &contacts.Contact{
+func TestPrintContactTable(t *testing.T) {
+	output := testutil.CaptureStdout(t, func() {
+		printContactTable([]*contacts.Contact{
+			{
 				ResourceName: "people/c123",
 				DisplayName:  "John Doe",
 				Emails:       []contacts.Email{{Value: "john@example.com"}},
 				Phones:       []contacts.Phone{{Value: "+1-555-1234"}},
 			},
-			wantContains: []string{
-				"ID: people/c123",
-				"Name: John Doe",
-				"Email: john@example.com",
-				"Phone: +1-555-1234",
-				"---",
-			},
-		},
-		{
-			name: "summary with organization",
-			contact: &contacts.Contact{
-				ResourceName: "people/c456",
-				DisplayName:  "Jane Smith",
-				Organizations: []contacts.Organization{
-					{Name: "Tech Corp"},
-				},
-			},
-			wantContains: []string{
-				"Organization: Tech Corp",
+			{
+				ResourceName:  "people/c456",
+				DisplayName:   "Jane Smith",
+				Organizations: []contacts.Organization{{Name: "Tech Corp"}},
 			},
-		},
-	}
-
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			// Capture stdout
-			oldStdout := os.Stdout
-			r, w, _ := os.Pipe()
-			os.Stdout = w
-
-			printContactSummary(tt.contact)
-
-			w.Close()
-			os.Stdout = oldStdout
-
-			var buf bytes.Buffer
-			io.Copy(&buf, r)
-
-			output := buf.String()
-
-			for _, want := range tt.wantContains {
-				testutil.Contains(t, output, want)
-			}
 		})
-	}
+	})
+
+	lines := strings.Split(strings.TrimSpace(output), "\n")
+	testutil.Len(t, lines, 3)
+	testutil.Equal(t, lines[0], "ID           NAME        EMAIL             PHONE        ORGANIZATION")
+	testutil.Equal(t, lines[1], "people/c123  John Doe    john@example.com  +1-555-1234  -")
+	testutil.Equal(t, lines[2], "people/c456  Jane Smith  -                 -            Tech Corp")
 }
 
 func TestPrintContactGroup(t *testing.T) {
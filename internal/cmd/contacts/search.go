@@ -12,10 +12,12 @@ func newSearchCommand() *cobra.Command {
 	var (
 		maxResults int64
 		idsOutput  bool
+		email      string
+		phone      string
 	)
 
 	cmd := &cobra.Command{
-		Use:   "search <query>",
+		Use:   "search [query]",
 		Short: "Search contacts",
 		Long: `Search contacts by name, email, phone number, or organization.
 
@@ -26,14 +28,39 @@ The query is matched against multiple fields:
 - Phone numbers
 - Organization name
 
+--email and --phone replace the query with an exact lookup: the value is
+normalized (lowercased, or stripped to digits and a leading +), searched for,
+and only contacts holding exactly that email or phone number are kept.
+
 Examples:
   gro contacts search "John"
   gro contacts search "example.com"
   gro contacts search "+1-555" --max 20
+  gro contacts search --email John@Example.com
+  gro contacts search --phone "+1 (555) 123-4567"
   gro ppl search "John" --ids | gro contacts add-to-group "Friends" --stdin`,
-		Args: cobra.ExactArgs(1),
+		Args: func(cmd *cobra.Command, args []string) error {
+			if email != "" || phone != "" {
+				return cobra.NoArgs(cmd, args)
+			}
+			return cobra.ExactArgs(1)(cmd, args)
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			query := args[0]
+			var query string
+			var match func(*contacts.Contact) bool
+			switch {
+			case email != "":
+				query = contacts.NormalizeEmail(email)
+				match = func(c *contacts.Contact) bool { return c.HasEmail(query) }
+			case phone != "":
+				query = contacts.NormalizePhone(phone)
+				if query == "" {
+					return fmt.Errorf("--phone %q contains no digits", phone)
+				}
+				match = func(c *contacts.Contact) bool { return c.HasPhone(query) }
+			default:
+				query = args[0]
+			}
 
 			client, err := newContactsClient(cmd.Context())
 			if err != nil {
@@ -45,7 +72,18 @@ Examples:
 				return fmt.Errorf("searching contacts: %w", err)
 			}
 
-			if len(resp.Results) == 0 {
+			var found []*contacts.Contact
+			for _, r := range resp.Results {
+				if r.Person == nil {
+					continue
+				}
+				contact := contacts.ParseContact(r.Person)
+				if match == nil || match(contact) {
+					found = append(found, contact)
+				}
+			}
+
+			if len(found) == 0 {
 				if !idsOutput {
 					fmt.Printf("No contacts found matching \"%s\".\n", query)
 				}
@@ -53,23 +91,14 @@ Examples:
 			}
 
 			if idsOutput {
-				for _, r := range resp.Results {
-					if r.Person != nil {
-						fmt.Println(r.Person.ResourceName)
-					}
+				for _, contact := range found {
+					fmt.Println(contact.ResourceName)
 				}
 				return nil
 			}
 
-			parsedContacts := make([]*contacts.Contact, len(resp.Results))
-			for i, r := range resp.Results {
-				parsedContacts[i] = contacts.ParseContact(r.Person)
-			}
-
-			fmt.Printf("Found %d contact(s) matching \"%s\":\n\n", len(resp.Results), query)
-			for _, contact := range parsedContacts {
-				printContactSummary(contact)
-			}
+			fmt.Printf("Found %d contact(s) matching \"%s\":\n\n", len(found), query)
+			printContactTable(found)
 
 			return nil
 		},
@@ -77,6 +106,9 @@ Examples:
 
 	cmd.Flags().Int64VarP(&maxResults, "max", "m", 10, "Maximum number of results")
 	cmd.Flags().BoolVar(&idsOutput, "ids", false, "Output only resource names, one per line")
+	cmd.Flags().StringVar(&email, "email", "", "Find contacts with exactly this email address")
+	cmd.Flags().StringVar(&phone, "phone", "", "Find contacts with exactly this phone number")
+	cmd.MarkFlagsMutuallyExclusive("email", "phone")
 
 	return cmd
 }
@@ -0,0 +1,316 @@
+// Package doctorcmd implements `gro doctor`, which checks each piece gro
+// needs to work — the OAuth client JSON, the config directory, the token
+// store, the token, and every authorized API — and reports them in one place.
+package doctorcmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"runtime"
+	"slices"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/open-cli-collective/cli-common/credstore"
+
+	"github.com/open-cli-collective/google-readonly/internal/auth"
+	"github.com/open-cli-collective/google-readonly/internal/calendar"
+	"github.com/open-cli-collective/google-readonly/internal/config"
+	"github.com/open-cli-collective/google-readonly/internal/contacts"
+	"github.com/open-cli-collective/google-readonly/internal/drive"
+	"github.com/open-cli-collective/google-readonly/internal/gmail"
+	"github.com/open-cli-collective/google-readonly/internal/keychain"
+	"github.com/open-cli-collective/google-readonly/internal/version"
+)
+
+// Probe makes one lightweight read against an API and returns a short
+// description of what it saw, such as the signed-in address
+type Probe func(ctx context.Context) (string, error)
+
+// apiNames are the check names of each domain's connectivity probe
+var apiNames = map[string]string{
+	"mail":     "Gmail API",
+	"calendar": "Calendar API",
+	"contacts": "Contacts API",
+	"drive":    "Drive API",
+}
+
+// defaultProbes reach each API through the same client a real command uses,
+// so a probe fails exactly when that command would
+var defaultProbes = map[string]Probe{
+	"mail": func(ctx context.Context) (string, error) {
+		client, err := gmail.NewClient(ctx)
+		if err != nil {
+			return "", err
+		}
+		profile, err := client.GetProfile(ctx)
+		if err != nil {
+			return "", err
+		}
+		return "signed in as " + profile.EmailAddress, nil
+	},
+	"calendar": func(ctx context.Context) (string, error) {
+		client, err := calendar.NewClient(ctx)
+		if err != nil {
+			return "", err
+		}
+		cals, err := client.ListCalendars(ctx)
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("%d calendar(s)", len(cals)), nil
+	},
+	"contacts": func(ctx context.Context) (string, error) {
+		client, err := contacts.NewClient(ctx)
+		if err != nil {
+			return "", err
+		}
+		if _, err := client.ListContacts(ctx, "", 1); err != nil {
+			return "", err
+		}
+		return "reachable", nil
+	},
+	"drive": func(ctx context.Context) (string, error) {
+		client, err := drive.NewClient(ctx)
+		if err != nil {
+			return "", err
+		}
+		if _, err := client.ListFiles(ctx, "", 1); err != nil {
+			return "", err
+		}
+		return "reachable", nil
+	},
+}
+
+// now is the clock the token expiry check reads; tests pin it
+var now = time.Now
+
+// NewCommand registers `gro doctor` with the production API probes.
+func NewCommand() *cobra.Command {
+	return newCommandWithDeps(defaultProbes)
+}
+
+// newCommandWithDeps is the test seam.
+func newCommandWithDeps(probes map[string]Probe) *cobra.Command {
+	return &cobra.Command{
+		Use:   "doctor",
+		Short: "Check gro's setup and report any problems",
+		Long: `Check everything gro needs to work and print one line per check:
+
+  - the OAuth client JSON exists and is a valid client file
+  - the config directory is not writable by other users
+  - the token store opens, and which backend it uses
+  - an OAuth token is stored and can be refreshed once it expires
+  - each API the token is authorized for answers a small read
+
+Services the token was not authorized for are skipped. The command exits
+non-zero when any check fails, so the output can be attached to a bug report
+as is.`,
+		Example: `  # Check the default profile
+  gro doctor
+
+  # Check another profile
+  gro doctor --profile work`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			return runDoctor(cmd.Context(), probes)
+		},
+	}
+}
+
+// status is the outcome of one check
+type status string
+
+const (
+	statusPass status = "PASS"
+	statusFail status = "FAIL"
+	statusSkip status = "SKIP"
+)
+
+// result is one line of the report
+type result struct {
+	Name   string
+	Status status
+	Detail string
+}
+
+func pass(name, detail string) result { return result{name, statusPass, detail} }
+func fail(name, detail string) result { return result{name, statusFail, detail} }
+func skip(name, detail string) result { return result{name, statusSkip, detail} }
+
+func runDoctor(ctx context.Context, probes map[string]Probe) error {
+	cfg, err := config.LoadConfigForRuntime()
+	if err != nil {
+		return fmt.Errorf("loading config: %w", err)
+	}
+
+	results := []result{checkClientJSON(cfg.OAuthClientPath), checkConfigDir()}
+	store, token := checkTokenStore()
+	results = append(results, store, token)
+	results = append(results, checkAPIs(ctx, probes, cfg.GrantedScopes, token.Status == statusPass)...)
+
+	info := version.Get()
+	fmt.Printf("gro %s (%s, %s)\n\n", version.Info(), info.GoVersion, info.Platform)
+
+	var failed, skipped int
+	for _, r := range results {
+		fmt.Printf("%s  %-18s %s\n", r.Status, r.Name, r.Detail)
+		switch r.Status {
+		case statusFail:
+			failed++
+		case statusSkip:
+			skipped++
+		}
+	}
+	fmt.Println()
+	fmt.Printf("%d checks: %d passed, %d failed, %d skipped\n", len(results), len(results)-failed-skipped, failed, skipped)
+
+	if failed > 0 {
+		return fmt.Errorf("%d of %d checks failed", failed, len(results))
+	}
+	return nil
+}
+
+// checkClientJSON loads the OAuth client JSON the way every command does,
+// which fails both when the file is missing and when it is not a client file
+func checkClientJSON(path string) result {
+	const name = "OAuth client JSON"
+	path = config.ExpandPath(path)
+	short := config.ShortenPath(path)
+	if _, err := os.Stat(path); err != nil {
+		if os.Getenv(auth.CredentialsEnvVar) == "" {
+			return fail(name, fmt.Sprintf("%s not found and %s is not set - run 'gro init'", short, auth.CredentialsEnvVar))
+		}
+		if _, err := auth.GetOAuthConfig(); err != nil {
+			return fail(name, err.Error())
+		}
+		return pass(name, "from "+auth.CredentialsEnvVar)
+	}
+	if _, err := auth.GetOAuthConfig(); err != nil {
+		return fail(name, fmt.Sprintf("%s is not a valid OAuth client file: %v", short, err))
+	}
+	return pass(name, short)
+}
+
+// checkConfigDir fails when the config directory is missing, or when other
+// users could write to it and so swap the OAuth client or config.yml
+func checkConfigDir() result {
+	const name = "Config directory"
+	dir, err := config.GetConfigDirNoCreate()
+	if err != nil {
+		return fail(name, err.Error())
+	}
+	short := config.ShortenPath(dir)
+	info, err := os.Stat(dir)
+	if err != nil {
+		return fail(name, fmt.Sprintf("%s not found - run 'gro init'", short))
+	}
+	if runtime.GOOS == "windows" {
+		// Unix permission bits mean nothing there; ACLs protect the profile
+		return pass(name, short)
+	}
+	mode := info.Mode().Perm()
+	if mode&0o022 != 0 {
+		return fail(name, fmt.Sprintf("%s is %s, writable by other users - run 'chmod go-w %s'", short, mode, short))
+	}
+	return pass(name, fmt.Sprintf("%s (%s)", short, mode))
+}
+
+// checkTokenStore opens the token store and reports its backend, then
+// whether it holds a token that is current or can be refreshed
+func checkTokenStore() (store, token result) {
+	const storeName, tokenName = "Token storage", "OAuth token"
+
+	// OpenNoMigrate: doctor is a read-only diagnostic like config show and
+	// must stay usable during an unresolved §1.8 conflict.
+	st, err := keychain.OpenNoMigrate()
+	if err != nil {
+		if errors.Is(err, credstore.ErrFilePassphraseRequired) {
+			if r, ok := checkEnvToken(tokenName); ok {
+				return skip(storeName, fmt.Sprintf("unavailable, using %s: %v", auth.TokenEnvVar, err)), r
+			}
+		}
+		return fail(storeName, err.Error()), skip(tokenName, "token store unavailable")
+	}
+	defer func() { _ = st.Close() }()
+	backend, src := st.Backend()
+	store = pass(storeName, fmt.Sprintf("%s (%s)", backend, src))
+
+	has, err := st.HasToken()
+	if err != nil {
+		return store, fail(tokenName, fmt.Sprintf("checking stored token: %v", err))
+	}
+	if !has {
+		if r, ok := checkEnvToken(tokenName); ok {
+			return store, r
+		}
+		return store, fail(tokenName, fmt.Sprintf("not found and %s is not set - run 'gro init'", auth.TokenEnvVar))
+	}
+	tok, err := st.Token()
+	if err != nil {
+		return store, fail(tokenName, fmt.Sprintf("reading stored token: %v", err))
+	}
+	return store, checkExpiry(tokenName, tok.Expiry, tok.RefreshToken != "")
+}
+
+// checkEnvToken checks the token in auth.TokenEnvVar, which commands use
+// when the keyring has none. ok is false when the variable is not set.
+func checkEnvToken(name string) (r result, ok bool) {
+	tok, ok, err := auth.EnvToken()
+	if !ok {
+		return result{}, false
+	}
+	if err != nil {
+		return fail(name, err.Error()), true
+	}
+	r = checkExpiry(name, tok.Expiry, tok.RefreshToken != "")
+	r.Detail += " (from " + auth.TokenEnvVar + ")"
+	return r, true
+}
+
+// checkExpiry passes a token that is still current or that carries a
+// refresh token; an expired token without one needs a new 'gro init'
+func checkExpiry(name string, expiry time.Time, canRefresh bool) result {
+	switch {
+	case expiry.IsZero():
+		return pass(name, "present, no expiry recorded")
+	case expiry.After(now()):
+		return pass(name, fmt.Sprintf("present, expires in %s", expiry.Sub(now()).Round(time.Second)))
+	case canRefresh:
+		return pass(name, "present, expired - will refresh on next use")
+	default:
+		return fail(name, "expired with no refresh token - run 'gro init'")
+	}
+}
+
+// checkAPIs runs the probe of every domain the token is authorized for.
+// Without recorded scopes every domain is tried, the same assumption
+// RequireScope makes.
+func checkAPIs(ctx context.Context, probes map[string]Probe, granted []string, haveToken bool) []result {
+	authorized := auth.Domains
+	if len(granted) > 0 {
+		authorized = auth.GrantedDomains(granted)
+	}
+
+	var results []result
+	for _, d := range auth.Domains {
+		name := apiNames[d]
+		switch {
+		case !slices.Contains(authorized, d):
+			results = append(results, skip(name, "not authorized"))
+		case !haveToken:
+			results = append(results, skip(name, "no usable token"))
+		default:
+			detail, err := probes[d](ctx)
+			if err != nil {
+				results = append(results, fail(name, err.Error()))
+			} else {
+				results = append(results, pass(name, detail))
+			}
+		}
+	}
+	return results
+}
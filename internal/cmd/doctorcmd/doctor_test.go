@@ -0,0 +1,210 @@
+package doctorcmd
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+	"time"
+
+	"golang.org/x/oauth2"
+
+	"github.com/open-cli-collective/google-readonly/internal/auth"
+	"github.com/open-cli-collective/google-readonly/internal/config"
+	"github.com/open-cli-collective/google-readonly/internal/credtest"
+	"github.com/open-cli-collective/google-readonly/internal/keychain"
+	"github.com/open-cli-collective/google-readonly/internal/testutil"
+	"github.com/open-cli-collective/google-readonly/internal/version"
+)
+
+const clientJSON = `{"installed":{"client_id":"123.apps.googleusercontent.com","project_id":"p","auth_uri":"https://accounts.google.com/o/oauth2/auth","token_uri":"https://oauth2.googleapis.com/token","client_secret":"s","redirect_uris":["http://localhost"]}}`
+
+// setup gives each test a hermetic config dir holding a valid OAuth client
+// JSON, and returns the dir
+func setup(t *testing.T) string {
+	t.Helper()
+	credtest.Setup(t)
+	dir := credtest.ConfigDir(t)
+	testutil.NoError(t, os.Chmod(dir, 0o700))
+	testutil.NoError(t, os.WriteFile(filepath.Join(dir, config.OAuthClientFile), []byte(clientJSON), 0o600))
+	return dir
+}
+
+func seedToken(t *testing.T, tok *oauth2.Token) {
+	t.Helper()
+	st, err := keychain.OpenNoMigrate()
+	testutil.NoError(t, err)
+	defer func() { _ = st.Close() }()
+	testutil.NoError(t, st.SetToken(tok))
+}
+
+// okProbes answers every probe, recording which domains were tried
+func okProbes(called *[]string) map[string]Probe {
+	probes := map[string]Probe{}
+	for _, d := range []string{"mail", "calendar", "contacts", "drive"} {
+		probes[d] = func(context.Context) (string, error) {
+			*called = append(*called, d)
+			return "ok from " + d, nil
+		}
+	}
+	return probes
+}
+
+func runCommand(t *testing.T, probes map[string]Probe) (string, error) {
+	t.Helper()
+	var err error
+	out := testutil.CaptureStdout(t, func() {
+		cmd := newCommandWithDeps(probes)
+		cmd.SetArgs(nil)
+		err = cmd.Execute()
+	})
+	return out, err
+}
+
+func TestDoctor_AllPass(t *testing.T) {
+	setup(t)
+	seedToken(t, &oauth2.Token{AccessToken: "A", RefreshToken: "R"})
+
+	var called []string
+	out, err := runCommand(t, okProbes(&called))
+	testutil.NoError(t, err)
+
+	testutil.Contains(t, out, "gro "+version.Info())
+	testutil.Contains(t, out, "PASS  OAuth client JSON")
+	testutil.Contains(t, out, "PASS  Config directory")
+	testutil.Contains(t, out, "PASS  Token storage      file")
+	testutil.Contains(t, out, "PASS  OAuth token        present")
+	testutil.Contains(t, out, "PASS  Gmail API          ok from mail")
+	testutil.Contains(t, out, "PASS  Drive API          ok from drive")
+	testutil.Contains(t, out, "8 checks: 8 passed, 0 failed, 0 skipped")
+	testutil.Len(t, called, 4)
+}
+
+func TestDoctor_NoTokenSkipsAPIs(t *testing.T) {
+	setup(t)
+
+	var called []string
+	out, err := runCommand(t, okProbes(&called))
+	testutil.Error(t, err)
+	testutil.Contains(t, err.Error(), "1 of 8 checks failed")
+
+	testutil.Contains(t, out, "FAIL  OAuth token        not found and GRO_TOKEN is not set - run 'gro init'")
+	testutil.Contains(t, out, "SKIP  Calendar API       no usable token")
+	testutil.Len(t, called, 0)
+}
+
+func TestDoctor_MissingClientJSON(t *testing.T) {
+	dir := setup(t)
+	testutil.NoError(t, os.Remove(filepath.Join(dir, config.OAuthClientFile)))
+	seedToken(t, &oauth2.Token{AccessToken: "A", RefreshToken: "R"})
+
+	var called []string
+	out, err := runCommand(t, okProbes(&called))
+	testutil.Error(t, err)
+	testutil.Contains(t, out, "FAIL  OAuth client JSON")
+	testutil.Contains(t, out, "not found and GRO_CREDENTIALS is not set - run 'gro init'")
+}
+
+func TestDoctor_EnvCredentials(t *testing.T) {
+	dir := setup(t)
+	testutil.NoError(t, os.Remove(filepath.Join(dir, config.OAuthClientFile)))
+	t.Setenv(auth.CredentialsEnvVar, base64.StdEncoding.EncodeToString([]byte(clientJSON)))
+	t.Setenv(auth.TokenEnvVar, base64.StdEncoding.EncodeToString([]byte(`{"access_token":"A","refresh_token":"R"}`)))
+
+	var called []string
+	out, err := runCommand(t, okProbes(&called))
+	testutil.NoError(t, err)
+	testutil.Contains(t, out, "PASS  OAuth client JSON  from GRO_CREDENTIALS")
+	testutil.Contains(t, out, "PASS  OAuth token        present, no expiry recorded (from GRO_TOKEN)")
+	testutil.Len(t, called, 4)
+}
+
+func TestDoctor_InvalidClientJSON(t *testing.T) {
+	dir := setup(t)
+	testutil.NoError(t, os.WriteFile(filepath.Join(dir, config.OAuthClientFile), []byte(`{"web":`), 0o600))
+	seedToken(t, &oauth2.Token{AccessToken: "A", RefreshToken: "R"})
+
+	var called []string
+	out, err := runCommand(t, okProbes(&called))
+	testutil.Error(t, err)
+	testutil.Contains(t, out, "is not a valid OAuth client file")
+}
+
+func TestDoctor_ProbeFailure(t *testing.T) {
+	setup(t)
+	seedToken(t, &oauth2.Token{AccessToken: "A", RefreshToken: "R"})
+
+	var called []string
+	probes := okProbes(&called)
+	probes["drive"] = func(context.Context) (string, error) {
+		return "", errors.New("googleapi: Error 403: Drive API has not been used in project")
+	}
+
+	out, err := runCommand(t, probes)
+	testutil.Error(t, err)
+	testutil.Contains(t, err.Error(), "1 of 8 checks failed")
+	testutil.Contains(t, out, "FAIL  Drive API          googleapi: Error 403")
+	testutil.Contains(t, out, "PASS  Gmail API")
+}
+
+func TestDoctor_SkipsDomainsNotGranted(t *testing.T) {
+	setup(t)
+	seedToken(t, &oauth2.Token{AccessToken: "A", RefreshToken: "R"})
+	testutil.NoError(t, config.SaveConfig(&config.Config{
+		CredentialRef: config.DefaultCredentialRef,
+		GrantedScopes: []string{"https://www.googleapis.com/auth/gmail.modify"},
+	}))
+
+	var called []string
+	out, err := runCommand(t, okProbes(&called))
+	testutil.NoError(t, err)
+	testutil.Contains(t, out, "SKIP  Contacts API       not authorized")
+	testutil.Contains(t, out, "8 checks: 5 passed, 0 failed, 3 skipped")
+	testutil.Len(t, called, 1)
+	testutil.Equal(t, called[0], "mail")
+}
+
+func TestDoctor_ConfigDirWritableByOthers(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("permission bits are not checked on Windows")
+	}
+	dir := setup(t)
+	seedToken(t, &oauth2.Token{AccessToken: "A", RefreshToken: "R"})
+	testutil.NoError(t, os.Chmod(dir, 0o777))
+
+	var called []string
+	out, err := runCommand(t, okProbes(&called))
+	testutil.Error(t, err)
+	testutil.Contains(t, out, "FAIL  Config directory")
+	testutil.Contains(t, out, "writable by other users - run 'chmod go-w")
+}
+
+func TestCheckExpiry(t *testing.T) {
+	at := time.Date(2026, 1, 15, 10, 0, 0, 0, time.UTC)
+	orig := now
+	now = func() time.Time { return at }
+	t.Cleanup(func() { now = orig })
+
+	tests := []struct {
+		name       string
+		expiry     time.Time
+		canRefresh bool
+		want       status
+		detail     string
+	}{
+		{"current", at.Add(45 * time.Minute), false, statusPass, "present, expires in 45m0s"},
+		{"expired with refresh token", at.Add(-time.Hour), true, statusPass, "present, expired - will refresh on next use"},
+		{"expired without refresh token", at.Add(-time.Hour), false, statusFail, "expired with no refresh token - run 'gro init'"},
+		{"no expiry", time.Time{}, false, statusPass, "present, no expiry recorded"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := checkExpiry("OAuth token", tt.expiry, tt.canRefresh)
+			testutil.Equal(t, r.Status, tt.want)
+			testutil.Equal(t, r.Detail, tt.detail)
+		})
+	}
+}
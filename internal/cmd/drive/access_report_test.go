@@ -0,0 +1,178 @@
+package drive
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"sync"
+	"testing"
+
+	driveapi "github.com/open-cli-collective/google-readonly/internal/drive"
+	"github.com/open-cli-collective/google-readonly/internal/testutil"
+)
+
+func TestAccessReportCommand(t *testing.T) {
+	cmd := newAccessReportCommand()
+
+	t.Run("has correct use", func(t *testing.T) {
+		testutil.Equal(t, cmd.Use, "access-report [folder-id]")
+	})
+
+	t.Run("accepts at most one argument", func(t *testing.T) {
+		testutil.NoError(t, cmd.Args(cmd, []string{}))
+		testutil.NoError(t, cmd.Args(cmd, []string{"folder-id"}))
+		testutil.Error(t, cmd.Args(cmd, []string{"a", "b"}))
+	})
+
+	t.Run("has depth and domain flags", func(t *testing.T) {
+		testutil.NotNil(t, cmd.Flags().Lookup("depth"))
+		testutil.NotNil(t, cmd.Flags().Lookup("domain"))
+	})
+}
+
+func TestClassifyPermission(t *testing.T) {
+	tests := []struct {
+		name string
+		perm *driveapi.Permission
+		want string
+	}{
+		{"anyone with link", &driveapi.Permission{Type: "anyone", Role: "reader"}, exposurePublic},
+		{"internal user", &driveapi.Permission{Type: "user", Email: "bob@corp.com"}, ""},
+		{"internal user, other case", &driveapi.Permission{Type: "user", Email: "Bob@CORP.com"}, ""},
+		{"external user", &driveapi.Permission{Type: "user", Email: "eve@gmail.com"}, exposureExternal},
+		{"external group", &driveapi.Permission{Type: "group", Email: "team@partner.io"}, exposureExternal},
+		{"internal domain", &driveapi.Permission{Type: "domain", Domain: "corp.com"}, ""},
+		{"external domain", &driveapi.Permission{Type: "domain", Domain: "partner.io"}, exposureExternal},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			testutil.Equal(t, classifyPermission(tt.perm, "corp.com"), tt.want)
+		})
+	}
+}
+
+// accessReportMock serves a small tree owned by corp.com in which only
+// public.pdf is shared with anyone
+func accessReportMock() *MockDriveClient {
+	files := map[string]*driveapi.File{
+		"folder_root": {ID: "folder_root", Name: "Projects", MimeType: driveapi.MimeTypeFolder, Owners: []string{"me@corp.com"}},
+		"folder_sub":  {ID: "folder_sub", Name: "Team", MimeType: driveapi.MimeTypeFolder},
+	}
+	children := map[string][]*driveapi.File{
+		"folder_root": {
+			files["folder_sub"],
+			{ID: "file_public", Name: "public.pdf", MimeType: "application/pdf"},
+			{ID: "file_private", Name: "private.txt", MimeType: "text/plain"},
+		},
+		"folder_sub": {
+			{ID: "file_team", Name: "plan.txt", MimeType: "text/plain"},
+		},
+	}
+
+	owner := &driveapi.Permission{Type: "user", Role: "owner", Email: "me@corp.com"}
+	mock := folderMock(files, children)
+	mock.ListPermissionsFunc = func(_ context.Context, fileID string) ([]*driveapi.Permission, error) {
+		switch fileID {
+		case "file_public":
+			return []*driveapi.Permission{owner, {Type: "anyone", Role: "reader"}}, nil
+		case "folder_sub":
+			return []*driveapi.Permission{owner, {Type: "domain", Role: "reader", Domain: "corp.com"}}, nil
+		default:
+			return []*driveapi.Permission{owner}, nil
+		}
+	}
+	return mock
+}
+
+func TestAccessReportCommand_FlagsPublicFile(t *testing.T) {
+	cmd := newAccessReportCommand()
+	cmd.SetArgs([]string{"folder_root"})
+
+	withMockClient(accessReportMock(), func() {
+		output := testutil.CaptureStdout(t, func() {
+			testutil.NoError(t, cmd.Execute())
+		})
+
+		testutil.Contains(t, output, "PUBLIC")
+		testutil.Contains(t, output, "Projects/public.pdf")
+		testutil.NotContains(t, output, "private.txt")
+		testutil.NotContains(t, output, "plan.txt")
+		testutil.NotContains(t, output, "EXTERNAL ")
+		testutil.Contains(t, output, "1 public and 0 externally shared item(s) outside corp.com, of 5 audited.")
+	})
+}
+
+func TestAccessReportCommand_ExternalDomainOverride(t *testing.T) {
+	cmd := newAccessReportCommand()
+	cmd.SetArgs([]string{"folder_root", "--domain", "other.org"})
+
+	withMockClient(accessReportMock(), func() {
+		output := testutil.CaptureStdout(t, func() {
+			testutil.NoError(t, cmd.Execute())
+		})
+
+		// Against another domain every corp.com grant is external
+		lines := strings.Split(output, "\n")
+		testutil.Contains(t, lines[1], "PUBLIC")
+		testutil.Contains(t, output, "EXTERNAL")
+		testutil.Contains(t, output, "Projects/Team/plan.txt")
+	})
+}
+
+func TestAccessReportCommand_NoFindings(t *testing.T) {
+	mock := accessReportMock()
+	mock.ListPermissionsFunc = func(_ context.Context, _ string) ([]*driveapi.Permission, error) {
+		return []*driveapi.Permission{{Type: "user", Role: "owner", Email: "me@corp.com"}}, nil
+	}
+	cmd := newAccessReportCommand()
+	cmd.SetArgs([]string{"folder_root"})
+
+	withMockClient(mock, func() {
+		output := testutil.CaptureStdout(t, func() {
+			testutil.NoError(t, cmd.Execute())
+		})
+		testutil.Contains(t, output, "No public or external shares outside corp.com in 5 item(s).")
+	})
+}
+
+func TestAccessReportCommand_NoOwnerNeedsDomain(t *testing.T) {
+	mock := &MockDriveClient{
+		GetFileFunc: func(_ context.Context, fileID string) (*driveapi.File, error) {
+			return &driveapi.File{ID: fileID, Name: "Shared", MimeType: driveapi.MimeTypeFolder}, nil
+		},
+	}
+	cmd := newAccessReportCommand()
+	cmd.SetArgs([]string{"folder_shared"})
+
+	withMockClient(mock, func() {
+		err := cmd.Execute()
+		testutil.Error(t, err)
+		testutil.Contains(t, err.Error(), "pass --domain")
+	})
+}
+
+func TestAuditPermissions_LooksUpEachItemOnce(t *testing.T) {
+	var mu sync.Mutex
+	calls := map[string]int{}
+	mock := &MockDriveClient{
+		ListPermissionsFunc: func(_ context.Context, fileID string) ([]*driveapi.Permission, error) {
+			mu.Lock()
+			calls[fileID]++
+			mu.Unlock()
+			if fileID == "broken" {
+				return nil, errors.New("forbidden")
+			}
+			return nil, nil
+		},
+	}
+
+	entries := []auditEntry{{ID: "a", Path: "A"}, {ID: "b", Path: "B"}, {ID: "broken", Path: "C"}}
+	findings, failed := auditPermissions(context.Background(), mock, entries, "corp.com")
+	testutil.Len(t, findings, 0)
+	testutil.Len(t, failed, 1)
+	testutil.Equal(t, failed[0].entry.Path, "C")
+	for _, e := range entries {
+		testutil.Equal(t, calls[e.ID], 1)
+	}
+}
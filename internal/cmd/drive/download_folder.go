@@ -0,0 +1,247 @@
+package drive
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/open-cli-collective/google-readonly/internal/config"
+	"github.com/open-cli-collective/google-readonly/internal/drive"
+	"github.com/open-cli-collective/google-readonly/internal/fileutil"
+	formatpkg "github.com/open-cli-collective/google-readonly/internal/format"
+	"github.com/open-cli-collective/google-readonly/internal/log"
+	"github.com/open-cli-collective/google-readonly/internal/progress"
+)
+
+// defaultRecursiveFormat is the export format used for Workspace files in a
+// recursive download when --format is not given. Every exportable Workspace
+// type supports it.
+const defaultRecursiveFormat = "pdf"
+
+// folderDownload tracks the state of a recursive folder download
+type folderDownload struct {
+	client    DriveClient
+	format    string
+	overwrite bool
+	// dryRun plans the download without creating directories, writing files
+	// or fetching any content
+	dryRun bool
+	// claimed holds every path outputPath has handed out, both the one a
+	// name asked for and the one it got, so a dry run numbers files the
+	// way the real download would without anything on disk
+	claimed map[string]bool
+	files   int
+	bytes   int64
+	exports int
+	skipped int
+}
+
+// downloadFolder mirrors a Drive folder into a local directory. With dryRun,
+// it prints where each file would be saved and the total size instead.
+func downloadFolder(ctx context.Context, client DriveClient, folder *drive.File, output, format string, depth int, overwrite, dryRun bool) error {
+	if output == "" {
+		output = sanitizeFileName(folder.Name)
+	}
+	if format == "" {
+		format = defaultRecursiveFormat
+	}
+	if depth <= 0 {
+		depth = math.MaxInt
+	}
+
+	tree, err := buildTree(ctx, client, folder.ID, depth, true)
+	if err != nil {
+		return fmt.Errorf("building folder tree: %w", err)
+	}
+
+	absOutputDir, err := filepath.Abs(output)
+	if err != nil {
+		return fmt.Errorf("resolving output directory: %w", err)
+	}
+	if !dryRun {
+		if err := os.MkdirAll(absOutputDir, config.OutputDirPerm); err != nil {
+			return fmt.Errorf("creating output directory: %w", err)
+		}
+	}
+
+	d := &folderDownload{
+		client:    client,
+		format:    format,
+		overwrite: overwrite,
+		dryRun:    dryRun,
+		claimed:   make(map[string]bool),
+	}
+	if err := d.downloadChildren(ctx, tree, absOutputDir, ""); err != nil {
+		return err
+	}
+
+	if dryRun {
+		d.printPlanSummary(output)
+		return nil
+	}
+	log.Status("\nDownloaded %d file(s), %s, to %s", d.files, formatpkg.Size(d.bytes), output)
+	if d.skipped > 0 {
+		log.Status("Skipped %d item(s)", d.skipped)
+	}
+	return nil
+}
+
+// downloadChildren writes each child of node into dir, recursing into folders.
+// rel is the path of dir relative to the output root, used for display.
+func (d *folderDownload) downloadChildren(ctx context.Context, node *TreeNode, dir, rel string) error {
+	for _, child := range node.Children {
+		childRel := filepath.Join(rel, child.Name)
+
+		switch {
+		case child.MimeType == drive.MimeTypeFolder:
+			subdir, err := fileutil.SafeJoin(dir, sanitizeFileName(child.Name))
+			if err != nil {
+				d.skip(childRel, err.Error())
+				continue
+			}
+			if !d.dryRun {
+				if err := os.MkdirAll(subdir, config.OutputDirPerm); err != nil {
+					return fmt.Errorf("creating directory %s: %w", childRel, err)
+				}
+			}
+			if err := d.downloadChildren(ctx, child, subdir, childRel); err != nil {
+				return err
+			}
+
+		case child.MimeType == drive.MimeTypeShortcut:
+			d.skip(childRel, "shortcut")
+
+		case drive.IsGoogleWorkspaceFile(child.MimeType):
+			exportMime, err := drive.GetExportMimeType(child.MimeType, d.format)
+			if err != nil {
+				d.skip(childRel, fmt.Sprintf("no %s export for %s", d.format, drive.GetTypeName(child.MimeType)))
+				continue
+			}
+			name := determineOutputPath(sanitizeFileName(child.Name), d.format, "")
+			if d.dryRun {
+				d.plan(dir, name, child.ID, childRel, -1)
+				continue
+			}
+			data, err := d.client.ExportFile(ctx, child.ID, exportMime)
+			if err != nil {
+				return fmt.Errorf("exporting %s: %w", childRel, err)
+			}
+			err = d.write(dir, name, child.ID, childRel, func(w io.Writer) (int64, error) {
+				n, err := w.Write(data)
+				return int64(n), err
+			})
+			if err != nil {
+				return fmt.Errorf("writing %s: %w", childRel, err)
+			}
+
+		default:
+			if d.dryRun {
+				d.plan(dir, sanitizeFileName(child.Name), child.ID, childRel, child.Size)
+				continue
+			}
+			err := d.write(dir, sanitizeFileName(child.Name), child.ID, childRel, func(w io.Writer) (int64, error) {
+				w, done := progress.Track(w, childRel, child.Size)
+				defer done()
+				return d.client.DownloadFileTo(ctx, child.ID, w)
+			})
+			if err != nil {
+				return fmt.Errorf("downloading %s: %w", childRel, err)
+			}
+		}
+	}
+	return nil
+}
+
+// write streams the output of fetch into name inside dir, at the path
+// outputPath picks. A name that cannot be created locally is skipped; a
+// failed transfer is returned.
+func (d *folderDownload) write(dir, name, fileID, rel string, fetch func(w io.Writer) (int64, error)) error {
+	outputPath, ok := d.outputPath(dir, name, fileID, rel)
+	if !ok {
+		return nil
+	}
+
+	f, err := os.OpenFile(outputPath, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, config.OutputFilePerm)
+	if err != nil {
+		d.skip(rel, fmt.Sprintf("creating file: %v", err))
+		return nil
+	}
+	n, err := copyAndClose(f, fetch)
+	if err != nil {
+		return err
+	}
+
+	d.files++
+	d.bytes += n
+	log.Status("Saved: %s", outputPath)
+	return nil
+}
+
+// plan records and prints a file a dry run would save. size is -1 for a
+// Workspace export, whose size is only known once it is exported.
+func (d *folderDownload) plan(dir, name, fileID, rel string, size int64) {
+	outputPath, ok := d.outputPath(dir, name, fileID, rel)
+	if !ok {
+		return
+	}
+	d.files++
+	if size < 0 {
+		d.exports++
+		fmt.Printf("Would export: %s (%s)\n", outputPath, d.format)
+		return
+	}
+	d.bytes += size
+	fmt.Printf("Would download: %s (%s)\n", outputPath, formatpkg.Size(size))
+}
+
+// printPlanSummary prints the totals of a dry run
+func (d *folderDownload) printPlanSummary(output string) {
+	fmt.Printf("\nWould download %d file(s), %s, to %s\n", d.files, formatpkg.Size(d.bytes), output)
+	if d.exports > 0 {
+		fmt.Printf("%d of them are %s exports, not included in the size\n", d.exports, d.format)
+	}
+	if d.skipped > 0 {
+		fmt.Printf("Would skip %d item(s)\n", d.skipped)
+	}
+}
+
+// outputPath returns the path name is saved to inside dir. Drive allows
+// duplicate names in a folder, so a name already used during this download
+// gets the file ID appended rather than overwriting the earlier file, and one
+// left by an earlier run is numbered unless overwrite is set. It reports
+// false, after recording the skip, when the name cannot be created locally.
+func (d *folderDownload) outputPath(dir, name, fileID, rel string) (string, bool) {
+	outputPath, err := fileutil.SafeJoin(dir, name)
+	if err != nil {
+		d.skip(rel, err.Error())
+		return "", false
+	}
+	if d.claimed[outputPath] {
+		ext := filepath.Ext(name)
+		outputPath, err = fileutil.SafeJoin(dir, strings.TrimSuffix(name, ext)+"_"+fileID+ext)
+		if err != nil {
+			d.skip(rel, err.Error())
+			return "", false
+		}
+	}
+	target := targetPath(outputPath, d.overwrite)
+	d.claimed[outputPath] = true
+	d.claimed[target] = true
+	return target, true
+}
+
+// skip records and reports an item that was not downloaded
+func (d *folderDownload) skip(rel, reason string) {
+	d.skipped++
+	log.Status("Skipped: %s (%s)", rel, reason)
+}
+
+// sanitizeFileName makes a Drive name usable as a single path component.
+// Drive names may contain slashes, which would otherwise create directories.
+func sanitizeFileName(name string) string {
+	return strings.NewReplacer("/", "_", "\\", "_").Replace(name)
+}
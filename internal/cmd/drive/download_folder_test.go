@@ -0,0 +1,347 @@
+package drive
+
+import (
+	"context"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	driveapi "github.com/open-cli-collective/google-readonly/internal/drive"
+	"github.com/open-cli-collective/google-readonly/internal/log"
+	"github.com/open-cli-collective/google-readonly/internal/testutil"
+)
+
+// folderMock serves a fixed folder hierarchy keyed by parent ID
+func folderMock(files map[string]*driveapi.File, children map[string][]*driveapi.File) *MockDriveClient {
+	return &MockDriveClient{
+		GetFileFunc: func(_ context.Context, fileID string) (*driveapi.File, error) {
+			if f, ok := files[fileID]; ok {
+				return f, nil
+			}
+			return nil, errors.New("file not found")
+		},
+		ListFilesWithScopeFunc: func(_ context.Context, query string, _ int64, _ driveapi.DriveScope) ([]*driveapi.File, error) {
+			for parent, kids := range children {
+				if strings.Contains(query, "'"+parent+"' in parents") {
+					return kids, nil
+				}
+			}
+			return nil, nil
+		},
+		DownloadFileFunc: func(_ context.Context, fileID string) ([]byte, error) {
+			return []byte("content of " + fileID), nil
+		},
+		ExportFileFunc: func(_ context.Context, fileID, mimeType string) ([]byte, error) {
+			return []byte(mimeType + " export of " + fileID), nil
+		},
+	}
+}
+
+func sampleFolderTree() (map[string]*driveapi.File, map[string][]*driveapi.File) {
+	files := map[string]*driveapi.File{
+		"folder_root": {ID: "folder_root", Name: "Projects", MimeType: driveapi.MimeTypeFolder},
+		"folder_sub":  {ID: "folder_sub", Name: "2024", MimeType: driveapi.MimeTypeFolder},
+		"file_pdf":    {ID: "file_pdf", Name: "report.pdf", MimeType: "application/pdf"},
+	}
+	children := map[string][]*driveapi.File{
+		"folder_root": {
+			files["folder_sub"],
+			files["file_pdf"],
+			{ID: "file_doc", Name: "Plan", MimeType: driveapi.MimeTypeDocument},
+			{ID: "file_link", Name: "Link", MimeType: driveapi.MimeTypeShortcut},
+		},
+		"folder_sub": {
+			{ID: "file_nested", Name: "notes.txt", MimeType: "text/plain"},
+		},
+	}
+	return files, children
+}
+
+func TestDownloadCommand_Recursive(t *testing.T) {
+	outDir := filepath.Join(t.TempDir(), "backup")
+	files, children := sampleFolderTree()
+
+	cmd := newDownloadCommand()
+	cmd.SetArgs([]string{"folder_root", "--recursive", "--output", outDir})
+
+	withMockClient(folderMock(files, children), func() {
+		output := testutil.CaptureStdout(t, func() {
+			err := cmd.Execute()
+			testutil.NoError(t, err)
+		})
+
+		data, err := os.ReadFile(filepath.Join(outDir, "report.pdf"))
+		testutil.NoError(t, err)
+		testutil.Equal(t, string(data), "content of file_pdf")
+
+		data, err = os.ReadFile(filepath.Join(outDir, "Plan.pdf"))
+		testutil.NoError(t, err)
+		testutil.Equal(t, string(data), "application/pdf export of file_doc")
+
+		data, err = os.ReadFile(filepath.Join(outDir, "2024", "notes.txt"))
+		testutil.NoError(t, err)
+		testutil.Equal(t, string(data), "content of file_nested")
+
+		_, err = os.Stat(filepath.Join(outDir, "Link"))
+		testutil.True(t, os.IsNotExist(err))
+
+		testutil.Contains(t, output, "Skipped: Link (shortcut)")
+		testutil.Contains(t, output, "Downloaded 3 file(s)")
+		testutil.Contains(t, output, "Skipped 1 item(s)")
+	})
+}
+
+func TestDownloadCommand_RecursiveQuiet(t *testing.T) {
+	outDir := t.TempDir()
+	log.Quiet = true
+	t.Cleanup(func() { log.Quiet = false })
+	files, children := sampleFolderTree()
+
+	cmd := newDownloadCommand()
+	cmd.SetArgs([]string{"folder_root", "--recursive", "--output", outDir})
+
+	withMockClient(folderMock(files, children), func() {
+		output := testutil.CaptureStdout(t, func() {
+			testutil.NoError(t, cmd.Execute())
+		})
+		// The shortcut is skipped without a word
+		testutil.Equal(t, output, "")
+	})
+
+	_, err := os.Stat(filepath.Join(outDir, "report.pdf"))
+	testutil.NoError(t, err)
+}
+
+func TestDownloadCommand_RecursiveFormat(t *testing.T) {
+	outDir := t.TempDir()
+	files, children := sampleFolderTree()
+
+	cmd := newDownloadCommand()
+	cmd.SetArgs([]string{"folder_root", "--recursive", "--output", outDir, "--format", "docx"})
+
+	withMockClient(folderMock(files, children), func() {
+		testutil.CaptureStdout(t, func() {
+			err := cmd.Execute()
+			testutil.NoError(t, err)
+		})
+
+		_, err := os.Stat(filepath.Join(outDir, "Plan.docx"))
+		testutil.NoError(t, err)
+	})
+}
+
+func TestDownloadCommand_RecursiveDepth(t *testing.T) {
+	outDir := t.TempDir()
+	files, children := sampleFolderTree()
+
+	cmd := newDownloadCommand()
+	cmd.SetArgs([]string{"folder_root", "--recursive", "--output", outDir, "--depth", "1"})
+
+	withMockClient(folderMock(files, children), func() {
+		testutil.CaptureStdout(t, func() {
+			err := cmd.Execute()
+			testutil.NoError(t, err)
+		})
+
+		_, err := os.Stat(filepath.Join(outDir, "report.pdf"))
+		testutil.NoError(t, err)
+		_, err = os.Stat(filepath.Join(outDir, "2024", "notes.txt"))
+		testutil.True(t, os.IsNotExist(err))
+	})
+}
+
+func TestDownloadCommand_RecursiveUnsafeNames(t *testing.T) {
+	outDir := t.TempDir()
+	files := map[string]*driveapi.File{
+		"folder_root": {ID: "folder_root", Name: "Projects", MimeType: driveapi.MimeTypeFolder},
+	}
+	children := map[string][]*driveapi.File{
+		"folder_root": {
+			{ID: "file_dots", Name: "..", MimeType: "text/plain"},
+			{ID: "file_slash", Name: "../escape.txt", MimeType: "text/plain"},
+			{ID: "dup_1", Name: "dup.txt", MimeType: "text/plain"},
+			{ID: "dup_2", Name: "dup.txt", MimeType: "text/plain"},
+		},
+	}
+
+	cmd := newDownloadCommand()
+	cmd.SetArgs([]string{"folder_root", "--recursive", "--output", outDir})
+
+	withMockClient(folderMock(files, children), func() {
+		output := testutil.CaptureStdout(t, func() {
+			err := cmd.Execute()
+			testutil.NoError(t, err)
+		})
+
+		testutil.Contains(t, output, "Skipped: ..")
+		_, err := os.Stat(filepath.Join(filepath.Dir(outDir), "escape.txt"))
+		testutil.True(t, os.IsNotExist(err))
+		_, err = os.Stat(filepath.Join(outDir, ".._escape.txt"))
+		testutil.NoError(t, err)
+
+		_, err = os.Stat(filepath.Join(outDir, "dup.txt"))
+		testutil.NoError(t, err)
+		_, err = os.Stat(filepath.Join(outDir, "dup_dup_2.txt"))
+		testutil.NoError(t, err)
+	})
+}
+
+func TestDownloadCommand_RecursiveRequiresFolder(t *testing.T) {
+	files, children := sampleFolderTree()
+
+	cmd := newDownloadCommand()
+	cmd.SetArgs([]string{"file_pdf", "--recursive"})
+
+	withMockClient(folderMock(files, children), func() {
+		err := cmd.Execute()
+		testutil.Error(t, err)
+		testutil.Contains(t, err.Error(), "--recursive requires a folder")
+	})
+}
+
+func TestDownloadCommand_RecursiveWithStdout(t *testing.T) {
+	cmd := newDownloadCommand()
+	cmd.SetArgs([]string{"folder_root", "--recursive", "--stdout"})
+
+	withMockClient(&MockDriveClient{}, func() {
+		err := cmd.Execute()
+		testutil.Error(t, err)
+		testutil.Contains(t, err.Error(), "cannot be used with --stdout")
+	})
+}
+
+func TestDownloadCommand_RecursiveExistingFiles(t *testing.T) {
+	outDir := filepath.Join(t.TempDir(), "backup")
+	files, children := sampleFolderTree()
+
+	for range 2 {
+		cmd := newDownloadCommand()
+		cmd.SetArgs([]string{"folder_root", "--recursive", "--output", outDir})
+		withMockClient(folderMock(files, children), func() {
+			testutil.CaptureStdout(t, func() {
+				testutil.NoError(t, cmd.Execute())
+			})
+		})
+	}
+
+	for _, name := range []string{"report.pdf", "report (1).pdf", "Plan (1).pdf", filepath.Join("2024", "notes (1).txt")} {
+		_, err := os.Stat(filepath.Join(outDir, name))
+		testutil.NoError(t, err)
+	}
+
+	cmd := newDownloadCommand()
+	cmd.SetArgs([]string{"folder_root", "--recursive", "--output", outDir, "--overwrite"})
+	withMockClient(folderMock(files, children), func() {
+		testutil.CaptureStdout(t, func() {
+			testutil.NoError(t, cmd.Execute())
+		})
+	})
+	_, err := os.Stat(filepath.Join(outDir, "report (2).pdf"))
+	testutil.True(t, os.IsNotExist(err))
+}
+
+func TestDownloadCommand_RecursiveDryRun(t *testing.T) {
+	outDir := filepath.Join(t.TempDir(), "backup")
+	files, children := sampleFolderTree()
+	files["file_pdf"].Size = 2048
+	children["folder_sub"][0].Size = 1024
+
+	mock := folderMock(files, children)
+	mock.DownloadFileToFunc = func(_ context.Context, fileID string, _ io.Writer) (int64, error) {
+		t.Errorf("dry run downloaded %s", fileID)
+		return 0, nil
+	}
+	mock.ExportFileFunc = func(_ context.Context, fileID, _ string) ([]byte, error) {
+		t.Errorf("dry run exported %s", fileID)
+		return nil, nil
+	}
+
+	cmd := newDownloadCommand()
+	cmd.SetArgs([]string{"folder_root", "--recursive", "--output", outDir, "--dry-run"})
+
+	withMockClient(mock, func() {
+		output := testutil.CaptureStdout(t, func() {
+			testutil.NoError(t, cmd.Execute())
+		})
+
+		testutil.Contains(t, output, "Would download: "+filepath.Join(outDir, "report.pdf")+" (2.0 KB)")
+		testutil.Contains(t, output, "Would download: "+filepath.Join(outDir, "2024", "notes.txt")+" (1.0 KB)")
+		testutil.Contains(t, output, "Would export: "+filepath.Join(outDir, "Plan.pdf")+" (pdf)")
+		testutil.Contains(t, output, "Skipped: Link (shortcut)")
+		testutil.Contains(t, output, "Would download 3 file(s), 3.0 KB, to "+outDir)
+		testutil.Contains(t, output, "1 of them are pdf exports, not included in the size")
+		testutil.Contains(t, output, "Would skip 1 item(s)")
+
+		_, err := os.Stat(outDir)
+		testutil.True(t, os.IsNotExist(err))
+	})
+}
+
+func TestDownloadCommand_DryRunRequiresRecursive(t *testing.T) {
+	cmd := newDownloadCommand()
+	cmd.SetArgs([]string{"file_pdf", "--dry-run"})
+
+	withMockClient(&MockDriveClient{}, func() {
+		err := cmd.Execute()
+		testutil.Error(t, err)
+		testutil.Contains(t, err.Error(), "--dry-run requires --recursive")
+	})
+}
+
+func TestDownloadCommand_RecursiveDuplicatesBesideExistingFile(t *testing.T) {
+	files := map[string]*driveapi.File{
+		"folder_root": {ID: "folder_root", Name: "Projects", MimeType: driveapi.MimeTypeFolder},
+	}
+	children := map[string][]*driveapi.File{
+		"folder_root": {
+			{ID: "pdf1", Name: "report.pdf", MimeType: "application/pdf", Size: 10},
+			{ID: "pdf2", Name: "report.pdf", MimeType: "application/pdf", Size: 10},
+		},
+	}
+	want := []string{"report (1).pdf", "report_pdf2.pdf"}
+
+	// setup leaves report.pdf from an earlier run in a fresh directory
+	setup := func(t *testing.T) string {
+		t.Helper()
+		outDir := t.TempDir()
+		testutil.NoError(t, os.WriteFile(filepath.Join(outDir, "report.pdf"), []byte("earlier"), 0o600))
+		return outDir
+	}
+
+	t.Run("download", func(t *testing.T) {
+		outDir := setup(t)
+		cmd := newDownloadCommand()
+		cmd.SetArgs([]string{"folder_root", "--recursive", "--output", outDir})
+		withMockClient(folderMock(files, children), func() {
+			testutil.CaptureStdout(t, func() {
+				testutil.NoError(t, cmd.Execute())
+			})
+		})
+
+		for name, fileID := range map[string]string{want[0]: "pdf1", want[1]: "pdf2"} {
+			data, err := os.ReadFile(filepath.Join(outDir, name))
+			testutil.NoError(t, err)
+			testutil.Equal(t, string(data), "content of "+fileID)
+		}
+		data, err := os.ReadFile(filepath.Join(outDir, "report.pdf"))
+		testutil.NoError(t, err)
+		testutil.Equal(t, string(data), "earlier")
+	})
+
+	t.Run("dry run", func(t *testing.T) {
+		outDir := setup(t)
+		cmd := newDownloadCommand()
+		cmd.SetArgs([]string{"folder_root", "--recursive", "--output", outDir, "--dry-run"})
+		withMockClient(folderMock(files, children), func() {
+			output := testutil.CaptureStdout(t, func() {
+				testutil.NoError(t, cmd.Execute())
+			})
+			for _, name := range want {
+				testutil.Contains(t, output, "Would download: "+filepath.Join(outDir, name)+" (10 B)")
+			}
+		})
+	})
+}
@@ -1,8 +1,14 @@
 package drive
 
 import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
 	"testing"
 
+	driveapi "github.com/open-cli-collective/google-readonly/internal/drive"
+	"github.com/open-cli-collective/google-readonly/internal/log"
 	"github.com/open-cli-collective/google-readonly/internal/testutil"
 )
 
@@ -10,12 +16,13 @@ func TestDownloadCommand(t *testing.T) {
 	cmd := newDownloadCommand()
 
 	t.Run("has correct use", func(t *testing.T) {
-		testutil.Equal(t, cmd.Use, "download <file-id>")
+		testutil.Equal(t, cmd.Use, "download [file-id]")
 	})
 
-	t.Run("requires exactly one argument", func(t *testing.T) {
+	t.Run("accepts at most one argument", func(t *testing.T) {
+		// Zero args is allowed at parse time; --path supplies the file instead.
 		err := cmd.Args(cmd, []string{})
-		testutil.Error(t, err)
+		testutil.NoError(t, err)
 
 		err = cmd.Args(cmd, []string{"file-id"})
 		testutil.NoError(t, err)
@@ -41,6 +48,25 @@ func TestDownloadCommand(t *testing.T) {
 		testutil.NotNil(t, flag)
 	})
 
+	t.Run("has recursive flag", func(t *testing.T) {
+		flag := cmd.Flags().Lookup("recursive")
+		testutil.NotNil(t, flag)
+		testutil.Equal(t, flag.Shorthand, "r")
+		testutil.Equal(t, flag.DefValue, "false")
+	})
+
+	t.Run("has depth flag", func(t *testing.T) {
+		flag := cmd.Flags().Lookup("depth")
+		testutil.NotNil(t, flag)
+		testutil.Equal(t, flag.DefValue, "0")
+	})
+
+	t.Run("has verify flag", func(t *testing.T) {
+		flag := cmd.Flags().Lookup("verify")
+		testutil.NotNil(t, flag)
+		testutil.Equal(t, flag.DefValue, "false")
+	})
+
 	t.Run("has short description", func(t *testing.T) {
 		testutil.Contains(t, cmd.Short, "Download")
 	})
@@ -52,6 +78,21 @@ func TestDetermineOutputPath(t *testing.T) {
 		testutil.Equal(t, result, "/custom/path.pdf")
 	})
 
+	t.Run("keeps an explicit output extension that differs from the format", func(t *testing.T) {
+		result := determineOutputPath("original.doc", "pdf", "report.document")
+		testutil.Equal(t, result, "report.document")
+	})
+
+	t.Run("appends the format extension to an output without one", func(t *testing.T) {
+		result := determineOutputPath("original.doc", "pdf", filepath.Join("out.d", "report"))
+		testutil.Equal(t, result, filepath.Join("out.d", "report.pdf"))
+	})
+
+	t.Run("leaves an output without extension alone when not exporting", func(t *testing.T) {
+		result := determineOutputPath("photo.jpg", "", "photo")
+		testutil.Equal(t, result, "photo")
+	})
+
 	t.Run("uses original name when no format or output", func(t *testing.T) {
 		result := determineOutputPath("document.pdf", "", "")
 		testutil.Equal(t, result, "document.pdf")
@@ -91,3 +132,190 @@ func TestDetermineOutputPath(t *testing.T) {
 		}
 	})
 }
+
+func TestSplitFormats(t *testing.T) {
+	testutil.Len(t, splitFormats(""), 0)
+	testutil.Equal(t, strings.Join(splitFormats("pdf"), ","), "pdf")
+	testutil.Equal(t, strings.Join(splitFormats(" pdf, docx,,txt,pdf "), ","), "pdf,docx,txt")
+}
+
+func TestDownloadCommand_MultipleFormats(t *testing.T) {
+	newMock := func(exported *[]string) *MockDriveClient {
+		return &MockDriveClient{
+			GetFileFunc: func(_ context.Context, _ string) (*driveapi.File, error) {
+				return testutil.SampleGoogleDoc("doc123"), nil
+			},
+			ExportFileFunc: func(_ context.Context, _, mimeType string) ([]byte, error) {
+				*exported = append(*exported, mimeType)
+				return []byte("content of " + mimeType), nil
+			},
+		}
+	}
+
+	t.Run("writes one file per format", func(t *testing.T) {
+		dir := t.TempDir()
+		var exported []string
+		cmd := newDownloadCommand()
+		cmd.SetArgs([]string{"doc123", "--format", "pdf,docx,txt", "-o", filepath.Join(dir, "archive.gdoc")})
+
+		withMockClient(newMock(&exported), func() {
+			output := testutil.CaptureStdout(t, func() {
+				testutil.NoError(t, cmd.Execute())
+			})
+			testutil.Contains(t, output, "Saved to: "+filepath.Join(dir, "archive.docx"))
+		})
+
+		testutil.Len(t, exported, 3)
+		for _, name := range []string{"archive.pdf", "archive.docx", "archive.txt"} {
+			_, err := os.Stat(filepath.Join(dir, name))
+			testutil.NoError(t, err)
+		}
+		data, err := os.ReadFile(filepath.Join(dir, "archive.pdf"))
+		testutil.NoError(t, err)
+		testutil.Equal(t, string(data), "content of application/pdf")
+	})
+
+	t.Run("names files after the document without --output", func(t *testing.T) {
+		t.Chdir(t.TempDir())
+		var exported []string
+		cmd := newDownloadCommand()
+		cmd.SetArgs([]string{"doc123", "--format", "pdf,md"})
+
+		withMockClient(newMock(&exported), func() {
+			_ = testutil.CaptureStdout(t, func() {
+				testutil.NoError(t, cmd.Execute())
+			})
+		})
+
+		for _, name := range []string{"My Document.pdf", "My Document.md"} {
+			_, err := os.Stat(name)
+			testutil.NoError(t, err)
+		}
+	})
+
+	t.Run("an unsupported format fails before any export", func(t *testing.T) {
+		dir := t.TempDir()
+		var exported []string
+		cmd := newDownloadCommand()
+		cmd.SetArgs([]string{"doc123", "--format", "pdf,xlsx", "-o", filepath.Join(dir, "archive")})
+
+		withMockClient(newMock(&exported), func() {
+			err := cmd.Execute()
+			testutil.Error(t, err)
+			testutil.Contains(t, err.Error(), "format 'xlsx' not supported")
+		})
+
+		testutil.Len(t, exported, 0)
+		entries, err := os.ReadDir(dir)
+		testutil.NoError(t, err)
+		testutil.Len(t, entries, 0)
+	})
+
+	t.Run("rejects a regular file", func(t *testing.T) {
+		mock := &MockDriveClient{
+			GetFileFunc: func(_ context.Context, _ string) (*driveapi.File, error) {
+				return testutil.SampleDriveFile("file123"), nil
+			},
+		}
+		cmd := newDownloadCommand()
+		cmd.SetArgs([]string{"file123", "--format", "pdf,docx"})
+
+		withMockClient(mock, func() {
+			err := cmd.Execute()
+			testutil.Error(t, err)
+			testutil.Contains(t, err.Error(), "only for Google Workspace files")
+		})
+	})
+
+	t.Run("rejects --stdout and --recursive", func(t *testing.T) {
+		for _, flag := range []string{"--stdout", "--recursive"} {
+			cmd := newDownloadCommand()
+			cmd.SetArgs([]string{"doc123", "--format", "pdf,docx", flag})
+
+			withMockClient(&MockDriveClient{}, func() {
+				err := cmd.Execute()
+				testutil.Error(t, err)
+				testutil.Contains(t, err.Error(), "several --format values")
+			})
+		}
+	})
+}
+
+func TestDownloadCommand_ExistingFile(t *testing.T) {
+	mock := &MockDriveClient{
+		GetFileFunc: func(_ context.Context, _ string) (*driveapi.File, error) {
+			return testutil.SampleDriveFile("file123"), nil
+		},
+		DownloadFileFunc: func(_ context.Context, _ string) ([]byte, error) {
+			return []byte("new"), nil
+		},
+	}
+
+	t.Run("saves a numbered copy", func(t *testing.T) {
+		t.Chdir(t.TempDir())
+		testutil.NoError(t, os.WriteFile("test-document.pdf", []byte("old"), 0o600))
+		cmd := newDownloadCommand()
+		cmd.SetArgs([]string{"file123"})
+
+		withMockClient(mock, func() {
+			output := testutil.CaptureStdout(t, func() {
+				testutil.NoError(t, cmd.Execute())
+			})
+			testutil.Contains(t, output, "Saved to: test-document (1).pdf")
+		})
+
+		data, err := os.ReadFile("test-document.pdf")
+		testutil.NoError(t, err)
+		testutil.Equal(t, string(data), "old")
+		data, err = os.ReadFile("test-document (1).pdf")
+		testutil.NoError(t, err)
+		testutil.Equal(t, string(data), "new")
+	})
+
+	t.Run("--overwrite replaces it", func(t *testing.T) {
+		t.Chdir(t.TempDir())
+		testutil.NoError(t, os.WriteFile("test-document.pdf", []byte("old"), 0o600))
+		cmd := newDownloadCommand()
+		cmd.SetArgs([]string{"file123", "--overwrite"})
+
+		withMockClient(mock, func() {
+			output := testutil.CaptureStdout(t, func() {
+				testutil.NoError(t, cmd.Execute())
+			})
+			testutil.Contains(t, output, "Saved to: test-document.pdf")
+		})
+
+		data, err := os.ReadFile("test-document.pdf")
+		testutil.NoError(t, err)
+		testutil.Equal(t, string(data), "new")
+		_, err = os.Stat("test-document (1).pdf")
+		testutil.True(t, os.IsNotExist(err))
+	})
+}
+
+func TestDownloadCommand_Quiet(t *testing.T) {
+	t.Chdir(t.TempDir())
+	log.Quiet = true
+	t.Cleanup(func() { log.Quiet = false })
+	mock := &MockDriveClient{
+		GetFileFunc: func(_ context.Context, _ string) (*driveapi.File, error) {
+			return testutil.SampleDriveFile("file123"), nil
+		},
+		DownloadFileFunc: func(_ context.Context, _ string) ([]byte, error) {
+			return []byte("content"), nil
+		},
+	}
+	cmd := newDownloadCommand()
+	cmd.SetArgs([]string{"file123"})
+
+	withMockClient(mock, func() {
+		output := testutil.CaptureStdout(t, func() {
+			testutil.NoError(t, cmd.Execute())
+		})
+		testutil.Equal(t, output, "")
+	})
+
+	data, err := os.ReadFile("test-document.pdf")
+	testutil.NoError(t, err)
+	testutil.Equal(t, string(data), "content")
+}
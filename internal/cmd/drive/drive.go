@@ -15,10 +15,19 @@ func NewCommand() *cobra.Command {
 
 This command group provides Google Drive functionality:
 - list: List files in Drive or a specific folder
+- recent: List recently modified files
+- starred: List starred files
 - search: Search for files by name, content, type, or date
+- query: List files matching a raw Drive query
 - get: Get detailed metadata for a file
+- revisions: List a file's version history
+- permissions: List who has access to a file
+- resolve: Resolve a My Drive path to a file ID
 - download: Download files or export Google Docs
 - tree: Display folder structure
+- du: Show total size of a folder tree
+- access-report: Find publicly or externally shared files in a folder tree
+- watch: Print files added to or modified in a folder
 - drives: List accessible shared drives
 - star: Star files
 - unstar: Unstar files
@@ -39,10 +48,19 @@ Examples:
 	}
 
 	cmd.AddCommand(newListCommand())
+	cmd.AddCommand(newRecentCommand())
+	cmd.AddCommand(newStarredCommand())
 	cmd.AddCommand(newSearchCommand())
+	cmd.AddCommand(newQueryCommand())
 	cmd.AddCommand(newGetCommand())
+	cmd.AddCommand(newRevisionsCommand())
+	cmd.AddCommand(newPermissionsCommand())
+	cmd.AddCommand(newResolveCommand())
 	cmd.AddCommand(newDownloadCommand())
 	cmd.AddCommand(newTreeCommand())
+	cmd.AddCommand(newDuCommand())
+	cmd.AddCommand(newAccessReportCommand())
+	cmd.AddCommand(newWatchCommand())
 	cmd.AddCommand(newDrivesCommand())
 	cmd.AddCommand(newStarCommand())
 	cmd.AddCommand(newUnstarCommand())
@@ -11,6 +11,7 @@ import (
 
 	"github.com/open-cli-collective/google-readonly/internal/cache"
 	"github.com/open-cli-collective/google-readonly/internal/drive"
+	"github.com/open-cli-collective/google-readonly/internal/format"
 )
 
 func newDrivesCommand() *cobra.Command {
@@ -99,7 +100,9 @@ Examples:
 // printSharedDrives prints shared drives in a formatted table
 func printSharedDrives(drives []*drive.SharedDrive) {
 	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
-	_, _ = fmt.Fprintln(w, "ID\tNAME")
+	if !format.NoHeaders {
+		_, _ = fmt.Fprintln(w, "ID\tNAME")
+	}
 
 	for _, d := range drives {
 		_, _ = fmt.Fprintf(w, "%s\t%s\n", d.ID, d.Name)
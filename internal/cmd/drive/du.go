@@ -0,0 +1,152 @@
+package drive
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+
+	"github.com/spf13/cobra"
+
+	"github.com/open-cli-collective/google-readonly/internal/drive"
+	"github.com/open-cli-collective/google-readonly/internal/format"
+	"github.com/open-cli-collective/google-readonly/internal/table"
+)
+
+// diskUsage is the accumulated size of a file or folder subtree
+type diskUsage struct {
+	Name     string
+	IsFolder bool
+	Size     int64
+	Files    int // files that report a size
+	NoSize   int // Google Workspace files, which report no size
+}
+
+func newDuCommand() *cobra.Command {
+	var depth int
+
+	cmd := &cobra.Command{
+		Use:   "du [folder-id]",
+		Short: "Show total size of a folder tree",
+		Long: `Show the total size of a folder and each of its immediate children.
+
+Sizes are summed recursively and children are sorted largest first. Google
+Workspace files (Docs, Sheets, Slides) report no size; they are listed with a
+"(no size)" note and counted separately. Shortcuts are ignored.
+
+Examples:
+  gro drive du                    # My Drive root
+  gro drive du <folder-id>        # Specific folder
+  gro drive du <folder-id> -d 2   # Only count two levels deep`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, err := newDriveClient(cmd.Context())
+			if err != nil {
+				return fmt.Errorf("creating Drive client: %w", err)
+			}
+
+			folderID := "root"
+			if len(args) > 0 {
+				folderID = args[0]
+			}
+
+			treeDepth := depth
+			if treeDepth <= 0 {
+				treeDepth = math.MaxInt
+			}
+
+			tree, err := buildTree(cmd.Context(), client, folderID, treeDepth, true)
+			if err != nil {
+				return fmt.Errorf("building folder tree: %w", err)
+			}
+
+			printDiskUsage(tree)
+			return nil
+		},
+	}
+
+	cmd.Flags().IntVarP(&depth, "depth", "d", 0, "Maximum folder depth to count (0 for no limit)")
+
+	return cmd
+}
+
+// usageOf sums the size of a tree node and everything below it
+func usageOf(node *TreeNode) diskUsage {
+	u := diskUsage{Name: node.Name, IsFolder: node.MimeType == drive.MimeTypeFolder}
+
+	switch {
+	case u.IsFolder:
+		for _, child := range node.Children {
+			c := usageOf(child)
+			u.Size += c.Size
+			u.Files += c.Files
+			u.NoSize += c.NoSize
+		}
+	case node.MimeType == drive.MimeTypeShortcut:
+		// Shortcuts take no storage of their own
+	case drive.IsGoogleWorkspaceFile(node.MimeType):
+		u.NoSize = 1
+	default:
+		u.Size = node.Size
+		u.Files = 1
+	}
+
+	return u
+}
+
+// childUsage returns the usage of each immediate child, largest first
+func childUsage(tree *TreeNode) []diskUsage {
+	var usages []diskUsage
+	for _, child := range tree.Children {
+		if child.MimeType == drive.MimeTypeShortcut {
+			continue
+		}
+		usages = append(usages, usageOf(child))
+	}
+
+	sort.SliceStable(usages, func(i, j int) bool {
+		if usages[i].Size != usages[j].Size {
+			return usages[i].Size > usages[j].Size
+		}
+		return usages[i].Name < usages[j].Name
+	})
+	return usages
+}
+
+// printDiskUsage prints per-child totals and a grand total for a folder tree
+func printDiskUsage(tree *TreeNode) {
+	tbl := table.New(
+		table.Column{Header: "SIZE", Align: table.Right},
+		table.Column{Header: "FILES", Align: table.Right},
+		table.Column{Header: "NAME", Shrink: true},
+	)
+
+	for _, u := range childUsage(tree) {
+		name := u.Name
+		note := ""
+		switch {
+		case u.IsFolder:
+			name += "/"
+			if u.NoSize > 0 {
+				note = fmt.Sprintf(" (+%d with no size)", u.NoSize)
+			}
+		case u.NoSize > 0:
+			note = " (no size)"
+		}
+
+		size := "-"
+		if u.Files > 0 {
+			size = format.Size(u.Size)
+		}
+
+		tbl.AddRow(size, strconv.Itoa(u.Files), name+note)
+	}
+	tbl.Print()
+
+	total := usageOf(tree)
+	fmt.Printf("\nTotal: %s in %d file(s)", format.Size(total.Size), total.Files)
+	if total.NoSize > 0 {
+		fmt.Printf(", plus %d Google Workspace file(s) with no size", total.NoSize)
+	}
+	fmt.Println()
+}
@@ -0,0 +1,143 @@
+package drive
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	driveapi "github.com/open-cli-collective/google-readonly/internal/drive"
+	"github.com/open-cli-collective/google-readonly/internal/testutil"
+)
+
+func TestDuCommand(t *testing.T) {
+	cmd := newDuCommand()
+
+	t.Run("has correct use", func(t *testing.T) {
+		testutil.Equal(t, cmd.Use, "du [folder-id]")
+	})
+
+	t.Run("accepts at most one argument", func(t *testing.T) {
+		testutil.NoError(t, cmd.Args(cmd, []string{}))
+		testutil.NoError(t, cmd.Args(cmd, []string{"folder-id"}))
+		testutil.Error(t, cmd.Args(cmd, []string{"a", "b"}))
+	})
+
+	t.Run("has depth flag", func(t *testing.T) {
+		flag := cmd.Flags().Lookup("depth")
+		testutil.NotNil(t, flag)
+		testutil.Equal(t, flag.Shorthand, "d")
+		testutil.Equal(t, flag.DefValue, "0")
+	})
+}
+
+func TestUsageOf(t *testing.T) {
+	tree := &TreeNode{
+		Name:     "Projects",
+		MimeType: driveapi.MimeTypeFolder,
+		Children: []*TreeNode{
+			{Name: "a.pdf", MimeType: "application/pdf", Size: 1000},
+			{Name: "Plan", MimeType: driveapi.MimeTypeDocument},
+			{Name: "Link", MimeType: driveapi.MimeTypeShortcut},
+			{
+				Name:     "Sub",
+				MimeType: driveapi.MimeTypeFolder,
+				Children: []*TreeNode{
+					{Name: "b.bin", MimeType: "application/octet-stream", Size: 500},
+					{Name: "Sheet", MimeType: driveapi.MimeTypeSpreadsheet},
+				},
+			},
+		},
+	}
+
+	u := usageOf(tree)
+	testutil.Equal(t, u.Size, int64(1500))
+	testutil.Equal(t, u.Files, 2)
+	testutil.Equal(t, u.NoSize, 2)
+
+	children := childUsage(tree)
+	testutil.Len(t, children, 3)
+	testutil.Equal(t, children[0].Name, "a.pdf")
+	testutil.Equal(t, children[1].Name, "Sub")
+	testutil.Equal(t, children[2].Name, "Plan")
+}
+
+func TestDuCommand_Success(t *testing.T) {
+	files := map[string]*driveapi.File{
+		"folder_root": {ID: "folder_root", Name: "Projects", MimeType: driveapi.MimeTypeFolder},
+		"folder_sub":  {ID: "folder_sub", Name: "Archive", MimeType: driveapi.MimeTypeFolder},
+	}
+	children := map[string][]*driveapi.File{
+		"folder_root": {
+			files["folder_sub"],
+			{ID: "file_small", Name: "small.txt", MimeType: "text/plain", Size: 100},
+			{ID: "file_doc", Name: "Plan", MimeType: driveapi.MimeTypeDocument},
+		},
+		"folder_sub": {
+			{ID: "file_big", Name: "big.zip", MimeType: "application/zip", Size: 3 * 1024 * 1024},
+			{ID: "file_sheet", Name: "Budget", MimeType: driveapi.MimeTypeSpreadsheet},
+		},
+	}
+
+	cmd := newDuCommand()
+	cmd.SetArgs([]string{"folder_root"})
+
+	withMockClient(folderMock(files, children), func() {
+		output := testutil.CaptureStdout(t, func() {
+			err := cmd.Execute()
+			testutil.NoError(t, err)
+		})
+
+		lines := strings.Split(output, "\n")
+		testutil.Contains(t, lines[1], "3.0 MB")
+		testutil.Contains(t, lines[1], "Archive/ (+1 with no size)")
+		testutil.Contains(t, lines[2], "small.txt")
+		testutil.Contains(t, lines[3], "Plan (no size)")
+		testutil.Contains(t, output, "Total: 3.0 MB in 2 file(s), plus 2 Google Workspace file(s) with no size")
+	})
+}
+
+func TestDuCommand_Depth(t *testing.T) {
+	files := map[string]*driveapi.File{
+		"folder_root": {ID: "folder_root", Name: "Projects", MimeType: driveapi.MimeTypeFolder},
+		"folder_sub":  {ID: "folder_sub", Name: "Archive", MimeType: driveapi.MimeTypeFolder},
+	}
+	children := map[string][]*driveapi.File{
+		"folder_root": {
+			files["folder_sub"],
+			{ID: "file_small", Name: "small.txt", MimeType: "text/plain", Size: 100},
+		},
+		"folder_sub": {
+			{ID: "file_big", Name: "big.zip", MimeType: "application/zip", Size: 3 * 1024 * 1024},
+		},
+	}
+
+	cmd := newDuCommand()
+	cmd.SetArgs([]string{"folder_root", "--depth", "1"})
+
+	withMockClient(folderMock(files, children), func() {
+		output := testutil.CaptureStdout(t, func() {
+			err := cmd.Execute()
+			testutil.NoError(t, err)
+		})
+
+		testutil.Contains(t, output, "Total: 100 B in 1 file(s)")
+	})
+}
+
+func TestDuCommand_APIError(t *testing.T) {
+	mock := &MockDriveClient{
+		GetFileFunc: func(_ context.Context, _ string) (*driveapi.File, error) {
+			return nil, errors.New("API error")
+		},
+	}
+
+	cmd := newDuCommand()
+	cmd.SetArgs([]string{"folder_root"})
+
+	withMockClient(mock, func() {
+		err := cmd.Execute()
+		testutil.Error(t, err)
+		testutil.Contains(t, err.Error(), "building folder tree")
+	})
+}
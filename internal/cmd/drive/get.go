@@ -1,7 +1,10 @@
 package drive
 
 import (
+	"context"
 	"fmt"
+	"sort"
+	"strconv"
 	"strings"
 
 	"github.com/spf13/cobra"
@@ -11,38 +14,97 @@ import (
 )
 
 func newGetCommand() *cobra.Command {
+	var (
+		path           string
+		revisionsCount bool
+		allMetadata    bool
+		links          bool
+	)
+
 	cmd := &cobra.Command{
-		Use:   "get <file-id>",
+		Use:   "get [file-id]",
 		Short: "Get file details",
 		Long: `Get detailed metadata for a specific file in Google Drive.
 
+--all-metadata requests every field Drive has for the file (fields=*) and
+prints each one as a dotted path and value, including fields the default
+view leaves out such as capabilities and exportLinks.
+
+--links prints only the file's URLs, one per line: the view link, the
+direct download link (regular files) and an export link per format
+(Google Workspace files). Nothing is downloaded.
+
 Examples:
-  gro drive get <file-id>        # Show file details`,
-		Args: cobra.ExactArgs(1),
+  gro drive get <file-id>                      # Show file details
+  gro drive get --path "/Projects/plan.docx"   # Look up by My Drive path
+  gro drive get <file-id> --revisions-count    # Include number of revisions
+  gro drive get <file-id> --all-metadata       # Every field Drive returns
+  gro drive get <file-id> --links              # View, download and export URLs`,
+		Args: cobra.MaximumNArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
+			if allMetadata && revisionsCount {
+				return fmt.Errorf("--all-metadata cannot be combined with --revisions-count")
+			}
+			if links && (allMetadata || revisionsCount) {
+				return fmt.Errorf("--links cannot be combined with --all-metadata or --revisions-count")
+			}
+
 			client, err := newDriveClient(cmd.Context())
 			if err != nil {
 				return fmt.Errorf("creating Drive client: %w", err)
 			}
 
-			fileID := args[0]
+			fileID, err := fileIDFromArgs(cmd.Context(), client, args, path)
+			if err != nil {
+				return err
+			}
+
+			if allMetadata {
+				meta, err := client.GetFileMetadata(cmd.Context(), fileID)
+				if err != nil {
+					return fmt.Errorf("getting metadata for %s: %w", fileID, err)
+				}
+				printAllMetadata(meta)
+				return nil
+			}
+
 			file, err := client.GetFile(cmd.Context(), fileID)
 			if err != nil {
 				return fmt.Errorf("getting file %s: %w", fileID, err)
 			}
 
+			if links {
+				printFileLinks(file)
+				return nil
+			}
+
 			printFileDetails(file)
+
+			if revisionsCount {
+				count, err := countRevisions(cmd.Context(), client, file)
+				if err != nil {
+					return err
+				}
+				fmt.Printf("Revisions:  %s\n", count)
+			}
 			return nil
 		},
 	}
 
+	cmd.Flags().StringVar(&path, "path", "", "Resolve the file by My Drive path instead of ID")
+	cmd.Flags().BoolVar(&revisionsCount, "revisions-count", false, "Show the number of revisions")
+	cmd.Flags().BoolVar(&allMetadata, "all-metadata", false, "Show every metadata field Drive returns (fields=*)")
+	cmd.Flags().BoolVar(&links, "links", false, "Show only the view, download and export links")
+
 	return cmd
 }
 
 // printFileDetails prints detailed file metadata in a formatted layout
 func printFileDetails(f *drive.File) {
 	fmt.Println("File Details")
-	fmt.Println("────────────────────────────────────────")
+	if !format.Plain {
+		fmt.Println("────────────────────────────────────────")
+	}
 
 	fmt.Printf("ID:         %s\n", f.ID)
 	fmt.Printf("Name:       %s\n", f.Name)
@@ -62,6 +124,14 @@ func printFileDetails(f *drive.File) {
 		fmt.Printf("Modified:   %s\n", f.ModifiedTime.Format("2006-01-02 15:04:05"))
 	}
 
+	if f.LastModifiedBy != "" {
+		fmt.Printf("Changed by: %s\n", f.LastModifiedBy)
+	}
+
+	if f.MD5 != "" {
+		fmt.Printf("MD5:        %s\n", f.MD5)
+	}
+
 	if len(f.Owners) > 0 {
 		fmt.Printf("Owner:      %s\n", strings.Join(f.Owners, ", "))
 	}
@@ -80,3 +150,96 @@ func printFileDetails(f *drive.File) {
 		fmt.Printf("Parent:     %s\n", strings.Join(f.Parents, ", "))
 	}
 }
+
+// printFileLinks prints the links Drive has for a file. Export links are
+// keyed by MIME type and printed in MIME type order.
+func printFileLinks(f *drive.File) {
+	if f.WebViewLink == "" && f.WebContentLink == "" && len(f.ExportLinks) == 0 {
+		fmt.Println("No links available.")
+		return
+	}
+
+	if f.WebViewLink != "" {
+		fmt.Printf("View:      %s\n", f.WebViewLink)
+	}
+	if f.WebContentLink != "" {
+		fmt.Printf("Download:  %s\n", f.WebContentLink)
+	}
+
+	mimeTypes := make([]string, 0, len(f.ExportLinks))
+	for m := range f.ExportLinks {
+		mimeTypes = append(mimeTypes, m)
+	}
+	sort.Strings(mimeTypes)
+	for _, m := range mimeTypes {
+		fmt.Printf("Export:    %s  %s\n", m, f.ExportLinks[m])
+	}
+}
+
+// printAllMetadata prints raw Drive metadata as one "path  value" line per
+// leaf, sorted by path. Nested objects use dotted paths and arrays use
+// [i] indexes, so nothing the API returned is dropped.
+func printAllMetadata(meta map[string]any) {
+	fields := map[string]string{}
+	flattenMetadata("", meta, fields)
+
+	paths := make([]string, 0, len(fields))
+	width := 0
+	for p := range fields {
+		paths = append(paths, p)
+		width = max(width, len(p))
+	}
+	sort.Strings(paths)
+
+	for _, p := range paths {
+		fmt.Printf("%-*s  %s\n", width, p, fields[p])
+	}
+}
+
+// flattenMetadata walks v and records each leaf under its path in out.
+// Empty objects and arrays are kept as {} and [] so their presence shows.
+func flattenMetadata(path string, v any, out map[string]string) {
+	switch val := v.(type) {
+	case map[string]any:
+		if len(val) == 0 && path != "" {
+			out[path] = "{}"
+		}
+		for k, child := range val {
+			if path != "" {
+				k = path + "." + k
+			}
+			flattenMetadata(k, child, out)
+		}
+	case []any:
+		if len(val) == 0 {
+			out[path] = "[]"
+		}
+		for i, child := range val {
+			flattenMetadata(fmt.Sprintf("%s[%d]", path, i), child, out)
+		}
+	case string:
+		out[path] = strings.ReplaceAll(val, "\n", `\n`)
+	case nil:
+		out[path] = "null"
+	default:
+		out[path] = fmt.Sprint(val)
+	}
+}
+
+// countRevisions returns the file's revision count for display, or "-" for
+// files that have no revision history (folders, shortcuts, and anything the
+// API reports as unsupported).
+func countRevisions(ctx context.Context, client DriveClient, f *drive.File) (string, error) {
+	if f.MimeType == drive.MimeTypeFolder || f.MimeType == drive.MimeTypeShortcut {
+		return "-", nil
+	}
+
+	revisions, err := client.ListRevisions(ctx, f.ID)
+	if err != nil {
+		if drive.IsRevisionsNotSupported(err) {
+			return "-", nil
+		}
+		return "", fmt.Errorf("listing revisions: %w", err)
+	}
+	return strconv.Itoa(len(revisions)), nil
+}
@@ -1,6 +1,7 @@
 package drive
 
 import (
+	"encoding/json"
 	"testing"
 	"time"
 
@@ -12,12 +13,13 @@ func TestGetCommand(t *testing.T) {
 	cmd := newGetCommand()
 
 	t.Run("has correct use", func(t *testing.T) {
-		testutil.Equal(t, cmd.Use, "get <file-id>")
+		testutil.Equal(t, cmd.Use, "get [file-id]")
 	})
 
-	t.Run("requires exactly one argument", func(t *testing.T) {
+	t.Run("accepts at most one argument", func(t *testing.T) {
+		// Zero args is allowed at parse time; --path supplies the file instead.
 		err := cmd.Args(cmd, []string{})
-		testutil.Error(t, err)
+		testutil.NoError(t, err)
 
 		err = cmd.Args(cmd, []string{"file-id"})
 		testutil.NoError(t, err)
@@ -29,6 +31,61 @@ func TestGetCommand(t *testing.T) {
 	t.Run("has short description", func(t *testing.T) {
 		testutil.Contains(t, cmd.Short, "Get")
 	})
+
+	t.Run("has path flag", func(t *testing.T) {
+		testutil.NotNil(t, cmd.Flags().Lookup("path"))
+	})
+
+	t.Run("has revisions-count flag", func(t *testing.T) {
+		flag := cmd.Flags().Lookup("revisions-count")
+		testutil.NotNil(t, flag)
+		testutil.Equal(t, flag.DefValue, "false")
+	})
+
+	t.Run("has all-metadata flag", func(t *testing.T) {
+		flag := cmd.Flags().Lookup("all-metadata")
+		testutil.NotNil(t, flag)
+		testutil.Equal(t, flag.DefValue, "false")
+	})
+
+	t.Run("has links flag", func(t *testing.T) {
+		flag := cmd.Flags().Lookup("links")
+		testutil.NotNil(t, flag)
+		testutil.Equal(t, flag.DefValue, "false")
+	})
+}
+
+func TestPrintAllMetadata(t *testing.T) {
+	meta := map[string]any{
+		"id":            "abc123",
+		"description":   "line one\nline two",
+		"starred":       false,
+		"size":          json.Number("2048"),
+		"parents":       []any{"p1", "p2"},
+		"properties":    map[string]any{},
+		"permissionIds": []any{},
+		"owners": []any{
+			map[string]any{"emailAddress": "owner@example.com", "me": true},
+		},
+		"trashingUser": nil,
+	}
+
+	output := testutil.CaptureStdout(t, func() {
+		printAllMetadata(meta)
+	})
+
+	testutil.Equal(t, output, `description             line one\nline two
+id                      abc123
+owners[0].emailAddress  owner@example.com
+owners[0].me            true
+parents[0]              p1
+parents[1]              p2
+permissionIds           []
+properties              {}
+size                    2048
+starred                 false
+trashingUser            null
+`)
 }
 
 func TestPrintFileDetails(t *testing.T) {
@@ -48,6 +105,8 @@ func TestPrintFileDetails(t *testing.T) {
 			Shared:       true,
 			WebViewLink:  "https://docs.google.com/document/d/abc123/edit",
 			Parents:      []string{"parent123"},
+
+			LastModifiedBy: "editor@example.com",
 		}
 
 		output := captureOutput(func() {
@@ -61,6 +120,7 @@ func TestPrintFileDetails(t *testing.T) {
 		testutil.Contains(t, output, "Size:       -")
 		testutil.Contains(t, output, "Created:    2024-01-10 09:30:00")
 		testutil.Contains(t, output, "Modified:   2024-01-15 14:22:00")
+		testutil.Contains(t, output, "Changed by: editor@example.com")
 		testutil.Contains(t, output, "Owner:      owner@example.com")
 		testutil.Contains(t, output, "Shared:     Yes")
 		testutil.Contains(t, output, "Web Link:   https://docs.google.com/document/d/abc123/edit")
@@ -1,12 +1,20 @@
 package drive
 
 import (
+	"bytes"
 	"context"
 	"errors"
+	"fmt"
+	"io"
 	"os"
+	"path/filepath"
+	"strings"
 	"testing"
 
+	"google.golang.org/api/googleapi"
+
 	driveapi "github.com/open-cli-collective/google-readonly/internal/drive"
+	"github.com/open-cli-collective/google-readonly/internal/format"
 	"github.com/open-cli-collective/google-readonly/internal/testutil"
 )
 
@@ -45,6 +53,85 @@ func TestListCommand_Success(t *testing.T) {
 	})
 }
 
+// withPlainOutput sets the --plain globals for the duration of a test
+func withPlainOutput(t *testing.T) {
+	t.Helper()
+	t.Cleanup(func() {
+		format.Plain = false
+		format.NoHeaders = false
+	})
+	format.Plain = true
+	format.NoHeaders = true
+}
+
+func TestListCommand_Plain(t *testing.T) {
+	withPlainOutput(t)
+
+	mock := &MockDriveClient{
+		ListFilesFunc: func(_ context.Context, _ string, _ int64) ([]*driveapi.File, error) {
+			return testutil.SampleDriveFiles(2), nil
+		},
+	}
+
+	cmd := newListCommand()
+
+	withMockClient(mock, func() {
+		output := testutil.CaptureStdout(t, func() {
+			err := cmd.Execute()
+			testutil.NoError(t, err)
+		})
+
+		testutil.Contains(t, output, "file_a")
+		testutil.NotContains(t, output, "NAME")
+		testutil.NotContains(t, output, "\x1b[")
+		testutil.True(t, strings.HasPrefix(output, "file_a"))
+	})
+}
+
+func TestListCommand_IDsOutput(t *testing.T) {
+	mock := &MockDriveClient{
+		ListFilesFunc: func(_ context.Context, _ string, _ int64) ([]*driveapi.File, error) {
+			return testutil.SampleDriveFiles(2), nil
+		},
+	}
+
+	cmd := newListCommand()
+	cmd.SetArgs([]string{"--ids"})
+
+	withMockClient(mock, func() {
+		output := testutil.CaptureStdout(t, func() {
+			err := cmd.Execute()
+			testutil.NoError(t, err)
+		})
+
+		testutil.Equal(t, output, "file_a\nfile_b\n")
+	})
+}
+
+func TestListCommand_ChangedBy(t *testing.T) {
+	mock := &MockDriveClient{
+		ListFilesFunc: func(_ context.Context, _ string, _ int64) ([]*driveapi.File, error) {
+			files := testutil.SampleDriveFiles(3)
+			files[0].LastModifiedBy = "alice@example.com"
+			files[1].LastModifiedBy = "bob@example.com"
+			files[2].LastModifiedBy = "alice@example.com"
+			return files, nil
+		},
+	}
+
+	cmd := newListCommand()
+	cmd.SetArgs([]string{"--changed-by", "alice@example.com", "--ids"})
+
+	withMockClient(mock, func() {
+		output := testutil.CaptureStdout(t, func() {
+			err := cmd.Execute()
+			testutil.NoError(t, err)
+		})
+
+		testutil.Equal(t, output, "file_a\nfile_c\n")
+	})
+}
+
 func TestListCommand_Empty(t *testing.T) {
 	mock := &MockDriveClient{
 		ListFilesFunc: func(_ context.Context, _ string, _ int64) ([]*driveapi.File, error) {
@@ -106,6 +193,41 @@ func TestListCommand_WithTypeFilter(t *testing.T) {
 	})
 }
 
+func TestListCommand_Sort(t *testing.T) {
+	mock := &MockDriveClient{
+		ListFilesFunc: func(_ context.Context, _ string, _ int64) ([]*driveapi.File, error) {
+			files := testutil.SampleDriveFiles(3)
+			files[0].Size = 20
+			files[1].Size = 30
+			files[2].Size = 10
+			return files, nil
+		},
+	}
+
+	cmd := newListCommand()
+	cmd.SetArgs([]string{"--sort", "size", "--reverse", "--ids"})
+
+	withMockClient(mock, func() {
+		output := testutil.CaptureStdout(t, func() {
+			err := cmd.Execute()
+			testutil.NoError(t, err)
+		})
+
+		testutil.Equal(t, output, "file_b\nfile_a\nfile_c\n")
+	})
+}
+
+func TestListCommand_InvalidSort(t *testing.T) {
+	cmd := newListCommand()
+	cmd.SetArgs([]string{"--sort", "owner"})
+
+	withMockClient(&MockDriveClient{}, func() {
+		err := cmd.Execute()
+		testutil.Error(t, err)
+		testutil.Contains(t, err.Error(), `invalid --sort "owner" (valid: name, modified, size, created)`)
+	})
+}
+
 func TestListCommand_InvalidType(t *testing.T) {
 	cmd := newListCommand()
 	cmd.SetArgs([]string{"--type", "invalid"})
@@ -165,6 +287,27 @@ func TestSearchCommand_Success(t *testing.T) {
 	})
 }
 
+func TestSearchCommand_IDsOutput(t *testing.T) {
+	mock := &MockDriveClient{
+		ListFilesFunc: func(_ context.Context, _ string, _ int64) ([]*driveapi.File, error) {
+			return testutil.SampleDriveFiles(2), nil
+		},
+	}
+
+	cmd := newSearchCommand()
+	cmd.SetArgs([]string{"report", "--ids"})
+
+	withMockClient(mock, func() {
+		output := testutil.CaptureStdout(t, func() {
+			err := cmd.Execute()
+			testutil.NoError(t, err)
+		})
+
+		testutil.Equal(t, output, "file_a\nfile_b\n")
+		testutil.NotContains(t, output, "NAME")
+	})
+}
+
 func TestSearchCommand_NameOnly(t *testing.T) {
 	mock := &MockDriveClient{
 		ListFilesFunc: func(_ context.Context, query string, _ int64) ([]*driveapi.File, error) {
@@ -186,6 +329,43 @@ func TestSearchCommand_NameOnly(t *testing.T) {
 	})
 }
 
+func TestSearchCommand_InTrashAndLive(t *testing.T) {
+	mock := &MockDriveClient{
+		ListFilesFunc: func(_ context.Context, query string, _ int64) ([]*driveapi.File, error) {
+			testutil.NotContains(t, query, "trashed")
+			testutil.Contains(t, query, "fullText contains 'report'")
+			live := testutil.SampleDriveFile("live_file")
+			trashed := testutil.SampleDriveFile("trashed_file")
+			trashed.Trashed = true
+			return []*driveapi.File{live, trashed}, nil
+		},
+	}
+
+	cmd := newSearchCommand()
+	cmd.SetArgs([]string{"report", "--in-trash-and-live"})
+
+	withMockClient(mock, func() {
+		output := testutil.CaptureStdout(t, func() {
+			err := cmd.Execute()
+			testutil.NoError(t, err)
+		})
+
+		testutil.Contains(t, output, "STATE")
+		lines := strings.Split(strings.TrimSpace(output), "\n")
+		var liveRow, trashedRow string
+		for _, line := range lines {
+			switch {
+			case strings.HasPrefix(line, "live_file"):
+				liveRow = line
+			case strings.HasPrefix(line, "trashed_file"):
+				trashedRow = line
+			}
+		}
+		testutil.True(t, strings.HasSuffix(liveRow, "live"))
+		testutil.True(t, strings.HasSuffix(trashedRow, "trashed"))
+	})
+}
+
 func TestSearchCommand_NoResults(t *testing.T) {
 	mock := &MockDriveClient{
 		ListFilesFunc: func(_ context.Context, _ string, _ int64) ([]*driveapi.File, error) {
@@ -263,6 +443,100 @@ func TestGetCommand_NotFound(t *testing.T) {
 	})
 }
 
+func TestGetCommand_RevisionsCount(t *testing.T) {
+	mock := &MockDriveClient{
+		GetFileFunc: func(_ context.Context, fileID string) (*driveapi.File, error) {
+			return testutil.SampleDriveFile(fileID), nil
+		},
+		ListRevisionsFunc: func(_ context.Context, fileID string) ([]*driveapi.Revision, error) {
+			testutil.Equal(t, fileID, "file123")
+			return []*driveapi.Revision{{ID: "r1"}, {ID: "r2"}, {ID: "r3"}}, nil
+		},
+	}
+
+	cmd := newGetCommand()
+	cmd.SetArgs([]string{"file123", "--revisions-count"})
+
+	withMockClient(mock, func() {
+		output := testutil.CaptureStdout(t, func() {
+			err := cmd.Execute()
+			testutil.NoError(t, err)
+		})
+
+		testutil.Contains(t, output, "Revisions:  3")
+	})
+}
+
+func TestGetCommand_RevisionsCountUnsupported(t *testing.T) {
+	mock := &MockDriveClient{
+		GetFileFunc: func(_ context.Context, fileID string) (*driveapi.File, error) {
+			return testutil.SampleDriveFile(fileID), nil
+		},
+		ListRevisionsFunc: func(_ context.Context, _ string) ([]*driveapi.Revision, error) {
+			return nil, fmt.Errorf("listing revisions: %w", &googleapi.Error{
+				Code:   403,
+				Errors: []googleapi.ErrorItem{{Reason: "revisionsNotSupported"}},
+			})
+		},
+	}
+
+	cmd := newGetCommand()
+	cmd.SetArgs([]string{"file123", "--revisions-count"})
+
+	withMockClient(mock, func() {
+		output := testutil.CaptureStdout(t, func() {
+			err := cmd.Execute()
+			testutil.NoError(t, err)
+		})
+
+		testutil.Contains(t, output, "Revisions:  -")
+	})
+}
+
+func TestGetCommand_RevisionsCountFolder(t *testing.T) {
+	mock := &MockDriveClient{
+		GetFileFunc: func(_ context.Context, fileID string) (*driveapi.File, error) {
+			return &driveapi.File{ID: fileID, Name: "Projects", MimeType: driveapi.MimeTypeFolder}, nil
+		},
+		ListRevisionsFunc: func(_ context.Context, _ string) ([]*driveapi.Revision, error) {
+			t.Error("ListRevisions should not be called for folders")
+			return nil, nil
+		},
+	}
+
+	cmd := newGetCommand()
+	cmd.SetArgs([]string{"folder123", "--revisions-count"})
+
+	withMockClient(mock, func() {
+		output := testutil.CaptureStdout(t, func() {
+			err := cmd.Execute()
+			testutil.NoError(t, err)
+		})
+
+		testutil.Contains(t, output, "Revisions:  -")
+	})
+}
+
+func TestGetCommand_RevisionsCountAPIError(t *testing.T) {
+	mock := &MockDriveClient{
+		GetFileFunc: func(_ context.Context, fileID string) (*driveapi.File, error) {
+			return testutil.SampleDriveFile(fileID), nil
+		},
+		ListRevisionsFunc: func(_ context.Context, _ string) ([]*driveapi.Revision, error) {
+			return nil, errors.New("API error")
+		},
+	}
+
+	cmd := newGetCommand()
+	cmd.SetArgs([]string{"file123", "--revisions-count"})
+
+	withMockClient(mock, func() {
+		err := cmd.Execute()
+		testutil.Error(t, err)
+		testutil.Contains(t, err.Error(), "listing revisions")
+	})
+}
+
 func TestDownloadCommand_RegularFile(t *testing.T) {
 	// Create a temp directory for download
 	tmpDir := t.TempDir()
@@ -294,6 +568,167 @@ func TestDownloadCommand_RegularFile(t *testing.T) {
 	})
 }
 
+func TestDownloadCommand_StreamsToFile(t *testing.T) {
+	outputPath := filepath.Join(t.TempDir(), "large.bin")
+	payload := bytes.Repeat([]byte("x"), 3*1024*1024)
+
+	mock := &MockDriveClient{
+		GetFileFunc: func(_ context.Context, _ string) (*driveapi.File, error) {
+			return testutil.SampleDriveFile("file123"), nil
+		},
+		DownloadFileToFunc: func(_ context.Context, fileID string, w io.Writer) (int64, error) {
+			testutil.Equal(t, fileID, "file123")
+			return io.Copy(w, bytes.NewReader(payload))
+		},
+	}
+
+	cmd := newDownloadCommand()
+	cmd.SetArgs([]string{"file123", "--output", outputPath})
+
+	withMockClient(mock, func() {
+		output := testutil.CaptureStdout(t, func() {
+			err := cmd.Execute()
+			testutil.NoError(t, err)
+		})
+
+		testutil.Contains(t, output, "Size: 3.0 MB")
+	})
+
+	info, err := os.Stat(outputPath)
+	testutil.NoError(t, err)
+	testutil.Equal(t, info.Size(), int64(len(payload)))
+}
+
+func TestDownloadCommand_StreamFailureRemovesPartialFile(t *testing.T) {
+	outputPath := filepath.Join(t.TempDir(), "partial.bin")
+
+	mock := &MockDriveClient{
+		GetFileFunc: func(_ context.Context, _ string) (*driveapi.File, error) {
+			return testutil.SampleDriveFile("file123"), nil
+		},
+		DownloadFileToFunc: func(_ context.Context, _ string, w io.Writer) (int64, error) {
+			n, _ := w.Write([]byte("half"))
+			return int64(n), errors.New("connection reset")
+		},
+	}
+
+	cmd := newDownloadCommand()
+	cmd.SetArgs([]string{"file123", "--output", outputPath})
+
+	withMockClient(mock, func() {
+		testutil.CaptureStdout(t, func() {
+			err := cmd.Execute()
+			testutil.Error(t, err)
+			testutil.Contains(t, err.Error(), "downloading file")
+		})
+	})
+
+	_, err := os.Stat(outputPath)
+	testutil.True(t, os.IsNotExist(err))
+}
+
+// verifyMock serves a regular file whose Drive checksum is md5 and whose
+// content is content
+func verifyMock(md5, content string) *MockDriveClient {
+	return &MockDriveClient{
+		GetFileFunc: func(_ context.Context, fileID string) (*driveapi.File, error) {
+			f := testutil.SampleDriveFile(fileID)
+			f.MD5 = md5
+			return f, nil
+		},
+		DownloadFileFunc: func(_ context.Context, _ string) ([]byte, error) {
+			return []byte(content), nil
+		},
+	}
+}
+
+func TestDownloadCommand_Verify(t *testing.T) {
+	// MD5 of "test content"
+	const sum = "9473fdd0d880a43c21b7778d34872157"
+
+	t.Run("matching checksum", func(t *testing.T) {
+		outputPath := filepath.Join(t.TempDir(), "out.pdf")
+		cmd := newDownloadCommand()
+		cmd.SetArgs([]string{"file123", "--verify", "--output", outputPath})
+
+		withMockClient(verifyMock(sum, "test content"), func() {
+			output := testutil.CaptureStdout(t, func() {
+				err := cmd.Execute()
+				testutil.NoError(t, err)
+			})
+
+			testutil.Contains(t, output, "Verified: MD5 "+sum)
+		})
+
+		_, err := os.Stat(outputPath)
+		testutil.NoError(t, err)
+	})
+
+	t.Run("mismatch removes the file", func(t *testing.T) {
+		outputPath := filepath.Join(t.TempDir(), "out.pdf")
+		cmd := newDownloadCommand()
+		cmd.SetArgs([]string{"file123", "--verify", "--output", outputPath})
+
+		withMockClient(verifyMock(sum, "tampered content"), func() {
+			testutil.CaptureStdout(t, func() {
+				err := cmd.Execute()
+				testutil.Error(t, err)
+				testutil.Contains(t, err.Error(), "checksum mismatch")
+				testutil.Contains(t, err.Error(), "want "+sum)
+			})
+		})
+
+		_, err := os.Stat(outputPath)
+		testutil.True(t, os.IsNotExist(err))
+	})
+
+	t.Run("no checksum on server", func(t *testing.T) {
+		cmd := newDownloadCommand()
+		cmd.SetArgs([]string{"file123", "--verify", "--stdout"})
+
+		withMockClient(verifyMock("", "test content"), func() {
+			err := cmd.Execute()
+			testutil.Error(t, err)
+			testutil.Contains(t, err.Error(), "no checksum")
+		})
+	})
+
+	t.Run("workspace export is not verified", func(t *testing.T) {
+		mock := &MockDriveClient{
+			GetFileFunc: func(_ context.Context, _ string) (*driveapi.File, error) {
+				return testutil.SampleGoogleDoc("doc123"), nil
+			},
+			ExportFileFunc: func(_ context.Context, _, _ string) ([]byte, error) {
+				return []byte("pdf content"), nil
+			},
+		}
+
+		cmd := newDownloadCommand()
+		cmd.SetArgs([]string{"doc123", "--format", "pdf", "--verify", "--output", filepath.Join(t.TempDir(), "doc.pdf")})
+
+		withMockClient(mock, func() {
+			output := testutil.CaptureStdout(t, func() {
+				err := cmd.Execute()
+				testutil.NoError(t, err)
+			})
+
+			testutil.Contains(t, output, "Saved to")
+			testutil.NotContains(t, output, "Verified")
+		})
+	})
+
+	t.Run("not supported with recursive", func(t *testing.T) {
+		cmd := newDownloadCommand()
+		cmd.SetArgs([]string{"folder1", "--recursive", "--verify"})
+
+		withMockClient(&MockDriveClient{}, func() {
+			err := cmd.Execute()
+			testutil.Error(t, err)
+			testutil.Contains(t, err.Error(), "not supported with --recursive")
+		})
+	})
+}
+
 func TestDownloadCommand_ToStdout(t *testing.T) {
 	mock := &MockDriveClient{
 		GetFileFunc: func(_ context.Context, _ string) (*driveapi.File, error) {
@@ -366,6 +801,56 @@ func TestDownloadCommand_ExportGoogleDoc(t *testing.T) {
 	})
 }
 
+func TestDownloadCommand_ExportOutputExtensionOverride(t *testing.T) {
+	t.Run("explicit extension is kept and the export MIME is unchanged", func(t *testing.T) {
+		outputPath := filepath.Join(t.TempDir(), "report.document")
+		var gotMime string
+		mock := &MockDriveClient{
+			GetFileFunc: func(_ context.Context, _ string) (*driveapi.File, error) {
+				return testutil.SampleGoogleDoc("doc123"), nil
+			},
+			ExportFileFunc: func(_ context.Context, _, mimeType string) ([]byte, error) {
+				gotMime = mimeType
+				return []byte("pdf content"), nil
+			},
+		}
+
+		cmd := newDownloadCommand()
+		cmd.SetArgs([]string{"doc123", "--format", "pdf", "--output", outputPath})
+
+		withMockClient(mock, func() {
+			output := testutil.CaptureStdout(t, func() {
+				err := cmd.Execute()
+				testutil.NoError(t, err)
+			})
+
+			testutil.Contains(t, output, "Saved to: "+outputPath)
+		})
+
+		testutil.Equal(t, gotMime, "application/pdf")
+		data, err := os.ReadFile(outputPath)
+		testutil.NoError(t, err)
+		testutil.Equal(t, string(data), "pdf content")
+	})
+
+	t.Run("unsupported format is still rejected", func(t *testing.T) {
+		mock := &MockDriveClient{
+			GetFileFunc: func(_ context.Context, _ string) (*driveapi.File, error) {
+				return testutil.SampleGoogleDoc("doc123"), nil
+			},
+		}
+
+		cmd := newDownloadCommand()
+		cmd.SetArgs([]string{"doc123", "--format", "xlsx", "--output", filepath.Join(t.TempDir(), "report.pdf")})
+
+		withMockClient(mock, func() {
+			err := cmd.Execute()
+			testutil.Error(t, err)
+			testutil.Contains(t, err.Error(), "getting export type")
+		})
+	})
+}
+
 func TestDownloadCommand_RegularFileCannotUseFormat(t *testing.T) {
 	mock := &MockDriveClient{
 		GetFileFunc: func(_ context.Context, _ string) (*driveapi.File, error) {
@@ -413,3 +898,149 @@ func TestDownloadCommand_ClientCreationError(t *testing.T) {
 		testutil.Contains(t, err.Error(), "creating Drive client")
 	})
 }
+
+func TestGetCommand_AllMetadata(t *testing.T) {
+	mock := &MockDriveClient{
+		GetFileFunc: func(_ context.Context, _ string) (*driveapi.File, error) {
+			t.Error("--all-metadata must not use the simplified GetFile")
+			return nil, nil
+		},
+		GetFileMetadataFunc: func(_ context.Context, fileID string) (map[string]any, error) {
+			testutil.Equal(t, fileID, "doc123")
+			return map[string]any{
+				"id":           "doc123",
+				"name":         "Plan",
+				"capabilities": map[string]any{"canDownload": true, "canEdit": false},
+				"exportLinks":  map[string]any{"application/pdf": "https://docs.google.com/export?id=doc123&format=pdf"},
+			}, nil
+		},
+	}
+
+	cmd := newGetCommand()
+	cmd.SetArgs([]string{"doc123", "--all-metadata"})
+
+	withMockClient(mock, func() {
+		output := testutil.CaptureStdout(t, func() {
+			err := cmd.Execute()
+			testutil.NoError(t, err)
+		})
+
+		testutil.Contains(t, output, "capabilities.canDownload")
+		testutil.Contains(t, output, "capabilities.canEdit")
+		testutil.Contains(t, output, "exportLinks.application/pdf")
+		testutil.Contains(t, output, "https://docs.google.com/export?id=doc123&format=pdf")
+		testutil.NotContains(t, output, "File Details")
+	})
+}
+
+func TestGetCommand_AllMetadataErrors(t *testing.T) {
+	t.Run("API error", func(t *testing.T) {
+		mock := &MockDriveClient{
+			GetFileMetadataFunc: func(_ context.Context, _ string) (map[string]any, error) {
+				return nil, errors.New("not found")
+			},
+		}
+
+		cmd := newGetCommand()
+		cmd.SetArgs([]string{"doc123", "--all-metadata"})
+
+		withMockClient(mock, func() {
+			err := cmd.Execute()
+			testutil.Error(t, err)
+			testutil.Contains(t, err.Error(), "getting metadata for doc123")
+		})
+	})
+
+	t.Run("not combinable with revisions-count", func(t *testing.T) {
+		cmd := newGetCommand()
+		cmd.SetArgs([]string{"doc123", "--all-metadata", "--revisions-count"})
+
+		withMockClient(&MockDriveClient{}, func() {
+			err := cmd.Execute()
+			testutil.Error(t, err)
+			testutil.Contains(t, err.Error(), "cannot be combined")
+		})
+	})
+}
+
+func TestGetCommand_Links(t *testing.T) {
+	t.Run("Workspace file", func(t *testing.T) {
+		mock := &MockDriveClient{
+			GetFileFunc: func(_ context.Context, _ string) (*driveapi.File, error) {
+				doc := testutil.SampleGoogleDoc("doc123")
+				doc.WebViewLink = "https://docs.google.com/document/d/doc123/edit"
+				doc.ExportLinks = map[string]string{
+					"text/plain":      "https://docs.google.com/export?id=doc123&exportFormat=txt",
+					"application/pdf": "https://docs.google.com/export?id=doc123&exportFormat=pdf",
+				}
+				return doc, nil
+			},
+		}
+
+		cmd := newGetCommand()
+		cmd.SetArgs([]string{"doc123", "--links"})
+
+		withMockClient(mock, func() {
+			output := testutil.CaptureStdout(t, func() {
+				testutil.NoError(t, cmd.Execute())
+			})
+
+			testutil.Equal(t, output, "View:      https://docs.google.com/document/d/doc123/edit\n"+
+				"Export:    application/pdf  https://docs.google.com/export?id=doc123&exportFormat=pdf\n"+
+				"Export:    text/plain  https://docs.google.com/export?id=doc123&exportFormat=txt\n")
+		})
+	})
+
+	t.Run("regular file", func(t *testing.T) {
+		mock := &MockDriveClient{
+			GetFileFunc: func(_ context.Context, _ string) (*driveapi.File, error) {
+				f := testutil.SampleDriveFile("file123")
+				f.WebViewLink = "https://drive.google.com/file/d/file123/view"
+				f.WebContentLink = "https://drive.google.com/uc?id=file123&export=download"
+				return f, nil
+			},
+		}
+
+		cmd := newGetCommand()
+		cmd.SetArgs([]string{"file123", "--links"})
+
+		withMockClient(mock, func() {
+			output := testutil.CaptureStdout(t, func() {
+				testutil.NoError(t, cmd.Execute())
+			})
+
+			testutil.Contains(t, output, "Download:  https://drive.google.com/uc?id=file123&export=download")
+			testutil.NotContains(t, output, "Export:")
+			testutil.NotContains(t, output, "File Details")
+		})
+	})
+
+	t.Run("no links", func(t *testing.T) {
+		mock := &MockDriveClient{
+			GetFileFunc: func(_ context.Context, _ string) (*driveapi.File, error) {
+				return &driveapi.File{ID: "file123", Name: "bare"}, nil
+			},
+		}
+
+		cmd := newGetCommand()
+		cmd.SetArgs([]string{"file123", "--links"})
+
+		withMockClient(mock, func() {
+			output := testutil.CaptureStdout(t, func() {
+				testutil.NoError(t, cmd.Execute())
+			})
+			testutil.Equal(t, output, "No links available.\n")
+		})
+	})
+
+	t.Run("not combinable with all-metadata", func(t *testing.T) {
+		cmd := newGetCommand()
+		cmd.SetArgs([]string{"doc123", "--links", "--all-metadata"})
+
+		withMockClient(&MockDriveClient{}, func() {
+			err := cmd.Execute()
+			testutil.Error(t, err)
+			testutil.Contains(t, err.Error(), "--links cannot be combined")
+		})
+	})
+}
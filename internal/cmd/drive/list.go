@@ -1,25 +1,32 @@
 package drive
 
 import (
+	"cmp"
 	"context"
 	"fmt"
-	"os"
+	"slices"
 	"strings"
-	"text/tabwriter"
 
 	"github.com/spf13/cobra"
 
 	"github.com/open-cli-collective/google-readonly/internal/drive"
 	"github.com/open-cli-collective/google-readonly/internal/format"
+	"github.com/open-cli-collective/google-readonly/internal/table"
 )
 
 func newListCommand() *cobra.Command {
 	var (
-		maxResults int64
-		fileType   string
-		idsOutput  bool
-		myDrive    bool
-		driveFlag  string
+		maxResults   int64
+		fileType     string
+		idsOutput    bool
+		myDrive      bool
+		driveFlag    string
+		changedBy    string
+		sortBy       string
+		reverse      bool
+		foldersFirst bool
+		withTrash    bool
+		onlyTrash    bool
 	)
 
 	cmd := &cobra.Command{
@@ -36,6 +43,18 @@ Examples:
   gro drive list --drive "Engineering"  # List files in shared drive root
   gro drive list --type document        # Filter by file type
   gro drive list --max 50               # Limit results
+  gro drive list --changed-by alice@example.com
+  gro drive list --sort modified --reverse  # Newest first
+  gro drive list --sort name --folders-first
+  gro drive list <folder-id> --trashed-only  # What was deleted from a folder
+
+--sort orders the fetched results (up to --max) by name, modified, size or
+created time, ascending; --reverse flips the order. Without --sort, files
+keep the API's order. --folders-first lists folders before files and
+applies to --ids as well.
+
+Trashed files are left out unless --in-trash-and-live or --trashed-only is
+given; either adds a STATE column marking each file live or trashed.
 
 File types: document, spreadsheet, presentation, folder, pdf, image, video, audio`,
 		Args: cobra.MaximumNArgs(1),
@@ -43,6 +62,13 @@ File types: document, spreadsheet, presentation, folder, pdf, image, video, audi
 			if myDrive && driveFlag != "" {
 				return fmt.Errorf("--my-drive and --drive are mutually exclusive")
 			}
+			if sortBy != "" && !slices.Contains(sortKeys, sortBy) {
+				return fmt.Errorf("invalid --sort %q (valid: %s)", sortBy, strings.Join(sortKeys, ", "))
+			}
+			trash, err := trashModeFromFlags(withTrash, onlyTrash)
+			if err != nil {
+				return err
+			}
 
 			client, err := newDriveClient(cmd.Context())
 			if err != nil {
@@ -61,7 +87,7 @@ File types: document, spreadsheet, presentation, folder, pdf, image, video, audi
 				return fmt.Errorf("resolving drive scope: %w", err)
 			}
 
-			query, err := buildListQueryWithScope(folderID, fileType, scope)
+			query, err := buildListQueryWithScope(folderID, fileType, scope, trash)
 			if err != nil {
 				return fmt.Errorf("building query: %w", err)
 			}
@@ -71,10 +97,13 @@ File types: document, spreadsheet, presentation, folder, pdf, image, video, audi
 				return fmt.Errorf("listing files: %w", err)
 			}
 
+			if changedBy != "" {
+				files = filterChangedBy(files, changedBy)
+			}
+			sortFiles(files, sortBy, reverse, foldersFirst)
+
 			if idsOutput {
-				for _, f := range files {
-					fmt.Println(f.ID)
-				}
+				printFileIDs(files)
 				return nil
 			}
 
@@ -83,20 +112,75 @@ File types: document, spreadsheet, presentation, folder, pdf, image, video, audi
 				return nil
 			}
 
-			printFileTable(files)
+			trash.printFiles(files)
 			return nil
 		},
 	}
 
 	cmd.Flags().Int64VarP(&maxResults, "max", "m", 25, "Maximum number of results to return")
 	cmd.Flags().StringVarP(&fileType, "type", "t", "", "Filter by file type")
+	_ = cmd.RegisterFlagCompletionFunc("type", completeFileType)
 	cmd.Flags().BoolVar(&idsOutput, "ids", false, "Output only file IDs (one per line, for piping)")
 	cmd.Flags().BoolVar(&myDrive, "my-drive", false, "Limit to My Drive only")
 	cmd.Flags().StringVar(&driveFlag, "drive", "", "List files in specific shared drive (name or ID)")
+	cmd.Flags().StringVar(&changedBy, "changed-by", "", "Only show files last modified by this email (applied to the fetched results)")
+	cmd.Flags().StringVar(&sortBy, "sort", "", "Sort by name, modified, size or created")
+	_ = cmd.RegisterFlagCompletionFunc("sort", cobra.FixedCompletions(sortKeys, cobra.ShellCompDirectiveNoFileComp))
+	cmd.Flags().BoolVar(&reverse, "reverse", false, "Reverse the sort order")
+	cmd.Flags().BoolVar(&foldersFirst, "folders-first", false, "List folders before files")
+	cmd.Flags().BoolVar(&withTrash, "in-trash-and-live", false, "Include trashed files alongside live ones, marked in a STATE column")
+	cmd.Flags().BoolVar(&onlyTrash, "trashed-only", false, "Only show files in the trash, marked in a STATE column")
 
 	return cmd
 }
 
+// filterChangedBy keeps files whose last modifier matches email, ignoring case.
+// The Drive query language cannot filter on lastModifyingUser, so this runs
+// on the results already fetched.
+func filterChangedBy(files []*drive.File, email string) []*drive.File {
+	var matched []*drive.File
+	for _, f := range files {
+		if strings.EqualFold(f.LastModifiedBy, email) {
+			matched = append(matched, f)
+		}
+	}
+	return matched
+}
+
+// sortKeys are the --sort values
+var sortKeys = []string{"name", "modified", "size", "created"}
+
+// sortFiles orders files in place by key (one of sortKeys, or "" to keep
+// the API order), optionally reversed, with folders first when foldersFirst
+// is set. Folders stay first when reversed. Ties keep their API order.
+func sortFiles(files []*drive.File, key string, reverse, foldersFirst bool) {
+	var byKey func(a, b *drive.File) int
+	switch key {
+	case "name":
+		byKey = func(a, b *drive.File) int {
+			return strings.Compare(strings.ToLower(a.Name), strings.ToLower(b.Name))
+		}
+	case "modified":
+		byKey = func(a, b *drive.File) int { return a.ModifiedTime.Compare(b.ModifiedTime) }
+	case "size":
+		byKey = func(a, b *drive.File) int { return cmp.Compare(a.Size, b.Size) }
+	case "created":
+		byKey = func(a, b *drive.File) int { return a.CreatedTime.Compare(b.CreatedTime) }
+	}
+
+	switch {
+	case byKey != nil && reverse:
+		slices.SortStableFunc(files, func(a, b *drive.File) int { return byKey(b, a) })
+	case byKey != nil:
+		slices.SortStableFunc(files, byKey)
+	case reverse:
+		slices.Reverse(files)
+	}
+	if foldersFirst {
+		slices.SortStableFunc(files, compareFoldersFirst)
+	}
+}
+
 // buildListQuery constructs a Drive API query string for listing files
 func buildListQuery(folderID, fileType string) (string, error) {
 	parts := []string{"trashed = false"}
@@ -118,9 +202,13 @@ func buildListQuery(folderID, fileType string) (string, error) {
 	return strings.Join(parts, " and "), nil
 }
 
-// buildListQueryWithScope constructs a Drive API query string with scope awareness
-func buildListQueryWithScope(folderID, fileType string, scope drive.DriveScope) (string, error) {
-	parts := []string{"trashed = false"}
+// buildListQueryWithScope constructs a Drive API query string with scope
+// awareness; trash selects whether live files, trashed files or both match
+func buildListQueryWithScope(folderID, fileType string, scope drive.DriveScope, trash trashMode) (string, error) {
+	var parts []string
+	if clause := trash.clause(); clause != "" {
+		parts = append(parts, clause)
+	}
 
 	// For shared drives, if no folder specified, we don't add 'root' in parents
 	// because the root is the drive itself
@@ -154,6 +242,21 @@ func resolveDriveScopeForList(ctx context.Context, client DriveClient, myDrive b
 	return resolveDriveScope(ctx, client, myDrive, driveFlag)
 }
 
+// fileTypes are the canonical --type values, offered by shell completion.
+// getMimeTypeFilter also accepts the short aliases doc, sheet and slides.
+var fileTypes = []string{"document", "spreadsheet", "presentation", "folder", "pdf", "image", "video", "audio"}
+
+// completeFileType completes the --type flag from fileTypes
+func completeFileType(_ *cobra.Command, _ []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	var matches []string
+	for _, t := range fileTypes {
+		if strings.HasPrefix(t, strings.ToLower(toComplete)) {
+			matches = append(matches, t)
+		}
+	}
+	return matches, cobra.ShellCompDirectiveNoFileComp
+}
+
 // getMimeTypeFilter returns the Drive API query filter for a file type
 func getMimeTypeFilter(fileType string) (string, error) {
 	switch strings.ToLower(fileType) {
@@ -174,16 +277,41 @@ func getMimeTypeFilter(fileType string) (string, error) {
 	case "audio":
 		return "mimeType contains 'audio/'", nil
 	default:
-		return "", fmt.Errorf("unknown file type: %s (valid types: document, spreadsheet, presentation, folder, pdf, image, video, audio)", fileType)
+		return "", fmt.Errorf("unknown file type: %s (valid types: %s)", fileType, strings.Join(fileTypes, ", "))
 	}
 }
 
-// printFileTable prints files in a formatted table.
-// Write errors to stdout are intentionally ignored as they indicate
-// the output stream is closed/broken and there's nothing useful to do.
+// printFileIDs prints one file ID per line with no header, for piping into
+// other commands (e.g. xargs gro drive get).
+func printFileIDs(files []*drive.File) {
+	for _, f := range files {
+		fmt.Println(f.ID)
+	}
+}
+
+// printFileTable prints files in a formatted table
 func printFileTable(files []*drive.File) {
-	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
-	_, _ = fmt.Fprintln(w, "ID\tNAME\tTYPE\tSIZE\tMODIFIED")
+	writeFileTable(files, false)
+}
+
+// printFileStateTable prints files like printFileTable with a trailing STATE
+// column marking each one live or trashed
+func printFileStateTable(files []*drive.File) {
+	writeFileTable(files, true)
+}
+
+func writeFileTable(files []*drive.File, withState bool) {
+	columns := []table.Column{
+		{Header: "ID"},
+		{Header: "NAME", Shrink: true},
+		{Header: "TYPE"},
+		{Header: "SIZE", Align: table.Right},
+		{Header: "MODIFIED"},
+	}
+	if withState {
+		columns = append(columns, table.Column{Header: "STATE"})
+	}
+	tbl := table.New(columns...)
 
 	for _, f := range files {
 		size := "-"
@@ -194,13 +322,21 @@ func printFileTable(files []*drive.File) {
 		modified := "-"
 		if !f.ModifiedTime.IsZero() {
 			modified = f.ModifiedTime.Format("2006-01-02")
+			if format.Relative {
+				modified = format.RelativeTime(f.ModifiedTime)
+			}
 		}
 
-		typeName := drive.GetTypeName(f.MimeType)
-
-		_, _ = fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n",
-			f.ID, f.Name, typeName, size, modified)
+		tbl.AddRow(f.ID, f.Name, drive.GetTypeName(f.MimeType), size, modified, fileState(f))
 	}
 
-	_ = w.Flush()
+	tbl.Print()
+}
+
+// fileState is the STATE column value for f
+func fileState(f *drive.File) string {
+	if f.Trashed {
+		return "trashed"
+	}
+	return "live"
 }
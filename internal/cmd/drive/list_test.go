@@ -1,8 +1,14 @@
 package drive
 
 import (
+	"strings"
 	"testing"
+	"time"
 
+	"github.com/spf13/cobra"
+
+	driveapi "github.com/open-cli-collective/google-readonly/internal/drive"
+	"github.com/open-cli-collective/google-readonly/internal/format"
 	"github.com/open-cli-collective/google-readonly/internal/testutil"
 )
 
@@ -37,11 +43,85 @@ func TestListCommand(t *testing.T) {
 		testutil.Equal(t, flag.Shorthand, "t")
 	})
 
+	t.Run("has changed-by flag", func(t *testing.T) {
+		flag := cmd.Flags().Lookup("changed-by")
+		testutil.NotNil(t, flag)
+		testutil.Equal(t, flag.DefValue, "")
+	})
+
+	t.Run("has sort flags", func(t *testing.T) {
+		testutil.NotNil(t, cmd.Flags().Lookup("sort"))
+		testutil.NotNil(t, cmd.Flags().Lookup("reverse"))
+		testutil.NotNil(t, cmd.Flags().Lookup("folders-first"))
+	})
+
 	t.Run("has short description", func(t *testing.T) {
 		testutil.Contains(t, cmd.Short, "List")
 	})
 }
 
+func TestSortFiles(t *testing.T) {
+	day := func(d int) time.Time { return time.Date(2024, 1, d, 0, 0, 0, 0, time.UTC) }
+	folder := driveapi.MimeTypeFolder
+	newFiles := func() []*driveapi.File {
+		return []*driveapi.File{
+			{ID: "b", Name: "beta", Size: 300, ModifiedTime: day(3), CreatedTime: day(1)},
+			{ID: "A", Name: "Alpha", MimeType: folder, ModifiedTime: day(1), CreatedTime: day(3)},
+			{ID: "c", Name: "charlie", Size: 100, ModifiedTime: day(2), CreatedTime: day(2)},
+			{ID: "d", Name: "delta", MimeType: folder, ModifiedTime: day(4), CreatedTime: day(4)},
+		}
+	}
+	ids := func(files []*driveapi.File) string {
+		var out []string
+		for _, f := range files {
+			out = append(out, f.ID)
+		}
+		return strings.Join(out, ",")
+	}
+
+	tests := []struct {
+		name         string
+		key          string
+		reverse      bool
+		foldersFirst bool
+		want         string
+	}{
+		{name: "no key keeps API order", want: "b,A,c,d"},
+		{name: "name ignores case", key: "name", want: "A,b,c,d"},
+		{name: "modified", key: "modified", want: "A,c,b,d"},
+		{name: "modified reversed", key: "modified", reverse: true, want: "d,b,c,A"},
+		{name: "size, ties keep API order", key: "size", want: "A,d,c,b"},
+		{name: "size reversed, ties keep API order", key: "size", reverse: true, want: "b,c,A,d"},
+		{name: "created", key: "created", want: "b,c,A,d"},
+		{name: "folders first alone", foldersFirst: true, want: "A,d,b,c"},
+		{name: "folders first by name", key: "name", foldersFirst: true, want: "A,d,b,c"},
+		{name: "folders stay first when reversed", key: "name", reverse: true, foldersFirst: true, want: "d,A,c,b"},
+		{name: "reverse without a key", reverse: true, want: "d,c,A,b"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			files := newFiles()
+			sortFiles(files, tt.key, tt.reverse, tt.foldersFirst)
+			testutil.Equal(t, ids(files), tt.want)
+		})
+	}
+}
+
+func TestFilterChangedBy(t *testing.T) {
+	files := []*driveapi.File{
+		{ID: "a", LastModifiedBy: "alice@example.com"},
+		{ID: "b", LastModifiedBy: "bob@example.com"},
+		{ID: "c", LastModifiedBy: "Alice@Example.com"},
+		{ID: "d"},
+	}
+
+	matched := filterChangedBy(files, "alice@example.com")
+
+	testutil.Len(t, matched, 2)
+	testutil.Equal(t, matched[0].ID, "a")
+	testutil.Equal(t, matched[1].ID, "c")
+}
+
 func TestBuildListQuery(t *testing.T) {
 	t.Run("builds query for root folder", func(t *testing.T) {
 		query, err := buildListQuery("", "")
@@ -165,4 +245,34 @@ func TestGetMimeTypeFilter(t *testing.T) {
 	}
 }
 
+func TestCompleteFileType(t *testing.T) {
+	got, directive := completeFileType(newListCommand(), nil, "p")
+	testutil.Equal(t, strings.Join(got, ","), "presentation,pdf")
+	testutil.Equal(t, directive, cobra.ShellCompDirectiveNoFileComp)
+
+	// every completed value must be accepted by --type
+	all, _ := completeFileType(newListCommand(), nil, "")
+	testutil.Len(t, all, len(fileTypes))
+	for _, ft := range all {
+		_, err := getMimeTypeFilter(ft)
+		testutil.NoError(t, err)
+	}
+}
+
 // Tests for formatSize moved to internal/format/format_test.go
+
+func TestPrintFileTable_RelativeTime(t *testing.T) {
+	files := []*driveapi.File{
+		{ID: "f1", Name: "notes", ModifiedTime: time.Now().Add(-3 * time.Hour)},
+		{ID: "f2", Name: "old", ModifiedTime: time.Date(2020, 5, 1, 12, 0, 0, 0, time.UTC)},
+	}
+
+	out := testutil.CaptureStdout(t, func() { printFileTable(files) })
+	testutil.Contains(t, out, time.Now().Add(-3*time.Hour).Format("2006-01-02"))
+
+	format.Relative = true
+	t.Cleanup(func() { format.Relative = false })
+	out = testutil.CaptureStdout(t, func() { printFileTable(files) })
+	testutil.Contains(t, out, "3 hours ago")
+	testutil.Contains(t, out, "2020-05-01")
+}
@@ -2,6 +2,7 @@ package drive
 
 import (
 	"context"
+	"io"
 
 	driveapi "github.com/open-cli-collective/google-readonly/internal/drive"
 )
@@ -9,14 +10,19 @@ import (
 // MockDriveClient is a configurable mock for DriveClient.
 type MockDriveClient struct {
 	ListFilesFunc          func(ctx context.Context, query string, pageSize int64) ([]*driveapi.File, error)
+	ListFilesOrderedFunc   func(ctx context.Context, query string, pageSize int64, orderBy string) ([]*driveapi.File, error)
 	ListFilesWithScopeFunc func(ctx context.Context, query string, pageSize int64, scope driveapi.DriveScope) ([]*driveapi.File, error)
 	GetFileFunc            func(ctx context.Context, fileID string) (*driveapi.File, error)
+	GetFileMetadataFunc    func(ctx context.Context, fileID string) (map[string]any, error)
 	DownloadFileFunc       func(ctx context.Context, fileID string) ([]byte, error)
+	DownloadFileToFunc     func(ctx context.Context, fileID string, w io.Writer) (int64, error)
 	ExportFileFunc         func(ctx context.Context, fileID, mimeType string) ([]byte, error)
 	ListSharedDrivesFunc   func(ctx context.Context, pageSize int64) ([]*driveapi.SharedDrive, error)
 	StarFileFunc           func(ctx context.Context, fileID string) error
 	UnstarFileFunc         func(ctx context.Context, fileID string) error
 	SearchFileIDsFunc      func(ctx context.Context, query string, pageSize int64) ([]string, error)
+	ListRevisionsFunc      func(ctx context.Context, fileID string) ([]*driveapi.Revision, error)
+	ListPermissionsFunc    func(ctx context.Context, fileID string) ([]*driveapi.Permission, error)
 }
 
 // Verify MockDriveClient implements DriveClient
@@ -29,6 +35,17 @@ func (m *MockDriveClient) ListFiles(ctx context.Context, query string, pageSize
 	return nil, nil
 }
 
+func (m *MockDriveClient) ListFilesOrdered(ctx context.Context, query string, pageSize int64, orderBy string) ([]*driveapi.File, error) {
+	if m.ListFilesOrderedFunc != nil {
+		return m.ListFilesOrderedFunc(ctx, query, pageSize, orderBy)
+	}
+	// Fall back to ListFiles if no ordered function defined
+	if m.ListFilesFunc != nil {
+		return m.ListFilesFunc(ctx, query, pageSize)
+	}
+	return nil, nil
+}
+
 func (m *MockDriveClient) ListFilesWithScope(ctx context.Context, query string, pageSize int64, scope driveapi.DriveScope) ([]*driveapi.File, error) {
 	if m.ListFilesWithScopeFunc != nil {
 		return m.ListFilesWithScopeFunc(ctx, query, pageSize, scope)
@@ -47,6 +64,13 @@ func (m *MockDriveClient) GetFile(ctx context.Context, fileID string) (*driveapi
 	return nil, nil
 }
 
+func (m *MockDriveClient) GetFileMetadata(ctx context.Context, fileID string) (map[string]any, error) {
+	if m.GetFileMetadataFunc != nil {
+		return m.GetFileMetadataFunc(ctx, fileID)
+	}
+	return nil, nil
+}
+
 func (m *MockDriveClient) DownloadFile(ctx context.Context, fileID string) ([]byte, error) {
 	if m.DownloadFileFunc != nil {
 		return m.DownloadFileFunc(ctx, fileID)
@@ -54,6 +78,22 @@ func (m *MockDriveClient) DownloadFile(ctx context.Context, fileID string) ([]by
 	return nil, nil
 }
 
+func (m *MockDriveClient) DownloadFileTo(ctx context.Context, fileID string, w io.Writer) (int64, error) {
+	if m.DownloadFileToFunc != nil {
+		return m.DownloadFileToFunc(ctx, fileID, w)
+	}
+	// Fall back to DownloadFile if no streaming function defined
+	if m.DownloadFileFunc != nil {
+		data, err := m.DownloadFileFunc(ctx, fileID)
+		if err != nil {
+			return 0, err
+		}
+		n, err := w.Write(data)
+		return int64(n), err
+	}
+	return 0, nil
+}
+
 func (m *MockDriveClient) ExportFile(ctx context.Context, fileID, mimeType string) ([]byte, error) {
 	if m.ExportFileFunc != nil {
 		return m.ExportFileFunc(ctx, fileID, mimeType)
@@ -88,3 +128,17 @@ func (m *MockDriveClient) SearchFileIDs(ctx context.Context, query string, pageS
 	}
 	return nil, nil
 }
+
+func (m *MockDriveClient) ListRevisions(ctx context.Context, fileID string) ([]*driveapi.Revision, error) {
+	if m.ListRevisionsFunc != nil {
+		return m.ListRevisionsFunc(ctx, fileID)
+	}
+	return nil, nil
+}
+
+func (m *MockDriveClient) ListPermissions(ctx context.Context, fileID string) ([]*driveapi.Permission, error) {
+	if m.ListPermissionsFunc != nil {
+		return m.ListPermissionsFunc(ctx, fileID)
+	}
+	return nil, nil
+}
@@ -2,26 +2,37 @@ package drive
 
 import (
 	"context"
+	"io"
 
+	"github.com/open-cli-collective/google-readonly/internal/auth"
 	"github.com/open-cli-collective/google-readonly/internal/drive"
 )
 
 // DriveClient defines the interface for Drive client operations used by drive commands.
 type DriveClient interface {
 	ListFiles(ctx context.Context, query string, pageSize int64) ([]*drive.File, error)
+	ListFilesOrdered(ctx context.Context, query string, pageSize int64, orderBy string) ([]*drive.File, error)
 	ListFilesWithScope(ctx context.Context, query string, pageSize int64, scope drive.DriveScope) ([]*drive.File, error)
 	GetFile(ctx context.Context, fileID string) (*drive.File, error)
+	GetFileMetadata(ctx context.Context, fileID string) (map[string]any, error)
 	DownloadFile(ctx context.Context, fileID string) ([]byte, error)
+	DownloadFileTo(ctx context.Context, fileID string, w io.Writer) (int64, error)
 	ExportFile(ctx context.Context, fileID string, mimeType string) ([]byte, error)
 	ListSharedDrives(ctx context.Context, pageSize int64) ([]*drive.SharedDrive, error)
 	StarFile(ctx context.Context, fileID string) error
 	UnstarFile(ctx context.Context, fileID string) error
 	SearchFileIDs(ctx context.Context, query string, pageSize int64) ([]string, error)
+	ListRevisions(ctx context.Context, fileID string) ([]*drive.Revision, error)
+	ListPermissions(ctx context.Context, fileID string) ([]*drive.Permission, error)
 }
 
 // ClientFactory is the function used to create Drive clients.
-// Override in tests to inject mocks.
+// Override in tests to inject mocks. It fails before any API call when the
+// token was never granted a Drive scope.
 var ClientFactory = func(ctx context.Context) (DriveClient, error) {
+	if err := auth.RequireScope("drive"); err != nil {
+		return nil, err
+	}
 	return drive.NewClient(ctx)
 }
 
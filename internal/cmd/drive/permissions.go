@@ -0,0 +1,65 @@
+package drive
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/open-cli-collective/google-readonly/internal/drive"
+	"github.com/open-cli-collective/google-readonly/internal/table"
+)
+
+func newPermissionsCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "permissions <file-id>",
+		Short: "List who has access to a file",
+		Long: `List the sharing permissions of a file or folder.
+
+Each row shows the grantee's role (owner, organizer, fileOrganizer, writer,
+commenter, reader), the grant type (user, group, domain, anyone), and the
+email or domain it applies to. Works for files in shared drives.
+
+Examples:
+  gro drive permissions <file-id>`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, err := newDriveClient(cmd.Context())
+			if err != nil {
+				return fmt.Errorf("creating Drive client: %w", err)
+			}
+
+			permissions, err := client.ListPermissions(cmd.Context(), args[0])
+			if err != nil {
+				return fmt.Errorf("listing permissions: %w", err)
+			}
+
+			if len(permissions) == 0 {
+				fmt.Println("No permissions found.")
+				return nil
+			}
+
+			printPermissionTable(permissions)
+			return nil
+		},
+	}
+
+	return cmd
+}
+
+// printPermissionTable prints permissions in a formatted table
+func printPermissionTable(permissions []*drive.Permission) {
+	tbl := table.New(
+		table.Column{Header: "ROLE"},
+		table.Column{Header: "TYPE"},
+		table.Column{Header: "GRANTEE"},
+		table.Column{Header: "INHERITED"},
+	)
+	for _, p := range permissions {
+		inherited := ""
+		if p.Inherited {
+			inherited = "yes"
+		}
+		tbl.AddRow(p.Role, p.Type, p.Grantee(), inherited)
+	}
+	tbl.Print()
+}
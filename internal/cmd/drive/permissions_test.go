@@ -0,0 +1,86 @@
+package drive
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	driveapi "github.com/open-cli-collective/google-readonly/internal/drive"
+	"github.com/open-cli-collective/google-readonly/internal/testutil"
+)
+
+func TestPermissionsCommand(t *testing.T) {
+	cmd := newPermissionsCommand()
+
+	t.Run("has correct use", func(t *testing.T) {
+		testutil.Equal(t, cmd.Use, "permissions <file-id>")
+	})
+
+	t.Run("requires exactly one argument", func(t *testing.T) {
+		testutil.Error(t, cmd.Args(cmd, []string{}))
+		testutil.NoError(t, cmd.Args(cmd, []string{"file-id"}))
+		testutil.Error(t, cmd.Args(cmd, []string{"a", "b"}))
+	})
+}
+
+func TestPermissionsCommand_Success(t *testing.T) {
+	mock := &MockDriveClient{
+		ListPermissionsFunc: func(_ context.Context, fileID string) ([]*driveapi.Permission, error) {
+			testutil.Equal(t, fileID, "file123")
+			return []*driveapi.Permission{
+				{Type: "user", Role: "owner", Email: "owner@example.com"},
+				{Type: "group", Role: "writer", Email: "team@example.com", Inherited: true},
+				{Type: "domain", Role: "reader", Domain: "example.com"},
+				{Type: "anyone", Role: "reader"},
+			}, nil
+		},
+	}
+
+	cmd := newPermissionsCommand()
+	cmd.SetArgs([]string{"file123"})
+
+	withMockClient(mock, func() {
+		output := testutil.CaptureStdout(t, func() {
+			err := cmd.Execute()
+			testutil.NoError(t, err)
+		})
+
+		testutil.Contains(t, output, "ROLE")
+		testutil.Contains(t, output, "owner@example.com")
+		testutil.Contains(t, output, "team@example.com")
+		testutil.Contains(t, output, "example.com")
+		testutil.Contains(t, output, "anyone")
+		testutil.Contains(t, output, "yes")
+	})
+}
+
+func TestPermissionsCommand_Empty(t *testing.T) {
+	cmd := newPermissionsCommand()
+	cmd.SetArgs([]string{"file123"})
+
+	withMockClient(&MockDriveClient{}, func() {
+		output := testutil.CaptureStdout(t, func() {
+			err := cmd.Execute()
+			testutil.NoError(t, err)
+		})
+
+		testutil.Contains(t, output, "No permissions found")
+	})
+}
+
+func TestPermissionsCommand_APIError(t *testing.T) {
+	mock := &MockDriveClient{
+		ListPermissionsFunc: func(_ context.Context, _ string) ([]*driveapi.Permission, error) {
+			return nil, errors.New("API error")
+		},
+	}
+
+	cmd := newPermissionsCommand()
+	cmd.SetArgs([]string{"file123"})
+
+	withMockClient(mock, func() {
+		err := cmd.Execute()
+		testutil.Error(t, err)
+		testutil.Contains(t, err.Error(), "listing permissions")
+	})
+}
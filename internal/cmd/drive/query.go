@@ -0,0 +1,118 @@
+package drive
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/spf13/cobra"
+)
+
+// trashedTerm finds a query that already says whether trashed files match
+var trashedTerm = regexp.MustCompile(`(?i)\btrashed\b`)
+
+func newQueryCommand() *cobra.Command {
+	var (
+		maxResults int64
+		idsOutput  bool
+		myDrive    bool
+		driveFlag  string
+		withTrash  bool
+		onlyTrash  bool
+	)
+
+	cmd := &cobra.Command{
+		Use:   "query <drive-query>",
+		Short: "List files matching a raw Drive query",
+		Long: `List files matching a query written in the Drive API's own search syntax,
+passed through unchanged. Trashed files are excluded unless the query
+mentions trashed itself or --in-trash-and-live or --trashed-only is given.
+When trashed files can match, a STATE column marks each file live or
+trashed.
+
+Like search, it covers all drives by default; --my-drive limits it to your
+personal drive and --drive to one shared drive.
+
+Query syntax: https://developers.google.com/drive/api/guides/search-files
+
+Examples:
+  gro drive query "mimeType = 'application/pdf' and modifiedTime > '2024-01-01T00:00:00'"
+  gro drive query "'me' in owners and name contains 'invoice'"
+  gro drive query "sharedWithMe and not mimeType contains 'google-apps'"
+  gro drive query "'<folder-id>' in parents and starred"
+  gro drive query "'me' in owners" --trashed-only --my-drive`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if myDrive && driveFlag != "" {
+				return fmt.Errorf("--my-drive and --drive are mutually exclusive")
+			}
+			query := strings.TrimSpace(args[0])
+			if query == "" {
+				return fmt.Errorf("query must not be empty")
+			}
+			trash, err := trashModeFromFlags(withTrash, onlyTrash)
+			if err != nil {
+				return err
+			}
+			query, trash, err = applyTrashMode(query, trash)
+			if err != nil {
+				return err
+			}
+
+			client, err := newDriveClient(cmd.Context())
+			if err != nil {
+				return fmt.Errorf("creating Drive client: %w", err)
+			}
+
+			ctx := cmd.Context()
+			scope, err := resolveDriveScope(ctx, client, myDrive, driveFlag)
+			if err != nil {
+				return fmt.Errorf("resolving drive scope: %w", err)
+			}
+
+			files, err := client.ListFilesWithScope(ctx, query, maxResults, scope)
+			if err != nil {
+				return fmt.Errorf("querying files: %w", err)
+			}
+
+			if idsOutput {
+				printFileIDs(files)
+				return nil
+			}
+
+			if len(files) == 0 {
+				fmt.Println("No files found.")
+				return nil
+			}
+
+			trash.printFiles(files)
+			return nil
+		},
+	}
+
+	cmd.Flags().Int64VarP(&maxResults, "max", "m", 25, "Maximum number of results to return")
+	cmd.Flags().BoolVar(&idsOutput, "ids", false, "Output only file IDs (one per line, for piping)")
+	cmd.Flags().BoolVar(&myDrive, "my-drive", false, "Limit query to My Drive only")
+	cmd.Flags().StringVar(&driveFlag, "drive", "", "Query a specific shared drive (name or ID)")
+	cmd.Flags().BoolVar(&withTrash, "in-trash-and-live", false, "Include trashed files alongside live ones, marked in a STATE column")
+	cmd.Flags().BoolVar(&onlyTrash, "trashed-only", false, "Only show files in the trash, marked in a STATE column")
+
+	return cmd
+}
+
+// applyTrashMode adds trash's condition to a raw query, parenthesized so an
+// "or" in the query cannot escape it. A query that mentions trashed is left
+// to decide for itself and reported as possibly matching trashed files; the
+// trash flags cannot be combined with it.
+func applyTrashMode(query string, trash trashMode) (string, trashMode, error) {
+	if trashedTerm.MatchString(query) {
+		if trash != liveOnly {
+			return "", trash, fmt.Errorf("--in-trash-and-live and --trashed-only cannot be combined with a query that mentions trashed")
+		}
+		return query, liveAndTrashed, nil
+	}
+	if clause := trash.clause(); clause != "" {
+		query = "(" + query + ") and " + clause
+	}
+	return query, trash, nil
+}
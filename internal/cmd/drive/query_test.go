@@ -0,0 +1,141 @@
+package drive
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	driveapi "github.com/open-cli-collective/google-readonly/internal/drive"
+	"github.com/open-cli-collective/google-readonly/internal/testutil"
+)
+
+func TestQueryCommand(t *testing.T) {
+	cmd := newQueryCommand()
+
+	t.Run("has correct use", func(t *testing.T) {
+		testutil.Equal(t, cmd.Use, "query <drive-query>")
+	})
+
+	t.Run("requires exactly one argument", func(t *testing.T) {
+		testutil.Error(t, cmd.Args(cmd, []string{}))
+		testutil.NoError(t, cmd.Args(cmd, []string{"starred"}))
+		testutil.Error(t, cmd.Args(cmd, []string{"a", "b"}))
+	})
+}
+
+func TestApplyTrashMode(t *testing.T) {
+	tests := []struct {
+		name      string
+		query     string
+		trash     trashMode
+		want      string
+		wantTrash trashMode
+		wantErr   bool
+	}{
+		{"live only by default", "starred", liveOnly, "(starred) and trashed = false", liveOnly, false},
+		{"or stays inside", "name contains 'a' or name contains 'b'", liveOnly, "(name contains 'a' or name contains 'b') and trashed = false", liveOnly, false},
+		{"live and trashed", "starred", liveAndTrashed, "starred", liveAndTrashed, false},
+		{"trashed only", "starred", trashedOnly, "(starred) and trashed = true", trashedOnly, false},
+		{"query decides", "trashed = true", liveOnly, "trashed = true", liveAndTrashed, false},
+		{"query decides, any case", "starred and Trashed=false", liveOnly, "starred and Trashed=false", liveAndTrashed, false},
+		{"word inside a name", "name contains 'untrashed'", liveOnly, "(name contains 'untrashed') and trashed = false", liveOnly, false},
+		{"flag conflicts with query", "trashed = true", trashedOnly, "", trashedOnly, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, gotTrash, err := applyTrashMode(tt.query, tt.trash)
+			if tt.wantErr {
+				testutil.Error(t, err)
+				return
+			}
+			testutil.NoError(t, err)
+			testutil.Equal(t, got, tt.want)
+			testutil.Equal(t, gotTrash, tt.wantTrash)
+		})
+	}
+}
+
+func TestQueryCommand_PassesQueryThrough(t *testing.T) {
+	var gotQuery string
+	var gotSize int64
+	var gotScope driveapi.DriveScope
+	mock := &MockDriveClient{
+		ListFilesWithScopeFunc: func(_ context.Context, query string, pageSize int64, scope driveapi.DriveScope) ([]*driveapi.File, error) {
+			gotQuery, gotSize, gotScope = query, pageSize, scope
+			return testutil.SampleDriveFiles(2), nil
+		},
+	}
+
+	cmd := newQueryCommand()
+	cmd.SetArgs([]string{"mimeType = 'application/pdf'", "--max", "5", "--my-drive"})
+
+	withMockClient(mock, func() {
+		output := testutil.CaptureStdout(t, func() {
+			testutil.NoError(t, cmd.Execute())
+		})
+		testutil.Contains(t, output, "NAME")
+		testutil.Contains(t, output, "file_a")
+	})
+
+	testutil.Equal(t, gotQuery, "(mimeType = 'application/pdf') and trashed = false")
+	testutil.Equal(t, gotSize, int64(5))
+	testutil.True(t, gotScope.MyDriveOnly)
+}
+
+func TestQueryCommand_AllDrivesByDefault(t *testing.T) {
+	var gotScope driveapi.DriveScope
+	mock := &MockDriveClient{
+		ListFilesWithScopeFunc: func(_ context.Context, _ string, _ int64, scope driveapi.DriveScope) ([]*driveapi.File, error) {
+			gotScope = scope
+			return nil, nil
+		},
+	}
+
+	cmd := newQueryCommand()
+	cmd.SetArgs([]string{"starred"})
+
+	withMockClient(mock, func() {
+		output := testutil.CaptureStdout(t, func() {
+			testutil.NoError(t, cmd.Execute())
+		})
+		testutil.Equal(t, output, "No files found.\n")
+	})
+	testutil.True(t, gotScope.AllDrives)
+}
+
+func TestQueryCommand_Errors(t *testing.T) {
+	t.Run("rejects empty query", func(t *testing.T) {
+		cmd := newQueryCommand()
+		cmd.SetArgs([]string{"  "})
+
+		err := cmd.Execute()
+		testutil.Error(t, err)
+		testutil.Contains(t, err.Error(), "query must not be empty")
+	})
+
+	t.Run("rejects both scope flags", func(t *testing.T) {
+		cmd := newQueryCommand()
+		cmd.SetArgs([]string{"starred", "--my-drive", "--drive", "Engineering"})
+
+		err := cmd.Execute()
+		testutil.Error(t, err)
+		testutil.Contains(t, err.Error(), "mutually exclusive")
+	})
+
+	t.Run("wraps API errors", func(t *testing.T) {
+		mock := &MockDriveClient{
+			ListFilesWithScopeFunc: func(_ context.Context, _ string, _ int64, _ driveapi.DriveScope) ([]*driveapi.File, error) {
+				return nil, errors.New("Invalid Value")
+			},
+		}
+
+		cmd := newQueryCommand()
+		cmd.SetArgs([]string{"bogus ="})
+
+		withMockClient(mock, func() {
+			err := cmd.Execute()
+			testutil.Error(t, err)
+			testutil.Contains(t, err.Error(), "querying files")
+		})
+	})
+}
@@ -0,0 +1,65 @@
+package drive
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+)
+
+func newRecentCommand() *cobra.Command {
+	var (
+		maxResults int64
+		idsOutput  bool
+		changedBy  string
+	)
+
+	cmd := &cobra.Command{
+		Use:   "recent",
+		Short: "List recently modified files",
+		Long: `List the most recently modified files you can access, newest first.
+
+--changed-by is applied to the fetched results, so it can return fewer
+than --max files.
+
+Examples:
+  gro drive recent
+  gro drive recent --max 50
+  gro drive recent --changed-by alice@example.com
+  gro drive recent --ids | xargs -n1 gro drive get`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			client, err := newDriveClient(cmd.Context())
+			if err != nil {
+				return fmt.Errorf("creating Drive client: %w", err)
+			}
+
+			files, err := client.ListFilesOrdered(cmd.Context(), "trashed = false", maxResults, "modifiedTime desc")
+			if err != nil {
+				return fmt.Errorf("listing recent files: %w", err)
+			}
+
+			if changedBy != "" {
+				files = filterChangedBy(files, changedBy)
+			}
+
+			if idsOutput {
+				printFileIDs(files)
+				return nil
+			}
+
+			if len(files) == 0 {
+				fmt.Println("No files found.")
+				return nil
+			}
+
+			printFileTable(files)
+			return nil
+		},
+	}
+
+	cmd.Flags().Int64VarP(&maxResults, "max", "m", 20, "Maximum number of results to return")
+	cmd.Flags().BoolVar(&idsOutput, "ids", false, "Output only file IDs (one per line, for piping)")
+	cmd.Flags().StringVar(&changedBy, "changed-by", "", "Only show files last modified by this email (applied to the fetched results)")
+
+	return cmd
+}
@@ -0,0 +1,153 @@
+package drive
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	driveapi "github.com/open-cli-collective/google-readonly/internal/drive"
+	"github.com/open-cli-collective/google-readonly/internal/testutil"
+)
+
+func TestRecentCommand(t *testing.T) {
+	cmd := newRecentCommand()
+
+	t.Run("has correct use", func(t *testing.T) {
+		testutil.Equal(t, cmd.Use, "recent")
+	})
+
+	t.Run("takes no arguments", func(t *testing.T) {
+		testutil.NoError(t, cmd.Args(cmd, []string{}))
+		testutil.Error(t, cmd.Args(cmd, []string{"extra"}))
+	})
+
+	t.Run("has max flag defaulting to 20", func(t *testing.T) {
+		flag := cmd.Flags().Lookup("max")
+		testutil.NotNil(t, flag)
+		testutil.Equal(t, flag.Shorthand, "m")
+		testutil.Equal(t, flag.DefValue, "20")
+	})
+
+	t.Run("has ids and changed-by flags", func(t *testing.T) {
+		testutil.NotNil(t, cmd.Flags().Lookup("ids"))
+		testutil.NotNil(t, cmd.Flags().Lookup("changed-by"))
+	})
+}
+
+func TestRecentCommand_Success(t *testing.T) {
+	mock := &MockDriveClient{
+		ListFilesOrderedFunc: func(_ context.Context, query string, pageSize int64, orderBy string) ([]*driveapi.File, error) {
+			testutil.Equal(t, query, "trashed = false")
+			testutil.Equal(t, pageSize, int64(20))
+			testutil.Equal(t, orderBy, "modifiedTime desc")
+			return testutil.SampleDriveFiles(2), nil
+		},
+	}
+
+	cmd := newRecentCommand()
+
+	withMockClient(mock, func() {
+		output := testutil.CaptureStdout(t, func() {
+			err := cmd.Execute()
+			testutil.NoError(t, err)
+		})
+
+		testutil.Contains(t, output, "NAME")
+		testutil.Contains(t, output, "file_a")
+		testutil.Contains(t, output, "file_b")
+	})
+}
+
+func TestRecentCommand_MaxPassthrough(t *testing.T) {
+	var gotPageSize int64
+	mock := &MockDriveClient{
+		ListFilesOrderedFunc: func(_ context.Context, _ string, pageSize int64, _ string) ([]*driveapi.File, error) {
+			gotPageSize = pageSize
+			return nil, nil
+		},
+	}
+
+	cmd := newRecentCommand()
+	cmd.SetArgs([]string{"--max", "5"})
+
+	withMockClient(mock, func() {
+		output := testutil.CaptureStdout(t, func() {
+			err := cmd.Execute()
+			testutil.NoError(t, err)
+		})
+
+		testutil.Contains(t, output, "No files found.")
+	})
+	testutil.Equal(t, gotPageSize, int64(5))
+}
+
+func TestRecentCommand_IDsOutput(t *testing.T) {
+	mock := &MockDriveClient{
+		ListFilesOrderedFunc: func(_ context.Context, _ string, _ int64, _ string) ([]*driveapi.File, error) {
+			return testutil.SampleDriveFiles(2), nil
+		},
+	}
+
+	cmd := newRecentCommand()
+	cmd.SetArgs([]string{"--ids"})
+
+	withMockClient(mock, func() {
+		output := testutil.CaptureStdout(t, func() {
+			err := cmd.Execute()
+			testutil.NoError(t, err)
+		})
+
+		testutil.Equal(t, output, "file_a\nfile_b\n")
+	})
+}
+
+func TestRecentCommand_ChangedBy(t *testing.T) {
+	alice := testutil.SampleDriveFile("file_alice")
+	alice.LastModifiedBy = "alice@example.com"
+	bob := testutil.SampleDriveFile("file_bob")
+	bob.LastModifiedBy = "bob@example.com"
+
+	mock := &MockDriveClient{
+		ListFilesOrderedFunc: func(_ context.Context, _ string, _ int64, _ string) ([]*driveapi.File, error) {
+			return []*driveapi.File{alice, bob}, nil
+		},
+	}
+
+	cmd := newRecentCommand()
+	cmd.SetArgs([]string{"--changed-by", "Alice@Example.com", "--ids"})
+
+	withMockClient(mock, func() {
+		output := testutil.CaptureStdout(t, func() {
+			err := cmd.Execute()
+			testutil.NoError(t, err)
+		})
+
+		testutil.Equal(t, output, "file_alice\n")
+	})
+}
+
+func TestRecentCommand_APIError(t *testing.T) {
+	mock := &MockDriveClient{
+		ListFilesOrderedFunc: func(_ context.Context, _ string, _ int64, _ string) ([]*driveapi.File, error) {
+			return nil, errors.New("API error")
+		},
+	}
+
+	cmd := newRecentCommand()
+
+	withMockClient(mock, func() {
+		err := cmd.Execute()
+		testutil.Error(t, err)
+		testutil.Contains(t, err.Error(), "listing recent files")
+	})
+}
+
+func TestRecentCommand_ClientError(t *testing.T) {
+	cmd := newRecentCommand()
+
+	withFailingClientFactory(func() {
+		err := cmd.Execute()
+		testutil.Error(t, err)
+		testutil.Contains(t, err.Error(), "creating Drive client")
+	})
+}
@@ -0,0 +1,121 @@
+package drive
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/open-cli-collective/google-readonly/internal/drive"
+)
+
+func newResolveCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "resolve <path>",
+		Short: "Resolve a My Drive path to a file ID",
+		Long: `Resolve a slash-separated path in My Drive to a file ID.
+
+The path is walked from the My Drive root one segment at a time. If a
+folder contains more than one item with the same name, the command fails
+and lists the candidate IDs.
+
+Examples:
+  gro drive resolve "/Projects/2024/plan.docx"
+  gro drive get $(gro drive resolve "/Projects/2024/plan.docx")`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, err := newDriveClient(cmd.Context())
+			if err != nil {
+				return fmt.Errorf("creating Drive client: %w", err)
+			}
+
+			file, err := ResolvedPath(cmd.Context(), client, args[0])
+			if err != nil {
+				return fmt.Errorf("resolving path: %w", err)
+			}
+
+			fmt.Println(file.ID)
+			return nil
+		},
+	}
+
+	return cmd
+}
+
+// ResolvedPath walks a slash-separated My Drive path from the root and
+// returns the file it names. Every segment but the last must be a folder.
+// Duplicate names at any level are an error listing the candidate IDs.
+func ResolvedPath(ctx context.Context, client DriveClient, path string) (*drive.File, error) {
+	segments := splitDrivePath(path)
+	if len(segments) == 0 {
+		return nil, fmt.Errorf("path %q does not name a file", path)
+	}
+
+	parentID := "root"
+	var current *drive.File
+	for i, name := range segments {
+		query := fmt.Sprintf("'%s' in parents and name = '%s' and trashed = false",
+			parentID, escapeQueryString(name))
+		if i < len(segments)-1 {
+			query += fmt.Sprintf(" and mimeType = '%s'", drive.MimeTypeFolder)
+		}
+
+		files, err := client.ListFiles(ctx, query, 10)
+		if err != nil {
+			return nil, fmt.Errorf("listing %s: %w", joinDrivePath(segments[:i+1]), err)
+		}
+
+		switch len(files) {
+		case 0:
+			return nil, fmt.Errorf("not found: %s", joinDrivePath(segments[:i+1]))
+		case 1:
+			current = files[0]
+			parentID = current.ID
+		default:
+			ids := make([]string, len(files))
+			for j, f := range files {
+				ids[j] = f.ID
+			}
+			return nil, fmt.Errorf("multiple items named %q in %s: %s",
+				name, joinDrivePath(segments[:i]), strings.Join(ids, ", "))
+		}
+	}
+
+	return current, nil
+}
+
+// splitDrivePath splits a path into its non-empty segments
+func splitDrivePath(path string) []string {
+	var segments []string
+	for _, s := range strings.Split(path, "/") {
+		if s != "" {
+			segments = append(segments, s)
+		}
+	}
+	return segments
+}
+
+// joinDrivePath renders segments as an absolute path for error messages
+func joinDrivePath(segments []string) string {
+	return "/" + strings.Join(segments, "/")
+}
+
+// fileIDFromArgs returns the target file ID from either a positional ID or
+// --path. Exactly one of the two must be given.
+func fileIDFromArgs(ctx context.Context, client DriveClient, args []string, path string) (string, error) {
+	switch {
+	case len(args) > 0 && path != "":
+		return "", fmt.Errorf("specify a file ID or --path, not both")
+	case len(args) > 0:
+		return args[0], nil
+	case path != "":
+		file, err := ResolvedPath(ctx, client, path)
+		if err != nil {
+			return "", fmt.Errorf("resolving path: %w", err)
+		}
+		return file.ID, nil
+	default:
+		return "", fmt.Errorf("a file ID or --path is required")
+	}
+}
@@ -0,0 +1,169 @@
+package drive
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	driveapi "github.com/open-cli-collective/google-readonly/internal/drive"
+	"github.com/open-cli-collective/google-readonly/internal/testutil"
+)
+
+// pathMock serves ListFiles from a parent-ID/name table so path walks can be
+// exercised without matching on full query strings.
+func pathMock(t *testing.T, children map[string][]*driveapi.File) *MockDriveClient {
+	return &MockDriveClient{
+		ListFilesFunc: func(_ context.Context, query string, _ int64) ([]*driveapi.File, error) {
+			for parent, files := range children {
+				if !strings.Contains(query, "'"+parent+"' in parents") {
+					continue
+				}
+				var matches []*driveapi.File
+				for _, f := range files {
+					if strings.Contains(query, "name = '"+escapeQueryString(f.Name)+"'") {
+						matches = append(matches, f)
+					}
+				}
+				return matches, nil
+			}
+			t.Logf("unexpected query: %s", query)
+			return nil, nil
+		},
+	}
+}
+
+func TestResolvedPath(t *testing.T) {
+	children := map[string][]*driveapi.File{
+		"root": {
+			{ID: "folder_projects", Name: "Projects", MimeType: driveapi.MimeTypeFolder},
+		},
+		"folder_projects": {
+			{ID: "folder_2024", Name: "2024", MimeType: driveapi.MimeTypeFolder},
+			{ID: "dup_1", Name: "notes.txt"},
+			{ID: "dup_2", Name: "notes.txt"},
+		},
+		"folder_2024": {
+			{ID: "file_plan", Name: "plan.docx"},
+		},
+	}
+
+	t.Run("resolves nested path", func(t *testing.T) {
+		file, err := ResolvedPath(context.Background(), pathMock(t, children), "/Projects/2024/plan.docx")
+		testutil.NoError(t, err)
+		testutil.Equal(t, file.ID, "file_plan")
+	})
+
+	t.Run("ignores leading, trailing and repeated slashes", func(t *testing.T) {
+		file, err := ResolvedPath(context.Background(), pathMock(t, children), "Projects//2024/")
+		testutil.NoError(t, err)
+		testutil.Equal(t, file.ID, "folder_2024")
+	})
+
+	t.Run("missing segment", func(t *testing.T) {
+		_, err := ResolvedPath(context.Background(), pathMock(t, children), "/Projects/2023/plan.docx")
+		testutil.Error(t, err)
+		testutil.Contains(t, err.Error(), "not found: /Projects/2023")
+	})
+
+	t.Run("duplicate names list candidates", func(t *testing.T) {
+		_, err := ResolvedPath(context.Background(), pathMock(t, children), "/Projects/notes.txt")
+		testutil.Error(t, err)
+		testutil.Contains(t, err.Error(), "multiple items named \"notes.txt\" in /Projects")
+		testutil.Contains(t, err.Error(), "dup_1, dup_2")
+	})
+
+	t.Run("empty path", func(t *testing.T) {
+		_, err := ResolvedPath(context.Background(), pathMock(t, children), "/")
+		testutil.Error(t, err)
+		testutil.Contains(t, err.Error(), "does not name a file")
+	})
+
+	t.Run("intermediate segments must be folders", func(t *testing.T) {
+		var queries []string
+		mock := &MockDriveClient{
+			ListFilesFunc: func(_ context.Context, query string, _ int64) ([]*driveapi.File, error) {
+				queries = append(queries, query)
+				return []*driveapi.File{{ID: "x", Name: "x"}}, nil
+			},
+		}
+		_, err := ResolvedPath(context.Background(), mock, "/a/b")
+		testutil.NoError(t, err)
+		testutil.Len(t, queries, 2)
+		testutil.Contains(t, queries[0], "mimeType = '"+driveapi.MimeTypeFolder+"'")
+		testutil.NotContains(t, queries[1], "mimeType")
+	})
+
+	t.Run("API error", func(t *testing.T) {
+		mock := &MockDriveClient{
+			ListFilesFunc: func(_ context.Context, _ string, _ int64) ([]*driveapi.File, error) {
+				return nil, errors.New("API error")
+			},
+		}
+		_, err := ResolvedPath(context.Background(), mock, "/Projects")
+		testutil.Error(t, err)
+		testutil.Contains(t, err.Error(), "listing /Projects")
+	})
+}
+
+func TestResolveCommand(t *testing.T) {
+	mock := pathMock(t, map[string][]*driveapi.File{
+		"root": {{ID: "file_report", Name: "report.pdf"}},
+	})
+
+	cmd := newResolveCommand()
+	cmd.SetArgs([]string{"/report.pdf"})
+
+	withMockClient(mock, func() {
+		output := testutil.CaptureStdout(t, func() {
+			err := cmd.Execute()
+			testutil.NoError(t, err)
+		})
+
+		testutil.Equal(t, output, "file_report\n")
+	})
+}
+
+func TestGetCommand_Path(t *testing.T) {
+	mock := pathMock(t, map[string][]*driveapi.File{
+		"root": {{ID: "file_report", Name: "report.pdf"}},
+	})
+	mock.GetFileFunc = func(_ context.Context, fileID string) (*driveapi.File, error) {
+		testutil.Equal(t, fileID, "file_report")
+		return testutil.SampleDriveFile(fileID), nil
+	}
+
+	cmd := newGetCommand()
+	cmd.SetArgs([]string{"--path", "/report.pdf"})
+
+	withMockClient(mock, func() {
+		output := testutil.CaptureStdout(t, func() {
+			err := cmd.Execute()
+			testutil.NoError(t, err)
+		})
+
+		testutil.Contains(t, output, "file_report")
+	})
+}
+
+func TestGetCommand_IDAndPathExclusive(t *testing.T) {
+	cmd := newGetCommand()
+	cmd.SetArgs([]string{"file_id", "--path", "/report.pdf"})
+
+	withMockClient(&MockDriveClient{}, func() {
+		err := cmd.Execute()
+		testutil.Error(t, err)
+		testutil.Contains(t, err.Error(), "not both")
+	})
+}
+
+func TestDownloadCommand_RequiresIDOrPath(t *testing.T) {
+	cmd := newDownloadCommand()
+	cmd.SetArgs([]string{})
+
+	withMockClient(&MockDriveClient{}, func() {
+		err := cmd.Execute()
+		testutil.Error(t, err)
+		testutil.Contains(t, err.Error(), "a file ID or --path is required")
+	})
+}
@@ -0,0 +1,86 @@
+package drive
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/open-cli-collective/google-readonly/internal/drive"
+	"github.com/open-cli-collective/google-readonly/internal/format"
+	"github.com/open-cli-collective/google-readonly/internal/table"
+)
+
+func newRevisionsCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "revisions <file-id>",
+		Short: "List file revisions",
+		Long: `List the version history of a file, oldest first.
+
+Google Workspace files (Docs, Sheets, Slides) report no size per revision.
+Folders and shortcuts have no revision history.
+
+Examples:
+  gro drive revisions <file-id>`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, err := newDriveClient(cmd.Context())
+			if err != nil {
+				return fmt.Errorf("creating Drive client: %w", err)
+			}
+
+			revisions, err := client.ListRevisions(cmd.Context(), args[0])
+			if err != nil {
+				if drive.IsRevisionsNotSupported(err) {
+					return fmt.Errorf("file %s does not support revisions", args[0])
+				}
+				return fmt.Errorf("listing revisions: %w", err)
+			}
+
+			if len(revisions) == 0 {
+				fmt.Println("No revisions found.")
+				return nil
+			}
+
+			printRevisionTable(revisions)
+			return nil
+		},
+	}
+
+	return cmd
+}
+
+// printRevisionTable prints revisions in a formatted table
+func printRevisionTable(revisions []*drive.Revision) {
+	tbl := table.New(
+		table.Column{Header: "ID"},
+		table.Column{Header: "MODIFIED"},
+		table.Column{Header: "SIZE", Align: table.Right},
+		table.Column{Header: "MODIFIED BY", Shrink: true},
+		table.Column{Header: "KEEP"},
+	)
+
+	for _, r := range revisions {
+		modified := "-"
+		if !r.ModifiedTime.IsZero() {
+			modified = r.ModifiedTime.Format("2006-01-02 15:04")
+		}
+
+		size := "-"
+		if r.Size > 0 {
+			size = format.Size(r.Size)
+		}
+
+		modifiedBy := r.LastModifiedBy
+		if modifiedBy == "" {
+			modifiedBy = "-"
+		}
+
+		keep := ""
+		if r.KeepForever {
+			keep = "forever"
+		}
+
+		tbl.AddRow(r.ID, modified, size, modifiedBy, keep)
+	}
+	tbl.Print()
+}
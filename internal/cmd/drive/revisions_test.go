@@ -0,0 +1,119 @@
+package drive
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"google.golang.org/api/googleapi"
+
+	driveapi "github.com/open-cli-collective/google-readonly/internal/drive"
+	"github.com/open-cli-collective/google-readonly/internal/testutil"
+)
+
+func TestRevisionsCommand(t *testing.T) {
+	cmd := newRevisionsCommand()
+
+	t.Run("has correct use", func(t *testing.T) {
+		testutil.Equal(t, cmd.Use, "revisions <file-id>")
+	})
+
+	t.Run("requires exactly one argument", func(t *testing.T) {
+		testutil.Error(t, cmd.Args(cmd, []string{}))
+		testutil.NoError(t, cmd.Args(cmd, []string{"file-id"}))
+		testutil.Error(t, cmd.Args(cmd, []string{"a", "b"}))
+	})
+}
+
+func TestRevisionsCommand_Success(t *testing.T) {
+	mock := &MockDriveClient{
+		ListRevisionsFunc: func(_ context.Context, fileID string) ([]*driveapi.Revision, error) {
+			testutil.Equal(t, fileID, "file123")
+			return []*driveapi.Revision{
+				{
+					ID:             "rev1",
+					ModifiedTime:   time.Date(2024, 1, 10, 9, 30, 0, 0, time.UTC),
+					LastModifiedBy: "alice@example.com",
+					Size:           2048,
+					KeepForever:    true,
+				},
+				{
+					ID:           "rev2",
+					ModifiedTime: time.Date(2024, 1, 15, 14, 22, 0, 0, time.UTC),
+				},
+			}, nil
+		},
+	}
+
+	cmd := newRevisionsCommand()
+	cmd.SetArgs([]string{"file123"})
+
+	withMockClient(mock, func() {
+		output := testutil.CaptureStdout(t, func() {
+			err := cmd.Execute()
+			testutil.NoError(t, err)
+		})
+
+		testutil.Contains(t, output, "ID")
+		testutil.Contains(t, output, "MODIFIED BY")
+		testutil.Contains(t, output, "rev1")
+		testutil.Contains(t, output, "2024-01-10 09:30")
+		testutil.Contains(t, output, "2.0 KB")
+		testutil.Contains(t, output, "alice@example.com")
+		testutil.Contains(t, output, "forever")
+		testutil.Contains(t, output, "rev2")
+	})
+}
+
+func TestRevisionsCommand_Empty(t *testing.T) {
+	cmd := newRevisionsCommand()
+	cmd.SetArgs([]string{"file123"})
+
+	withMockClient(&MockDriveClient{}, func() {
+		output := testutil.CaptureStdout(t, func() {
+			err := cmd.Execute()
+			testutil.NoError(t, err)
+		})
+
+		testutil.Contains(t, output, "No revisions found")
+	})
+}
+
+func TestRevisionsCommand_NotSupported(t *testing.T) {
+	mock := &MockDriveClient{
+		ListRevisionsFunc: func(_ context.Context, _ string) ([]*driveapi.Revision, error) {
+			return nil, fmt.Errorf("listing revisions: %w", &googleapi.Error{
+				Code:   403,
+				Errors: []googleapi.ErrorItem{{Reason: "revisionsNotSupported"}},
+			})
+		},
+	}
+
+	cmd := newRevisionsCommand()
+	cmd.SetArgs([]string{"folder123"})
+
+	withMockClient(mock, func() {
+		err := cmd.Execute()
+		testutil.Error(t, err)
+		testutil.Contains(t, err.Error(), "does not support revisions")
+	})
+}
+
+func TestRevisionsCommand_APIError(t *testing.T) {
+	mock := &MockDriveClient{
+		ListRevisionsFunc: func(_ context.Context, _ string) ([]*driveapi.Revision, error) {
+			return nil, errors.New("API error")
+		},
+	}
+
+	cmd := newRevisionsCommand()
+	cmd.SetArgs([]string{"file123"})
+
+	withMockClient(mock, func() {
+		err := cmd.Execute()
+		testutil.Error(t, err)
+		testutil.Contains(t, err.Error(), "listing revisions")
+	})
+}
@@ -3,8 +3,11 @@ package drive
 import (
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
+
+	"github.com/open-cli-collective/google-readonly/internal/format"
 )
 
 func newSearchCommand() *cobra.Command {
@@ -19,6 +22,8 @@ func newSearchCommand() *cobra.Command {
 		idsOutput  bool
 		myDrive    bool
 		driveFlag  string
+		withTrash  bool
+		onlyTrash  bool
 	)
 
 	cmd := &cobra.Command{
@@ -38,8 +43,11 @@ Examples:
   gro drive search --type spreadsheet           # Filter by type
   gro drive search --owner me                   # Files you own
   gro drive search --owner john@example.com     # Files owned by someone
-  gro drive search --modified-after 2024-01-01  # Modified after date
+  gro drive search --modified-after 2024-01-01  # Modified on or after date
+  gro drive search "budget" --owner me --modified-before 2024-06-30
   gro drive search --in-folder <folder-id>      # Search within folder
+  gro drive search "budget" --in-trash-and-live # Live and trashed matches, with a STATE column
+  gro drive search "budget" --trashed-only      # Only matches in the trash
 
 File types: document, spreadsheet, presentation, folder, pdf, image, video, audio`,
 		Args: cobra.MaximumNArgs(1),
@@ -47,6 +55,10 @@ File types: document, spreadsheet, presentation, folder, pdf, image, video, audi
 			if myDrive && driveFlag != "" {
 				return fmt.Errorf("--my-drive and --drive are mutually exclusive")
 			}
+			trash, err := trashModeFromFlags(withTrash, onlyTrash)
+			if err != nil {
+				return err
+			}
 
 			client, err := newDriveClient(cmd.Context())
 			if err != nil {
@@ -58,7 +70,7 @@ File types: document, spreadsheet, presentation, folder, pdf, image, video, audi
 				query = args[0]
 			}
 
-			searchQuery, err := buildSearchQuery(query, nameOnly, fileType, owner, modAfter, modBefore, inFolder)
+			searchQuery, err := buildSearchQuery(query, nameOnly, fileType, owner, modAfter, modBefore, inFolder, trash)
 			if err != nil {
 				return fmt.Errorf("building search query: %w", err)
 			}
@@ -76,9 +88,7 @@ File types: document, spreadsheet, presentation, folder, pdf, image, video, audi
 			}
 
 			if idsOutput {
-				for _, f := range files {
-					fmt.Println(f.ID)
-				}
+				printFileIDs(files)
 				return nil
 			}
 
@@ -96,7 +106,7 @@ File types: document, spreadsheet, presentation, folder, pdf, image, video, audi
 			} else {
 				fmt.Printf("Found %d file(s):\n\n", len(files))
 			}
-			printFileTable(files)
+			trash.printFiles(files)
 			return nil
 		},
 	}
@@ -104,20 +114,27 @@ File types: document, spreadsheet, presentation, folder, pdf, image, video, audi
 	cmd.Flags().Int64VarP(&maxResults, "max", "m", 25, "Maximum number of results to return")
 	cmd.Flags().BoolVarP(&nameOnly, "name", "n", false, "Search filename only (not content)")
 	cmd.Flags().StringVarP(&fileType, "type", "t", "", "Filter by file type")
+	_ = cmd.RegisterFlagCompletionFunc("type", completeFileType)
 	cmd.Flags().StringVar(&owner, "owner", "", "Filter by owner (\"me\" or email address)")
-	cmd.Flags().StringVar(&modAfter, "modified-after", "", "Modified after date (YYYY-MM-DD)")
-	cmd.Flags().StringVar(&modBefore, "modified-before", "", "Modified before date (YYYY-MM-DD)")
+	cmd.Flags().StringVar(&modAfter, "modified-after", "", "Only files modified on or after this date (YYYY-MM-DD, UTC)")
+	cmd.Flags().StringVar(&modBefore, "modified-before", "", "Only files modified on or before this date (YYYY-MM-DD, UTC)")
 	cmd.Flags().StringVar(&inFolder, "in-folder", "", "Search within specific folder")
 	cmd.Flags().BoolVar(&idsOutput, "ids", false, "Output only file IDs (one per line, for piping)")
 	cmd.Flags().BoolVar(&myDrive, "my-drive", false, "Limit search to My Drive only")
 	cmd.Flags().StringVar(&driveFlag, "drive", "", "Search in specific shared drive (name or ID)")
+	cmd.Flags().BoolVar(&withTrash, "in-trash-and-live", false, "Include trashed files alongside live ones, marked in a STATE column")
+	cmd.Flags().BoolVar(&onlyTrash, "trashed-only", false, "Only show files in the trash, marked in a STATE column")
 
 	return cmd
 }
 
-// buildSearchQuery constructs a Drive API query string for searching files
-func buildSearchQuery(query string, nameOnly bool, fileType, owner, modAfter, modBefore, inFolder string) (string, error) {
-	parts := []string{"trashed = false"}
+// buildSearchQuery constructs a Drive API query string for searching files.
+// trash selects whether live files, trashed files or both match.
+func buildSearchQuery(query string, nameOnly bool, fileType, owner, modAfter, modBefore, inFolder string, trash trashMode) (string, error) {
+	var parts []string
+	if clause := trash.clause(); clause != "" {
+		parts = append(parts, clause)
+	}
 
 	// Text search
 	if query != "" {
@@ -140,16 +157,31 @@ func buildSearchQuery(query string, nameOnly bool, fileType, owner, modAfter, mo
 
 	// Owner filter
 	if owner != "" {
-		parts = append(parts, fmt.Sprintf("'%s' in owners", owner))
+		parts = append(parts, fmt.Sprintf("'%s' in owners", escapeQueryString(owner)))
 	}
 
-	// Date filters
+	// Date filters. Both days are included, in UTC as Drive reads them: the
+	// range runs from midnight of --modified-after to midnight after
+	// --modified-before.
+	var after, before time.Time
 	if modAfter != "" {
-		// Drive API requires RFC3339 format
-		parts = append(parts, fmt.Sprintf("modifiedTime > '%sT00:00:00'", modAfter))
+		t, err := format.ParseDate(modAfter)
+		if err != nil {
+			return "", fmt.Errorf("invalid --modified-after date: %w", err)
+		}
+		after = t
+		parts = append(parts, fmt.Sprintf("modifiedTime >= '%s'", after.Format(time.RFC3339)))
 	}
 	if modBefore != "" {
-		parts = append(parts, fmt.Sprintf("modifiedTime < '%sT23:59:59'", modBefore))
+		t, err := format.ParseDate(modBefore)
+		if err != nil {
+			return "", fmt.Errorf("invalid --modified-before date: %w", err)
+		}
+		before = t.AddDate(0, 0, 1)
+		parts = append(parts, fmt.Sprintf("modifiedTime < '%s'", before.Format(time.RFC3339)))
+	}
+	if !after.IsZero() && !before.IsZero() && !after.Before(before) {
+		return "", fmt.Errorf("--modified-after %s must not be later than --modified-before %s", modAfter, modBefore)
 	}
 
 	// Folder scope
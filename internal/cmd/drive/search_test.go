@@ -70,74 +70,115 @@ func TestSearchCommand(t *testing.T) {
 
 func TestBuildSearchQuery(t *testing.T) {
 	t.Run("builds full-text search query", func(t *testing.T) {
-		query, err := buildSearchQuery("quarterly report", false, "", "", "", "", "")
+		query, err := buildSearchQuery("quarterly report", false, "", "", "", "", "", liveOnly)
 		testutil.NoError(t, err)
 		testutil.Contains(t, query, "trashed = false")
 		testutil.Contains(t, query, "fullText contains 'quarterly report'")
 	})
 
 	t.Run("builds name-only search query", func(t *testing.T) {
-		query, err := buildSearchQuery("budget", true, "", "", "", "", "")
+		query, err := buildSearchQuery("budget", true, "", "", "", "", "", liveOnly)
 		testutil.NoError(t, err)
 		testutil.Contains(t, query, "name contains 'budget'")
 		testutil.NotContains(t, query, "fullText")
 	})
 
 	t.Run("adds type filter", func(t *testing.T) {
-		query, err := buildSearchQuery("test", false, "document", "", "", "", "")
+		query, err := buildSearchQuery("test", false, "document", "", "", "", "", liveOnly)
 		testutil.NoError(t, err)
 		testutil.Contains(t, query, "mimeType = 'application/vnd.google-apps.document'")
 	})
 
 	t.Run("returns error for invalid type", func(t *testing.T) {
-		_, err := buildSearchQuery("test", false, "invalid", "", "", "", "")
+		_, err := buildSearchQuery("test", false, "invalid", "", "", "", "", liveOnly)
 		testutil.Error(t, err)
 		testutil.Contains(t, err.Error(), "unknown file type")
 	})
 
 	t.Run("adds owner filter with 'me'", func(t *testing.T) {
-		query, err := buildSearchQuery("", false, "", "me", "", "", "")
+		query, err := buildSearchQuery("", false, "", "me", "", "", "", liveOnly)
 		testutil.NoError(t, err)
 		testutil.Contains(t, query, "'me' in owners")
 	})
 
 	t.Run("adds owner filter with email", func(t *testing.T) {
-		query, err := buildSearchQuery("", false, "", "john@example.com", "", "", "")
+		query, err := buildSearchQuery("", false, "", "john@example.com", "", "", "", liveOnly)
 		testutil.NoError(t, err)
 		testutil.Contains(t, query, "'john@example.com' in owners")
 	})
 
+	t.Run("escapes quotes in owner", func(t *testing.T) {
+		query, err := buildSearchQuery("", false, "", "o'brien@example.com", "", "", "", liveOnly)
+		testutil.NoError(t, err)
+		testutil.Contains(t, query, `'o\'brien@example.com' in owners`)
+	})
+
 	t.Run("adds modified-after filter", func(t *testing.T) {
-		query, err := buildSearchQuery("", false, "", "", "2024-01-01", "", "")
+		query, err := buildSearchQuery("", false, "", "", "2024-01-01", "", "", liveOnly)
 		testutil.NoError(t, err)
-		testutil.Contains(t, query, "modifiedTime > '2024-01-01T00:00:00'")
+		testutil.Contains(t, query, "modifiedTime >= '2024-01-01T00:00:00Z'")
 	})
 
-	t.Run("adds modified-before filter", func(t *testing.T) {
-		query, err := buildSearchQuery("", false, "", "", "", "2024-12-31", "")
+	t.Run("modified-before includes the whole day", func(t *testing.T) {
+		query, err := buildSearchQuery("", false, "", "", "", "2024-12-31", "", liveOnly)
 		testutil.NoError(t, err)
-		testutil.Contains(t, query, "modifiedTime < '2024-12-31T23:59:59'")
+		testutil.Contains(t, query, "modifiedTime < '2025-01-01T00:00:00Z'")
+	})
+
+	t.Run("rejects malformed dates", func(t *testing.T) {
+		_, err := buildSearchQuery("", false, "", "", "01/02/2024", "", "", liveOnly)
+		testutil.Error(t, err)
+		testutil.Contains(t, err.Error(), "invalid --modified-after date")
+
+		_, err = buildSearchQuery("", false, "", "", "", "2024-13-01", "", liveOnly)
+		testutil.Error(t, err)
+		testutil.Contains(t, err.Error(), "invalid --modified-before date")
+	})
+
+	t.Run("rejects a reversed range", func(t *testing.T) {
+		_, err := buildSearchQuery("", false, "", "", "2024-06-02", "2024-06-01", "", liveOnly)
+		testutil.Error(t, err)
+		testutil.Contains(t, err.Error(), "must not be later than --modified-before")
+
+		// one day is a valid range
+		query, err := buildSearchQuery("", false, "", "", "2024-06-01", "2024-06-01", "", liveOnly)
+		testutil.NoError(t, err)
+		testutil.Contains(t, query, "modifiedTime >= '2024-06-01T00:00:00Z' and modifiedTime < '2024-06-02T00:00:00Z'")
+	})
+
+	t.Run("ANDs the filters with the search term", func(t *testing.T) {
+		query, err := buildSearchQuery("budget", true, "", "alice@example.com", "2024-01-01", "2024-03-31", "", liveOnly)
+		testutil.NoError(t, err)
+		testutil.Equal(t, query, "trashed = false and name contains 'budget' and 'alice@example.com' in owners"+
+			" and modifiedTime >= '2024-01-01T00:00:00Z' and modifiedTime < '2024-04-01T00:00:00Z'")
 	})
 
 	t.Run("adds folder scope", func(t *testing.T) {
-		query, err := buildSearchQuery("", false, "", "", "", "", "folder123")
+		query, err := buildSearchQuery("", false, "", "", "", "", "folder123", liveOnly)
 		testutil.NoError(t, err)
 		testutil.Contains(t, query, "'folder123' in parents")
 	})
 
 	t.Run("combines multiple filters", func(t *testing.T) {
-		query, err := buildSearchQuery("report", false, "document", "me", "2024-01-01", "", "folder123")
+		query, err := buildSearchQuery("report", false, "document", "me", "2024-01-01", "", "folder123", liveOnly)
 		testutil.NoError(t, err)
 		testutil.Contains(t, query, "trashed = false")
 		testutil.Contains(t, query, "fullText contains 'report'")
 		testutil.Contains(t, query, "mimeType = 'application/vnd.google-apps.document'")
 		testutil.Contains(t, query, "'me' in owners")
-		testutil.Contains(t, query, "modifiedTime > '2024-01-01T00:00:00'")
+		testutil.Contains(t, query, "modifiedTime >= '2024-01-01T00:00:00Z'")
 		testutil.Contains(t, query, "'folder123' in parents")
 	})
 
+	t.Run("drops the trashed clause when including trash", func(t *testing.T) {
+		query, err := buildSearchQuery("report", false, "", "", "", "", "", liveAndTrashed)
+		testutil.NoError(t, err)
+		testutil.NotContains(t, query, "trashed")
+		testutil.Equal(t, query, "fullText contains 'report'")
+	})
+
 	t.Run("builds query with no search term", func(t *testing.T) {
-		query, err := buildSearchQuery("", false, "document", "", "", "", "")
+		query, err := buildSearchQuery("", false, "document", "", "", "", "", liveOnly)
 		testutil.NoError(t, err)
 		testutil.Contains(t, query, "trashed = false")
 		testutil.Contains(t, query, "mimeType")
@@ -0,0 +1,59 @@
+package drive
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+)
+
+// starredQuery selects the starred files that are not in the trash
+const starredQuery = "starred = true and trashed = false"
+
+func newStarredCommand() *cobra.Command {
+	var (
+		maxResults int64
+		idsOutput  bool
+	)
+
+	cmd := &cobra.Command{
+		Use:   "starred",
+		Short: "List starred files",
+		Long: `List the files you have starred, most recently modified first. Trashed
+files are left out.
+
+Examples:
+  gro drive starred
+  gro drive starred --max 50
+  gro drive starred --ids | xargs -n1 gro drive get`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			client, err := newDriveClient(cmd.Context())
+			if err != nil {
+				return fmt.Errorf("creating Drive client: %w", err)
+			}
+
+			files, err := client.ListFilesOrdered(cmd.Context(), starredQuery, maxResults, "modifiedTime desc")
+			if err != nil {
+				return fmt.Errorf("listing starred files: %w", err)
+			}
+
+			if idsOutput {
+				printFileIDs(files)
+				return nil
+			}
+
+			if len(files) == 0 {
+				fmt.Println("No starred files.")
+				return nil
+			}
+
+			printFileTable(files)
+			return nil
+		},
+	}
+
+	cmd.Flags().Int64VarP(&maxResults, "max", "m", 20, "Maximum number of results to return")
+	cmd.Flags().BoolVar(&idsOutput, "ids", false, "Output only file IDs (one per line, for piping)")
+
+	return cmd
+}
@@ -0,0 +1,106 @@
+package drive
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	driveapi "github.com/open-cli-collective/google-readonly/internal/drive"
+	"github.com/open-cli-collective/google-readonly/internal/testutil"
+)
+
+func TestStarredCommand(t *testing.T) {
+	cmd := newStarredCommand()
+
+	t.Run("has correct use", func(t *testing.T) {
+		testutil.Equal(t, cmd.Use, "starred")
+	})
+
+	t.Run("takes no arguments", func(t *testing.T) {
+		testutil.NoError(t, cmd.Args(cmd, []string{}))
+		testutil.Error(t, cmd.Args(cmd, []string{"extra"}))
+	})
+
+	t.Run("has max flag defaulting to 20", func(t *testing.T) {
+		flag := cmd.Flags().Lookup("max")
+		testutil.NotNil(t, flag)
+		testutil.Equal(t, flag.Shorthand, "m")
+		testutil.Equal(t, flag.DefValue, "20")
+	})
+}
+
+func TestStarredCommand_Success(t *testing.T) {
+	mock := &MockDriveClient{
+		ListFilesOrderedFunc: func(_ context.Context, query string, pageSize int64, orderBy string) ([]*driveapi.File, error) {
+			testutil.Equal(t, query, "starred = true and trashed = false")
+			testutil.Equal(t, pageSize, int64(5))
+			testutil.Equal(t, orderBy, "modifiedTime desc")
+			return testutil.SampleDriveFiles(2), nil
+		},
+	}
+
+	cmd := newStarredCommand()
+	cmd.SetArgs([]string{"--max", "5"})
+
+	withMockClient(mock, func() {
+		output := testutil.CaptureStdout(t, func() {
+			testutil.NoError(t, cmd.Execute())
+		})
+
+		testutil.Contains(t, output, "NAME")
+		testutil.Contains(t, output, "file_a")
+		testutil.Contains(t, output, "file_b")
+	})
+}
+
+func TestStarredCommand_IDs(t *testing.T) {
+	mock := &MockDriveClient{
+		ListFilesOrderedFunc: func(_ context.Context, _ string, _ int64, _ string) ([]*driveapi.File, error) {
+			return testutil.SampleDriveFiles(2), nil
+		},
+	}
+
+	cmd := newStarredCommand()
+	cmd.SetArgs([]string{"--ids"})
+
+	withMockClient(mock, func() {
+		output := testutil.CaptureStdout(t, func() {
+			testutil.NoError(t, cmd.Execute())
+		})
+		testutil.NotContains(t, output, "NAME")
+		testutil.Contains(t, output, "file_a")
+	})
+}
+
+func TestStarredCommand_Empty(t *testing.T) {
+	mock := &MockDriveClient{
+		ListFilesOrderedFunc: func(_ context.Context, _ string, _ int64, _ string) ([]*driveapi.File, error) {
+			return nil, nil
+		},
+	}
+
+	cmd := newStarredCommand()
+
+	withMockClient(mock, func() {
+		output := testutil.CaptureStdout(t, func() {
+			testutil.NoError(t, cmd.Execute())
+		})
+		testutil.Equal(t, output, "No starred files.\n")
+	})
+}
+
+func TestStarredCommand_APIError(t *testing.T) {
+	mock := &MockDriveClient{
+		ListFilesOrderedFunc: func(_ context.Context, _ string, _ int64, _ string) ([]*driveapi.File, error) {
+			return nil, errors.New("quota exceeded")
+		},
+	}
+
+	cmd := newStarredCommand()
+
+	withMockClient(mock, func() {
+		err := cmd.Execute()
+		testutil.Error(t, err)
+		testutil.Contains(t, err.Error(), "listing starred files")
+	})
+}
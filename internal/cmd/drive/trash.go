@@ -0,0 +1,53 @@
+package drive
+
+import (
+	"fmt"
+
+	"github.com/open-cli-collective/google-readonly/internal/drive"
+)
+
+// trashMode selects whether a listing covers live files, trashed files or
+// both
+type trashMode int
+
+const (
+	liveOnly trashMode = iota
+	liveAndTrashed
+	trashedOnly
+)
+
+// trashModeFromFlags maps --in-trash-and-live and --trashed-only to a
+// trashMode. Neither set keeps the default of live files only.
+func trashModeFromFlags(withTrash, onlyTrash bool) (trashMode, error) {
+	switch {
+	case withTrash && onlyTrash:
+		return liveOnly, fmt.Errorf("--in-trash-and-live and --trashed-only are mutually exclusive")
+	case withTrash:
+		return liveAndTrashed, nil
+	case onlyTrash:
+		return trashedOnly, nil
+	}
+	return liveOnly, nil
+}
+
+// clause returns the query condition for the mode, empty when trashed and
+// live files both match
+func (m trashMode) clause() string {
+	switch m {
+	case liveOnly:
+		return "trashed = false"
+	case trashedOnly:
+		return "trashed = true"
+	}
+	return ""
+}
+
+// printFiles prints files in the file table, with the STATE column when the
+// mode lets trashed files through
+func (m trashMode) printFiles(files []*drive.File) {
+	if m == liveOnly {
+		printFileTable(files)
+		return
+	}
+	printFileStateTable(files)
+}
@@ -0,0 +1,107 @@
+package drive
+
+import (
+	"context"
+	"testing"
+
+	"github.com/spf13/cobra"
+
+	driveapi "github.com/open-cli-collective/google-readonly/internal/drive"
+	"github.com/open-cli-collective/google-readonly/internal/testutil"
+)
+
+func TestTrashModeFromFlags(t *testing.T) {
+	mode, err := trashModeFromFlags(false, false)
+	testutil.NoError(t, err)
+	testutil.Equal(t, mode.clause(), "trashed = false")
+
+	mode, err = trashModeFromFlags(true, false)
+	testutil.NoError(t, err)
+	testutil.Equal(t, mode.clause(), "")
+
+	mode, err = trashModeFromFlags(false, true)
+	testutil.NoError(t, err)
+	testutil.Equal(t, mode.clause(), "trashed = true")
+
+	_, err = trashModeFromFlags(true, true)
+	testutil.Error(t, err)
+	testutil.Contains(t, err.Error(), "mutually exclusive")
+}
+
+// trashedFileMock answers every listing with one trashed file, recording
+// the query it was sent
+func trashedFileMock(gotQuery *string) *MockDriveClient {
+	return &MockDriveClient{
+		ListFilesFunc: func(_ context.Context, query string, _ int64) ([]*driveapi.File, error) {
+			*gotQuery = query
+			f := testutil.SampleDriveFile("deleted_file")
+			f.Trashed = true
+			return []*driveapi.File{f}, nil
+		},
+	}
+}
+
+func TestTrashedOnlyFlag(t *testing.T) {
+	tests := []struct {
+		name      string
+		newCmd    func() *cobra.Command
+		args      []string
+		wantQuery string
+	}{
+		{"list", newListCommand, []string{"folder123", "--trashed-only"}, "trashed = true and 'folder123' in parents"},
+		{"search", newSearchCommand, []string{"report", "--trashed-only"}, "trashed = true and fullText contains 'report'"},
+		{"query", newQueryCommand, []string{"starred", "--trashed-only"}, "(starred) and trashed = true"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var gotQuery string
+			cmd := tt.newCmd()
+			cmd.SetArgs(tt.args)
+
+			withMockClient(trashedFileMock(&gotQuery), func() {
+				output := testutil.CaptureStdout(t, func() {
+					testutil.NoError(t, cmd.Execute())
+				})
+				testutil.Contains(t, output, "STATE")
+				testutil.Contains(t, output, "trashed")
+			})
+			testutil.Equal(t, gotQuery, tt.wantQuery)
+		})
+	}
+}
+
+func TestListCommand_InTrashAndLive(t *testing.T) {
+	var gotQuery string
+	cmd := newListCommand()
+	cmd.SetArgs([]string{"--in-trash-and-live"})
+
+	withMockClient(trashedFileMock(&gotQuery), func() {
+		output := testutil.CaptureStdout(t, func() {
+			testutil.NoError(t, cmd.Execute())
+		})
+		testutil.Contains(t, output, "STATE")
+	})
+	testutil.Equal(t, gotQuery, "'root' in parents")
+}
+
+func TestListCommand_DefaultHidesTrash(t *testing.T) {
+	var gotQuery string
+	cmd := newListCommand()
+
+	withMockClient(trashedFileMock(&gotQuery), func() {
+		output := testutil.CaptureStdout(t, func() {
+			testutil.NoError(t, cmd.Execute())
+		})
+		testutil.NotContains(t, output, "STATE")
+	})
+	testutil.Equal(t, gotQuery, "trashed = false and 'root' in parents")
+}
+
+func TestTrashFlags_MutuallyExclusive(t *testing.T) {
+	cmd := newListCommand()
+	cmd.SetArgs([]string{"--in-trash-and-live", "--trashed-only"})
+
+	err := cmd.Execute()
+	testutil.Error(t, err)
+	testutil.Contains(t, err.Error(), "mutually exclusive")
+}
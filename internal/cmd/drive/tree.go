@@ -3,11 +3,16 @@ package drive
 import (
 	"context"
 	"fmt"
+	"slices"
 	"sort"
+	"sync"
 
 	"github.com/spf13/cobra"
 
+	"github.com/open-cli-collective/google-readonly/internal/color"
 	"github.com/open-cli-collective/google-readonly/internal/drive"
+	"github.com/open-cli-collective/google-readonly/internal/format"
+	"github.com/open-cli-collective/google-readonly/internal/progress"
 )
 
 // TreeNode represents a node in the folder tree
@@ -15,6 +20,8 @@ type TreeNode struct {
 	ID       string      `json:"id"`
 	Name     string      `json:"name"`
 	Type     string      `json:"type"`
+	MimeType string      `json:"mimeType,omitempty"`
+	Size     int64       `json:"size,omitempty"`
 	Children []*TreeNode `json:"children,omitempty"`
 }
 
@@ -92,31 +99,105 @@ func buildTree(ctx context.Context, client DriveClient, folderID string, depth i
 	return buildTreeWithScope(ctx, client, folderID, "", depth, includeFiles)
 }
 
-// buildTreeWithScope builds folder tree with optional root name override
+// treeWorkers bounds how many Drive calls a tree walk has in flight at once,
+// so a wide tree does not trip Drive's per-user rate limit
+const treeWorkers = 8
+
+// buildTreeWithScope builds folder tree with optional root name override.
+// A running count of the items listed is shown on stderr while it walks.
 func buildTreeWithScope(ctx context.Context, client DriveClient, folderID, rootName string, depth int, includeFiles bool) (*TreeNode, error) {
+	tally := progress.Count("items")
+	defer tally.Done()
+	w := &treeWalker{
+		client:       client,
+		tally:        tally,
+		includeFiles: includeFiles,
+		sem:          make(chan struct{}, treeWorkers),
+		files:        make(map[string]*drive.File),
+	}
+	return w.walk(ctx, folderID, rootName, depth, nil)
+}
+
+// treeWalker holds what every level of one buildTreeWithScope walk shares
+type treeWalker struct {
+	client       DriveClient
+	tally        *progress.Tally
+	includeFiles bool
+	sem          chan struct{} // a slot per Drive call in flight
+
+	mu    sync.Mutex
+	files map[string]*drive.File // metadata seen so far in this walk, by ID
+}
+
+// call runs fn, a Drive call, once a worker slot is free
+func (w *treeWalker) call(fn func()) {
+	w.sem <- struct{}{}
+	defer func() { <-w.sem }()
+	fn()
+}
+
+// getFile returns folderID's metadata, from a listing earlier in the walk
+// when there was one, so each folder is fetched at most once
+func (w *treeWalker) getFile(ctx context.Context, folderID string) (*drive.File, error) {
+	w.mu.Lock()
+	f, ok := w.files[folderID]
+	w.mu.Unlock()
+	if ok {
+		return f, nil
+	}
+
+	var err error
+	w.call(func() { f, err = w.client.GetFile(ctx, folderID) })
+	if err != nil {
+		return nil, err
+	}
+	w.remember(f)
+	return f, nil
+}
+
+// remember caches files' metadata for getFile
+func (w *treeWalker) remember(files ...*drive.File) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	for _, f := range files {
+		w.files[f.ID] = f
+	}
+}
+
+// walk builds the node for folderID and, depth permitting, its subtree,
+// adding each folder's children to the tally as they are listed. Sibling
+// folders are expanded concurrently. A walk holds a worker slot only while
+// it talks to Drive, never while it waits for its children, so nesting
+// cannot exhaust the pool. ancestors are the folder IDs on the path from
+// the root: a folder that turns up inside itself, which items added to
+// several folders make possible, is left out rather than followed forever.
+func (w *treeWalker) walk(ctx context.Context, folderID, rootName string, depth int, ancestors []string) (*TreeNode, error) {
 	// Get folder info
 	var folderName string
 	var folderType string
+	folderMimeType := drive.MimeTypeFolder
 
 	if folderID == "root" {
 		folderName = "My Drive"
 		folderType = "Folder"
-	} else if rootName != "" && depth == 2 { // First call with override
+	} else if rootName != "" { // Only the first call carries an override
 		folderName = rootName
 		folderType = "Shared Drive"
 	} else {
-		folder, err := client.GetFile(ctx, folderID)
+		folder, err := w.getFile(ctx, folderID)
 		if err != nil {
 			return nil, fmt.Errorf("getting folder info: %w", err)
 		}
 		folderName = folder.Name
 		folderType = drive.GetTypeName(folder.MimeType)
+		folderMimeType = folder.MimeType
 	}
 
 	node := &TreeNode{
-		ID:   folderID,
-		Name: folderName,
-		Type: folderType,
+		ID:       folderID,
+		Name:     folderName,
+		Type:     folderType,
+		MimeType: folderMimeType,
 	}
 
 	// Stop if we've reached the depth limit
@@ -126,75 +207,118 @@ func buildTreeWithScope(ctx context.Context, client DriveClient, folderID, rootN
 
 	// Build query to list children - use scope for shared drive support
 	query := fmt.Sprintf("'%s' in parents and trashed = false", folderID)
-	if !includeFiles {
+	if !w.includeFiles {
 		query += fmt.Sprintf(" and mimeType = '%s'", drive.MimeTypeFolder)
 	}
 
 	// Use ListFilesWithScope to support shared drives
 	scope := drive.DriveScope{AllDrives: true}
-	children, err := client.ListFilesWithScope(ctx, query, 100, scope)
+	var children []*drive.File
+	var err error
+	w.call(func() { children, err = w.client.ListFilesWithScope(ctx, query, 100, scope) })
 	if err != nil {
 		return nil, fmt.Errorf("listing children: %w", err)
 	}
+	w.tally.Add(len(children))
+	w.remember(children...)
+	if len(children) == 0 {
+		return node, nil
+	}
 
 	// Sort children: folders first, then by name
 	sort.Slice(children, func(i, j int) bool {
-		iIsFolder := children[i].MimeType == drive.MimeTypeFolder
-		jIsFolder := children[j].MimeType == drive.MimeTypeFolder
-		if iIsFolder != jIsFolder {
-			return iIsFolder // folders first
+		if c := compareFoldersFirst(children[i], children[j]); c != 0 {
+			return c < 0
 		}
 		return children[i].Name < children[j].Name
 	})
 
-	// Process children
-	for _, child := range children {
-		if child.MimeType == drive.MimeTypeFolder {
+	// Process children. Each subtree fills its own slot, so the sorted order
+	// holds however the walks finish.
+	path := append(slices.Clip(ancestors), folderID)
+	node.Children = make([]*TreeNode, len(children))
+	var wg sync.WaitGroup
+	for i, child := range children {
+		if child.MimeType != drive.MimeTypeFolder {
+			// Add file as leaf node
+			node.Children[i] = &TreeNode{
+				ID:       child.ID,
+				Name:     child.Name,
+				Type:     drive.GetTypeName(child.MimeType),
+				MimeType: child.MimeType,
+				Size:     child.Size,
+			}
+			continue
+		}
+		if slices.Contains(path, child.ID) {
+			continue
+		}
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
 			// Recursively build subtree for folders (don't pass rootName on recursion)
-			childNode, err := buildTreeWithScope(ctx, client, child.ID, "", depth-1, includeFiles)
+			childNode, err := w.walk(ctx, child.ID, "", depth-1, path)
 			if err != nil {
-				// Log error but continue with other children
-				continue
+				// Leave the folder out but continue with other children
+				return
 			}
-			node.Children = append(node.Children, childNode)
-		} else {
-			// Add file as leaf node
-			node.Children = append(node.Children, &TreeNode{
-				ID:   child.ID,
-				Name: child.Name,
-				Type: drive.GetTypeName(child.MimeType),
-			})
-		}
+			node.Children[i] = childNode
+		}()
 	}
+	wg.Wait()
+	node.Children = slices.DeleteFunc(node.Children, func(n *TreeNode) bool { return n == nil })
 
 	return node, nil
 }
 
-// printTree prints the tree structure with tree characters
+// printTree prints the tree structure with tree characters. Under --plain the
+// branch glyphs are replaced with two-space indentation per level.
 func printTree(node *TreeNode, prefix string, isRoot bool) {
 	if isRoot {
-		fmt.Println(node.Name)
+		fmt.Println(color.Folder(node.Name))
 	}
 
+	glyphs := format.Tree()
+
 	for i, child := range node.Children {
 		isLast := i == len(node.Children)-1
 
+		name := child.Name
+		if child.MimeType == drive.MimeTypeFolder {
+			name = color.Folder(name)
+		}
+
 		// Print the current line
 		if isLast {
-			fmt.Printf("%s└── %s\n", prefix, child.Name)
+			fmt.Printf("%s%s%s\n", prefix, glyphs.LastBranch, name)
 		} else {
-			fmt.Printf("%s├── %s\n", prefix, child.Name)
+			fmt.Printf("%s%s%s\n", prefix, glyphs.Branch, name)
 		}
 
 		// Print children with updated prefix
 		if len(child.Children) > 0 {
 			var newPrefix string
 			if isLast {
-				newPrefix = prefix + "    "
+				newPrefix = prefix + glyphs.Space
 			} else {
-				newPrefix = prefix + "│   "
+				newPrefix = prefix + glyphs.Pipe
 			}
 			printTree(child, newPrefix, false)
 		}
 	}
 }
+
+// compareFoldersFirst orders a folder before a non-folder. It returns 0 when
+// a and b are both folders or both not, leaving the order to the caller.
+func compareFoldersFirst(a, b *drive.File) int {
+	aIsFolder := a.MimeType == drive.MimeTypeFolder
+	bIsFolder := b.MimeType == drive.MimeTypeFolder
+	switch {
+	case aIsFolder == bIsFolder:
+		return 0
+	case aIsFolder:
+		return -1
+	default:
+		return 1
+	}
+}
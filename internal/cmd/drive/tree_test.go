@@ -3,8 +3,12 @@ package drive
 import (
 	"context"
 	"fmt"
+	"io"
 	"strings"
+	"sync"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/open-cli-collective/google-readonly/internal/drive"
 	"github.com/open-cli-collective/google-readonly/internal/testutil"
@@ -149,6 +153,36 @@ func TestPrintTree(t *testing.T) {
 		testutil.Contains(t, output, "        └── Level3")
 	})
 
+	t.Run("plain output indents without glyphs", func(t *testing.T) {
+		withPlainOutput(t)
+
+		node := &TreeNode{
+			ID:   "root",
+			Name: "My Drive",
+			Type: "Folder",
+			Children: []*TreeNode{
+				{
+					ID:   "1",
+					Name: "Projects",
+					Type: "Folder",
+					Children: []*TreeNode{
+						{ID: "1a", Name: "Project A", Type: "Folder"},
+					},
+				},
+				{ID: "2", Name: "Documents", Type: "Folder"},
+			},
+		}
+
+		output := captureOutput(func() {
+			printTree(node, "", true)
+		})
+
+		testutil.Equal(t, output, "My Drive\n  Projects\n    Project A\n  Documents\n")
+		for _, glyph := range []string{"├", "└", "│", "─"} {
+			testutil.NotContains(t, output, glyph)
+		}
+	})
+
 	t.Run("handles empty children", func(t *testing.T) {
 		node := &TreeNode{
 			ID:       "root",
@@ -226,6 +260,10 @@ func (m *mockDriveClient) ListFiles(_ context.Context, query string, _ int64) ([
 	return []*drive.File{}, nil
 }
 
+func (m *mockDriveClient) ListFilesOrdered(ctx context.Context, query string, pageSize int64, _ string) ([]*drive.File, error) {
+	return m.ListFiles(ctx, query, pageSize)
+}
+
 func (m *mockDriveClient) ListFilesWithScope(ctx context.Context, query string, pageSize int64, _ drive.DriveScope) ([]*drive.File, error) {
 	// Delegate to ListFiles for testing purposes
 	return m.ListFiles(ctx, query, pageSize)
@@ -235,6 +273,10 @@ func (m *mockDriveClient) DownloadFile(_ context.Context, _ string) ([]byte, err
 	return nil, fmt.Errorf("not implemented")
 }
 
+func (m *mockDriveClient) DownloadFileTo(_ context.Context, _ string, _ io.Writer) (int64, error) {
+	return 0, fmt.Errorf("not implemented")
+}
+
 func (m *mockDriveClient) ExportFile(_ context.Context, _ string, _ string) ([]byte, error) {
 	return nil, fmt.Errorf("not implemented")
 }
@@ -255,6 +297,18 @@ func (m *mockDriveClient) SearchFileIDs(_ context.Context, _ string, _ int64) ([
 	return nil, fmt.Errorf("not implemented")
 }
 
+func (m *mockDriveClient) ListRevisions(_ context.Context, _ string) ([]*drive.Revision, error) {
+	return nil, fmt.Errorf("not implemented")
+}
+
+func (m *mockDriveClient) ListPermissions(_ context.Context, _ string) ([]*drive.Permission, error) {
+	return nil, fmt.Errorf("not implemented")
+}
+
+func (m *mockDriveClient) GetFileMetadata(_ context.Context, _ string) (map[string]any, error) {
+	return nil, fmt.Errorf("not implemented")
+}
+
 func TestBuildTree(t *testing.T) {
 	t.Run("builds tree for root folder", func(t *testing.T) {
 		mock := newMockDriveClient()
@@ -263,7 +317,7 @@ func TestBuildTree(t *testing.T) {
 			{ID: "folder1", Name: "Documents", MimeType: drive.MimeTypeFolder},
 			{ID: "folder2", Name: "Photos", MimeType: drive.MimeTypeFolder},
 		}
-		// GetFile is called for each child folder during recursion
+		// Child folders come from the listing; GetFile is only a fallback
 		mock.files["folder1"] = &drive.File{ID: "folder1", Name: "Documents", MimeType: drive.MimeTypeFolder}
 		mock.files["folder2"] = &drive.File{ID: "folder2", Name: "Photos", MimeType: drive.MimeTypeFolder}
 
@@ -361,3 +415,113 @@ func TestBuildTree(t *testing.T) {
 		testutil.Equal(t, tree.Children[1].Name, "aaa.txt")
 	})
 }
+
+func TestBuildTree_Concurrent(t *testing.T) {
+	t.Run("expands sibling folders in parallel and keeps the order", func(t *testing.T) {
+		const width = 30
+		var siblings []*drive.File
+		// Listed in reverse so the sort has work to do
+		for i := width - 1; i >= 0; i-- {
+			siblings = append(siblings, &drive.File{ID: fmt.Sprintf("f%02d", i), Name: fmt.Sprintf("folder-%02d", i), MimeType: drive.MimeTypeFolder})
+		}
+		siblings = append(siblings, &drive.File{ID: "doc", Name: "a-file.txt", MimeType: "text/plain"})
+
+		var inFlight, peak atomic.Int32
+		track := func() func() {
+			n := inFlight.Add(1)
+			for {
+				p := peak.Load()
+				if n <= p || peak.CompareAndSwap(p, n) {
+					break
+				}
+			}
+			time.Sleep(5 * time.Millisecond)
+			return func() { inFlight.Add(-1) }
+		}
+
+		mock := &MockDriveClient{
+			ListFilesWithScopeFunc: func(_ context.Context, query string, _ int64, _ drive.DriveScope) ([]*drive.File, error) {
+				defer track()()
+				if strings.Contains(query, "'root' in parents") {
+					return siblings, nil
+				}
+				return nil, nil
+			},
+			GetFileFunc: func(_ context.Context, fileID string) (*drive.File, error) {
+				defer track()()
+				for _, f := range siblings {
+					if f.ID == fileID {
+						return f, nil
+					}
+				}
+				return nil, fmt.Errorf("file not found: %s", fileID)
+			},
+		}
+
+		tree, err := buildTree(context.Background(), mock, "root", 2, true)
+		testutil.NoError(t, err)
+		testutil.Len(t, tree.Children, width+1)
+		for i := range width {
+			testutil.Equal(t, tree.Children[i].Name, fmt.Sprintf("folder-%02d", i))
+		}
+		testutil.Equal(t, tree.Children[width].Name, "a-file.txt")
+
+		if p := peak.Load(); p < 2 || p > treeWorkers {
+			t.Errorf("peak concurrent calls = %d, want between 2 and %d", p, treeWorkers)
+		}
+	})
+
+	t.Run("does not follow a folder into itself", func(t *testing.T) {
+		mock := newMockDriveClient()
+		mock.files["top"] = &drive.File{ID: "top", Name: "Top", MimeType: drive.MimeTypeFolder}
+		mock.files["sub"] = &drive.File{ID: "sub", Name: "Sub", MimeType: drive.MimeTypeFolder}
+		mock.children["top"] = []*drive.File{mock.files["sub"]}
+		mock.children["sub"] = []*drive.File{mock.files["top"]}
+
+		tree, err := buildTree(context.Background(), mock, "top", 10, false)
+		testutil.NoError(t, err)
+		testutil.Len(t, tree.Children, 1)
+		testutil.Equal(t, tree.Children[0].Name, "Sub")
+		testutil.Len(t, tree.Children[0].Children, 0)
+	})
+}
+
+func TestBuildTree_FetchesEachFolderOnce(t *testing.T) {
+	var mu sync.Mutex
+	getFileCalls := map[string]int{}
+	listing := map[string][]*drive.File{
+		"top": {
+			{ID: "a", Name: "A", MimeType: drive.MimeTypeFolder},
+			{ID: "b", Name: "B", MimeType: drive.MimeTypeFolder},
+		},
+		"a": {{ID: "a1", Name: "A1", MimeType: drive.MimeTypeFolder}},
+		"b": {{ID: "b1", Name: "notes.txt", MimeType: "text/plain"}},
+	}
+	mock := &MockDriveClient{
+		ListFilesWithScopeFunc: func(_ context.Context, query string, _ int64, _ drive.DriveScope) ([]*drive.File, error) {
+			for id, files := range listing {
+				if strings.HasPrefix(query, "'"+id+"' in parents") {
+					return files, nil
+				}
+			}
+			return nil, nil
+		},
+		GetFileFunc: func(_ context.Context, fileID string) (*drive.File, error) {
+			mu.Lock()
+			getFileCalls[fileID]++
+			mu.Unlock()
+			return &drive.File{ID: fileID, Name: "Top", MimeType: drive.MimeTypeFolder}, nil
+		},
+	}
+
+	tree, err := buildTree(context.Background(), mock, "top", 3, true)
+	testutil.NoError(t, err)
+	testutil.Equal(t, tree.Name, "Top")
+	testutil.Len(t, tree.Children, 2)
+	testutil.Equal(t, tree.Children[0].Children[0].Name, "A1")
+	testutil.Equal(t, tree.Children[1].Children[0].Name, "notes.txt")
+
+	// Only the starting folder needs a lookup; the rest were listed
+	testutil.Equal(t, len(getFileCalls), 1)
+	testutil.Equal(t, getFileCalls["top"], 1)
+}
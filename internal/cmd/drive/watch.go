@@ -0,0 +1,148 @@
+package drive
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/open-cli-collective/google-readonly/internal/drive"
+	"github.com/open-cli-collective/google-readonly/internal/format"
+)
+
+// watchPageSize is how many of the folder's most recently modified items each
+// poll fetches. Results are ordered by modifiedTime, so changes are always at
+// the top of the page.
+const watchPageSize = 100
+
+// minWatchInterval keeps --interval from hammering the API. Tests lower it.
+var minWatchInterval = 5 * time.Second
+
+func newWatchCommand() *cobra.Command {
+	var interval time.Duration
+
+	cmd := &cobra.Command{
+		Use:   "watch <folder-id>",
+		Short: "Print files added to or modified in a folder",
+		Long: `Poll a folder and print each file that is added or modified.
+
+The first poll records the folder's current contents; after that, every
+poll prints the items that are new or have a newer modified time. Only
+direct children of the folder are watched. Stop with Ctrl-C.
+
+Each poll looks at the 100 most recently modified items, so more changes
+than that within one interval are not all reported.
+
+Examples:
+  gro drive watch <folder-id>
+  gro drive watch <folder-id> --interval 5m`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if interval < minWatchInterval {
+				return fmt.Errorf("--interval must be at least %s", minWatchInterval)
+			}
+
+			client, err := newDriveClient(cmd.Context())
+			if err != nil {
+				return fmt.Errorf("creating Drive client: %w", err)
+			}
+
+			ctx := cmd.Context()
+			folder, err := client.GetFile(ctx, args[0])
+			if err != nil {
+				return fmt.Errorf("getting folder: %w", err)
+			}
+			if folder.MimeType != drive.MimeTypeFolder {
+				return fmt.Errorf("%s is a %s, not a folder", folder.Name, drive.GetTypeName(folder.MimeType))
+			}
+
+			w := &folderWatcher{client: client, folderID: folder.ID}
+			if _, err := w.poll(ctx); err != nil {
+				return err
+			}
+			fmt.Printf("Watching %s (%d item(s)), polling every %s. Press Ctrl-C to stop.\n",
+				folder.Name, len(w.seen), interval)
+
+			ticker := time.NewTicker(interval)
+			defer ticker.Stop()
+			for {
+				select {
+				case <-ctx.Done():
+					return nil
+				case <-ticker.C:
+				}
+
+				changes, err := w.poll(ctx)
+				if err != nil {
+					if ctx.Err() != nil {
+						return nil
+					}
+					return err
+				}
+				for _, c := range changes {
+					printWatchChange(c)
+				}
+			}
+		},
+	}
+
+	cmd.Flags().DurationVarP(&interval, "interval", "i", time.Minute, "Time between polls (minimum 5s)")
+
+	return cmd
+}
+
+// watchChange is a file that appeared or changed between two polls
+type watchChange struct {
+	File  *drive.File
+	Added bool
+}
+
+// folderWatcher remembers the modified time of every item seen in a folder
+type folderWatcher struct {
+	client   DriveClient
+	folderID string
+	seen     map[string]time.Time
+}
+
+// poll lists the folder and returns the items that are new or modified since
+// the previous poll, oldest first. The first poll only records a baseline and
+// returns nothing.
+func (w *folderWatcher) poll(ctx context.Context) ([]watchChange, error) {
+	query := fmt.Sprintf("'%s' in parents and trashed = false", w.folderID)
+	files, err := w.client.ListFilesWithScope(ctx, query, watchPageSize, drive.DriveScope{AllDrives: true})
+	if err != nil {
+		return nil, fmt.Errorf("listing folder: %w", err)
+	}
+
+	baseline := w.seen == nil
+	if baseline {
+		w.seen = make(map[string]time.Time, len(files))
+	}
+
+	var changes []watchChange
+	// Walk oldest first so changes print in the order they happened
+	for i := len(files) - 1; i >= 0; i-- {
+		f := files[i]
+		last, known := w.seen[f.ID]
+		w.seen[f.ID] = f.ModifiedTime
+		switch {
+		case baseline:
+		case !known:
+			changes = append(changes, watchChange{File: f, Added: true})
+		case f.ModifiedTime.After(last):
+			changes = append(changes, watchChange{File: f})
+		}
+	}
+	return changes, nil
+}
+
+// printWatchChange prints one change as a single greppable line
+func printWatchChange(c watchChange) {
+	action := "modified"
+	if c.Added {
+		action = "added"
+	}
+	fmt.Printf("%s  %-8s  %s  %s\n",
+		format.Date(c.File.ModifiedTime, "2006-01-02 15:04:05"), action, c.File.ID, c.File.Name)
+}
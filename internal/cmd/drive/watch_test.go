@@ -0,0 +1,163 @@
+package drive
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	driveapi "github.com/open-cli-collective/google-readonly/internal/drive"
+	"github.com/open-cli-collective/google-readonly/internal/testutil"
+)
+
+func TestWatchCommand(t *testing.T) {
+	cmd := newWatchCommand()
+
+	t.Run("has correct use", func(t *testing.T) {
+		testutil.Equal(t, cmd.Use, "watch <folder-id>")
+	})
+
+	t.Run("requires exactly one argument", func(t *testing.T) {
+		testutil.Error(t, cmd.Args(cmd, []string{}))
+		testutil.NoError(t, cmd.Args(cmd, []string{"folder-id"}))
+	})
+
+	t.Run("has interval flag", func(t *testing.T) {
+		flag := cmd.Flags().Lookup("interval")
+		testutil.NotNil(t, flag)
+		testutil.Equal(t, flag.Shorthand, "i")
+		testutil.Equal(t, flag.DefValue, "1m0s")
+	})
+}
+
+// watchFile returns a file modified at the given minute past 2024-01-15 10:00
+func watchFile(id string, minute int) *driveapi.File {
+	f := testutil.SampleDriveFile(id)
+	f.Name = id + ".txt"
+	f.ModifiedTime = time.Date(2024, 1, 15, 10, minute, 0, 0, time.UTC)
+	return f
+}
+
+func TestFolderWatcher_Poll(t *testing.T) {
+	polls := [][]*driveapi.File{
+		{watchFile("old", 0), watchFile("edited", 1)},
+		{watchFile("edited", 5), watchFile("new", 4), watchFile("old", 0)},
+		{watchFile("edited", 5), watchFile("new", 4), watchFile("old", 0)},
+	}
+	call := 0
+	mock := &MockDriveClient{
+		ListFilesWithScopeFunc: func(_ context.Context, query string, pageSize int64, scope driveapi.DriveScope) ([]*driveapi.File, error) {
+			testutil.Equal(t, query, "'folder1' in parents and trashed = false")
+			testutil.Equal(t, pageSize, int64(watchPageSize))
+			testutil.True(t, scope.AllDrives)
+			files := polls[call]
+			call++
+			return files, nil
+		},
+	}
+	w := &folderWatcher{client: mock, folderID: "folder1"}
+
+	changes, err := w.poll(context.Background())
+	testutil.NoError(t, err)
+	testutil.Len(t, changes, 0)
+
+	changes, err = w.poll(context.Background())
+	testutil.NoError(t, err)
+	testutil.Len(t, changes, 2)
+	testutil.Equal(t, changes[0].File.ID, "new")
+	testutil.True(t, changes[0].Added)
+	testutil.Equal(t, changes[1].File.ID, "edited")
+	testutil.False(t, changes[1].Added)
+
+	changes, err = w.poll(context.Background())
+	testutil.NoError(t, err)
+	testutil.Len(t, changes, 0)
+}
+
+func TestWatchCommand_PrintsOnlyNewFiles(t *testing.T) {
+	saved := minWatchInterval
+	minWatchInterval = time.Millisecond
+	t.Cleanup(func() { minWatchInterval = saved })
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	call := 0
+	mock := &MockDriveClient{
+		GetFileFunc: func(_ context.Context, fileID string) (*driveapi.File, error) {
+			return &driveapi.File{ID: fileID, Name: "Shared", MimeType: driveapi.MimeTypeFolder}, nil
+		},
+		ListFilesWithScopeFunc: func(_ context.Context, _ string, _ int64, _ driveapi.DriveScope) ([]*driveapi.File, error) {
+			call++
+			if call == 1 {
+				return []*driveapi.File{watchFile("existing", 0)}, nil
+			}
+			// Stop after the second poll has been reported
+			cancel()
+			return []*driveapi.File{watchFile("arrived", 3), watchFile("existing", 0)}, nil
+		},
+	}
+
+	cmd := newWatchCommand()
+	cmd.SetArgs([]string{"folder1", "--interval", "1ms"})
+
+	withMockClient(mock, func() {
+		output := testutil.CaptureStdout(t, func() {
+			err := cmd.ExecuteContext(ctx)
+			testutil.NoError(t, err)
+		})
+
+		testutil.Contains(t, output, "Watching Shared (1 item(s))")
+		testutil.Contains(t, output, "2024-01-15 10:03:00  added     arrived  arrived.txt")
+		testutil.NotContains(t, output, "existing")
+	})
+	testutil.Equal(t, call, 2)
+}
+
+func TestWatchCommand_IntervalTooShort(t *testing.T) {
+	cmd := newWatchCommand()
+	cmd.SetArgs([]string{"folder1", "--interval", "1s"})
+
+	withMockClient(&MockDriveClient{}, func() {
+		err := cmd.Execute()
+		testutil.Error(t, err)
+		testutil.Contains(t, err.Error(), "--interval must be at least 5s")
+	})
+}
+
+func TestWatchCommand_NotAFolder(t *testing.T) {
+	mock := &MockDriveClient{
+		GetFileFunc: func(_ context.Context, fileID string) (*driveapi.File, error) {
+			return testutil.SampleDriveFile(fileID), nil
+		},
+	}
+
+	cmd := newWatchCommand()
+	cmd.SetArgs([]string{"file123"})
+
+	withMockClient(mock, func() {
+		err := cmd.Execute()
+		testutil.Error(t, err)
+		testutil.Contains(t, err.Error(), "not a folder")
+	})
+}
+
+func TestWatchCommand_ListError(t *testing.T) {
+	mock := &MockDriveClient{
+		GetFileFunc: func(_ context.Context, fileID string) (*driveapi.File, error) {
+			return &driveapi.File{ID: fileID, Name: "Shared", MimeType: driveapi.MimeTypeFolder}, nil
+		},
+		ListFilesWithScopeFunc: func(_ context.Context, _ string, _ int64, _ driveapi.DriveScope) ([]*driveapi.File, error) {
+			return nil, errors.New("API error")
+		},
+	}
+
+	cmd := newWatchCommand()
+	cmd.SetArgs([]string{"folder1"})
+
+	withMockClient(mock, func() {
+		err := cmd.Execute()
+		testutil.Error(t, err)
+		testutil.Contains(t, err.Error(), "listing folder")
+	})
+}
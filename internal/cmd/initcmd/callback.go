@@ -0,0 +1,164 @@
+package initcmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"time"
+
+	"golang.org/x/oauth2"
+
+	"github.com/open-cli-collective/google-readonly/internal/auth"
+)
+
+// callbackTimeout bounds how long --local-server waits for the browser to
+// be redirected back. A var so tests can shorten it.
+var callbackTimeout = 5 * time.Minute
+
+// callbackShutdownTimeout bounds the graceful shutdown of the callback server
+// so a browser holding a keep-alive connection cannot stall init.
+const callbackShutdownTimeout = 2 * time.Second
+
+// callbackPage is shown in the browser once the code has been captured
+const callbackPage = `<!DOCTYPE html>
+<html><head><title>gro</title></head>
+<body><p>%s You can close this tab and return to the terminal.</p></body></html>`
+
+// callbackResult is what the callback handler hands back to the waiter
+type callbackResult struct {
+	code string
+	err  error
+}
+
+// callbackServer is a temporary loopback HTTP server that receives the OAuth
+// redirect and captures the authorization code from it.
+type callbackServer struct {
+	listener net.Listener
+	srv      *http.Server
+	results  chan callbackResult
+}
+
+// startCallbackServer binds a random loopback port and starts serving. It
+// binds 127.0.0.1 rather than "localhost" so the browser cannot resolve the
+// redirect to an IPv6 address nothing is listening on; Google accepts any
+// loopback port for desktop OAuth clients.
+func startCallbackServer(listen func(network, address string) (net.Listener, error)) (*callbackServer, error) {
+	ln, err := listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return nil, err
+	}
+
+	s := &callbackServer{
+		listener: ln,
+		results:  make(chan callbackResult, 1),
+	}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/", s.handle)
+	s.srv = &http.Server{Handler: mux, ReadHeaderTimeout: 10 * time.Second}
+
+	go func() { _ = s.srv.Serve(ln) }()
+	return s, nil
+}
+
+// RedirectURL is the redirect URI to send in the auth request
+func (s *callbackServer) RedirectURL() string {
+	return fmt.Sprintf("http://%s/", s.listener.Addr().String())
+}
+
+// handle receives the OAuth redirect. Only the first result is kept; later
+// requests (a browser retry, a favicon fetch) get the same page but are
+// otherwise ignored.
+func (s *callbackServer) handle(w http.ResponseWriter, r *http.Request) {
+	if r.URL.Path != "/" {
+		http.NotFound(w, r)
+		return
+	}
+
+	q := r.URL.Query()
+	var result callbackResult
+	switch {
+	case q.Get("error") != "":
+		result.err = fmt.Errorf("authorization failed: %s", q.Get("error"))
+	case q.Get("state") != auth.AuthState:
+		result.err = errors.New("authorization callback had an unexpected state parameter")
+	case q.Get("code") == "":
+		result.err = errors.New("no authorization code found in callback")
+	default:
+		result.code = q.Get("code")
+	}
+
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	if result.err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = fmt.Fprintf(w, callbackPage, "Authentication failed.")
+	} else {
+		_, _ = fmt.Fprintf(w, callbackPage, "Authentication complete.")
+	}
+
+	select {
+	case s.results <- result:
+	default:
+	}
+}
+
+// Wait blocks until the callback delivers a result, the timeout passes or
+// ctx is cancelled.
+func (s *callbackServer) Wait(ctx context.Context, timeout time.Duration) (string, error) {
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+
+	select {
+	case r := <-s.results:
+		return r.code, r.err
+	case <-timer.C:
+		return "", fmt.Errorf("timed out after %s waiting for the browser to complete authorization", timeout)
+	case <-ctx.Done():
+		return "", ctx.Err()
+	}
+}
+
+// Close shuts the server down, forcing it closed if open connections do not
+// drain within callbackShutdownTimeout.
+func (s *callbackServer) Close() {
+	ctx, cancel := context.WithTimeout(context.Background(), callbackShutdownTimeout)
+	defer cancel()
+	if err := s.srv.Shutdown(ctx); err != nil {
+		_ = s.srv.Close()
+	}
+}
+
+// authCodeFromLocalServer runs the --local-server flow: it serves the
+// redirect on a loopback port, opens the browser and waits for the code. It
+// returns the code together with the OAuth config carrying the matching
+// redirect URL, which the token exchange must use. An empty code with a nil
+// error means the caller should fall back to the manual paste flow, because
+// the port could not be bound or the browser could not be opened.
+func authCodeFromLocalServer(ctx context.Context, d initDeps, oauthCfg *oauth2.Config) (string, *oauth2.Config, error) {
+	srv, err := startCallbackServer(d.Listen)
+	if err != nil {
+		d.View.Info("Could not start the local callback server (%v); falling back to pasting the redirect URL.", err)
+		return "", oauthCfg, nil
+	}
+	defer srv.Close()
+
+	cfg := *oauthCfg
+	cfg.RedirectURL = srv.RedirectURL()
+	authURL := auth.GetAuthURL(&cfg)
+
+	if err := d.OpenBrowser(authURL); err != nil {
+		d.View.Info("Could not open browser automatically (%v); falling back to pasting the redirect URL.", err)
+		return "", oauthCfg, nil
+	}
+	d.View.Println("Waiting for you to finish signing in in the browser. If it didn't open, visit:")
+	d.View.Println("")
+	d.View.Println("  " + authURL)
+	d.View.Println("")
+
+	code, err := srv.Wait(ctx, callbackTimeout)
+	if err != nil {
+		return "", oauthCfg, err
+	}
+	return code, &cfg, nil
+}
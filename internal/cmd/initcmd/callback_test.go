@@ -0,0 +1,227 @@
+package initcmd
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"golang.org/x/oauth2"
+
+	"github.com/open-cli-collective/google-readonly/internal/auth"
+	"github.com/open-cli-collective/google-readonly/internal/view"
+)
+
+// hitCallback performs the browser's redirect to the callback server
+func hitCallback(t *testing.T, redirectURL string, query url.Values) int {
+	t.Helper()
+	resp, err := http.Get(redirectURL + "?" + query.Encode()) //nolint:gosec,noctx // G107: loopback test server
+	if err != nil {
+		t.Errorf("callback request: %v", err)
+		return 0
+	}
+	_, _ = io.Copy(io.Discard, resp.Body)
+	_ = resp.Body.Close()
+	return resp.StatusCode
+}
+
+func TestCallbackServer(t *testing.T) {
+	t.Parallel()
+
+	t.Run("captures the code", func(t *testing.T) {
+		t.Parallel()
+		srv, err := startCallbackServer(net.Listen)
+		if err != nil {
+			t.Fatalf("start: %v", err)
+		}
+		defer srv.Close()
+
+		if !strings.HasPrefix(srv.RedirectURL(), "http://127.0.0.1:") {
+			t.Errorf("RedirectURL = %q, want a loopback URL", srv.RedirectURL())
+		}
+
+		status := hitCallback(t, srv.RedirectURL(), url.Values{"code": {"ABC"}, "state": {auth.AuthState}})
+		if status != http.StatusOK {
+			t.Errorf("status = %d, want 200", status)
+		}
+		code, err := srv.Wait(context.Background(), time.Second)
+		if err != nil {
+			t.Fatalf("Wait: %v", err)
+		}
+		if code != "ABC" {
+			t.Errorf("code = %q, want ABC", code)
+		}
+	})
+
+	t.Run("reports a denied consent", func(t *testing.T) {
+		t.Parallel()
+		srv, err := startCallbackServer(net.Listen)
+		if err != nil {
+			t.Fatalf("start: %v", err)
+		}
+		defer srv.Close()
+
+		status := hitCallback(t, srv.RedirectURL(), url.Values{"error": {"access_denied"}, "state": {auth.AuthState}})
+		if status != http.StatusBadRequest {
+			t.Errorf("status = %d, want 400", status)
+		}
+		if _, err := srv.Wait(context.Background(), time.Second); err == nil || !strings.Contains(err.Error(), "access_denied") {
+			t.Errorf("expected access_denied error, got %v", err)
+		}
+	})
+
+	t.Run("rejects an unexpected state", func(t *testing.T) {
+		t.Parallel()
+		srv, err := startCallbackServer(net.Listen)
+		if err != nil {
+			t.Fatalf("start: %v", err)
+		}
+		defer srv.Close()
+
+		hitCallback(t, srv.RedirectURL(), url.Values{"code": {"ABC"}, "state": {"forged"}})
+		if _, err := srv.Wait(context.Background(), time.Second); err == nil || !strings.Contains(err.Error(), "state") {
+			t.Errorf("expected state error, got %v", err)
+		}
+	})
+
+	t.Run("times out", func(t *testing.T) {
+		t.Parallel()
+		srv, err := startCallbackServer(net.Listen)
+		if err != nil {
+			t.Fatalf("start: %v", err)
+		}
+		defer srv.Close()
+
+		if _, err := srv.Wait(context.Background(), 10*time.Millisecond); err == nil || !strings.Contains(err.Error(), "timed out") {
+			t.Errorf("expected timeout error, got %v", err)
+		}
+	})
+
+	t.Run("stops serving after Close", func(t *testing.T) {
+		t.Parallel()
+		srv, err := startCallbackServer(net.Listen)
+		if err != nil {
+			t.Fatalf("start: %v", err)
+		}
+		srv.Close()
+
+		resp, err := http.Get(srv.RedirectURL()) //nolint:gosec,noctx // G107: loopback test server
+		if err == nil {
+			_ = resp.Body.Close()
+			t.Error("expected the server to be shut down")
+		}
+	})
+}
+
+// localServerDeps returns deps for a --local-server run whose browser opener
+// follows the consent URL's redirect_uri straight back with code.
+func localServerDeps(t *testing.T, code string) (initDeps, *stubPrompter, *oauth2.Config) {
+	t.Helper()
+	d := baseDeps(t, newFakeFS())
+	d.Listen = net.Listen
+	d.GetOAuthConfig = func() (*oauth2.Config, error) {
+		return &oauth2.Config{ClientID: "id", Endpoint: oauth2.Endpoint{AuthURL: "https://accounts.example.com/auth"}}, nil
+	}
+	d.OpenBrowser = func(authURL string) error {
+		u, err := url.Parse(authURL)
+		if err != nil {
+			return err
+		}
+		q := u.Query()
+		go hitCallback(t, q.Get("redirect_uri"), url.Values{"code": {code}, "state": {q.Get("state")}})
+		return nil
+	}
+	exchanged := &oauth2.Config{}
+	d.ExchangeAuthCode = func(_ context.Context, cfg *oauth2.Config, got string) (*oauth2.Token, error) {
+		*exchanged = *cfg
+		if got != code {
+			t.Errorf("exchanged code = %q, want %q", got, code)
+		}
+		return &oauth2.Token{AccessToken: "tok"}, nil
+	}
+	stub := &stubPrompter{redirectURL: "http://localhost/?code=PASTED"}
+	d.Prompter = stub
+	return d, stub, exchanged
+}
+
+func credentialsFile(t *testing.T) string {
+	t.Helper()
+	src := filepath.Join(t.TempDir(), "downloaded.json")
+	if err := os.WriteFile(src, []byte(validOAuthJSON), 0644); err != nil {
+		t.Fatal(err)
+	}
+	return src
+}
+
+func TestRunWith_LocalServerCapturesCode(t *testing.T) {
+	t.Parallel()
+	d, stub, exchanged := localServerDeps(t, "FROM-CALLBACK")
+
+	err := runWith(context.Background(), d, &initOptions{credentialsFile: credentialsFile(t), localServer: true})
+	if err != nil {
+		t.Fatalf("runWith: %v", err)
+	}
+	if contains(stub.calls, "redirect") || contains(stub.calls, "browser") {
+		t.Errorf("local server flow must not prompt, calls=%v", stub.calls)
+	}
+	if !strings.HasPrefix(exchanged.RedirectURL, "http://127.0.0.1:") {
+		t.Errorf("exchange must use the callback redirect URL, got %q", exchanged.RedirectURL)
+	}
+}
+
+func TestRunWith_LocalServerFallsBackWhenPortUnavailable(t *testing.T) {
+	t.Parallel()
+	d, stub, exchanged := localServerDeps(t, "PASTED")
+	d.Listen = func(_, _ string) (net.Listener, error) { return nil, errors.New("address in use") }
+	var out bytes.Buffer
+	d.View = view.NewWithWriters(&out, &bytes.Buffer{})
+
+	err := runWith(context.Background(), d, &initOptions{credentialsFile: credentialsFile(t), localServer: true})
+	if err != nil {
+		t.Fatalf("runWith: %v", err)
+	}
+	if !contains(stub.calls, "redirect") {
+		t.Errorf("expected the paste prompt after fallback, calls=%v", stub.calls)
+	}
+	if exchanged.RedirectURL != "" {
+		t.Errorf("fallback must exchange with the unmodified config, got redirect %q", exchanged.RedirectURL)
+	}
+	if !strings.Contains(out.String(), "address in use") {
+		t.Errorf("expected the bind failure to be reported, got:\n%s", out.String())
+	}
+}
+
+func TestRunWith_LocalServerFallsBackWhenBrowserFails(t *testing.T) {
+	t.Parallel()
+	d, stub, _ := localServerDeps(t, "PASTED")
+	d.OpenBrowser = func(_ string) error { return errors.New("no display") }
+
+	err := runWith(context.Background(), d, &initOptions{credentialsFile: credentialsFile(t), localServer: true})
+	if err != nil {
+		t.Fatalf("runWith: %v", err)
+	}
+	if !contains(stub.calls, "redirect") {
+		t.Errorf("expected the paste prompt after fallback, calls=%v", stub.calls)
+	}
+}
+
+func TestRunWith_LocalServerFlagConflicts(t *testing.T) {
+	t.Parallel()
+	for _, opts := range []*initOptions{
+		{localServer: true, noBrowser: true},
+		{localServer: true, authCodeStdin: true},
+	} {
+		err := runWith(context.Background(), baseDeps(t, newFakeFS()), opts)
+		if err == nil || !strings.Contains(err.Error(), "--local-server cannot be combined") {
+			t.Errorf("opts %+v: expected conflict error, got %v", *opts, err)
+		}
+	}
+}
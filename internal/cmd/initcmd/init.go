@@ -7,10 +7,11 @@ import (
 	"errors"
 	"fmt"
 	"io"
-	"net/http"
+	"net"
 	"net/url"
 	"os"
 	"path/filepath"
+	"slices"
 	"strings"
 
 	"github.com/atotto/clipboard"
@@ -19,11 +20,11 @@ import (
 	"github.com/spf13/cobra"
 	"golang.org/x/oauth2"
 	"golang.org/x/oauth2/google"
-	"google.golang.org/api/googleapi"
 
 	"github.com/open-cli-collective/google-readonly/internal/auth"
 	mecmd "github.com/open-cli-collective/google-readonly/internal/cmd/me"
 	"github.com/open-cli-collective/google-readonly/internal/config"
+	"github.com/open-cli-collective/google-readonly/internal/exit"
 	"github.com/open-cli-collective/google-readonly/internal/gmail"
 	"github.com/open-cli-collective/google-readonly/internal/keychain"
 	"github.com/open-cli-collective/google-readonly/internal/people"
@@ -36,6 +37,12 @@ type initOptions struct {
 	noBrowser       bool
 	noVerify        bool
 	authCodeStdin   bool
+	localServer     bool
+	// only is the --only list of services to authorize; empty means all
+	only string
+	// replaceToken skips the stored-token check so a token that already
+	// covers the services is still replaced; set by Reauthorize
+	replaceToken bool
 }
 
 // NewCommand returns the init command.
@@ -49,10 +56,15 @@ func NewCommand() *cobra.Command {
 
   1. Reading your downloaded OAuth client JSON (clipboard, paste, or file path).
   2. Opening the consent URL in your browser.
-  3. Pasting the redirect URL back to complete authentication.
+  3. Pasting the redirect URL back to complete authentication. With
+     --local-server, a temporary localhost server captures it instead.
 
 After setup, run 'gro me' to see who you're authenticated as.
 
+By default gro asks for access to Gmail, Calendar, Contacts and Drive. Use
+--only to authorize just the services you use, e.g. --only mail,calendar;
+commands for the others then say how to add them.
+
 The wizard first asks how you're getting your credentials.json:
   - Admin-provided (e.g. via 1Password): paste or point to the file.
   - DIY: walks you through creating a Google Cloud project yourself,
@@ -75,10 +87,23 @@ it will read, validate, and write it to the config directory for you.`, workspac
 	cmd.Flags().BoolVar(&opts.noBrowser, "no-browser", false, "Don't try to open the consent URL in a browser")
 	cmd.Flags().BoolVar(&opts.noVerify, "no-verify", false, "Skip connectivity verification after setup")
 	cmd.Flags().BoolVar(&opts.authCodeStdin, "auth-code-stdin", false, "Read the OAuth authorization code/redirect URL from stdin (two-phase install; implies no browser-open)")
+	cmd.Flags().BoolVar(&opts.localServer, "local-server", false, "Capture the authorization code with a temporary localhost server instead of pasting the redirect URL")
+	cmd.Flags().StringVar(&opts.only, "only", "", "Comma-separated services to authorize: mail, calendar, contacts, drive (default: all)")
 
 	return cmd
 }
 
+// Reauthorize replaces the stored token with one granted only the scopes of
+// domains, through the same consent round-trip as 'gro init --only'. The
+// old token stays in place until the new one is saved, so an abandoned
+// flow leaves gro working as before.
+func Reauthorize(ctx context.Context, domains []string) error {
+	return runWith(ctx, defaultDeps(), &initOptions{
+		only:         strings.Join(domains, ","),
+		replaceToken: true,
+	})
+}
+
 // initDeps groups every external collaborator the wizard touches. Tests
 // override individual fields; production uses defaultDeps().
 type initDeps struct {
@@ -98,6 +123,9 @@ type initDeps struct {
 	// Browser opener.
 	OpenBrowser func(url string) error
 
+	// Listen binds the --local-server callback port. Test seam.
+	Listen func(network, address string) (net.Listener, error)
+
 	// DetectConfigRelocation / ApplyConfigRelocation are the MON-5371 init
 	// relocation gate. Injected so parallel tests (which cannot t.Setenv the
 	// hermetic env) can stub them to a no-op; production wires them to the
@@ -168,6 +196,7 @@ func defaultDeps() initDeps {
 		ClipboardSupported:     func() bool { return !clipboard.Unsupported },
 		ClipboardReadAll:       clipboard.ReadAll,
 		OpenBrowser:            browser.OpenURL,
+		Listen:                 net.Listen,
 		DetectConfigRelocation: config.DetectConfigRelocation,
 		ApplyConfigRelocation:  config.ApplyConfigRelocation,
 		EnsureMigrated:         ensureMigrated,
@@ -280,6 +309,14 @@ func readAllStdin() (string, error) {
 
 // runWith is the testable entry point for the wizard. NewCommand wraps it.
 func runWith(ctx context.Context, d initDeps, opts *initOptions) error {
+	if opts.localServer && (opts.noBrowser || opts.authCodeStdin) {
+		return errors.New("--local-server cannot be combined with --no-browser or --auth-code-stdin")
+	}
+	domains, err := auth.ParseDomains(opts.only)
+	if err != nil {
+		return err
+	}
+
 	// Step -1 (must precede the §1.8 migration): the MON-5371 config-dir
 	// relocation gate. If the old hand-rolled dir and the new statedir-
 	// resolved dir both exist with divergent settings, abort BEFORE
@@ -321,52 +358,37 @@ func runWith(ctx context.Context, d initDeps, opts *initOptions) error {
 	}
 
 	// Step 3: token resolution.
-	handled, err := tryExistingToken(ctx, d, opts)
-	if err != nil {
-		return err
-	}
-	if handled {
-		return nil
+	if !opts.replaceToken {
+		handled, err := tryExistingToken(ctx, d, opts, domains)
+		if err != nil {
+			return err
+		}
+		if handled {
+			return nil
+		}
 	}
 
-	// Step 4: OAuth flow.
+	// Step 4: OAuth flow. --local-server captures the code from the
+	// redirect itself; if it cannot (no port, no browser) it hands back an
+	// empty code and the manual paste flow takes over.
 	oauthCfg, err := d.GetOAuthConfig()
 	if err != nil {
 		return fmt.Errorf("loading OAuth config: %w", err)
 	}
+	oauthCfg.Scopes = auth.ScopesForDomains(domains)
 
-	authURL := auth.GetAuthURL(oauthCfg)
-	if !opts.authCodeStdin && !opts.noBrowser {
-		open, err := d.Prompter.ConfirmOpenBrowser()
+	var code string
+	if opts.localServer {
+		code, oauthCfg, err = authCodeFromLocalServer(ctx, d, oauthCfg)
 		if err != nil {
 			return err
 		}
-		if open {
-			if err := d.OpenBrowser(authURL); err != nil {
-				d.View.Info("Could not open browser automatically (%v).", err)
-			}
-		}
 	}
-	d.View.Println("If your browser didn't open, paste this URL into it:")
-	d.View.Println("")
-	d.View.Println("  " + authURL)
-	d.View.Println("")
-
-	// Two-phase install: --auth-code-stdin reads the code/redirect URL from
-	// stdin (the installer pauses between "open URL" and "feed code back")
-	// instead of the interactive prompt. The value is never echoed.
-	var codeInput string
-	if opts.authCodeStdin {
-		codeInput, err = d.StdinReadAll()
-	} else {
-		codeInput, err = d.Prompter.PasteRedirectURL()
-	}
-	if err != nil {
-		return err
-	}
-	code := extractAuthCode(codeInput)
 	if code == "" {
-		return errors.New("no authorization code found in input")
+		code, err = authCodeFromPaste(d, opts, oauthCfg)
+		if err != nil {
+			return err
+		}
 	}
 
 	token, err := d.ExchangeAuthCode(ctx, oauthCfg, code)
@@ -383,19 +405,22 @@ func runWith(ctx context.Context, d initDeps, opts *initOptions) error {
 	if cfgErr != nil {
 		cfg = &config.Config{}
 	}
-	cfg.GrantedScopes = auth.AllScopes
+	cfg.GrantedScopes = grantedScopes(token, oauthCfg.Scopes)
 	if saveErr := d.SaveConfig(cfg); saveErr != nil {
 		d.View.Error("Warning: saving granted scopes: %v", saveErr)
 	}
 
 	// Step 7: verify + render `gro me` one-liner. People failure is fatal —
 	// init's success contract is that you can immediately run `gro me`.
+	// Gmail is only verified when it was authorized.
 	if !opts.noVerify {
-		email, err := d.GmailVerify(ctx)
-		if err != nil {
-			return fmt.Errorf("verifying Gmail API: %w", err)
+		if slices.Contains(auth.GrantedDomains(cfg.GrantedScopes), "mail") {
+			email, err := d.GmailVerify(ctx)
+			if err != nil {
+				return fmt.Errorf("verifying Gmail API: %w", err)
+			}
+			d.View.Success("Verified Gmail API for %s", email)
 		}
-		d.View.Success("Verified Gmail API for %s", email)
 
 		profile, err := d.PeopleGetMe(ctx)
 		if err != nil {
@@ -408,10 +433,72 @@ func runWith(ctx context.Context, d initDeps, opts *initOptions) error {
 	d.View.Println("")
 	d.View.Println("Setup complete! Try:")
 	d.View.Println("  gro me")
-	d.View.Println("  gro mail search \"is:unread\"")
+	d.View.Println("  " + tryHints[domains[0]])
 	return nil
 }
 
+// tryHints are the commands suggested after setup, one per service; the
+// first service authorized picks the hint
+var tryHints = map[string]string{
+	"mail":     `gro mail search "is:unread"`,
+	"calendar": "gro calendar today",
+	"contacts": "gro contacts list",
+	"drive":    "gro drive recent",
+}
+
+// grantedScopes returns the scopes Google reports granting with token, and
+// requested when the token response did not say. Users can untick scopes on
+// the consent screen, so the two can differ.
+func grantedScopes(token *oauth2.Token, requested []string) []string {
+	if s, ok := token.Extra("scope").(string); ok {
+		if granted := strings.Fields(s); len(granted) > 0 {
+			return granted
+		}
+	}
+	return requested
+}
+
+// authCodeFromPaste runs the manual flow: show (and optionally open) the
+// consent URL, then read the redirect URL or bare code back from the prompt,
+// or from stdin under --auth-code-stdin.
+func authCodeFromPaste(d initDeps, opts *initOptions, oauthCfg *oauth2.Config) (string, error) {
+	authURL := auth.GetAuthURL(oauthCfg)
+	if !opts.authCodeStdin && !opts.noBrowser {
+		open, err := d.Prompter.ConfirmOpenBrowser()
+		if err != nil {
+			return "", err
+		}
+		if open {
+			if err := d.OpenBrowser(authURL); err != nil {
+				d.View.Info("Could not open browser automatically (%v).", err)
+			}
+		}
+	}
+	d.View.Println("If your browser didn't open, paste this URL into it:")
+	d.View.Println("")
+	d.View.Println("  " + authURL)
+	d.View.Println("")
+
+	// Two-phase install: --auth-code-stdin reads the code/redirect URL from
+	// stdin (the installer pauses between "open URL" and "feed code back")
+	// instead of the interactive prompt. The value is never echoed.
+	var codeInput string
+	var err error
+	if opts.authCodeStdin {
+		codeInput, err = d.StdinReadAll()
+	} else {
+		codeInput, err = d.Prompter.PasteRedirectURL()
+	}
+	if err != nil {
+		return "", err
+	}
+	code := extractAuthCode(codeInput)
+	if code == "" {
+		return "", errors.New("no authorization code found in input")
+	}
+	return code, nil
+}
+
 // tryExistingToken handles the case where a token is already stored.
 // Returns (handled=true, nil) if init is done; (handled=false, nil) if the
 // caller should fall through to the OAuth flow; (_, err) on errors.
@@ -420,15 +507,18 @@ func runWith(ctx context.Context, d initDeps, opts *initOptions) error {
 // but People-insufficient token (typical of users who upgraded gro) must
 // trigger re-auth here, otherwise `gro me`'s "run gro init" message
 // produces an infinite remediation loop.
-func tryExistingToken(ctx context.Context, d initDeps, opts *initOptions) (bool, error) {
+func tryExistingToken(ctx context.Context, d initDeps, opts *initOptions, domains []string) (bool, error) {
 	if !d.HasStoredToken() {
 		return false, nil
 	}
 
 	// Loud-and-early stale-scope check from the recorded scopes. This runs
 	// regardless of --no-verify because letting --no-verify skip it would
-	// re-open the same remediation loop #107 is trying to close.
-	if cfg, err := d.LoadConfig(); err == nil {
+	// re-open the same remediation loop #107 is trying to close. A token
+	// without a recorded scope list predates the record and is taken to
+	// cover every service.
+	granted := auth.Domains
+	if cfg, err := d.LoadConfig(); err == nil && len(cfg.GrantedScopes) > 0 {
 		if msg := auth.CheckScopesMigration(cfg.GrantedScopes); msg != "" {
 			d.View.Error("Recorded scopes are stale.")
 			d.View.Println(msg)
@@ -437,6 +527,21 @@ func tryExistingToken(ctx context.Context, d initDeps, opts *initOptions) (bool,
 			}
 			return false, nil
 		}
+
+		granted = auth.GrantedDomains(cfg.GrantedScopes)
+		var missing []string
+		for _, domain := range domains {
+			if !slices.Contains(granted, domain) {
+				missing = append(missing, domain)
+			}
+		}
+		if len(missing) > 0 {
+			d.View.Error("Stored token is not authorized for %s.", strings.Join(missing, ", "))
+			if err := promptAndDeleteForReauth(d); err != nil {
+				return false, err
+			}
+			return false, nil
+		}
 	}
 
 	// --no-verify keeps the historical "accept token, skip API calls" semantic
@@ -446,18 +551,22 @@ func tryExistingToken(ctx context.Context, d initDeps, opts *initOptions) (bool,
 		return true, finishExisting(d, nil /* no profile */)
 	}
 
-	email, err := d.GmailVerify(ctx)
-	if err != nil {
-		if isAuthError(err) {
-			d.View.Error("Stored token is expired or revoked.")
-			if err := promptAndDeleteForReauth(d); err != nil {
-				return false, err
+	// Gmail is the historical liveness check; a token without mail access
+	// is checked through People alone
+	if slices.Contains(granted, "mail") {
+		email, err := d.GmailVerify(ctx)
+		if err != nil {
+			if exit.IsAuthError(err) {
+				d.View.Error("Stored token is expired or revoked.")
+				if err := promptAndDeleteForReauth(d); err != nil {
+					return false, err
+				}
+				return false, nil
 			}
-			return false, nil
+			return false, err
 		}
-		return false, err
+		d.View.Success("Already authenticated as %s", email)
 	}
-	d.View.Success("Already authenticated as %s", email)
 
 	// People verify catches scope-stale tokens that Gmail accepts. Reuse the
 	// returned profile for the success-line render so we don't make a second
@@ -471,8 +580,18 @@ func tryExistingToken(ctx context.Context, d initDeps, opts *initOptions) (bool,
 			}
 			return false, nil
 		}
+		if exit.IsAuthError(err) {
+			d.View.Error("Stored token is expired or revoked.")
+			if err := promptAndDeleteForReauth(d); err != nil {
+				return false, err
+			}
+			return false, nil
+		}
 		return false, fmt.Errorf("verifying People API: %w", err)
 	}
+	if !slices.Contains(granted, "mail") {
+		d.View.Success("Already authenticated as %s", profile.PrimaryEmail)
+	}
 
 	return true, finishExisting(d, profile)
 }
@@ -668,24 +787,6 @@ func extractAuthCode(input string) string {
 	return input
 }
 
-// isAuthError is preserved from the original implementation.
-func isAuthError(err error) bool {
-	if err == nil {
-		return false
-	}
-	var apiErr *googleapi.Error
-	if ok := errorAs(err, &apiErr); ok {
-		return apiErr.Code == http.StatusUnauthorized
-	}
-	errStr := err.Error()
-	return strings.Contains(errStr, "401") &&
-		(strings.Contains(errStr, "Invalid Credentials") ||
-			strings.Contains(errStr, "invalid_grant") ||
-			strings.Contains(errStr, "Token has been expired or revoked"))
-}
-
-var errorAs = errors.As
-
 // workspaceAdminsURL points to the repo's Workspace-admin walkthrough.
 // Referenced from both cmd.Long and the runtime wizard, so installed-CLI
 // users (Homebrew/Chocolatey/Winget) reach it without a local checkout.
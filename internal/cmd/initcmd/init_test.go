@@ -14,6 +14,7 @@ import (
 	"golang.org/x/oauth2"
 	"google.golang.org/api/googleapi"
 
+	"github.com/open-cli-collective/google-readonly/internal/auth"
 	"github.com/open-cli-collective/google-readonly/internal/config"
 	"github.com/open-cli-collective/google-readonly/internal/people"
 	"github.com/open-cli-collective/google-readonly/internal/testutil"
@@ -51,7 +52,7 @@ func TestInitCommand(t *testing.T) {
 
 	t.Run("has expected flags", func(t *testing.T) {
 		t.Parallel()
-		for _, name := range []string{"no-verify", "no-browser", "credentials-file"} {
+		for _, name := range []string{"no-verify", "no-browser", "credentials-file", "local-server"} {
 			if cmd.Flags().Lookup(name) == nil {
 				t.Errorf("missing flag: %s", name)
 			}
@@ -95,32 +96,6 @@ func TestExtractAuthCode(t *testing.T) {
 	}
 }
 
-func TestIsAuthError(t *testing.T) {
-	t.Parallel()
-	tests := []struct {
-		name     string
-		err      error
-		expected bool
-	}{
-		{"nil error", nil, false},
-		{"generic error", errors.New("something went wrong"), false},
-		{"network error", errors.New("connection refused"), false},
-		{"googleapi 401", &googleapi.Error{Code: http.StatusUnauthorized, Message: "Invalid Credentials"}, true},
-		{"googleapi 403", &googleapi.Error{Code: http.StatusForbidden, Message: "Access denied"}, false},
-		{"googleapi 404", &googleapi.Error{Code: http.StatusNotFound, Message: "Not found"}, false},
-		{"text 401 + Invalid Credentials", errors.New("googleapi: Error 401: Invalid Credentials"), true},
-		{"text 401 + invalid_grant", errors.New("oauth2: 401 invalid_grant: Token has been expired"), true},
-		{"text Token has been expired or revoked", errors.New("401: Token has been expired or revoked"), true},
-		{"text 401 alone", errors.New("HTTP 401 response"), false},
-	}
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			t.Parallel()
-			testutil.Equal(t, isAuthError(tt.err), tt.expected)
-		})
-	}
-}
-
 func TestValidateOAuthJSONRejectsGarbage(t *testing.T) {
 	t.Parallel()
 	if err := validateOAuthJSON("not json"); err == nil {
@@ -965,3 +940,132 @@ func TestRunWith_EnsureMigratedRunsFirst(t *testing.T) {
 		t.Fatalf("EnsureMigrated must run first and SetToken must not run on conflict; order=%v", order)
 	}
 }
+
+// TestRunWithOnlyRequestsSubset covers `gro init --only calendar`: only the
+// Calendar and profile scopes are requested and recorded, and Gmail, which
+// the token cannot reach, is not verified.
+func TestRunWithOnlyRequestsSubset(t *testing.T) {
+	t.Parallel()
+	fs := newFakeFS()
+	d := baseDeps(t, fs)
+	credPath, _ := d.GetCredentialsPath()
+	if err := os.WriteFile(credPath, []byte(validOAuthJSON), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	var requested []string
+	d.ExchangeAuthCode = func(_ context.Context, cfg *oauth2.Config, _ string) (*oauth2.Token, error) {
+		requested = cfg.Scopes
+		return &oauth2.Token{AccessToken: "tok"}, nil
+	}
+	d.GmailVerify = func(_ context.Context) (string, error) {
+		t.Fatal("GmailVerify should not be called without mail access")
+		return "", nil
+	}
+	var saved *config.Config
+	d.SaveConfig = func(c *config.Config) error { saved = c; return nil }
+	out := &bytes.Buffer{}
+	d.View = view.NewWithWriters(out, &bytes.Buffer{})
+	d.Prompter = &stubPrompter{redirectURL: "http://localhost/?code=ABC"}
+
+	if err := runWith(context.Background(), d, &initOptions{noBrowser: true, only: "calendar"}); err != nil {
+		t.Fatalf("runWith: %v", err)
+	}
+
+	want := auth.ScopesForDomains([]string{"calendar"})
+	if strings.Join(requested, " ") != strings.Join(want, " ") {
+		t.Errorf("requested scopes = %v, want %v", requested, want)
+	}
+	if saved == nil || strings.Join(saved.GrantedScopes, " ") != strings.Join(want, " ") {
+		t.Errorf("recorded scopes = %v, want %v", saved, want)
+	}
+	if !strings.Contains(out.String(), "gro calendar today") {
+		t.Errorf("expected a calendar hint, got %q", out.String())
+	}
+}
+
+func TestRunWithOnlyRejectsUnknownService(t *testing.T) {
+	t.Parallel()
+	d := baseDeps(t, newFakeFS())
+	err := runWith(context.Background(), d, &initOptions{only: "mail,photos"})
+	if err == nil || !strings.Contains(err.Error(), `unknown --only value "photos"`) {
+		t.Fatalf("expected unknown --only error, got %v", err)
+	}
+}
+
+// TestRunWithExistingTokenMissingServiceReauths covers asking for a service
+// the stored token was never granted: the wizard offers to re-authenticate.
+func TestRunWithExistingTokenMissingServiceReauths(t *testing.T) {
+	t.Parallel()
+	fs := newFakeFS()
+	d := baseDeps(t, fs)
+	credPath, _ := d.GetCredentialsPath()
+	if err := os.WriteFile(credPath, []byte(validOAuthJSON), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	cfg := &config.Config{GrantedScopes: auth.ScopesForDomains([]string{"mail"})}
+	d.LoadConfig = func() (*config.Config, error) { return cfg, nil }
+	d.SaveConfig = func(c *config.Config) error { *cfg = *c; return nil }
+	d.HasStoredToken = func() bool { return true }
+	deleteCalled := false
+	d.DeleteToken = func() error { deleteCalled = true; return nil }
+	errOut := &bytes.Buffer{}
+	d.View = view.NewWithWriters(&bytes.Buffer{}, errOut)
+	stub := &stubPrompter{redirectURL: "http://localhost/?code=ABC", reauth: true}
+	d.Prompter = stub
+
+	if err := runWith(context.Background(), d, &initOptions{noBrowser: true, only: "mail,drive"}); err != nil {
+		t.Fatalf("runWith: %v", err)
+	}
+	if !strings.Contains(errOut.String(), "not authorized for drive") {
+		t.Errorf("expected the missing service to be named, got %q", errOut.String())
+	}
+	if !deleteCalled || !contains(stub.calls, "redirect") {
+		t.Errorf("expected re-auth, deleted=%v calls=%v", deleteCalled, stub.calls)
+	}
+}
+
+func TestGrantedScopes(t *testing.T) {
+	t.Parallel()
+	requested := []string{"a", "b"}
+
+	tok := &oauth2.Token{AccessToken: "tok"}
+	if got := grantedScopes(tok, requested); strings.Join(got, " ") != "a b" {
+		t.Errorf("without a scope in the response, got %v", got)
+	}
+
+	tok = tok.WithExtra(map[string]any{"scope": "b c"})
+	if got := grantedScopes(tok, requested); strings.Join(got, " ") != "b c" {
+		t.Errorf("with a scope in the response, got %v", got)
+	}
+}
+
+// TestRunWithReplaceTokenSkipsExistingToken covers Reauthorize: a stored
+// token that already covers the services is replaced rather than accepted.
+func TestRunWithReplaceTokenSkipsExistingToken(t *testing.T) {
+	t.Parallel()
+	fs := newFakeFS()
+	d := baseDeps(t, fs)
+	credPath, _ := d.GetCredentialsPath()
+	if err := os.WriteFile(credPath, []byte(validOAuthJSON), 0600); err != nil {
+		t.Fatal(err)
+	}
+	d.HasStoredToken = func() bool { return true }
+	d.DeleteToken = func() error {
+		t.Fatal("the old token must stay until the new one is saved")
+		return nil
+	}
+	saved := false
+	d.SetToken = func(_ *oauth2.Token) error { saved = true; return nil }
+	stub := &stubPrompter{redirectURL: "http://localhost/?code=ABC"}
+	d.Prompter = stub
+
+	opts := &initOptions{noBrowser: true, only: "calendar,drive", replaceToken: true}
+	if err := runWith(context.Background(), d, opts); err != nil {
+		t.Fatalf("runWith: %v", err)
+	}
+	if !saved || !contains(stub.calls, "redirect") {
+		t.Errorf("expected a fresh OAuth flow, saved=%v calls=%v", saved, stub.calls)
+	}
+}
@@ -2,16 +2,21 @@ package mail
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"os"
 	"path/filepath"
+	"slices"
 	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
 
 	"github.com/open-cli-collective/google-readonly/internal/config"
+	"github.com/open-cli-collective/google-readonly/internal/fileutil"
 	"github.com/open-cli-collective/google-readonly/internal/format"
 	"github.com/open-cli-collective/google-readonly/internal/gmail"
+	"github.com/open-cli-collective/google-readonly/internal/log"
 	ziputil "github.com/open-cli-collective/google-readonly/internal/zip"
 )
 
@@ -21,34 +26,94 @@ func newDownloadAttachmentsCommand() *cobra.Command {
 		outputDir string
 		extract   bool
 		all       bool
+		label     string
+		since     string
+		mimeTypes []string
+		minSize   string
+		maxSize   string
+		inline    bool
+		overwrite bool
 	)
 
 	cmd := &cobra.Command{
-		Use:   "download <message-id>",
+		Use:   "download [message-id]",
 		Short: "Download attachments from a message",
 		Long: `Download attachments from a Gmail message to local disk.
 
 By default, requires --filename to specify which attachment to download,
 or --all to download all attachments.
 
-Zip files can be automatically extracted with --extract flag.
+Instead of a message ID, --label and/or --since download attachments from
+every matching message. --since accepts a date (YYYY-MM-DD) or a relative
+age (e.g. 30d, 2w). Attachments that share a filename are saved with the
+message ID as a prefix.
+
+--mime-type, --min-size and --max-size narrow the download further.
+--mime-type is repeatable or comma-separated and accepts a whole family
+such as image/*. Sizes take units like 100KB or 1.5MB. The number of
+attachments each filter skipped is printed at the end.
+
+Inline parts, such as images embedded in an HTML body, are skipped by --all
+unless --include-inline is given. With it, a manifest.json is written next to
+the files, mapping each saved file that has a Content-ID to that ID and its
+MIME part path, so cid: references in the HTML can be rewired offline.
+
+An existing file is never replaced: the attachment is saved as
+"name (1).ext", "name (2).ext", and so on instead. --overwrite replaces it.
+
+Zip, tar and gzip-compressed tar (.tar.gz, .tgz) attachments can be
+automatically extracted with --extract flag.
 
 Examples:
   gro mail attachments download 18abc123def456 --filename report.pdf
   gro mail attachments download 18abc123def456 --all
   gro mail attachments download 18abc123def456 --all --output ~/Downloads
-  gro mail attachments download 18abc123def456 --filename archive.zip --extract`,
-		Args: cobra.ExactArgs(1),
+  gro mail attachments download 18abc123def456 --all --overwrite
+  gro mail attachments download 18abc123def456 --filename archive.zip --extract
+  gro mail attachments download 18abc123def456 --filename logs.tar.gz --extract
+  gro mail attachments download 18abc123def456 --all --include-inline
+  gro mail attachments download --label Invoices --since 30d --all --output ./invoices
+  gro mail attachments download --label Invoices --all --mime-type application/pdf --min-size 100KB`,
+		Args: cobra.MaximumNArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			if filename == "" && !all {
 				return fmt.Errorf("must specify --filename or --all")
 			}
 
+			search := label != "" || since != ""
+			if search && len(args) > 0 {
+				return fmt.Errorf("specify a message ID or --label/--since, not both")
+			}
+			if !search && len(args) == 0 {
+				return fmt.Errorf("a message ID or --label/--since is required")
+			}
+
+			filter, err := newAttachmentFilter(mimeTypes, minSize, maxSize)
+			if err != nil {
+				return err
+			}
+			filter.filename = filename
+			filter.includeInline = inline
+
+			var after time.Time
+			if since != "" {
+				t, err := parseSince(since, time.Now())
+				if err != nil {
+					return fmt.Errorf("invalid --since: %w", err)
+				}
+				after = t
+			}
+
 			client, err := newGmailClient(cmd.Context())
 			if err != nil {
 				return fmt.Errorf("creating Gmail client: %w", err)
 			}
 
+			if search {
+				query := buildExportQuery(label, after) + " has:attachment"
+				return downloadMatchingAttachments(cmd.Context(), client, query, outputDir, extract, overwrite, filter)
+			}
+
 			messageID := args[0]
 			attachments, err := client.GetAttachments(cmd.Context(), messageID)
 			if err != nil {
@@ -56,7 +121,7 @@ Examples:
 			}
 
 			if len(attachments) == 0 {
-				fmt.Println("No attachments found for message.")
+				log.Status("No attachments found for message.")
 				return nil
 			}
 
@@ -72,6 +137,13 @@ Examples:
 				return fmt.Errorf("attachment not found: %s", filename)
 			}
 
+			toDownload = filter.apply(toDownload)
+			if len(toDownload) == 0 {
+				log.Status("No attachments match the filters.")
+				filter.printSkipped()
+				return nil
+			}
+
 			// Create output directory if needed
 			if err := os.MkdirAll(outputDir, config.OutputDirPerm); err != nil {
 				return fmt.Errorf("creating output directory: %w", err)
@@ -84,16 +156,20 @@ Examples:
 			}
 
 			// Download each attachment
+			var manifest []manifestEntry
 			for _, att := range toDownload {
 				// Sanitize filename for display to prevent terminal injection
 				safeFilename := SanitizeFilename(att.Filename)
 
 				// Security: Validate output path to prevent path traversal attacks
-				outputPath, err := safeOutputPath(absOutputDir, att.Filename)
+				outputPath, err := fileutil.SafeJoin(absOutputDir, att.Filename)
 				if err != nil {
 					fmt.Fprintf(os.Stderr, "Skipping %s: %v\n", safeFilename, err)
 					continue
 				}
+				if !overwrite {
+					outputPath = fileutil.UniquePath(filepath.Dir(outputPath), filepath.Base(outputPath))
+				}
 
 				data, err := downloadAttachment(cmd.Context(), client, messageID, att)
 				if err != nil {
@@ -106,21 +182,17 @@ Examples:
 					continue
 				}
 
-				fmt.Printf("Downloaded: %s (%s)\n", outputPath, format.Size(int64(len(data))))
-
-				// Extract if zip and --extract flag
-				if extract && isZipFile(att.Filename, att.MimeType) {
-					extractDir := filepath.Join(outputDir,
-						strings.TrimSuffix(att.Filename, filepath.Ext(att.Filename)))
-					if err := ziputil.Extract(outputPath, extractDir, ziputil.DefaultOptions()); err != nil {
-						fmt.Fprintf(os.Stderr, "Error extracting %s: %v\n", safeFilename, err)
-					} else {
-						fmt.Printf("Extracted to: %s\n", extractDir)
-					}
+				log.Status("Downloaded: %s (%s)", outputPath, format.Size(int64(len(data))))
+				manifest = appendManifest(manifest, filter, messageID, outputPath, att)
+
+				// Extract if zip or tar and --extract flag
+				if extract && isArchiveFile(att.Filename, att.MimeType) {
+					extractAttachment(outputDir, outputPath, filepath.Base(outputPath))
 				}
 			}
 
-			return nil
+			filter.printSkipped()
+			return writeManifest(absOutputDir, manifest)
 		},
 	}
 
@@ -129,63 +201,315 @@ Examples:
 	cmd.Flags().StringVarP(&outputDir, "output", "o", ".",
 		"Directory to save attachments")
 	cmd.Flags().BoolVarP(&extract, "extract", "e", false,
-		"Extract zip files after download")
+		"Extract zip and tar archives after download")
 	cmd.Flags().BoolVarP(&all, "all", "a", false,
 		"Download all attachments (required if no --filename specified)")
+	cmd.Flags().StringVarP(&label, "label", "l", "",
+		"Download from all messages with this label instead of one message")
+	_ = cmd.RegisterFlagCompletionFunc("label", completeLabelNames)
+	cmd.Flags().StringVar(&since, "since", "",
+		"Download from all messages since a date (YYYY-MM-DD) or age (e.g. 30d, 2w)")
+	cmd.Flags().StringSliceVar(&mimeTypes, "mime-type", nil,
+		"Only download attachments of this MIME type, e.g. application/pdf or image/* (repeatable)")
+	cmd.Flags().StringVar(&minSize, "min-size", "",
+		"Only download attachments at least this large (e.g. 100KB)")
+	cmd.Flags().StringVar(&maxSize, "max-size", "",
+		"Only download attachments at most this large (e.g. 5MB)")
+	cmd.Flags().BoolVar(&inline, "include-inline", false,
+		"Also download inline parts and write a manifest.json of their Content-IDs")
+	cmd.Flags().BoolVar(&overwrite, "overwrite", false,
+		"Replace existing files instead of saving numbered copies")
 
 	return cmd
 }
 
-func downloadAttachment(ctx context.Context, client MailClient, messageID string, att *gmail.Attachment) ([]byte, error) {
-	if att.AttachmentID != "" {
-		return client.DownloadAttachment(ctx, messageID, att.AttachmentID)
+// downloadMatchingAttachments downloads attachments from every message
+// matching query into outputDir. A filename already written by an earlier
+// message gets the message ID as a prefix, and one already on disk is
+// numbered unless overwrite is set. Per-attachment failures are reported and
+// skipped; a summary is printed at the end.
+func downloadMatchingAttachments(ctx context.Context, client MailClient, query, outputDir string, extract, overwrite bool, filter *attachmentFilter) error {
+	ids, err := client.ListAllMessageIDs(ctx, query)
+	if err != nil {
+		return fmt.Errorf("searching messages: %w", err)
 	}
-	return client.DownloadInlineAttachment(ctx, messageID, att.PartID)
+	if len(ids) == 0 {
+		log.Status("No matching messages with attachments.")
+		return nil
+	}
+
+	if err := os.MkdirAll(outputDir, config.OutputDirPerm); err != nil {
+		return fmt.Errorf("creating output directory: %w", err)
+	}
+	absOutputDir, err := filepath.Abs(outputDir)
+	if err != nil {
+		return fmt.Errorf("resolving download directory: %w", err)
+	}
+
+	written := make(map[string]bool)
+	var manifest []manifestEntry
+	var files, messages, skipped int
+	var total int64
+	for _, id := range ids {
+		attachments, err := client.GetAttachments(ctx, id)
+		if err != nil {
+			return fmt.Errorf("getting attachments for %s: %w", id, err)
+		}
+
+		saved := 0
+		for _, att := range attachments {
+			if !filter.keep(att) {
+				continue
+			}
+
+			// Sanitize filename for display to prevent terminal injection
+			safeFilename := SanitizeFilename(att.Filename)
+
+			name := att.Filename
+			if written[name] {
+				name = id + "_" + name
+			}
+
+			// Security: Validate output path to prevent path traversal attacks
+			outputPath, err := fileutil.SafeJoin(absOutputDir, name)
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "Skipping %s: %v\n", safeFilename, err)
+				skipped++
+				continue
+			}
+			if !overwrite {
+				outputPath = fileutil.UniquePath(filepath.Dir(outputPath), filepath.Base(outputPath))
+			}
+
+			data, err := downloadAttachment(ctx, client, id, att)
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "Error downloading %s: %v\n", safeFilename, err)
+				skipped++
+				continue
+			}
+
+			if err := saveAttachment(outputPath, data); err != nil {
+				fmt.Fprintf(os.Stderr, "Error saving %s: %v\n", safeFilename, err)
+				skipped++
+				continue
+			}
+
+			written[name] = true
+			files++
+			saved++
+			total += int64(len(data))
+			log.Status("Downloaded: %s (%s)", outputPath, format.Size(int64(len(data))))
+			manifest = appendManifest(manifest, filter, id, outputPath, att)
+
+			if extract && isArchiveFile(att.Filename, att.MimeType) {
+				extractAttachment(outputDir, outputPath, filepath.Base(outputPath))
+			}
+		}
+		if saved > 0 {
+			messages++
+		}
+	}
+
+	log.Status("\nDownloaded %d attachment(s) from %d message(s), %s, to %s",
+		files, messages, format.Size(total), outputDir)
+	if skipped > 0 {
+		log.Status("Skipped %d attachment(s)", skipped)
+	}
+	filter.printSkipped()
+	return writeManifest(absOutputDir, manifest)
 }
 
-func saveAttachment(path string, data []byte) error {
-	return os.WriteFile(path, data, config.OutputFilePerm)
+// attachmentFilter applies --filename, --mime-type, --min-size and --max-size
+// and counts how many attachments each of the last three skipped. A zero size
+// bound is unset. Inline parts are skipped unless includeInline is set or
+// --filename names them.
+type attachmentFilter struct {
+	filename      string
+	mimeTypes     []string
+	minSize       int64
+	maxSize       int64
+	includeInline bool
+
+	skippedInline int
+	skippedMime   int
+	skippedMin    int
+	skippedMax    int
 }
 
-func isZipFile(filename, mimeType string) bool {
-	ext := strings.ToLower(filepath.Ext(filename))
-	return ext == ".zip" ||
-		mimeType == "application/zip" ||
-		mimeType == "application/x-zip-compressed"
+// newAttachmentFilter parses the filter flags
+func newAttachmentFilter(mimeTypes []string, minSize, maxSize string) (*attachmentFilter, error) {
+	f := &attachmentFilter{}
+	for _, m := range mimeTypes {
+		if m = strings.ToLower(strings.TrimSpace(m)); m != "" {
+			f.mimeTypes = append(f.mimeTypes, m)
+		}
+	}
+
+	var err error
+	if minSize != "" {
+		if f.minSize, err = format.ParseSize(minSize); err != nil {
+			return nil, fmt.Errorf("invalid --min-size: %w", err)
+		}
+	}
+	if maxSize != "" {
+		if f.maxSize, err = format.ParseSize(maxSize); err != nil {
+			return nil, fmt.Errorf("invalid --max-size: %w", err)
+		}
+	}
+	if f.maxSize > 0 && f.minSize > f.maxSize {
+		return nil, fmt.Errorf("--min-size %s is larger than --max-size %s", minSize, maxSize)
+	}
+	return f, nil
 }
 
-// safeOutputPath validates that the output path for a filename stays within the
-// destination directory, preventing path traversal attacks from malicious filenames.
-func safeOutputPath(destDir, filename string) (string, error) {
-	// Clean the filename to normalize path separators and remove redundant elements
-	cleanName := filepath.Clean(filename)
+// keep reports whether att passes every filter, counting the first filter
+// that rejects it. A --filename mismatch is not counted.
+func (f *attachmentFilter) keep(att *gmail.Attachment) bool {
+	switch {
+	case f.filename != "" && att.Filename != f.filename:
+		return false
+	case att.IsInline && !f.includeInline && f.filename == "":
+		f.skippedInline++
+	case len(f.mimeTypes) > 0 && !matchesMimeType(att.MimeType, f.mimeTypes):
+		f.skippedMime++
+	case f.minSize > 0 && att.Size < f.minSize:
+		f.skippedMin++
+	case f.maxSize > 0 && att.Size > f.maxSize:
+		f.skippedMax++
+	default:
+		return true
+	}
+	return false
+}
 
-	// Reject absolute paths
-	if filepath.IsAbs(cleanName) {
-		return "", fmt.Errorf("invalid attachment filename: absolute path not allowed")
+// apply returns the attachments that pass the filters
+func (f *attachmentFilter) apply(atts []*gmail.Attachment) []*gmail.Attachment {
+	var kept []*gmail.Attachment
+	for _, att := range atts {
+		if f.keep(att) {
+			kept = append(kept, att)
+		}
 	}
+	return kept
+}
 
-	// Reject paths that try to escape with ..
-	if strings.HasPrefix(cleanName, ".."+string(filepath.Separator)) || cleanName == ".." {
-		return "", fmt.Errorf("invalid attachment filename: path traversal not allowed")
+// printSkipped reports the attachments each filter skipped, if any
+func (f *attachmentFilter) printSkipped() {
+	var parts []string
+	if f.skippedInline > 0 {
+		parts = append(parts, fmt.Sprintf("%d inline (use --include-inline)", f.skippedInline))
 	}
+	if f.skippedMime > 0 {
+		parts = append(parts, fmt.Sprintf("%d by --mime-type", f.skippedMime))
+	}
+	if f.skippedMin > 0 {
+		parts = append(parts, fmt.Sprintf("%d by --min-size", f.skippedMin))
+	}
+	if f.skippedMax > 0 {
+		parts = append(parts, fmt.Sprintf("%d by --max-size", f.skippedMax))
+	}
+	if len(parts) > 0 {
+		log.Status("Filtered out: %s", strings.Join(parts, ", "))
+	}
+}
 
-	// Also check for .. anywhere in the path (after cleaning)
-	for _, part := range strings.Split(cleanName, string(filepath.Separator)) {
-		if part == ".." {
-			return "", fmt.Errorf("invalid attachment filename: path traversal not allowed")
+// inlineManifestFile is the sidecar --include-inline writes into the output
+// directory
+const inlineManifestFile = "manifest.json"
+
+// manifestEntry maps one saved file to the MIME part it came from
+type manifestEntry struct {
+	File      string `json:"file"`
+	ContentID string `json:"contentId"`
+	PartID    string `json:"partId"`
+	MimeType  string `json:"mimeType"`
+	MessageID string `json:"messageId"`
+}
+
+// appendManifest records a saved attachment for the manifest when
+// --include-inline is set and the part has a Content-ID
+func appendManifest(entries []manifestEntry, filter *attachmentFilter, messageID, path string, att *gmail.Attachment) []manifestEntry {
+	if !filter.includeInline || att.ContentID == "" {
+		return entries
+	}
+	return append(entries, manifestEntry{
+		File:      filepath.Base(path),
+		ContentID: att.ContentID,
+		PartID:    att.PartID,
+		MimeType:  att.MimeType,
+		MessageID: messageID,
+	})
+}
+
+// writeManifest writes the Content-ID manifest into dir. Nothing is written
+// when no saved file had a Content-ID.
+func writeManifest(dir string, entries []manifestEntry) error {
+	if len(entries) == 0 {
+		return nil
+	}
+	data, err := json.MarshalIndent(map[string]any{"files": entries}, "", "  ")
+	if err != nil {
+		return fmt.Errorf("encoding manifest: %w", err)
+	}
+	path := filepath.Join(dir, inlineManifestFile)
+	if err := os.WriteFile(path, append(data, '\n'), config.OutputFilePerm); err != nil {
+		return fmt.Errorf("writing manifest: %w", err)
+	}
+	log.Status("Wrote manifest: %s", path)
+	return nil
+}
+
+// matchesMimeType reports whether mimeType equals one of patterns, ignoring
+// case. A pattern ending in /* matches the whole family.
+func matchesMimeType(mimeType string, patterns []string) bool {
+	mimeType = strings.ToLower(mimeType)
+	for _, p := range patterns {
+		if family, ok := strings.CutSuffix(p, "/*"); ok {
+			if strings.HasPrefix(mimeType, family+"/") {
+				return true
+			}
+		} else if mimeType == p {
+			return true
 		}
 	}
+	return false
+}
 
-	// Build the full output path
-	outputPath := filepath.Join(destDir, cleanName)
+// extractAttachment unpacks a saved archive into a directory named after
+// it. Failures are reported but do not stop the download.
+func extractAttachment(outputDir, outputPath, name string) {
+	extractDir := filepath.Join(outputDir, ziputil.TrimExt(name))
+	if err := ziputil.ExtractArchive(outputPath, extractDir, ziputil.DefaultOptions()); err != nil {
+		fmt.Fprintf(os.Stderr, "Error extracting %s: %v\n", SanitizeFilename(name), err)
+	} else {
+		log.Status("Extracted to: %s", extractDir)
+	}
+}
 
-	// Final security check: ensure the resolved path is within destDir
-	// This handles edge cases like symlinks or other path manipulation
-	cleanOutput := filepath.Clean(outputPath)
-	if !strings.HasPrefix(cleanOutput, destDir+string(filepath.Separator)) && cleanOutput != destDir {
-		return "", fmt.Errorf("invalid attachment filename: path escapes destination directory")
+func downloadAttachment(ctx context.Context, client MailClient, messageID string, att *gmail.Attachment) ([]byte, error) {
+	if att.AttachmentID != "" {
+		return client.DownloadAttachment(ctx, messageID, att.AttachmentID)
 	}
+	return client.DownloadInlineAttachment(ctx, messageID, att.PartID)
+}
+
+func saveAttachment(path string, data []byte) error {
+	return os.WriteFile(path, data, config.OutputFilePerm)
+}
+
+// archiveMimeTypes are the attachment MIME types --extract unpacks
+// regardless of the file name
+var archiveMimeTypes = []string{
+	"application/zip",
+	"application/x-zip-compressed",
+	"application/x-tar",
+	"application/x-gtar",
+	"application/x-compressed-tar",
+}
 
-	return outputPath, nil
+// isArchiveFile reports whether an attachment is a zip or tar archive, by
+// extension or MIME type. A bare .gz is not: it may not hold a tar.
+func isArchiveFile(filename, mimeType string) bool {
+	return ziputil.FormatFromName(filename) != ziputil.FormatUnknown ||
+		slices.Contains(archiveMimeTypes, mimeType)
 }
@@ -1,119 +1,360 @@
 package mail
 
 import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"os"
 	"path/filepath"
 	"testing"
 
+	gmailapi "github.com/open-cli-collective/google-readonly/internal/gmail"
 	"github.com/open-cli-collective/google-readonly/internal/testutil"
 )
 
-func TestSafeOutputPath(t *testing.T) {
-	destDir := "/tmp/downloads"
-
-	tests := []struct {
-		name        string
-		filename    string
-		expectError bool
-		errorMsg    string
-	}{
-		{
-			name:        "simple filename",
-			filename:    "report.pdf",
-			expectError: false,
-		},
-		{
-			name:        "filename with spaces",
-			filename:    "my report.pdf",
-			expectError: false,
-		},
-		{
-			name:        "filename in subdirectory",
-			filename:    "attachments/report.pdf",
-			expectError: false,
-		},
-		{
-			name:        "path traversal with ..",
-			filename:    "../../../etc/passwd",
-			expectError: true,
-			errorMsg:    "path traversal not allowed",
+// searchAttachmentsMock serves two matching messages, each with one
+// attachment named invoice.pdf, and records the search query
+func searchAttachmentsMock(t *testing.T, query *string) *MockGmailClient {
+	return &MockGmailClient{
+		ListAllMessageIDsFunc: func(_ context.Context, q string) ([]string, error) {
+			*query = q
+			return []string{"msg1", "msg2"}, nil
 		},
-		{
-			name:        "path traversal at start",
-			filename:    "../secret.txt",
-			expectError: true,
-			errorMsg:    "path traversal not allowed",
+		GetAttachmentsFunc: func(_ context.Context, messageID string) ([]*gmailapi.Attachment, error) {
+			att := testutil.SampleAttachment("invoice.pdf")
+			att.AttachmentID = "att_" + messageID
+			return []*gmailapi.Attachment{att}, nil
 		},
-		{
-			name:        "path traversal in middle",
-			filename:    "subdir/../../../etc/passwd",
-			expectError: true,
-			errorMsg:    "path traversal not allowed",
+		DownloadAttachmentFunc: func(_ context.Context, messageID, attachmentID string) ([]byte, error) {
+			testutil.Equal(t, attachmentID, "att_"+messageID)
+			return []byte("pdf from " + messageID), nil
 		},
-		{
-			name:        "double dot only",
-			filename:    "..",
-			expectError: true,
-			errorMsg:    "path traversal not allowed",
-		},
-		{
-			name:        "absolute path unix",
-			filename:    "/etc/passwd",
-			expectError: true,
-			errorMsg:    "absolute path not allowed",
+	}
+}
+
+func TestDownloadAttachmentsCommand_LabelSince(t *testing.T) {
+	outDir := t.TempDir()
+	var query string
+	mock := searchAttachmentsMock(t, &query)
+
+	cmd := newDownloadAttachmentsCommand()
+	cmd.SetArgs([]string{"--label", "Invoices", "--since", "30d", "--all", "--output", outDir})
+
+	withMockClient(mock, func() {
+		output := testutil.CaptureStdout(t, func() {
+			err := cmd.Execute()
+			testutil.NoError(t, err)
+		})
+
+		testutil.Contains(t, output, "Downloaded 2 attachment(s) from 2 message(s)")
+		testutil.NotContains(t, output, "Skipped")
+	})
+
+	testutil.Contains(t, query, "label:Invoices")
+	testutil.Contains(t, query, "after:")
+	testutil.Contains(t, query, "has:attachment")
+
+	data, err := os.ReadFile(filepath.Join(outDir, "invoice.pdf"))
+	testutil.NoError(t, err)
+	testutil.Equal(t, string(data), "pdf from msg1")
+
+	data, err = os.ReadFile(filepath.Join(outDir, "msg2_invoice.pdf"))
+	testutil.NoError(t, err)
+	testutil.Equal(t, string(data), "pdf from msg2")
+}
+
+func TestDownloadAttachmentsCommand_SearchSkipsUnsafeNames(t *testing.T) {
+	outDir := t.TempDir()
+	mock := &MockGmailClient{
+		ListAllMessageIDsFunc: func(_ context.Context, _ string) ([]string, error) {
+			return []string{"msg1"}, nil
 		},
-		{
-			name:        "hidden file",
-			filename:    ".hidden",
-			expectError: false,
+		GetAttachmentsFunc: func(_ context.Context, _ string) ([]*gmailapi.Attachment, error) {
+			return []*gmailapi.Attachment{
+				testutil.SampleAttachment("../escape.pdf"),
+				testutil.SampleAttachment("ok.pdf"),
+			}, nil
 		},
-		{
-			name:        "dot in filename",
-			filename:    "report.v2.pdf",
-			expectError: false,
+		DownloadAttachmentFunc: func(_ context.Context, _, _ string) ([]byte, error) {
+			return []byte("data"), nil
 		},
-		{
-			name:        "empty after traversal",
-			filename:    "foo/../bar",
-			expectError: false, // After cleaning becomes "bar" which is valid
+	}
+
+	cmd := newDownloadAttachmentsCommand()
+	cmd.SetArgs([]string{"--label", "Invoices", "--all", "--output", outDir})
+
+	withMockClient(mock, func() {
+		output := testutil.CaptureStdout(t, func() {
+			err := cmd.Execute()
+			testutil.NoError(t, err)
+		})
+
+		testutil.Contains(t, output, "Downloaded 1 attachment(s) from 1 message(s)")
+		testutil.Contains(t, output, "Skipped 1 attachment(s)")
+	})
+
+	_, err := os.Stat(filepath.Join(filepath.Dir(outDir), "escape.pdf"))
+	testutil.True(t, os.IsNotExist(err))
+}
+
+func TestDownloadAttachmentsCommand_SearchNoMatches(t *testing.T) {
+	mock := &MockGmailClient{
+		ListAllMessageIDsFunc: func(_ context.Context, _ string) ([]string, error) {
+			return nil, nil
 		},
 	}
 
+	cmd := newDownloadAttachmentsCommand()
+	cmd.SetArgs([]string{"--since", "2024-01-01", "--all", "--output", t.TempDir()})
+
+	withMockClient(mock, func() {
+		output := testutil.CaptureStdout(t, func() {
+			err := cmd.Execute()
+			testutil.NoError(t, err)
+		})
+
+		testutil.Contains(t, output, "No matching messages")
+	})
+}
+
+func TestDownloadAttachmentsCommand_TargetValidation(t *testing.T) {
+	tests := []struct {
+		name string
+		args []string
+		want string
+	}{
+		{"message ID and label", []string{"msg1", "--label", "Invoices", "--all"}, "not both"},
+		{"neither", []string{"--all"}, "a message ID or --label/--since is required"},
+		{"invalid since", []string{"--since", "soon", "--all"}, "invalid --since"},
+	}
+
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			result, err := safeOutputPath(destDir, tt.filename)
+			cmd := newDownloadAttachmentsCommand()
+			cmd.SetArgs(tt.args)
 
-			if tt.expectError {
+			withMockClient(&MockGmailClient{}, func() {
+				err := cmd.Execute()
 				testutil.Error(t, err)
-				if tt.errorMsg != "" {
-					testutil.Contains(t, err.Error(), tt.errorMsg)
-				}
-			} else {
-				testutil.NoError(t, err)
-				// Verify the result is within destDir
-				testutil.True(t, filepath.IsAbs(result) || result == filepath.Join(destDir, filepath.Clean(tt.filename)))
-			}
+				testutil.Contains(t, err.Error(), tt.want)
+			})
 		})
 	}
 }
 
-func TestSafeOutputPath_StaysWithinDestDir(t *testing.T) {
-	destDir := "/tmp/downloads"
-
-	// Valid cases should produce paths within destDir
-	validCases := []string{
-		"simple.txt",
-		"dir/file.txt",
-		"a/b/c/deep.txt",
+func TestDownloadAttachmentsCommand_Filters(t *testing.T) {
+	outDir := t.TempDir()
+	mock := &MockGmailClient{
+		GetAttachmentsFunc: func(_ context.Context, _ string) ([]*gmailapi.Attachment, error) {
+			return []*gmailapi.Attachment{
+				{Filename: "big.pdf", MimeType: "application/pdf", Size: 500 * 1024, AttachmentID: "a1"},
+				{Filename: "small.pdf", MimeType: "application/pdf", Size: 10 * 1024, AttachmentID: "a2"},
+				{Filename: "photo.png", MimeType: "image/png", Size: 800 * 1024, AttachmentID: "a3"},
+				{Filename: "huge.pdf", MimeType: "application/PDF", Size: 20 * 1024 * 1024, AttachmentID: "a4"},
+			}, nil
+		},
+		DownloadAttachmentFunc: func(_ context.Context, _, id string) ([]byte, error) {
+			return []byte("data " + id), nil
+		},
 	}
 
-	for _, filename := range validCases {
-		t.Run(filename, func(t *testing.T) {
-			result, err := safeOutputPath(destDir, filename)
+	cmd := newDownloadAttachmentsCommand()
+	cmd.SetArgs([]string{"msg1", "--all", "--output", outDir,
+		"--mime-type", "application/pdf", "--min-size", "100KB", "--max-size", "5MB"})
+
+	withMockClient(mock, func() {
+		output := testutil.CaptureStdout(t, func() {
+			err := cmd.Execute()
 			testutil.NoError(t, err)
+		})
 
-			// Result must start with destDir
-			testutil.True(t, len(result) >= len(destDir))
-			testutil.Equal(t, result[:len(destDir)], destDir)
+		testutil.Contains(t, output, "big.pdf")
+		testutil.NotContains(t, output, "small.pdf")
+		testutil.Contains(t, output, "Filtered out: 1 by --mime-type, 1 by --min-size, 1 by --max-size")
+	})
+
+	entries, err := os.ReadDir(outDir)
+	testutil.NoError(t, err)
+	testutil.Len(t, entries, 1)
+}
+
+func TestNewAttachmentFilter(t *testing.T) {
+	f, err := newAttachmentFilter([]string{" Image/* ", "application/pdf"}, "1KB", "")
+	testutil.NoError(t, err)
+	testutil.True(t, f.keep(&gmailapi.Attachment{MimeType: "image/jpeg", Size: 2048}))
+	testutil.True(t, f.keep(&gmailapi.Attachment{MimeType: "application/pdf", Size: 2048}))
+	testutil.False(t, f.keep(&gmailapi.Attachment{MimeType: "text/plain", Size: 2048}))
+	testutil.False(t, f.keep(&gmailapi.Attachment{MimeType: "image/png", Size: 10}))
+	testutil.Equal(t, f.skippedMime, 1)
+	testutil.Equal(t, f.skippedMin, 1)
+
+	_, err = newAttachmentFilter(nil, "big", "")
+	testutil.Error(t, err)
+	testutil.Contains(t, err.Error(), "invalid --min-size")
+
+	_, err = newAttachmentFilter(nil, "2MB", "1MB")
+	testutil.Error(t, err)
+	testutil.Contains(t, err.Error(), "larger than --max-size")
+}
+
+func inlineAttachmentsMock() *MockGmailClient {
+	return &MockGmailClient{
+		GetAttachmentsFunc: func(_ context.Context, _ string) ([]*gmailapi.Attachment, error) {
+			return []*gmailapi.Attachment{
+				{Filename: "report.pdf", MimeType: "application/pdf", AttachmentID: "a1", PartID: "1"},
+				{Filename: "logo.png", MimeType: "image/png", AttachmentID: "a2", PartID: "0.1", IsInline: true, ContentID: "logo@example.com"},
+			}, nil
+		},
+		DownloadAttachmentFunc: func(_ context.Context, _, id string) ([]byte, error) {
+			return []byte("data " + id), nil
+		},
+	}
+}
+
+func TestDownloadAttachmentsCommand_SkipsInlineByDefault(t *testing.T) {
+	outDir := t.TempDir()
+	cmd := newDownloadAttachmentsCommand()
+	cmd.SetArgs([]string{"msg1", "--all", "--output", outDir})
+
+	withMockClient(inlineAttachmentsMock(), func() {
+		output := testutil.CaptureStdout(t, func() {
+			testutil.NoError(t, cmd.Execute())
+		})
+		testutil.Contains(t, output, "report.pdf")
+		testutil.Contains(t, output, "Filtered out: 1 inline (use --include-inline)")
+		testutil.NotContains(t, output, "manifest")
+	})
+
+	_, err := os.Stat(filepath.Join(outDir, "logo.png"))
+	testutil.True(t, os.IsNotExist(err))
+}
+
+func TestDownloadAttachmentsCommand_IncludeInlineWritesManifest(t *testing.T) {
+	outDir := t.TempDir()
+	cmd := newDownloadAttachmentsCommand()
+	cmd.SetArgs([]string{"msg1", "--all", "--include-inline", "--output", outDir})
+
+	withMockClient(inlineAttachmentsMock(), func() {
+		output := testutil.CaptureStdout(t, func() {
+			testutil.NoError(t, cmd.Execute())
+		})
+		testutil.Contains(t, output, "logo.png")
+		testutil.Contains(t, output, "Wrote manifest:")
+	})
+
+	data, err := os.ReadFile(filepath.Join(outDir, "manifest.json"))
+	testutil.NoError(t, err)
+	var manifest struct {
+		Files []manifestEntry `json:"files"`
+	}
+	testutil.NoError(t, json.Unmarshal(data, &manifest))
+	testutil.Len(t, manifest.Files, 1)
+	testutil.Equal(t, manifest.Files[0], manifestEntry{
+		File:      "logo.png",
+		ContentID: "logo@example.com",
+		PartID:    "0.1",
+		MimeType:  "image/png",
+		MessageID: "msg1",
+	})
+}
+
+func TestDownloadAttachmentsCommand_FilenameIncludesInline(t *testing.T) {
+	outDir := t.TempDir()
+	cmd := newDownloadAttachmentsCommand()
+	cmd.SetArgs([]string{"msg1", "--filename", "logo.png", "--output", outDir})
+
+	withMockClient(inlineAttachmentsMock(), func() {
+		testutil.CaptureStdout(t, func() {
+			testutil.NoError(t, cmd.Execute())
+		})
+	})
+
+	_, err := os.Stat(filepath.Join(outDir, "logo.png"))
+	testutil.NoError(t, err)
+
+	// only --include-inline writes the manifest
+	_, err = os.Stat(filepath.Join(outDir, "manifest.json"))
+	testutil.True(t, os.IsNotExist(err))
+}
+
+func TestDownloadAttachmentsCommand_ExistingFile(t *testing.T) {
+	t.Run("saves a numbered copy", func(t *testing.T) {
+		outDir := t.TempDir()
+		testutil.NoError(t, os.WriteFile(filepath.Join(outDir, "report.pdf"), []byte("old"), 0o600))
+		cmd := newDownloadAttachmentsCommand()
+		cmd.SetArgs([]string{"msg1", "--filename", "report.pdf", "--output", outDir})
+
+		withMockClient(inlineAttachmentsMock(), func() {
+			output := testutil.CaptureStdout(t, func() {
+				testutil.NoError(t, cmd.Execute())
+			})
+			testutil.Contains(t, output, "Downloaded: "+filepath.Join(outDir, "report (1).pdf"))
+		})
+
+		data, err := os.ReadFile(filepath.Join(outDir, "report.pdf"))
+		testutil.NoError(t, err)
+		testutil.Equal(t, string(data), "old")
+		data, err = os.ReadFile(filepath.Join(outDir, "report (1).pdf"))
+		testutil.NoError(t, err)
+		testutil.Equal(t, string(data), "data a1")
+	})
+
+	t.Run("--overwrite replaces it", func(t *testing.T) {
+		outDir := t.TempDir()
+		testutil.NoError(t, os.WriteFile(filepath.Join(outDir, "report.pdf"), []byte("old"), 0o600))
+		cmd := newDownloadAttachmentsCommand()
+		cmd.SetArgs([]string{"msg1", "--filename", "report.pdf", "--output", outDir, "--overwrite"})
+
+		withMockClient(inlineAttachmentsMock(), func() {
+			testutil.CaptureStdout(t, func() {
+				testutil.NoError(t, cmd.Execute())
+			})
 		})
+
+		data, err := os.ReadFile(filepath.Join(outDir, "report.pdf"))
+		testutil.NoError(t, err)
+		testutil.Equal(t, string(data), "data a1")
+		_, err = os.Stat(filepath.Join(outDir, "report (1).pdf"))
+		testutil.True(t, os.IsNotExist(err))
+	})
+}
+
+func TestDownloadAttachmentsCommand_ExtractTarGz(t *testing.T) {
+	var buf bytes.Buffer
+	gz := gzip.NewWriter(&buf)
+	tw := tar.NewWriter(gz)
+	content := "2024-01-01 started"
+	testutil.NoError(t, tw.WriteHeader(&tar.Header{Name: "logs/app.log", Typeflag: tar.TypeReg, Mode: 0644, Size: int64(len(content))}))
+	_, err := tw.Write([]byte(content))
+	testutil.NoError(t, err)
+	testutil.NoError(t, tw.Close())
+	testutil.NoError(t, gz.Close())
+
+	mock := &MockGmailClient{
+		GetAttachmentsFunc: func(_ context.Context, _ string) ([]*gmailapi.Attachment, error) {
+			return []*gmailapi.Attachment{
+				{Filename: "logs.tar.gz", MimeType: "application/gzip", AttachmentID: "a1", PartID: "1"},
+			}, nil
+		},
+		DownloadAttachmentFunc: func(_ context.Context, _, _ string) ([]byte, error) {
+			return buf.Bytes(), nil
+		},
 	}
+
+	outDir := t.TempDir()
+	cmd := newDownloadAttachmentsCommand()
+	cmd.SetArgs([]string{"msg1", "--all", "--extract", "--output", outDir})
+
+	withMockClient(mock, func() {
+		output := testutil.CaptureStdout(t, func() {
+			testutil.NoError(t, cmd.Execute())
+		})
+		testutil.Contains(t, output, "Extracted to: "+filepath.Join(outDir, "logs"))
+	})
+
+	data, err := os.ReadFile(filepath.Join(outDir, "logs", "logs", "app.log"))
+	testutil.NoError(t, err)
+	testutil.Equal(t, string(data), content)
 }
@@ -44,6 +44,9 @@ Examples:
 				if att.IsInline {
 					fmt.Printf("   Inline: yes\n")
 				}
+				if att.ContentID != "" {
+					fmt.Printf("   Content-ID: %s\n", SanitizeOutput(att.ContentID))
+				}
 				fmt.Println()
 			}
 
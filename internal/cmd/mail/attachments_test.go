@@ -6,7 +6,7 @@ import (
 	"github.com/open-cli-collective/google-readonly/internal/testutil"
 )
 
-func TestIsZipFile(t *testing.T) {
+func TestIsArchiveFile(t *testing.T) {
 	tests := []struct {
 		name     string
 		filename string
@@ -23,11 +23,16 @@ func TestIsZipFile(t *testing.T) {
 		{"no extension with wrong mime", "archive", "application/octet-stream", false},
 		{"zip extension with different mime", "archive.zip", "application/octet-stream", true},
 		{"empty filename with zip mime", "", "application/zip", true},
+		{"tar extension", "backup.tar", "", true},
+		{"tar.gz extension", "logs.tar.gz", "application/gzip", true},
+		{"tgz extension uppercase", "LOGS.TGZ", "", true},
+		{"application/x-tar mime type", "archive", "application/x-tar", true},
+		{"bare gz is not an archive", "notes.txt.gz", "application/gzip", false},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			result := isZipFile(tt.filename, tt.mimeType)
+			result := isArchiveFile(tt.filename, tt.mimeType)
 			testutil.Equal(t, result, tt.expected)
 		})
 	}
@@ -76,15 +81,18 @@ func TestDownloadAttachmentsCommand(t *testing.T) {
 	cmd := newDownloadAttachmentsCommand()
 
 	t.Run("has correct use", func(t *testing.T) {
-		testutil.Equal(t, cmd.Use, "download <message-id>")
+		testutil.Equal(t, cmd.Use, "download [message-id]")
 	})
 
-	t.Run("requires exactly one argument", func(t *testing.T) {
+	t.Run("accepts at most one argument", func(t *testing.T) {
 		err := cmd.Args(cmd, []string{})
-		testutil.Error(t, err)
+		testutil.NoError(t, err)
 
 		err = cmd.Args(cmd, []string{"msg123"})
 		testutil.NoError(t, err)
+
+		err = cmd.Args(cmd, []string{"msg123", "msg456"})
+		testutil.Error(t, err)
 	})
 
 	t.Run("has required flags", func(t *testing.T) {
@@ -96,6 +104,8 @@ func TestDownloadAttachmentsCommand(t *testing.T) {
 			{"output", "o"},
 			{"extract", "e"},
 			{"all", "a"},
+			{"label", "l"},
+			{"since", ""},
 		}
 
 		for _, f := range flags {
@@ -0,0 +1,41 @@
+package mail
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+)
+
+func newCountCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "count <query>",
+		Short: "Count messages matching a query",
+		Long: `Print the number of messages matching a Gmail search query.
+
+Only message IDs are listed, a page at a time, so no message is fetched and
+the count is exact rather than Gmail's estimate. The output is a single
+integer, for use in scripts.
+
+Examples:
+  gro mail count "is:unread"
+  gro mail count "from:alice@example.com newer_than:7d"
+  gro mail count "has:attachment larger:10M"`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, err := newGmailClient(cmd.Context())
+			if err != nil {
+				return fmt.Errorf("creating Gmail client: %w", err)
+			}
+
+			n, err := client.CountMessages(cmd.Context(), args[0])
+			if err != nil {
+				return fmt.Errorf("counting messages: %w", err)
+			}
+
+			fmt.Println(n)
+			return nil
+		},
+	}
+
+	return cmd
+}
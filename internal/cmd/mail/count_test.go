@@ -0,0 +1,82 @@
+package mail
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/open-cli-collective/google-readonly/internal/testutil"
+)
+
+func TestCountCommand(t *testing.T) {
+	cmd := newCountCommand()
+
+	t.Run("has correct use", func(t *testing.T) {
+		testutil.Equal(t, cmd.Use, "count <query>")
+	})
+
+	t.Run("requires exactly one argument", func(t *testing.T) {
+		testutil.Error(t, cmd.Args(cmd, []string{}))
+		testutil.NoError(t, cmd.Args(cmd, []string{"is:unread"}))
+		testutil.Error(t, cmd.Args(cmd, []string{"is:unread", "extra"}))
+	})
+}
+
+func TestCountCommand_Success(t *testing.T) {
+	mock := &MockGmailClient{
+		CountMessagesFunc: func(_ context.Context, query string) (int64, error) {
+			testutil.Equal(t, query, "is:unread")
+			return 1234, nil
+		},
+	}
+
+	cmd := newCountCommand()
+	cmd.SetArgs([]string{"is:unread"})
+
+	withMockClient(mock, func() {
+		output := testutil.CaptureStdout(t, func() {
+			testutil.NoError(t, cmd.Execute())
+		})
+		testutil.Equal(t, output, "1234\n")
+	})
+}
+
+func TestCountCommand_Zero(t *testing.T) {
+	cmd := newCountCommand()
+	cmd.SetArgs([]string{"from:nobody@example.com"})
+
+	withMockClient(&MockGmailClient{}, func() {
+		output := testutil.CaptureStdout(t, func() {
+			testutil.NoError(t, cmd.Execute())
+		})
+		testutil.Equal(t, output, "0\n")
+	})
+}
+
+func TestCountCommand_APIError(t *testing.T) {
+	mock := &MockGmailClient{
+		CountMessagesFunc: func(_ context.Context, _ string) (int64, error) {
+			return 0, errors.New("invalid query")
+		},
+	}
+
+	cmd := newCountCommand()
+	cmd.SetArgs([]string{"is:unread"})
+
+	withMockClient(mock, func() {
+		err := cmd.Execute()
+		testutil.Error(t, err)
+		testutil.Contains(t, err.Error(), "counting messages")
+	})
+}
+
+func TestCountCommand_ClientError(t *testing.T) {
+	cmd := newCountCommand()
+	cmd.SetArgs([]string{"is:unread"})
+
+	withFailingClientFactory(func() {
+		err := cmd.Execute()
+		testutil.Error(t, err)
+		testutil.Contains(t, err.Error(), "creating Gmail client")
+	})
+}
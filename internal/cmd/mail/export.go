@@ -0,0 +1,174 @@
+package mail
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/open-cli-collective/google-readonly/internal/config"
+	"github.com/open-cli-collective/google-readonly/internal/fileutil"
+	"github.com/open-cli-collective/google-readonly/internal/log"
+)
+
+func newExportCommand() *cobra.Command {
+	var (
+		label     string
+		since     string
+		outputDir string
+	)
+
+	cmd := &cobra.Command{
+		Use:   "export",
+		Short: "Incrementally export messages as .eml files",
+		Long: `Export messages to local .eml files, picking up where the last run stopped.
+
+Each message is saved as <message-id>.eml in its original RFC 5322 form.
+After a run, the newest exported message's date is recorded per account and
+label in the config directory. The next run only exports messages newer
+than that, so the command is safe to run from cron.
+
+--since sets the starting point for the first run (no recorded state).
+It accepts a date (YYYY-MM-DD) or a relative age (e.g. 30d, 2w).
+
+Examples:
+  gro mail export --label Receipts --output ./receipts
+  gro mail export --label Archive --since 90d --output ~/mail-backup
+  gro mail export --output ./all-mail`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			var after time.Time
+			if since != "" {
+				t, err := parseSince(since, time.Now())
+				if err != nil {
+					return fmt.Errorf("invalid --since: %w", err)
+				}
+				after = t
+			}
+
+			client, err := newGmailClient(cmd.Context())
+			if err != nil {
+				return fmt.Errorf("creating Gmail client: %w", err)
+			}
+
+			ctx := cmd.Context()
+
+			profile, err := client.GetProfile(ctx)
+			if err != nil {
+				return fmt.Errorf("getting profile: %w", err)
+			}
+
+			state, err := loadExportState()
+			if err != nil {
+				return err
+			}
+			key := exportStateKey(profile.EmailAddress, label)
+			last := state[key]
+			if last > 0 {
+				after = time.UnixMilli(last)
+			}
+
+			ids, err := client.ListAllMessageIDs(ctx, buildExportQuery(label, after))
+			if err != nil {
+				return fmt.Errorf("listing messages: %w", err)
+			}
+
+			if err := os.MkdirAll(outputDir, config.OutputDirPerm); err != nil {
+				return fmt.Errorf("creating output directory: %w", err)
+			}
+			absOutputDir, err := filepath.Abs(outputDir)
+			if err != nil {
+				return fmt.Errorf("resolving output directory: %w", err)
+			}
+
+			exported := 0
+			newest := last
+			for _, id := range ids {
+				raw, err := client.GetRawMessage(ctx, id)
+				if err != nil {
+					return fmt.Errorf("getting message %s: %w", id, err)
+				}
+				// after: has one-second granularity, so the previous run's
+				// newest message can match again
+				if raw.InternalDate <= last {
+					continue
+				}
+
+				outputPath, err := fileutil.SafeJoin(absOutputDir, raw.ID+".eml")
+				if err != nil {
+					return fmt.Errorf("message %s: %w", raw.ID, err)
+				}
+				if err := os.WriteFile(outputPath, raw.Raw, config.OutputFilePerm); err != nil {
+					return fmt.Errorf("writing %s: %w", outputPath, err)
+				}
+
+				exported++
+				if raw.InternalDate > newest {
+					newest = raw.InternalDate
+				}
+			}
+
+			if exported == 0 {
+				log.Status("No new messages to export.")
+				return nil
+			}
+
+			state[key] = newest
+			if err := saveExportState(state); err != nil {
+				return err
+			}
+
+			log.Status("Exported %d message(s) to %s", exported, outputDir)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&label, "label", "l", "", "Only export messages with this label")
+	_ = cmd.RegisterFlagCompletionFunc("label", completeLabelNames)
+	cmd.Flags().StringVar(&since, "since", "", "Start of the first export: YYYY-MM-DD or an age such as 30d or 2w")
+	cmd.Flags().StringVarP(&outputDir, "output", "o", ".", "Directory to save .eml files")
+
+	return cmd
+}
+
+// buildExportQuery builds the Gmail search for messages with label received
+// after the given time. Gmail matches multi-word labels written with hyphens.
+func buildExportQuery(label string, after time.Time) string {
+	var parts []string
+	if label != "" {
+		parts = append(parts, "label:"+strings.ReplaceAll(label, " ", "-"))
+	}
+	if !after.IsZero() {
+		parts = append(parts, fmt.Sprintf("after:%d", after.Unix()))
+	}
+	return strings.Join(parts, " ")
+}
+
+// parseSince parses a YYYY-MM-DD date or a relative age in days (30d) or
+// weeks (2w) counted back from now
+func parseSince(value string, now time.Time) (time.Time, error) {
+	if t, err := time.ParseInLocation("2006-01-02", value, now.Location()); err == nil {
+		return t, nil
+	}
+
+	if len(value) < 2 {
+		return time.Time{}, fmt.Errorf("%q is not a date (YYYY-MM-DD) or age (e.g. 30d, 2w)", value)
+	}
+	n, err := strconv.Atoi(value[:len(value)-1])
+	if err != nil || n < 0 {
+		return time.Time{}, fmt.Errorf("%q is not a date (YYYY-MM-DD) or age (e.g. 30d, 2w)", value)
+	}
+
+	switch value[len(value)-1] {
+	case 'd':
+		return now.AddDate(0, 0, -n), nil
+	case 'w':
+		return now.AddDate(0, 0, -7*n), nil
+	default:
+		return time.Time{}, fmt.Errorf("%q is not a date (YYYY-MM-DD) or age (e.g. 30d, 2w)", value)
+	}
+}
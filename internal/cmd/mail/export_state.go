@@ -0,0 +1,75 @@
+package mail
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/open-cli-collective/google-readonly/internal/config"
+)
+
+// exportStateFile holds the incremental export high-water marks, in the
+// config directory
+const exportStateFile = "mail_export_state.json"
+
+// exportState maps an account/label key to the internal date (milliseconds
+// since the epoch) of the newest message exported for it
+type exportState map[string]int64
+
+// exportStateKey identifies one incremental export stream
+func exportStateKey(account, label string) string {
+	if label == "" {
+		label = "*"
+	}
+	return account + "/" + label
+}
+
+// exportStatePath returns the path of the export state file
+func exportStatePath() (string, error) {
+	dir, err := config.GetConfigDir()
+	if err != nil {
+		return "", fmt.Errorf("getting config directory: %w", err)
+	}
+	return filepath.Join(dir, exportStateFile), nil
+}
+
+// loadExportState reads the export state. A missing file is an empty state.
+func loadExportState() (exportState, error) {
+	path, err := exportStatePath()
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := os.ReadFile(path) //nolint:gosec // path is in the config dir
+	if errors.Is(err, os.ErrNotExist) {
+		return exportState{}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("reading export state: %w", err)
+	}
+
+	state := exportState{}
+	if err := json.Unmarshal(data, &state); err != nil {
+		return nil, fmt.Errorf("parsing export state %s: %w", path, err)
+	}
+	return state, nil
+}
+
+// saveExportState writes the export state
+func saveExportState(state exportState) error {
+	path, err := exportStatePath()
+	if err != nil {
+		return err
+	}
+
+	data, err := json.MarshalIndent(state, "", "  ")
+	if err != nil {
+		return fmt.Errorf("encoding export state: %w", err)
+	}
+	if err := os.WriteFile(path, data, config.TokenPerm); err != nil {
+		return fmt.Errorf("writing export state: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,222 @@
+package mail
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	gmailapi "github.com/open-cli-collective/google-readonly/internal/gmail"
+	"github.com/open-cli-collective/google-readonly/internal/testutil"
+)
+
+func TestExportCommand(t *testing.T) {
+	cmd := newExportCommand()
+
+	t.Run("has correct use", func(t *testing.T) {
+		testutil.Equal(t, cmd.Use, "export")
+	})
+
+	t.Run("has label flag", func(t *testing.T) {
+		flag := cmd.Flags().Lookup("label")
+		testutil.NotNil(t, flag)
+		testutil.Equal(t, flag.Shorthand, "l")
+	})
+
+	t.Run("has since flag", func(t *testing.T) {
+		testutil.NotNil(t, cmd.Flags().Lookup("since"))
+	})
+
+	t.Run("has output flag", func(t *testing.T) {
+		flag := cmd.Flags().Lookup("output")
+		testutil.NotNil(t, flag)
+		testutil.Equal(t, flag.DefValue, ".")
+	})
+}
+
+func TestBuildExportQuery(t *testing.T) {
+	t.Run("label only", func(t *testing.T) {
+		testutil.Equal(t, buildExportQuery("Receipts", time.Time{}), "label:Receipts")
+	})
+
+	t.Run("multi-word label and after", func(t *testing.T) {
+		after := time.Unix(1700000000, 0)
+		testutil.Equal(t, buildExportQuery("Tax Docs", after), "label:Tax-Docs after:1700000000")
+	})
+
+	t.Run("no filters", func(t *testing.T) {
+		testutil.Equal(t, buildExportQuery("", time.Time{}), "")
+	})
+}
+
+func TestParseSince(t *testing.T) {
+	now := time.Date(2024, 3, 31, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		value string
+		want  time.Time
+	}{
+		{"30d", time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)},
+		{"2w", time.Date(2024, 3, 17, 12, 0, 0, 0, time.UTC)},
+		{"2024-01-15", time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			got, err := parseSince(tt.value, now)
+			testutil.NoError(t, err)
+			testutil.True(t, got.Equal(tt.want))
+		})
+	}
+
+	for _, bad := range []string{"", "d", "soon", "10y", "-3d"} {
+		t.Run("rejects "+bad, func(t *testing.T) {
+			_, err := parseSince(bad, now)
+			testutil.Error(t, err)
+		})
+	}
+}
+
+// exportMock serves a mailbox of raw messages keyed by ID. Only messages
+// newer than the query's after: bound are returned, like Gmail would.
+func exportMock(t *testing.T, mailbox map[string]int64, queries *[]string) *MockGmailClient {
+	return &MockGmailClient{
+		GetProfileFunc: func(_ context.Context) (*gmailapi.Profile, error) {
+			return &gmailapi.Profile{EmailAddress: "me@example.com"}, nil
+		},
+		ListAllMessageIDsFunc: func(_ context.Context, query string) ([]string, error) {
+			*queries = append(*queries, query)
+			var after int64
+			for _, part := range strings.Fields(query) {
+				if v, ok := strings.CutPrefix(part, "after:"); ok {
+					after, _ = strconv.ParseInt(v, 10, 64)
+				}
+			}
+			var ids []string
+			for id, date := range mailbox {
+				if date/1000 >= after {
+					ids = append(ids, id)
+				}
+			}
+			return ids, nil
+		},
+		GetRawMessageFunc: func(_ context.Context, id string) (*gmailapi.RawMessage, error) {
+			date, ok := mailbox[id]
+			if !ok {
+				t.Errorf("unexpected message %s", id)
+				return nil, errors.New("not found")
+			}
+			return &gmailapi.RawMessage{ID: id, InternalDate: date, Raw: []byte("Subject: " + id + "\r\n\r\nbody\r\n")}, nil
+		},
+	}
+}
+
+func TestExportCommand_Incremental(t *testing.T) {
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+	outDir := t.TempDir()
+
+	mailbox := map[string]int64{
+		"msg1": 1700000000000,
+		"msg2": 1700000100000,
+	}
+	var queries []string
+	mock := exportMock(t, mailbox, &queries)
+
+	run := func() string {
+		cmd := newExportCommand()
+		cmd.SetArgs([]string{"--label", "Receipts", "--output", outDir})
+		var output string
+		withMockClient(mock, func() {
+			output = testutil.CaptureStdout(t, func() {
+				err := cmd.Execute()
+				testutil.NoError(t, err)
+			})
+		})
+		return output
+	}
+
+	// First run exports everything with the label
+	output := run()
+	testutil.Contains(t, output, "Exported 2 message(s)")
+	testutil.Equal(t, queries[0], "label:Receipts")
+
+	data, err := os.ReadFile(filepath.Join(outDir, "msg1.eml"))
+	testutil.NoError(t, err)
+	testutil.Contains(t, string(data), "Subject: msg1")
+
+	// A new message arrives; the second run only exports it
+	mailbox["msg3"] = 1700000200000
+	testutil.NoError(t, os.Remove(filepath.Join(outDir, "msg1.eml")))
+
+	output = run()
+	testutil.Contains(t, output, "Exported 1 message(s)")
+	testutil.Equal(t, queries[1], "label:Receipts after:1700000100")
+
+	_, err = os.Stat(filepath.Join(outDir, "msg3.eml"))
+	testutil.NoError(t, err)
+	_, err = os.Stat(filepath.Join(outDir, "msg1.eml"))
+	testutil.True(t, os.IsNotExist(err))
+
+	// Nothing new on a third run
+	output = run()
+	testutil.Contains(t, output, "No new messages")
+}
+
+func TestExportCommand_StateKeyedByLabel(t *testing.T) {
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+
+	mailbox := map[string]int64{"msg1": 1700000000000}
+	var queries []string
+	mock := exportMock(t, mailbox, &queries)
+
+	for _, label := range []string{"Receipts", "Travel"} {
+		cmd := newExportCommand()
+		cmd.SetArgs([]string{"--label", label, "--output", t.TempDir()})
+		withMockClient(mock, func() {
+			output := testutil.CaptureStdout(t, func() {
+				err := cmd.Execute()
+				testutil.NoError(t, err)
+			})
+			testutil.Contains(t, output, "Exported 1 message(s)")
+		})
+	}
+
+	state, err := loadExportState()
+	testutil.NoError(t, err)
+	testutil.Equal(t, state["me@example.com/Receipts"], int64(1700000000000))
+	testutil.Equal(t, state["me@example.com/Travel"], int64(1700000000000))
+}
+
+func TestExportCommand_Since(t *testing.T) {
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+
+	var queries []string
+	mock := exportMock(t, map[string]int64{}, &queries)
+
+	cmd := newExportCommand()
+	cmd.SetArgs([]string{"--since", "2024-01-15", "--output", t.TempDir()})
+	withMockClient(mock, func() {
+		output := testutil.CaptureStdout(t, func() {
+			err := cmd.Execute()
+			testutil.NoError(t, err)
+		})
+		testutil.Contains(t, output, "No new messages")
+	})
+
+	testutil.Len(t, queries, 1)
+	testutil.Contains(t, queries[0], "after:")
+}
+
+func TestExportCommand_InvalidSince(t *testing.T) {
+	cmd := newExportCommand()
+	cmd.SetArgs([]string{"--since", "soon"})
+
+	withMockClient(&MockGmailClient{}, func() {
+		err := cmd.Execute()
+		testutil.Error(t, err)
+		testutil.Contains(t, err.Error(), "invalid --since")
+	})
+}
@@ -3,6 +3,7 @@ package mail
 import (
 	"context"
 	"errors"
+	"strings"
 	"testing"
 
 	"google.golang.org/api/gmail/v1"
@@ -121,6 +122,39 @@ func TestSearchCommand_IDsOutput(t *testing.T) {
 	})
 }
 
+func TestSearchCommand_ThreadIDsOutput(t *testing.T) {
+	mock := &MockGmailClient{
+		SearchThreadIDsFunc: func(_ context.Context, query string, maxResults int64) ([]string, error) {
+			testutil.Equal(t, query, "from:alice@example.com")
+			testutil.Equal(t, maxResults, int64(5))
+			return []string{"thread1", "thread2"}, nil
+		},
+	}
+
+	cmd := newSearchCommand()
+	cmd.SetArgs([]string{"from:alice@example.com", "--thread-ids", "--max", "5"})
+
+	withMockClient(mock, func() {
+		output := testutil.CaptureStdout(t, func() {
+			err := cmd.Execute()
+			testutil.NoError(t, err)
+		})
+
+		testutil.Equal(t, output, "thread1\nthread2\n")
+	})
+}
+
+func TestSearchCommand_IDsAndThreadIDsExclusive(t *testing.T) {
+	cmd := newSearchCommand()
+	cmd.SetArgs([]string{"is:inbox", "--ids", "--thread-ids"})
+
+	withMockClient(&MockGmailClient{}, func() {
+		err := cmd.Execute()
+		testutil.Error(t, err)
+		testutil.Contains(t, err.Error(), "mutually exclusive")
+	})
+}
+
 func TestSearchCommand_SkippedMessages(t *testing.T) {
 	mock := &MockGmailClient{
 		SearchMessagesFunc: func(_ context.Context, _ string, _ int64) ([]*gmailapi.Message, int, error) {
@@ -182,6 +216,133 @@ func TestReadCommand_NotFound(t *testing.T) {
 	})
 }
 
+func TestReadCommand_AttachmentsSummaryOnly(t *testing.T) {
+	mock := &MockGmailClient{
+		GetMessageFunc: func(_ context.Context, messageID string, _ bool) (*gmailapi.Message, error) {
+			msg := testutil.SampleMessage(messageID)
+			msg.Attachments = []*gmailapi.Attachment{
+				testutil.SampleAttachment("invoice.pdf"),
+				testutil.SampleAttachment("photo.png"),
+				testutil.SampleAttachment("notes.txt"),
+			}
+			msg.Attachments[1].Size = 2048
+			return msg, nil
+		},
+	}
+
+	cmd := newReadCommand()
+	cmd.SetArgs([]string{"msg123", "--attachments-summary-only"})
+
+	withMockClient(mock, func() {
+		output := testutil.CaptureStdout(t, func() {
+			err := cmd.Execute()
+			testutil.NoError(t, err)
+		})
+
+		testutil.Contains(t, output, "Attachments: 3 (4.0 KB): invoice.pdf, photo.png, notes.txt\n")
+		testutil.Contains(t, output, "--- Body ---")
+		// No per-attachment detail block
+		testutil.NotContains(t, output, "Type: application/pdf")
+		testutil.NotContains(t, output, "Size: 1.0 KB")
+		testutil.NotContains(t, output, "1. invoice.pdf")
+	})
+}
+
+func TestReadCommand_OutputEML(t *testing.T) {
+	raw := "From: alice@example.com\r\nSubject: Hi\r\n\r\nBody line\r\n"
+	mock := &MockGmailClient{
+		GetRawMessageFunc: func(_ context.Context, messageID string) (*gmailapi.RawMessage, error) {
+			testutil.Equal(t, messageID, "msg123")
+			return &gmailapi.RawMessage{ID: messageID, Raw: []byte(raw)}, nil
+		},
+		GetMessageFunc: func(_ context.Context, _ string, _ bool) (*gmailapi.Message, error) {
+			t.Fatal("--output eml must not fetch the parsed message")
+			return nil, nil
+		},
+	}
+
+	cmd := newReadCommand()
+	cmd.SetArgs([]string{"msg123", "--output", "eml"})
+
+	withMockClient(mock, func() {
+		output := testutil.CaptureStdout(t, func() {
+			err := cmd.Execute()
+			testutil.NoError(t, err)
+		})
+
+		testutil.Equal(t, output, raw)
+	})
+}
+
+func TestReadCommand_Raw(t *testing.T) {
+	raw := "From: alice@example.com\r\nSubject: Hi\r\n\r\nBody line\r\n"
+	mock := &MockGmailClient{
+		GetRawMessageFunc: func(_ context.Context, _ string) (*gmailapi.RawMessage, error) {
+			return &gmailapi.RawMessage{ID: "msg123", Raw: []byte(raw)}, nil
+		},
+	}
+
+	t.Run("writes the source", func(t *testing.T) {
+		cmd := newReadCommand()
+		cmd.SetArgs([]string{"msg123", "--raw"})
+
+		withMockClient(mock, func() {
+			output := testutil.CaptureStdout(t, func() {
+				testutil.NoError(t, cmd.Execute())
+			})
+			testutil.Equal(t, output, raw)
+		})
+	})
+
+	for _, args := range [][]string{
+		{"--raw", "--output", "text"},
+		{"--raw", "--attachments-summary-only"},
+	} {
+		t.Run(strings.Join(args, " "), func(t *testing.T) {
+			cmd := newReadCommand()
+			cmd.SetArgs(append([]string{"msg123"}, args...))
+			cmd.SilenceUsage = true
+			cmd.SilenceErrors = true
+
+			withMockClient(mock, func() {
+				err := cmd.Execute()
+				testutil.Error(t, err)
+				testutil.Contains(t, err.Error(), "cannot be combined")
+			})
+		})
+	}
+}
+
+func TestReadCommand_OutputEMLError(t *testing.T) {
+	mock := &MockGmailClient{
+		GetRawMessageFunc: func(_ context.Context, _ string) (*gmailapi.RawMessage, error) {
+			return nil, errors.New("message not found")
+		},
+	}
+
+	cmd := newReadCommand()
+	cmd.SetArgs([]string{"nonexistent", "-o", "eml"})
+
+	withMockClient(mock, func() {
+		err := cmd.Execute()
+		testutil.Error(t, err)
+		testutil.Contains(t, err.Error(), "reading message")
+	})
+}
+
+func TestReadCommand_InvalidOutput(t *testing.T) {
+	cmd := newReadCommand()
+	cmd.SetArgs([]string{"msg123", "--output", "json"})
+	cmd.SilenceUsage = true
+	cmd.SilenceErrors = true
+
+	withMockClient(&MockGmailClient{}, func() {
+		err := cmd.Execute()
+		testutil.Error(t, err)
+		testutil.Contains(t, err.Error(), `invalid --output "json"`)
+	})
+}
+
 func TestThreadCommand_Success(t *testing.T) {
 	mock := &MockGmailClient{
 		GetThreadFunc: func(_ context.Context, id string) ([]*gmailapi.Message, error) {
@@ -231,6 +392,131 @@ func TestLabelsCommand_Success(t *testing.T) {
 	})
 }
 
+func TestLabelsCommand_Filters(t *testing.T) {
+	labelsWithEmpty := func() []*gmail.Label {
+		return append(testutil.SampleLabels(),
+			&gmail.Label{Id: "Label_3", Name: "Stale", Type: "user", MessagesTotal: 0, MessagesUnread: 0})
+	}
+
+	tests := []struct {
+		name    string
+		args    []string
+		want    []string
+		notWant []string
+	}{
+		{
+			name: "no filters shows empty labels",
+			args: nil,
+			want: []string{"Stale", "SENT", "Work"},
+		},
+		{
+			name:    "--non-empty hides empty labels",
+			args:    []string{"--non-empty"},
+			want:    []string{"SENT", "Work", "Personal"},
+			notWant: []string{"Stale"},
+		},
+		{
+			name:    "--min-unread keeps labels at or above the threshold",
+			args:    []string{"--min-unread", "2"},
+			want:    []string{"INBOX", "Work", "Social"},
+			notWant: []string{"Personal", "SENT", "Stale"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock := &MockGmailClient{
+				FetchLabelsFunc: func(_ context.Context) error { return nil },
+				GetLabelsFunc:   labelsWithEmpty,
+			}
+
+			cmd := newLabelsCommand()
+			cmd.SetArgs(tt.args)
+
+			withMockClient(mock, func() {
+				output := testutil.CaptureStdout(t, func() {
+					err := cmd.Execute()
+					testutil.NoError(t, err)
+				})
+
+				for _, w := range tt.want {
+					testutil.Contains(t, output, w)
+				}
+				for _, nw := range tt.notWant {
+					testutil.NotContains(t, output, nw)
+				}
+			})
+		})
+	}
+
+	t.Run("nothing left after filtering", func(t *testing.T) {
+		mock := &MockGmailClient{
+			FetchLabelsFunc: func(_ context.Context) error { return nil },
+			GetLabelsFunc:   labelsWithEmpty,
+		}
+
+		cmd := newLabelsCommand()
+		cmd.SetArgs([]string{"--min-unread", "100"})
+
+		withMockClient(mock, func() {
+			output := testutil.CaptureStdout(t, func() {
+				err := cmd.Execute()
+				testutil.NoError(t, err)
+			})
+
+			testutil.Contains(t, output, "No labels match the filters.")
+		})
+	})
+
+	t.Run("rejects negative --min-unread", func(t *testing.T) {
+		cmd := newLabelsCommand()
+		cmd.SetArgs([]string{"--min-unread", "-1"})
+
+		withMockClient(&MockGmailClient{}, func() {
+			err := cmd.Execute()
+			testutil.Error(t, err)
+			testutil.Contains(t, err.Error(), "--min-unread")
+		})
+	})
+
+	t.Run("rejects unknown --sort", func(t *testing.T) {
+		cmd := newLabelsCommand()
+		cmd.SetArgs([]string{"--sort", "size"})
+
+		withMockClient(&MockGmailClient{}, func() {
+			err := cmd.Execute()
+			testutil.Error(t, err)
+			testutil.Contains(t, err.Error(), `invalid --sort "size"`)
+		})
+	})
+}
+
+func TestLabelsCommand_Refresh(t *testing.T) {
+	for _, refresh := range []bool{false, true} {
+		var fetched, refreshed bool
+		mock := &MockGmailClient{
+			FetchLabelsFunc:   func(_ context.Context) error { fetched = true; return nil },
+			RefreshLabelsFunc: func(_ context.Context) error { refreshed = true; return nil },
+			GetLabelsFunc:     testutil.SampleLabels,
+		}
+
+		cmd := newLabelsCommand()
+		if refresh {
+			cmd.SetArgs([]string{"--refresh"})
+		}
+
+		withMockClient(mock, func() {
+			_ = testutil.CaptureStdout(t, func() {
+				testutil.NoError(t, cmd.Execute())
+			})
+		})
+
+		// --refresh bypasses the cache; without it the cache is consulted
+		testutil.Equal(t, refreshed, refresh)
+		testutil.Equal(t, fetched, !refresh)
+	}
+}
+
 func TestLabelsCommand_Empty(t *testing.T) {
 	mock := &MockGmailClient{
 		FetchLabelsFunc: func(_ context.Context) error {
@@ -0,0 +1,42 @@
+package mail
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+)
+
+func newHeadersCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "headers <message-id>",
+		Short: "Show every header of a message",
+		Long: `Show every header of a message, in the order it appears in the source.
+
+Unlike 'gro mail read', which shows a parsed subset, this prints each
+header as "Name: value", repeated headers such as Received included, for
+debugging delivery and authentication (SPF, DKIM, DMARC) results.
+
+Examples:
+  gro mail headers 18abc123def456
+  gro mail headers 18abc123def456 | grep -i '^authentication-results'`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, err := newGmailClient(cmd.Context())
+			if err != nil {
+				return fmt.Errorf("creating Gmail client: %w", err)
+			}
+
+			headers, err := client.GetMessageHeaders(cmd.Context(), args[0])
+			if err != nil {
+				return fmt.Errorf("getting message headers: %w", err)
+			}
+
+			for _, h := range headers {
+				fmt.Printf("%s: %s\n", SanitizeOutput(h.Name), SanitizeOutput(h.Value))
+			}
+			return nil
+		},
+	}
+
+	return cmd
+}
@@ -0,0 +1,67 @@
+package mail
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	gmailapi "github.com/open-cli-collective/google-readonly/internal/gmail"
+	"github.com/open-cli-collective/google-readonly/internal/testutil"
+)
+
+func TestHeadersCommand(t *testing.T) {
+	cmd := newHeadersCommand()
+
+	t.Run("has correct use", func(t *testing.T) {
+		testutil.Equal(t, cmd.Use, "headers <message-id>")
+	})
+
+	t.Run("requires exactly one argument", func(t *testing.T) {
+		testutil.Error(t, cmd.Args(cmd, []string{}))
+		testutil.NoError(t, cmd.Args(cmd, []string{"msg123"}))
+	})
+}
+
+func TestHeadersCommand_PrintsInOrder(t *testing.T) {
+	mock := &MockGmailClient{
+		GetMessageHeadersFunc: func(_ context.Context, messageID string) ([]gmailapi.Header, error) {
+			testutil.Equal(t, messageID, "msg123")
+			return []gmailapi.Header{
+				{Name: "Received", Value: "from mx2.example.com"},
+				{Name: "Received", Value: "from mx1.example.com"},
+				{Name: "Subject", Value: "Hi\x1b[31m there"},
+			}, nil
+		},
+	}
+
+	cmd := newHeadersCommand()
+	cmd.SetArgs([]string{"msg123"})
+
+	withMockClient(mock, func() {
+		output := testutil.CaptureStdout(t, func() {
+			testutil.NoError(t, cmd.Execute())
+		})
+
+		testutil.Equal(t, output, `Received: from mx2.example.com
+Received: from mx1.example.com
+Subject: Hi there
+`)
+	})
+}
+
+func TestHeadersCommand_APIError(t *testing.T) {
+	mock := &MockGmailClient{
+		GetMessageHeadersFunc: func(_ context.Context, _ string) ([]gmailapi.Header, error) {
+			return nil, errors.New("not found")
+		},
+	}
+
+	cmd := newHeadersCommand()
+	cmd.SetArgs([]string{"msg123"})
+
+	withMockClient(mock, func() {
+		err := cmd.Execute()
+		testutil.Error(t, err)
+		testutil.Contains(t, err.Error(), "getting message headers")
+	})
+}
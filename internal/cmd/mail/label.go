@@ -24,7 +24,8 @@ Examples:
   gro mail label "Work" msg123 msg456
   gro mail search "from:boss" --ids | gro mail label "Important" --stdin
   gro mail label "Projects" --query "subject:sprint"`,
-		Args: cobra.MinimumNArgs(1),
+		Args:              cobra.MinimumNArgs(1),
+		ValidArgsFunction: completeLabelArg,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			labelName := args[0]
 			messageArgs := args[1:]
@@ -35,7 +36,7 @@ Examples:
 			}
 
 			ctx := cmd.Context()
-			labelID, err := client.GetLabelID(ctx, labelName)
+			labelID, err := resolveLabelName(ctx, client, labelName)
 			if err != nil {
 				return fmt.Errorf("resolving label: %w", err)
 			}
@@ -94,7 +95,8 @@ func newUnlabelCommand() *cobra.Command {
 Examples:
   gro mail unlabel "Work" msg123
   gro mail unlabel "Old" --query "label:Old older_than:90d"`,
-		Args: cobra.MinimumNArgs(1),
+		Args:              cobra.MinimumNArgs(1),
+		ValidArgsFunction: completeLabelArg,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			labelName := args[0]
 			messageArgs := args[1:]
@@ -105,7 +107,7 @@ Examples:
 			}
 
 			ctx := cmd.Context()
-			labelID, err := client.GetLabelID(ctx, labelName)
+			labelID, err := resolveLabelName(ctx, client, labelName)
 			if err != nil {
 				return fmt.Errorf("resolving label: %w", err)
 			}
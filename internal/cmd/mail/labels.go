@@ -1,14 +1,19 @@
 package mail
 
 import (
+	"cmp"
+	"context"
 	"fmt"
+	"slices"
 	"sort"
+	"strconv"
 	"strings"
 
 	"github.com/spf13/cobra"
 	gmailapi "google.golang.org/api/gmail/v1"
 
-	"github.com/open-cli-collective/google-readonly/internal/format"
+	"github.com/open-cli-collective/google-readonly/internal/suggest"
+	"github.com/open-cli-collective/google-readonly/internal/table"
 )
 
 // Label represents a Gmail label for output
@@ -21,6 +26,13 @@ type Label struct {
 }
 
 func newLabelsCommand() *cobra.Command {
+	var (
+		nonEmpty  bool
+		minUnread int64
+		refresh   bool
+		sortBy    string
+	)
+
 	cmd := &cobra.Command{
 		Use:   "labels",
 		Short: "List all labels",
@@ -28,16 +40,44 @@ func newLabelsCommand() *cobra.Command {
 
 Shows label name, type (system/user/category), and message counts.
 
+--non-empty hides labels with no messages and --min-unread N shows only
+labels with at least N unread messages. Both use the counts Gmail already
+returns with the label list.
+
+Labels are grouped as user, category, then system, and sorted by name within
+each group. --sort unread puts the labels with the most unread messages
+first, and --sort total those with the most messages, which makes the list a
+quick overview of where mail is piling up.
+
+Labels are cached on disk for an hour per profile, which also speeds up
+label name resolution in other mail commands. The counts are as of that
+fetch; --refresh fetches the labels again and updates the cache.
+
 Examples:
-  gro mail labels`,
+  gro mail labels
+  gro mail labels --non-empty
+  gro mail labels --min-unread 1
+  gro mail labels --sort unread --min-unread 1
+  gro mail labels --refresh`,
 		Args: cobra.NoArgs,
 		RunE: func(cmd *cobra.Command, _ []string) error {
+			if minUnread < 0 {
+				return fmt.Errorf("--min-unread must be 0 or greater, got %d", minUnread)
+			}
+			if !slices.Contains(labelSortKeys, sortBy) {
+				return fmt.Errorf("invalid --sort %q (valid: %s)", sortBy, strings.Join(labelSortKeys, ", "))
+			}
+
 			client, err := newGmailClient(cmd.Context())
 			if err != nil {
 				return fmt.Errorf("creating Gmail client: %w", err)
 			}
 
-			if err := client.FetchLabels(cmd.Context()); err != nil {
+			fetch := client.FetchLabels
+			if refresh {
+				fetch = client.RefreshLabels
+			}
+			if err := fetch(cmd.Context()); err != nil {
 				return fmt.Errorf("fetching labels: %w", err)
 			}
 
@@ -59,30 +99,79 @@ Examples:
 				labels = append(labels, label)
 			}
 
-			sort.Slice(labels, func(i, j int) bool {
-				if labels[i].Type != labels[j].Type {
-					return labelTypePriority(labels[i].Type) < labelTypePriority(labels[j].Type)
-				}
-				return strings.ToLower(labels[i].Name) < strings.ToLower(labels[j].Name)
-			})
+			labels = filterLabels(labels, nonEmpty, minUnread)
+			if len(labels) == 0 {
+				fmt.Println("No labels match the filters.")
+				return nil
+			}
+
+			sortLabels(labels, sortBy)
 
-			fmt.Printf("%-30s %-10s %8s %8s\n", "NAME", "TYPE", "TOTAL", "UNREAD")
-			fmt.Println(strings.Repeat("-", 60))
+			tbl := table.New(
+				table.Column{Header: "NAME", MaxWidth: 40, Shrink: true},
+				table.Column{Header: "TYPE"},
+				table.Column{Header: "TOTAL", Align: table.Right},
+				table.Column{Header: "UNREAD", Align: table.Right},
+			)
 			for _, label := range labels {
-				fmt.Printf("%-30s %-10s %8d %8d\n",
-					format.Truncate(label.Name, 30),
-					label.Type,
-					label.MessagesTotal,
-					label.MessagesUnread)
+				tbl.AddRow(label.Name, label.Type,
+					strconv.FormatInt(label.MessagesTotal, 10),
+					strconv.FormatInt(label.MessagesUnread, 10))
 			}
+			tbl.Print()
 
 			return nil
 		},
 	}
 
+	cmd.Flags().BoolVar(&nonEmpty, "non-empty", false, "Hide labels with no messages")
+	cmd.Flags().Int64Var(&minUnread, "min-unread", 0, "Show only labels with at least N unread messages")
+	cmd.Flags().BoolVar(&refresh, "refresh", false, "Fetch labels from the API instead of the cache")
+	cmd.Flags().StringVar(&sortBy, "sort", "name", "Sort by name, unread or total")
+	_ = cmd.RegisterFlagCompletionFunc("sort", cobra.FixedCompletions(labelSortKeys, cobra.ShellCompDirectiveNoFileComp))
+
 	return cmd
 }
 
+// filterLabels drops labels with no messages when nonEmpty is set and labels
+// with fewer than minUnread unread messages. A zero minUnread keeps them all.
+func filterLabels(labels []Label, nonEmpty bool, minUnread int64) []Label {
+	if !nonEmpty && minUnread == 0 {
+		return labels
+	}
+	kept := labels[:0]
+	for _, l := range labels {
+		if nonEmpty && l.MessagesTotal == 0 {
+			continue
+		}
+		if l.MessagesUnread < minUnread {
+			continue
+		}
+		kept = append(kept, l)
+	}
+	return kept
+}
+
+// labelSortKeys are the --sort values
+var labelSortKeys = []string{"name", "unread", "total"}
+
+// sortLabels orders labels in place by key (one of labelSortKeys). The counts
+// sort largest first; labels with equal counts keep the name order.
+func sortLabels(labels []Label, key string) {
+	slices.SortFunc(labels, func(a, b Label) int {
+		if a.Type != b.Type {
+			return cmp.Compare(labelTypePriority(a.Type), labelTypePriority(b.Type))
+		}
+		return strings.Compare(strings.ToLower(a.Name), strings.ToLower(b.Name))
+	})
+	switch key {
+	case "unread":
+		slices.SortStableFunc(labels, func(a, b Label) int { return cmp.Compare(b.MessagesUnread, a.MessagesUnread) })
+	case "total":
+		slices.SortStableFunc(labels, func(a, b Label) int { return cmp.Compare(b.MessagesTotal, a.MessagesTotal) })
+	}
+}
+
 func getLabelType(gl *gmailapi.Label) string {
 	// Check for categories
 	if strings.HasPrefix(gl.Id, "CATEGORY_") {
@@ -109,3 +198,45 @@ func labelTypePriority(t string) int {
 		return 3
 	}
 }
+
+// completeLabelNames completes a label name from the account's Gmail labels.
+// Completion must never break the shell, so any client or API error simply
+// yields no suggestions.
+func completeLabelNames(cmd *cobra.Command, _ []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	ctx := cmd.Context()
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	client, err := newGmailClient(ctx)
+	if err != nil {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+	if err := client.FetchLabels(ctx); err != nil {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+
+	var names, all []string
+	prefix := strings.ToLower(toComplete)
+	for _, l := range client.GetLabels() {
+		if strings.HasPrefix(strings.ToLower(l.Name), prefix) {
+			names = append(names, l.Name)
+		}
+		all = append(all, l.Name)
+	}
+	if len(names) == 0 {
+		// A typo matches no prefix; offer the closest names instead, in
+		// closeness order, for shells that show non-prefix matches
+		return suggest.Closest(toComplete, all), cobra.ShellCompDirectiveNoFileComp | cobra.ShellCompDirectiveKeepOrder
+	}
+	sort.Strings(names)
+	return names, cobra.ShellCompDirectiveNoFileComp
+}
+
+// completeLabelArg completes the leading <label-name> argument of label and
+// unlabel; the message IDs after it get no suggestions.
+func completeLabelArg(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	if len(args) > 0 {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+	return completeLabelNames(cmd, args, toComplete)
+}
@@ -1,8 +1,10 @@
 package mail
 
 import (
+	"strings"
 	"testing"
 
+	"github.com/spf13/cobra"
 	gmailapi "google.golang.org/api/gmail/v1"
 
 	"github.com/open-cli-collective/google-readonly/internal/testutil"
@@ -27,6 +29,57 @@ func TestLabelsCommand(t *testing.T) {
 		testutil.NotEmpty(t, cmd.Short)
 		testutil.Contains(t, cmd.Short, "label")
 	})
+
+	t.Run("has filter flags", func(t *testing.T) {
+		testutil.NotNil(t, cmd.Flags().Lookup("non-empty"))
+		flag := cmd.Flags().Lookup("min-unread")
+		testutil.NotNil(t, flag)
+		testutil.Equal(t, flag.DefValue, "0")
+	})
+
+	t.Run("has refresh flag", func(t *testing.T) {
+		flag := cmd.Flags().Lookup("refresh")
+		testutil.NotNil(t, flag)
+		testutil.Equal(t, flag.DefValue, "false")
+	})
+
+	t.Run("has sort flag", func(t *testing.T) {
+		flag := cmd.Flags().Lookup("sort")
+		testutil.NotNil(t, flag)
+		testutil.Equal(t, flag.DefValue, "name")
+	})
+}
+
+func TestSortLabels(t *testing.T) {
+	names := func(labels []Label) string {
+		out := make([]string, len(labels))
+		for i, l := range labels {
+			out[i] = l.Name
+		}
+		return strings.Join(out, " ")
+	}
+
+	tests := []struct {
+		key  string
+		want string
+	}{
+		{key: "name", want: "alpha Beta Social INBOX"},
+		{key: "unread", want: "Social Beta INBOX alpha"},
+		{key: "total", want: "INBOX alpha Beta Social"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.key, func(t *testing.T) {
+			labels := []Label{
+				{Name: "INBOX", Type: "system", MessagesTotal: 100, MessagesUnread: 3},
+				{Name: "Social", Type: "category", MessagesTotal: 30, MessagesUnread: 10},
+				{Name: "Beta", Type: "user", MessagesTotal: 40, MessagesUnread: 3},
+				{Name: "alpha", Type: "user", MessagesTotal: 40, MessagesUnread: 0},
+			}
+			sortLabels(labels, tt.key)
+			testutil.Equal(t, names(labels), tt.want)
+		})
+	}
 }
 
 func TestGetLabelType(t *testing.T) {
@@ -85,3 +138,38 @@ func TestLabelTypePriority(t *testing.T) {
 }
 
 // Tests for truncate moved to internal/format/format_test.go
+
+func TestCompleteLabelNames(t *testing.T) {
+	mock := &MockGmailClient{
+		GetLabelsFunc: func() []*gmailapi.Label {
+			return []*gmailapi.Label{
+				{Id: "Label_2", Name: "Work/Projects"},
+				{Id: "INBOX", Name: "INBOX"},
+				{Id: "Label_1", Name: "Work"},
+			}
+		},
+	}
+
+	withMockClient(mock, func() {
+		got, directive := completeLabelNames(newExportCommand(), nil, "wo")
+		testutil.Equal(t, strings.Join(got, ","), "Work,Work/Projects")
+		testutil.Equal(t, directive, cobra.ShellCompDirectiveNoFileComp)
+
+		// a typo matching no prefix falls back to the closest names
+		got, directive = completeLabelNames(newExportCommand(), nil, "Wrok")
+		testutil.Equal(t, strings.Join(got, ","), "Work")
+		testutil.Equal(t, directive, cobra.ShellCompDirectiveNoFileComp|cobra.ShellCompDirectiveKeepOrder)
+
+		// only the leading argument of label is a label name
+		got, _ = completeLabelArg(newLabelCommand(), []string{"Work"}, "")
+		testutil.Len(t, got, 0)
+	})
+
+	t.Run("client errors give no suggestions", func(t *testing.T) {
+		withFailingClientFactory(func() {
+			got, directive := completeLabelNames(newExportCommand(), nil, "")
+			testutil.Len(t, got, 0)
+			testutil.Equal(t, directive, cobra.ShellCompDirectiveNoFileComp)
+		})
+	})
+}
@@ -0,0 +1,117 @@
+package mail
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/open-cli-collective/google-readonly/internal/suggest"
+)
+
+func newListCommand() *cobra.Command {
+	var (
+		labelName  string
+		maxResults int64
+		idsOnly    bool
+	)
+
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List the messages with a label",
+		Long: `List the messages carrying a label, newest first, without writing a
+search query. The label is given by the name 'gro mail labels' shows, in any
+letter case, and must exist: a name that matches no label is an error rather
+than an empty result. System labels such as INBOX, STARRED or SENT work too.
+
+Examples:
+  gro mail list --label Work
+  gro mail list --label "Receipts/2024" --max 50
+  gro mail list --label inbox --ids | gro mail archive --stdin`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			client, err := newGmailClient(cmd.Context())
+			if err != nil {
+				return fmt.Errorf("creating Gmail client: %w", err)
+			}
+
+			labelID, err := resolveLabelName(cmd.Context(), client, labelName)
+			if err != nil {
+				return err
+			}
+
+			if idsOnly {
+				ids, err := client.ListLabelMessageIDs(cmd.Context(), labelID, maxResults)
+				if err != nil {
+					return fmt.Errorf("listing messages: %w", err)
+				}
+				for _, id := range ids {
+					fmt.Println(id)
+				}
+				return nil
+			}
+
+			messages, skipped, err := client.ListLabelMessages(cmd.Context(), labelID, maxResults)
+			if err != nil {
+				return fmt.Errorf("listing messages: %w", err)
+			}
+
+			if len(messages) == 0 {
+				fmt.Printf("No messages labeled %s.\n", client.GetLabelName(labelID))
+				return nil
+			}
+
+			for _, msg := range messages {
+				printMessageHeader(msg, MessagePrintOptions{
+					IncludeThreadID: true,
+					IncludeSnippet:  true,
+				})
+				fmt.Println("---")
+			}
+
+			if skipped > 0 {
+				fmt.Printf("Note: %d message(s) could not be retrieved.\n", skipped)
+			}
+
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&labelName, "label", "l", "", "Label name to list (required)")
+	cmd.Flags().Int64VarP(&maxResults, "max", "m", 10, "Maximum number of results to return")
+	cmd.Flags().BoolVar(&idsOnly, "ids", false, "Output only message IDs (one per line, for piping)")
+	_ = cmd.MarkFlagRequired("label")
+	_ = cmd.RegisterFlagCompletionFunc("label", completeLabelNames)
+
+	return cmd
+}
+
+// resolveLabelName returns the ID of the label called name. An exact match
+// wins; otherwise a case-insensitive one is accepted, so "inbox" finds the
+// INBOX system label. When nothing matches, the error suggests the closest
+// label names.
+func resolveLabelName(ctx context.Context, client MailClient, name string) (string, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return "", fmt.Errorf("--label must not be empty")
+	}
+	if err := client.FetchLabels(ctx); err != nil {
+		return "", fmt.Errorf("fetching labels: %w", err)
+	}
+	if id, err := client.GetLabelID(ctx, name); err == nil {
+		return id, nil
+	}
+	labels := client.GetLabels()
+	names := make([]string, 0, len(labels))
+	for _, l := range labels {
+		if strings.EqualFold(l.Name, name) {
+			return l.Id, nil
+		}
+		names = append(names, l.Name)
+	}
+	if hint := suggest.DidYouMean(suggest.Closest(name, names)); hint != "" {
+		return "", fmt.Errorf("label %q not found; %s", name, hint)
+	}
+	return "", fmt.Errorf("label %q not found; run 'gro mail labels' to see your labels", name)
+}
@@ -0,0 +1,153 @@
+package mail
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"google.golang.org/api/gmail/v1"
+
+	gmailapi "github.com/open-cli-collective/google-readonly/internal/gmail"
+	"github.com/open-cli-collective/google-readonly/internal/testutil"
+)
+
+// labelsMock serves the labels Work (Label_1) and INBOX
+func labelsMock() *MockGmailClient {
+	labels := []*gmail.Label{
+		{Id: "Label_1", Name: "Work", Type: "user"},
+		{Id: "INBOX", Name: "INBOX", Type: "system"},
+	}
+	return &MockGmailClient{
+		GetLabelIDFunc: func(_ context.Context, name string) (string, error) {
+			for _, l := range labels {
+				if l.Name == name {
+					return l.Id, nil
+				}
+			}
+			return "", errors.New("label not found")
+		},
+		GetLabelsFunc: func() []*gmail.Label { return labels },
+		GetLabelNameFunc: func(id string) string {
+			for _, l := range labels {
+				if l.Id == id {
+					return l.Name
+				}
+			}
+			return id
+		},
+	}
+}
+
+func TestListCommand(t *testing.T) {
+	cmd := newListCommand()
+
+	t.Run("requires --label", func(t *testing.T) {
+		cmd := newListCommand()
+		cmd.SetArgs(nil)
+		withMockClient(labelsMock(), func() {
+			err := cmd.Execute()
+			testutil.Error(t, err)
+			testutil.Contains(t, err.Error(), `required flag(s) "label" not set`)
+		})
+	})
+
+	t.Run("has max and ids flags", func(t *testing.T) {
+		testutil.Equal(t, cmd.Flags().Lookup("max").DefValue, "10")
+		testutil.Equal(t, cmd.Flags().Lookup("ids").DefValue, "false")
+	})
+}
+
+func TestListCommand_ListsLabel(t *testing.T) {
+	mock := labelsMock()
+	var gotLabel string
+	var gotMax int64
+	mock.ListLabelMessagesFunc = func(_ context.Context, labelID string, maxResults int64) ([]*gmailapi.Message, int, error) {
+		gotLabel, gotMax = labelID, maxResults
+		return []*gmailapi.Message{{ID: "m1", ThreadID: "t1", Subject: "Quarterly plan", From: "alice@example.com"}}, 0, nil
+	}
+
+	cmd := newListCommand()
+	cmd.SetArgs([]string{"--label", "work", "--max", "25"})
+
+	withMockClient(mock, func() {
+		out := testutil.CaptureStdout(t, func() {
+			testutil.NoError(t, cmd.Execute())
+		})
+		testutil.Contains(t, out, "Quarterly plan")
+		testutil.Contains(t, out, "m1")
+	})
+	testutil.Equal(t, gotLabel, "Label_1")
+	testutil.Equal(t, gotMax, int64(25))
+}
+
+func TestListCommand_IDs(t *testing.T) {
+	mock := labelsMock()
+	mock.ListLabelMessageIDsFunc = func(_ context.Context, labelID string, _ int64) ([]string, error) {
+		testutil.Equal(t, labelID, "INBOX")
+		return []string{"m1", "m2"}, nil
+	}
+
+	cmd := newListCommand()
+	cmd.SetArgs([]string{"--label", "inbox", "--ids"})
+
+	withMockClient(mock, func() {
+		out := testutil.CaptureStdout(t, func() {
+			testutil.NoError(t, cmd.Execute())
+		})
+		testutil.Equal(t, out, "m1\nm2\n")
+	})
+}
+
+func TestListCommand_Empty(t *testing.T) {
+	cmd := newListCommand()
+	cmd.SetArgs([]string{"--label", "Work"})
+
+	withMockClient(labelsMock(), func() {
+		out := testutil.CaptureStdout(t, func() {
+			testutil.NoError(t, cmd.Execute())
+		})
+		testutil.Equal(t, out, "No messages labeled Work.\n")
+	})
+}
+
+func TestListCommand_UnknownLabel(t *testing.T) {
+	mock := labelsMock()
+	mock.ListLabelMessagesFunc = func(context.Context, string, int64) ([]*gmailapi.Message, int, error) {
+		t.Fatal("must not list messages for an unknown label")
+		return nil, 0, nil
+	}
+
+	cmd := newListCommand()
+	cmd.SetArgs([]string{"--label", "Personal"})
+
+	withMockClient(mock, func() {
+		err := cmd.Execute()
+		testutil.Error(t, err)
+		testutil.Contains(t, err.Error(), `label "Personal" not found`)
+	})
+}
+
+func TestListCommand_SuggestsCloseLabels(t *testing.T) {
+	cmd := newListCommand()
+	cmd.SetArgs([]string{"--label", "Wrok"})
+
+	withMockClient(labelsMock(), func() {
+		err := cmd.Execute()
+		testutil.Error(t, err)
+		testutil.Equal(t, err.Error(), `label "Wrok" not found; did you mean 'Work'?`)
+	})
+}
+
+func TestListCommand_LabelFetchError(t *testing.T) {
+	mock := labelsMock()
+	mock.FetchLabelsFunc = func(context.Context) error { return errors.New("quota exceeded") }
+
+	cmd := newListCommand()
+	cmd.SetArgs([]string{"--label", "Work"})
+
+	withMockClient(mock, func() {
+		err := cmd.Execute()
+		testutil.Error(t, err)
+		testutil.Contains(t, err.Error(), "fetching labels: quota exceeded")
+	})
+}
@@ -14,11 +14,16 @@ func NewCommand() *cobra.Command {
 
 This command group provides Gmail functionality:
 - search: Search for messages using Gmail query syntax
+- list: List the messages with a label
+- count: Count messages matching a query
 - read: Read a single message
 - thread: Read a full conversation thread
 - labels: List all labels
 - attachments: List and download attachments
+- structure: Show a message's MIME part tree
+- headers: Show every header of a message
 - draft: Compose a draft (never sent automatically)
+- export: Incrementally export messages as .eml files
 
 Organizational operations (non-destructive):
 - archive: Remove messages from inbox
@@ -38,10 +43,14 @@ Examples:
 	}
 
 	cmd.AddCommand(newSearchCommand())
+	cmd.AddCommand(newListCommand())
+	cmd.AddCommand(newCountCommand())
 	cmd.AddCommand(newReadCommand())
 	cmd.AddCommand(newThreadCommand())
 	cmd.AddCommand(newLabelsCommand())
 	cmd.AddCommand(newAttachmentsCommand())
+	cmd.AddCommand(newStructureCommand())
+	cmd.AddCommand(newHeadersCommand())
 	cmd.AddCommand(newArchiveCommand())
 	cmd.AddCommand(newStarCommand())
 	cmd.AddCommand(newUnstarCommand())
@@ -51,6 +60,7 @@ Examples:
 	cmd.AddCommand(newUnlabelCommand())
 	cmd.AddCommand(newCategorizeCommand())
 	cmd.AddCommand(newDraftCommand())
+	cmd.AddCommand(newExportCommand())
 
 	return cmd
 }
@@ -1,8 +1,11 @@
 package mail
 
 import (
+	"context"
 	"testing"
 
+	"github.com/open-cli-collective/google-readonly/internal/config"
+	"github.com/open-cli-collective/google-readonly/internal/credtest"
 	"github.com/open-cli-collective/google-readonly/internal/testutil"
 )
 
@@ -26,9 +29,24 @@ func TestMailCommand(t *testing.T) {
 			names = append(names, sub.Name())
 		}
 		testutil.SliceContains(t, names, "search")
+		testutil.SliceContains(t, names, "list")
+		testutil.SliceContains(t, names, "count")
 		testutil.SliceContains(t, names, "read")
 		testutil.SliceContains(t, names, "thread")
 		testutil.SliceContains(t, names, "labels")
 		testutil.SliceContains(t, names, "attachments")
 	})
 }
+
+func TestClientFactory_GmailScopePreflight(t *testing.T) {
+	credtest.Setup(t)
+	err := config.SaveConfig(&config.Config{
+		GrantedScopes: []string{"https://www.googleapis.com/auth/calendar.readonly"},
+	})
+	testutil.NoError(t, err)
+
+	client, err := ClientFactory(context.Background())
+	testutil.Error(t, err)
+	testutil.True(t, client == nil)
+	testutil.Contains(t, err.Error(), "not authorized for Gmail")
+}
@@ -14,8 +14,12 @@ type MockGmailClient struct {
 	GetMessageFunc               func(ctx context.Context, messageID string, includeBody bool) (*gmailapi.Message, error)
 	SearchMessagesFunc           func(ctx context.Context, query string, maxResults int64) ([]*gmailapi.Message, int, error)
 	SearchMessageIDsFunc         func(ctx context.Context, query string, maxResults int64) ([]string, error)
+	SearchThreadIDsFunc          func(ctx context.Context, query string, maxResults int64) ([]string, error)
+	ListLabelMessagesFunc        func(ctx context.Context, labelID string, maxResults int64) ([]*gmailapi.Message, int, error)
+	ListLabelMessageIDsFunc      func(ctx context.Context, labelID string, maxResults int64) ([]string, error)
 	GetThreadFunc                func(ctx context.Context, id string) ([]*gmailapi.Message, error)
 	FetchLabelsFunc              func(ctx context.Context) error
+	RefreshLabelsFunc            func(ctx context.Context) error
 	GetLabelNameFunc             func(labelID string) string
 	GetLabelIDFunc               func(ctx context.Context, name string) (string, error)
 	GetLabelsFunc                func() []*gmail.Label
@@ -23,8 +27,13 @@ type MockGmailClient struct {
 	GetAttachmentsFunc           func(ctx context.Context, messageID string) ([]*gmailapi.Attachment, error)
 	DownloadAttachmentFunc       func(ctx context.Context, messageID, attachmentID string) ([]byte, error)
 	DownloadInlineAttachmentFunc func(ctx context.Context, messageID, partID string) ([]byte, error)
+	GetMessageStructureFunc      func(ctx context.Context, messageID string) (*gmailapi.MessagePart, error)
+	GetMessageHeadersFunc        func(ctx context.Context, messageID string) ([]gmailapi.Header, error)
 	GetProfileFunc               func(ctx context.Context) (*gmailapi.Profile, error)
 	CreateDraftFunc              func(ctx context.Context, msg gmailapi.DraftMessage) (*gmailapi.DraftResult, error)
+	GetRawMessageFunc            func(ctx context.Context, messageID string) (*gmailapi.RawMessage, error)
+	ListAllMessageIDsFunc        func(ctx context.Context, query string) ([]string, error)
+	CountMessagesFunc            func(ctx context.Context, query string) (int64, error)
 }
 
 // Verify MockGmailClient implements MailClient
@@ -44,6 +53,20 @@ func (m *MockGmailClient) SearchMessages(ctx context.Context, query string, maxR
 	return nil, 0, nil
 }
 
+func (m *MockGmailClient) ListLabelMessages(ctx context.Context, labelID string, maxResults int64) ([]*gmailapi.Message, int, error) {
+	if m.ListLabelMessagesFunc != nil {
+		return m.ListLabelMessagesFunc(ctx, labelID, maxResults)
+	}
+	return nil, 0, nil
+}
+
+func (m *MockGmailClient) ListLabelMessageIDs(ctx context.Context, labelID string, maxResults int64) ([]string, error) {
+	if m.ListLabelMessageIDsFunc != nil {
+		return m.ListLabelMessageIDsFunc(ctx, labelID, maxResults)
+	}
+	return nil, nil
+}
+
 func (m *MockGmailClient) SearchMessageIDs(ctx context.Context, query string, maxResults int64) ([]string, error) {
 	if m.SearchMessageIDsFunc != nil {
 		return m.SearchMessageIDsFunc(ctx, query, maxResults)
@@ -51,6 +74,13 @@ func (m *MockGmailClient) SearchMessageIDs(ctx context.Context, query string, ma
 	return nil, nil
 }
 
+func (m *MockGmailClient) SearchThreadIDs(ctx context.Context, query string, maxResults int64) ([]string, error) {
+	if m.SearchThreadIDsFunc != nil {
+		return m.SearchThreadIDsFunc(ctx, query, maxResults)
+	}
+	return nil, nil
+}
+
 func (m *MockGmailClient) GetThread(ctx context.Context, id string) ([]*gmailapi.Message, error) {
 	if m.GetThreadFunc != nil {
 		return m.GetThreadFunc(ctx, id)
@@ -65,6 +95,13 @@ func (m *MockGmailClient) FetchLabels(ctx context.Context) error {
 	return nil
 }
 
+func (m *MockGmailClient) RefreshLabels(ctx context.Context) error {
+	if m.RefreshLabelsFunc != nil {
+		return m.RefreshLabelsFunc(ctx)
+	}
+	return nil
+}
+
 func (m *MockGmailClient) GetLabelName(labelID string) string {
 	if m.GetLabelNameFunc != nil {
 		return m.GetLabelNameFunc(labelID)
@@ -114,6 +151,20 @@ func (m *MockGmailClient) DownloadInlineAttachment(ctx context.Context, messageI
 	return nil, nil
 }
 
+func (m *MockGmailClient) GetMessageStructure(ctx context.Context, messageID string) (*gmailapi.MessagePart, error) {
+	if m.GetMessageStructureFunc != nil {
+		return m.GetMessageStructureFunc(ctx, messageID)
+	}
+	return nil, nil
+}
+
+func (m *MockGmailClient) GetMessageHeaders(ctx context.Context, messageID string) ([]gmailapi.Header, error) {
+	if m.GetMessageHeadersFunc != nil {
+		return m.GetMessageHeadersFunc(ctx, messageID)
+	}
+	return nil, nil
+}
+
 func (m *MockGmailClient) GetProfile(ctx context.Context) (*gmailapi.Profile, error) {
 	if m.GetProfileFunc != nil {
 		return m.GetProfileFunc(ctx)
@@ -127,3 +178,24 @@ func (m *MockGmailClient) CreateDraft(ctx context.Context, msg gmailapi.DraftMes
 	}
 	return &gmailapi.DraftResult{ID: "mock-draft-id"}, nil
 }
+
+func (m *MockGmailClient) GetRawMessage(ctx context.Context, messageID string) (*gmailapi.RawMessage, error) {
+	if m.GetRawMessageFunc != nil {
+		return m.GetRawMessageFunc(ctx, messageID)
+	}
+	return nil, nil
+}
+
+func (m *MockGmailClient) ListAllMessageIDs(ctx context.Context, query string) ([]string, error) {
+	if m.ListAllMessageIDsFunc != nil {
+		return m.ListAllMessageIDsFunc(ctx, query)
+	}
+	return nil, nil
+}
+
+func (m *MockGmailClient) CountMessages(ctx context.Context, query string) (int64, error) {
+	if m.CountMessagesFunc != nil {
+		return m.CountMessagesFunc(ctx, query)
+	}
+	return 0, nil
+}
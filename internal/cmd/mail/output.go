@@ -3,11 +3,17 @@ package mail
 import (
 	"context"
 	"fmt"
+	"net/mail"
 	"strings"
 
 	gmailv1 "google.golang.org/api/gmail/v1"
 
+	"github.com/open-cli-collective/google-readonly/internal/auth"
+	"github.com/open-cli-collective/google-readonly/internal/cache"
+	"github.com/open-cli-collective/google-readonly/internal/color"
+	"github.com/open-cli-collective/google-readonly/internal/format"
 	"github.com/open-cli-collective/google-readonly/internal/gmail"
+	"github.com/open-cli-collective/google-readonly/internal/progress"
 )
 
 // MailClient defines the interface for Gmail client operations used by mail commands.
@@ -15,8 +21,12 @@ type MailClient interface {
 	GetMessage(ctx context.Context, messageID string, includeBody bool) (*gmail.Message, error)
 	SearchMessages(ctx context.Context, query string, maxResults int64) ([]*gmail.Message, int, error)
 	SearchMessageIDs(ctx context.Context, query string, maxResults int64) ([]string, error)
+	SearchThreadIDs(ctx context.Context, query string, maxResults int64) ([]string, error)
+	ListLabelMessages(ctx context.Context, labelID string, maxResults int64) ([]*gmail.Message, int, error)
+	ListLabelMessageIDs(ctx context.Context, labelID string, maxResults int64) ([]string, error)
 	GetThread(ctx context.Context, id string) ([]*gmail.Message, error)
 	FetchLabels(ctx context.Context) error
+	RefreshLabels(ctx context.Context) error
 	GetLabelName(labelID string) string
 	GetLabelID(ctx context.Context, name string) (string, error)
 	GetLabels() []*gmailv1.Label
@@ -24,14 +34,35 @@ type MailClient interface {
 	GetAttachments(ctx context.Context, messageID string) ([]*gmail.Attachment, error)
 	DownloadAttachment(ctx context.Context, messageID string, attachmentID string) ([]byte, error)
 	DownloadInlineAttachment(ctx context.Context, messageID string, partID string) ([]byte, error)
+	GetMessageStructure(ctx context.Context, messageID string) (*gmail.MessagePart, error)
+	GetMessageHeaders(ctx context.Context, messageID string) ([]gmail.Header, error)
 	GetProfile(ctx context.Context) (*gmail.Profile, error)
 	CreateDraft(ctx context.Context, msg gmail.DraftMessage) (*gmail.DraftResult, error)
+	GetRawMessage(ctx context.Context, messageID string) (*gmail.RawMessage, error)
+	ListAllMessageIDs(ctx context.Context, query string) ([]string, error)
+	CountMessages(ctx context.Context, query string) (int64, error)
 }
 
 // ClientFactory is the function used to create Gmail clients.
-// Override in tests to inject mocks.
+// Override in tests to inject mocks. It fails before any API call when the
+// token was never granted a Gmail scope.
 var ClientFactory = func(ctx context.Context) (MailClient, error) {
-	return gmail.NewClient(ctx)
+	if err := auth.RequireScope("mail"); err != nil {
+		return nil, err
+	}
+	client, err := gmail.NewClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+	// The label cache is disposable: without it, labels are fetched from
+	// the API every run
+	if c, err := cache.New(); err == nil {
+		client.SetLabelCache(c)
+	}
+	client.SetPageTally(func(noun string) gmail.PageTally {
+		return progress.Count(noun)
+	})
+	return client, nil
 }
 
 // newGmailClient creates and returns a new Gmail client
@@ -45,6 +76,8 @@ type MessagePrintOptions struct {
 	IncludeTo       bool
 	IncludeSnippet  bool
 	IncludeBody     bool
+	// IncludeAttachmentSummary adds one compact line listing the attachments
+	IncludeAttachmentSummary bool
 }
 
 // printMessageHeader prints the common header fields of a message
@@ -58,8 +91,8 @@ func printMessageHeader(msg *gmail.Message, opts MessagePrintOptions) {
 	if opts.IncludeTo {
 		fmt.Printf("To: %s\n", SanitizeOutput(msg.To))
 	}
-	fmt.Printf("Subject: %s\n", SanitizeOutput(msg.Subject))
-	fmt.Printf("Date: %s\n", msg.Date)
+	fmt.Printf("Subject: %s\n", color.Bold(SanitizeOutput(msg.Subject)))
+	fmt.Printf("Date: %s\n", formatMessageDate(msg.Date))
 	if len(msg.Labels) > 0 {
 		fmt.Printf("Labels: %s\n", strings.Join(msg.Labels, ", "))
 	}
@@ -69,8 +102,44 @@ func printMessageHeader(msg *gmail.Message, opts MessagePrintOptions) {
 	if opts.IncludeSnippet {
 		fmt.Printf("Snippet: %s\n", SanitizeOutput(msg.Snippet))
 	}
+	if opts.IncludeAttachmentSummary {
+		fmt.Printf("Attachments: %s\n", attachmentSummary(msg.Attachments))
+	}
 	if opts.IncludeBody {
 		fmt.Print("\n--- Body ---\n\n")
 		fmt.Println(SanitizeOutput(msg.Body))
 	}
 }
+
+// attachmentSummary condenses attachments into count, total size and
+// filenames, e.g. "3 (12.0 KB): a.pdf, b.png, c.txt"
+func attachmentSummary(attachments []*gmail.Attachment) string {
+	if len(attachments) == 0 {
+		return "none"
+	}
+	var total int64
+	names := make([]string, len(attachments))
+	for i, att := range attachments {
+		total += att.Size
+		// Sanitize filenames to prevent terminal injection from malicious attachment names
+		names[i] = SanitizeFilename(att.Filename)
+	}
+	return fmt.Sprintf("%d (%s): %s", len(attachments), format.Size(total), strings.Join(names, ", "))
+}
+
+// formatMessageDate renders a Date header relative to now on a terminal, or
+// using the configured --date-format. With neither, or a header that does
+// not parse, the header is printed as received.
+func formatMessageDate(date string) string {
+	if format.DateLayout == "" && !format.Relative {
+		return date
+	}
+	t, err := mail.ParseDate(date)
+	if err != nil {
+		return date
+	}
+	if format.Relative {
+		return format.RelativeTime(t)
+	}
+	return format.Date(t, "")
+}
@@ -3,6 +3,8 @@ package mail
 import (
 	"testing"
 
+	"github.com/open-cli-collective/google-readonly/internal/format"
+	"github.com/open-cli-collective/google-readonly/internal/gmail"
 	"github.com/open-cli-collective/google-readonly/internal/testutil"
 )
 
@@ -29,3 +31,37 @@ func TestMessagePrintOptions(t *testing.T) {
 		testutil.True(t, opts.IncludeBody)
 	})
 }
+
+func TestFormatMessageDate(t *testing.T) {
+	const header = "Thu, 7 Mar 2024 14:05:00 +0000"
+
+	t.Run("prints header unchanged by default", func(t *testing.T) {
+		testutil.Equal(t, formatMessageDate(header), header)
+	})
+
+	t.Run("applies configured layout", func(t *testing.T) {
+		format.DateLayout = "2006-01-02 15:04"
+		defer func() { format.DateLayout = "" }()
+		testutil.Equal(t, formatMessageDate(header), "2024-03-07 14:05")
+	})
+
+	t.Run("keeps unparseable header", func(t *testing.T) {
+		format.DateLayout = "2006-01-02 15:04"
+		defer func() { format.DateLayout = "" }()
+		testutil.Equal(t, formatMessageDate("sometime last week"), "sometime last week")
+	})
+}
+
+func TestAttachmentSummary(t *testing.T) {
+	t.Run("none", func(t *testing.T) {
+		testutil.Equal(t, attachmentSummary(nil), "none")
+	})
+
+	t.Run("count, total size and names", func(t *testing.T) {
+		atts := []*gmail.Attachment{
+			{Filename: "a.pdf", Size: 1024},
+			{Filename: "b.png", Size: 512},
+		}
+		testutil.Equal(t, attachmentSummary(atts), "2 (1.5 KB): a.pdf, b.png")
+	})
+}
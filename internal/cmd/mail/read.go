@@ -2,11 +2,24 @@ package mail
 
 import (
 	"fmt"
+	"os"
 
 	"github.com/spf13/cobra"
 )
 
+// Output formats accepted by --output on read
+const (
+	outputText = "text"
+	outputEML  = "eml"
+)
+
 func newReadCommand() *cobra.Command {
+	var (
+		output             string
+		raw                bool
+		attachmentsSummary bool
+	)
+
 	cmd := &cobra.Command{
 		Use:   "read <message-id>",
 		Short: "Read a single message",
@@ -14,28 +27,70 @@ func newReadCommand() *cobra.Command {
 
 The message ID can be obtained from the search command output.
 
+With --output eml (or its shorthand --raw) the message is written to stdout
+exactly as Gmail stores it (RFC 822 source), for piping into other mail
+tools or saving as a .eml file.
+
+--attachments-summary-only adds one line with the attachment count, total
+size and filenames; use 'gro mail attachments list' for per-attachment
+details.
+
 Examples:
-  gro mail read 18abc123def456`,
+  gro mail read 18abc123def456
+  gro mail read 18abc123def456 --output eml > message.eml
+  gro mail read 18abc123def456 --raw | less
+  gro mail read 18abc123def456 --attachments-summary-only`,
 		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
+			if raw {
+				if cmd.Flags().Changed("output") && output != outputEML {
+					return fmt.Errorf("--raw cannot be combined with --output %s", output)
+				}
+				output = outputEML
+			}
+			switch output {
+			case outputText, outputEML:
+			default:
+				return fmt.Errorf("invalid --output %q: must be %s or %s", output, outputText, outputEML)
+			}
+			if output == outputEML && attachmentsSummary {
+				return fmt.Errorf("--attachments-summary-only cannot be combined with --output eml or --raw")
+			}
+
 			client, err := newGmailClient(cmd.Context())
 			if err != nil {
 				return fmt.Errorf("creating Gmail client: %w", err)
 			}
 
+			if output == outputEML {
+				raw, err := client.GetRawMessage(cmd.Context(), args[0])
+				if err != nil {
+					return fmt.Errorf("reading message: %w", err)
+				}
+				if _, err := os.Stdout.Write(raw.Raw); err != nil {
+					return fmt.Errorf("writing message: %w", err)
+				}
+				return nil
+			}
+
 			msg, err := client.GetMessage(cmd.Context(), args[0], true)
 			if err != nil {
 				return fmt.Errorf("reading message: %w", err)
 			}
 
 			printMessageHeader(msg, MessagePrintOptions{
-				IncludeTo:   true,
-				IncludeBody: true,
+				IncludeTo:                true,
+				IncludeBody:              true,
+				IncludeAttachmentSummary: attachmentsSummary,
 			})
 
 			return nil
 		},
 	}
 
+	cmd.Flags().StringVarP(&output, "output", "o", outputText, "Output format: text or eml (raw RFC 822 source)")
+	cmd.Flags().BoolVar(&raw, "raw", false, "Write the raw RFC 822 source; shorthand for --output eml")
+	cmd.Flags().BoolVar(&attachmentsSummary, "attachments-summary-only", false, "Add a one-line attachment summary (count, total size, filenames)")
+
 	return cmd
 }
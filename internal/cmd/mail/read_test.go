@@ -32,4 +32,11 @@ func TestReadCommand(t *testing.T) {
 	t.Run("long description mentions message ID source", func(t *testing.T) {
 		testutil.Contains(t, cmd.Long, "search")
 	})
+
+	t.Run("has output flag defaulting to text", func(t *testing.T) {
+		flag := cmd.Flags().Lookup("output")
+		testutil.NotNil(t, flag)
+		testutil.Equal(t, flag.Shorthand, "o")
+		testutil.Equal(t, flag.DefValue, "text")
+	})
 }
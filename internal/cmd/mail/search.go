@@ -2,38 +2,70 @@ package mail
 
 import (
 	"fmt"
+	"slices"
+	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
+
+	"github.com/open-cli-collective/google-readonly/internal/format"
 )
 
 func newSearchCommand() *cobra.Command {
 	var (
-		maxResults int64
-		idsOnly    bool
+		maxResults    int64
+		idsOnly       bool
+		threadIDsOnly bool
+		filters       searchFilters
 	)
 
 	cmd := &cobra.Command{
-		Use:   "search <query>",
+		Use:   "search [query]",
 		Short: "Search for messages",
 		Long: `Search for Gmail messages using Gmail's search syntax.
 
+--after, --before, --from, --to, --has-attachment, --unread, --starred,
+--important, --in and --category add the matching Gmail operators to the
+query, so the query itself may be omitted when they are used. All of them
+must match. --after and --before take YYYY-MM-DD in local time; --before
+excludes that day, like Gmail's before:. --in takes a system location
+(inbox, sent, drafts, spam, trash, snoozed, anywhere) or a label name.
+
 Examples:
   gro mail search "from:alice@example.com"
   gro mail search "subject:meeting" --max 20
   gro mail search "is:unread"
   gro mail search "after:2024/01/01 before:2024/02/01"
   gro mail search "is:inbox" --ids | gro mail archive --stdin
+  gro mail search "from:alice@example.com" --thread-ids | xargs -n1 gro mail thread
+  gro mail search --from alice@example.com --after 2024-01-01 --has-attachment
+  gro mail search "invoice" --before 2024-02-01
+  gro mail search --unread --category promotions
+  gro mail search "report" --in Work --starred
 
 For more query operators, see: https://support.google.com/mail/answer/7190`,
-		Args: cobra.ExactArgs(1),
+		Args: cobra.MaximumNArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
+			if idsOnly && threadIDsOnly {
+				return fmt.Errorf("--ids and --thread-ids are mutually exclusive")
+			}
+
+			var userQuery string
+			if len(args) > 0 {
+				userQuery = args[0]
+			}
+			query, err := filters.buildQuery(userQuery, time.Local)
+			if err != nil {
+				return err
+			}
+
 			client, err := newGmailClient(cmd.Context())
 			if err != nil {
 				return fmt.Errorf("creating Gmail client: %w", err)
 			}
 
 			if idsOnly {
-				ids, err := client.SearchMessageIDs(cmd.Context(), args[0], maxResults)
+				ids, err := client.SearchMessageIDs(cmd.Context(), query, maxResults)
 				if err != nil {
 					return fmt.Errorf("searching messages: %w", err)
 				}
@@ -43,7 +75,18 @@ For more query operators, see: https://support.google.com/mail/answer/7190`,
 				return nil
 			}
 
-			messages, skipped, err := client.SearchMessages(cmd.Context(), args[0], maxResults)
+			if threadIDsOnly {
+				ids, err := client.SearchThreadIDs(cmd.Context(), query, maxResults)
+				if err != nil {
+					return fmt.Errorf("searching threads: %w", err)
+				}
+				for _, id := range ids {
+					fmt.Println(id)
+				}
+				return nil
+			}
+
+			messages, skipped, err := client.SearchMessages(cmd.Context(), query, maxResults)
 			if err != nil {
 				return fmt.Errorf("searching messages: %w", err)
 			}
@@ -71,6 +114,122 @@ For more query operators, see: https://support.google.com/mail/answer/7190`,
 
 	cmd.Flags().Int64VarP(&maxResults, "max", "m", 10, "Maximum number of results to return")
 	cmd.Flags().BoolVar(&idsOnly, "ids", false, "Output only message IDs (one per line, for piping)")
+	cmd.Flags().BoolVar(&threadIDsOnly, "thread-ids", false, "Output only unique thread IDs (one per line, for piping)")
+	cmd.Flags().StringVar(&filters.after, "after", "", "Only messages on or after this date (YYYY-MM-DD)")
+	cmd.Flags().StringVar(&filters.before, "before", "", "Only messages before this date (YYYY-MM-DD)")
+	cmd.Flags().StringVar(&filters.from, "from", "", "Only messages from this sender")
+	cmd.Flags().StringVar(&filters.to, "to", "", "Only messages to this recipient")
+	cmd.Flags().BoolVar(&filters.hasAttachment, "has-attachment", false, "Only messages with attachments")
+	cmd.Flags().BoolVar(&filters.unread, "unread", false, "Only unread messages")
+	cmd.Flags().BoolVar(&filters.starred, "starred", false, "Only starred messages")
+	cmd.Flags().BoolVar(&filters.important, "important", false, "Only messages marked important")
+	cmd.Flags().StringVar(&filters.in, "in", "", "Only messages in this location or label")
+	cmd.Flags().StringVar(&filters.category, "category", "", "Only messages in this inbox category ("+strings.Join(searchCategories, ", ")+")")
 
 	return cmd
 }
+
+// searchFilters are the convenience flags of mail search that compose into
+// Gmail query operators
+type searchFilters struct {
+	after         string
+	before        string
+	from          string
+	to            string
+	hasAttachment bool
+	unread        bool
+	starred       bool
+	important     bool
+	in            string
+	category      string
+}
+
+// searchCategories are the inbox tabs Gmail's category: operator accepts
+var searchCategories = []string{"primary", "social", "promotions", "updates", "forums"}
+
+// systemLocations are the mailbox locations Gmail searches with in:, where
+// any other --in value is a label name searched with label:
+var systemLocations = []string{"inbox", "sent", "drafts", "spam", "trash", "snoozed", "anywhere"}
+
+// buildQuery appends the operators for the set filters to the user's query.
+// The query is parenthesized when filters follow it, so an OR in it does not
+// bind to the first filter: "a OR b" with --unread is "(a OR b) is:unread",
+// not "a OR (b is:unread)". Dates are midnight in loc, passed to Gmail as epoch seconds so the day
+// boundary is the user's rather than Gmail's.
+func (f searchFilters) buildQuery(query string, loc *time.Location) (string, error) {
+	parts := []string{}
+	if q := strings.TrimSpace(query); q != "" {
+		parts = append(parts, q)
+	}
+
+	var after, before time.Time
+	if f.after != "" {
+		t, err := format.ParseDate(f.after)
+		if err != nil {
+			return "", fmt.Errorf("invalid --after date: %w", err)
+		}
+		after = time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, loc)
+		parts = append(parts, fmt.Sprintf("after:%d", after.Unix()))
+	}
+	if f.before != "" {
+		t, err := format.ParseDate(f.before)
+		if err != nil {
+			return "", fmt.Errorf("invalid --before date: %w", err)
+		}
+		before = time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, loc)
+		parts = append(parts, fmt.Sprintf("before:%d", before.Unix()))
+	}
+	if !after.IsZero() && !before.IsZero() && !after.Before(before) {
+		return "", fmt.Errorf("--after %s must be earlier than --before %s", f.after, f.before)
+	}
+
+	if f.from != "" {
+		parts = append(parts, "from:"+quoteQueryValue(f.from))
+	}
+	if f.to != "" {
+		parts = append(parts, "to:"+quoteQueryValue(f.to))
+	}
+	if f.hasAttachment {
+		parts = append(parts, "has:attachment")
+	}
+	if f.unread {
+		parts = append(parts, "is:unread")
+	}
+	if f.starred {
+		parts = append(parts, "is:starred")
+	}
+	if f.important {
+		parts = append(parts, "is:important")
+	}
+	if in := strings.TrimSpace(f.in); in != "" {
+		if slices.Contains(systemLocations, strings.ToLower(in)) {
+			parts = append(parts, "in:"+strings.ToLower(in))
+		} else {
+			parts = append(parts, "label:"+quoteQueryValue(in))
+		}
+	}
+	if f.category != "" {
+		category := strings.ToLower(f.category)
+		if !slices.Contains(searchCategories, category) {
+			return "", fmt.Errorf("invalid --category %q; valid categories: %s", f.category, strings.Join(searchCategories, ", "))
+		}
+		parts = append(parts, "category:"+category)
+	}
+
+	if len(parts) == 0 {
+		return "", fmt.Errorf("a search query or at least one filter flag is required")
+	}
+	if q := strings.TrimSpace(query); q != "" && len(parts) > 1 {
+		parts[0] = "(" + q + ")"
+	}
+	return strings.Join(parts, " "), nil
+}
+
+// quoteQueryValue quotes an operator value that contains whitespace so Gmail
+// reads it as one term
+func quoteQueryValue(v string) string {
+	if strings.ContainsAny(v, " \t") {
+		return `"` + strings.ReplaceAll(v, `"`, "") + `"`
+	}
+	return v
+}
@@ -1,7 +1,9 @@
 package mail
 
 import (
+	"context"
 	"testing"
+	"time"
 
 	"github.com/open-cli-collective/google-readonly/internal/testutil"
 )
@@ -10,12 +12,12 @@ func TestSearchCommand(t *testing.T) {
 	cmd := newSearchCommand()
 
 	t.Run("has correct use", func(t *testing.T) {
-		testutil.Equal(t, cmd.Use, "search <query>")
+		testutil.Equal(t, cmd.Use, "search [query]")
 	})
 
-	t.Run("requires exactly one argument", func(t *testing.T) {
+	t.Run("accepts at most one argument", func(t *testing.T) {
 		err := cmd.Args(cmd, []string{})
-		testutil.Error(t, err)
+		testutil.NoError(t, err)
 
 		err = cmd.Args(cmd, []string{"query"})
 		testutil.NoError(t, err)
@@ -43,3 +45,98 @@ func TestSearchCommand(t *testing.T) {
 		testutil.Contains(t, cmd.Long, "is:unread")
 	})
 }
+
+func TestSearchFiltersBuildQuery(t *testing.T) {
+	loc := time.FixedZone("UTC-5", -5*60*60)
+	tests := []struct {
+		name    string
+		query   string
+		filters searchFilters
+		want    string
+		wantErr string
+	}{
+		{name: "query only", query: "is:unread", want: "is:unread"},
+		{
+			name:    "dates at local midnight",
+			query:   "invoice",
+			filters: searchFilters{after: "2024-01-01", before: "2024-02-01"},
+			want:    "(invoice) after:1704085200 before:1706763600",
+		},
+		{
+			name:    "filters without a query",
+			filters: searchFilters{from: "alice@example.com", to: "bob@example.com", hasAttachment: true},
+			want:    "from:alice@example.com to:bob@example.com has:attachment",
+		},
+		{
+			name:    "state flags AND with the query",
+			query:   "from:boss",
+			filters: searchFilters{unread: true, starred: true, important: true},
+			want:    "(from:boss) is:unread is:starred is:important",
+		},
+		{name: "OR in the query stays grouped", query: "a OR b", filters: searchFilters{unread: true}, want: "(a OR b) is:unread"},
+		{name: "query alone is not wrapped", query: "a OR b", want: "a OR b"},
+		{name: "system location uses in:", filters: searchFilters{in: "Inbox"}, want: "in:inbox"},
+		{name: "other location is a label", filters: searchFilters{in: "Work"}, want: "label:Work"},
+		{name: "label with a space is quoted", filters: searchFilters{in: "Project X"}, want: `label:"Project X"`},
+		{name: "category", query: "sale", filters: searchFilters{category: "Promotions", unread: true}, want: "(sale) is:unread category:promotions"},
+		{name: "invalid category", filters: searchFilters{category: "spam"}, wantErr: `invalid --category "spam"; valid categories: primary, social, promotions, updates, forums`},
+		{name: "sender with a space is quoted", filters: searchFilters{from: "Alice Smith"}, want: `from:"Alice Smith"`},
+		{name: "invalid date", filters: searchFilters{after: "01/02/2024"}, wantErr: "invalid --after date: invalid date format"},
+		{name: "inverted range", filters: searchFilters{after: "2024-02-01", before: "2024-01-01"}, wantErr: "must be earlier than --before"},
+		{name: "nothing to search", query: "  ", wantErr: "a search query or at least one filter flag is required"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.filters.buildQuery(tt.query, loc)
+			if tt.wantErr != "" {
+				testutil.Error(t, err)
+				testutil.Contains(t, err.Error(), tt.wantErr)
+				return
+			}
+			testutil.NoError(t, err)
+			testutil.Equal(t, got, tt.want)
+		})
+	}
+}
+
+func TestSearchCommand_ComposesFilterFlags(t *testing.T) {
+	var gotQuery string
+	mock := &MockGmailClient{
+		SearchMessageIDsFunc: func(_ context.Context, query string, _ int64) ([]string, error) {
+			gotQuery = query
+			return []string{"msg1"}, nil
+		},
+	}
+
+	cmd := newSearchCommand()
+	cmd.SetArgs([]string{"subject:report", "--from", "alice@example.com", "--has-attachment", "--ids"})
+
+	withMockClient(mock, func() {
+		output := testutil.CaptureStdout(t, func() {
+			testutil.NoError(t, cmd.Execute())
+		})
+		testutil.Equal(t, output, "msg1\n")
+	})
+	testutil.Equal(t, gotQuery, "(subject:report) from:alice@example.com has:attachment")
+}
+
+func TestSearchCommand_ComposesLabelHelperFlags(t *testing.T) {
+	var gotQuery string
+	mock := &MockGmailClient{
+		SearchMessageIDsFunc: func(_ context.Context, query string, _ int64) ([]string, error) {
+			gotQuery = query
+			return nil, nil
+		},
+	}
+
+	cmd := newSearchCommand()
+	cmd.SetArgs([]string{"invoice", "--unread", "--in", "Finance", "--category", "updates", "--ids"})
+
+	withMockClient(mock, func() {
+		testutil.CaptureStdout(t, func() {
+			testutil.NoError(t, cmd.Execute())
+		})
+	})
+	testutil.Equal(t, gotQuery, "(invoice) is:unread label:Finance category:updates")
+}
@@ -0,0 +1,68 @@
+package mail
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/open-cli-collective/google-readonly/internal/format"
+	"github.com/open-cli-collective/google-readonly/internal/gmail"
+)
+
+func newStructureCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "structure <message-id>",
+		Short: "Show a message's MIME part tree",
+		Long: `Show the MIME part tree of a message without downloading any content.
+
+Each part is printed with its part ID, MIME type, filename, size, and
+Content-Disposition, indented under its parent. Part IDs are the same ones
+used by 'gro mail attachments', so a debugging session can map an
+attachment back to where it sits in the message.
+
+Examples:
+  gro mail structure 18abc123def456`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, err := newGmailClient(cmd.Context())
+			if err != nil {
+				return fmt.Errorf("creating Gmail client: %w", err)
+			}
+
+			root, err := client.GetMessageStructure(cmd.Context(), args[0])
+			if err != nil {
+				return fmt.Errorf("getting message structure: %w", err)
+			}
+
+			printMessagePart(root, 0)
+			return nil
+		},
+	}
+
+	return cmd
+}
+
+// printMessagePart prints part and its descendants, two spaces of indent
+// per level. The root part has no ID and is printed without one.
+func printMessagePart(part *gmail.MessagePart, depth int) {
+	fields := []string{part.MimeType}
+	if part.PartID != "" {
+		fields = append([]string{"[" + part.PartID + "]"}, fields...)
+	}
+	if part.Filename != "" {
+		// Sanitize filename to prevent terminal injection from malicious attachment names
+		fields = append(fields, fmt.Sprintf("%q", SanitizeFilename(part.Filename)))
+	}
+	if part.Size > 0 {
+		fields = append(fields, format.Size(part.Size))
+	}
+	if part.Disposition != "" {
+		fields = append(fields, part.Disposition)
+	}
+
+	fmt.Printf("%s%s\n", strings.Repeat("  ", depth), strings.Join(fields, "  "))
+	for _, child := range part.Parts {
+		printMessagePart(child, depth+1)
+	}
+}
@@ -0,0 +1,84 @@
+package mail
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	gmailapi "github.com/open-cli-collective/google-readonly/internal/gmail"
+	"github.com/open-cli-collective/google-readonly/internal/testutil"
+)
+
+func TestStructureCommand(t *testing.T) {
+	cmd := newStructureCommand()
+
+	t.Run("has correct use", func(t *testing.T) {
+		testutil.Equal(t, cmd.Use, "structure <message-id>")
+	})
+
+	t.Run("requires exactly one argument", func(t *testing.T) {
+		testutil.Error(t, cmd.Args(cmd, []string{}))
+		testutil.NoError(t, cmd.Args(cmd, []string{"msg123"}))
+	})
+}
+
+func TestStructureCommand_NestedMultipart(t *testing.T) {
+	mock := &MockGmailClient{
+		GetMessageStructureFunc: func(_ context.Context, messageID string) (*gmailapi.MessagePart, error) {
+			testutil.Equal(t, messageID, "msg123")
+			return &gmailapi.MessagePart{
+				MimeType: "multipart/mixed",
+				Parts: []*gmailapi.MessagePart{
+					{
+						PartID:   "0",
+						MimeType: "multipart/related",
+						Parts: []*gmailapi.MessagePart{
+							{
+								PartID:   "0.0",
+								MimeType: "multipart/alternative",
+								Parts: []*gmailapi.MessagePart{
+									{PartID: "0.0.0", MimeType: "text/plain", Size: 42},
+								},
+							},
+							{PartID: "0.1", MimeType: "image/png", Filename: "nested.png", Size: 500, Disposition: "inline"},
+						},
+					},
+				},
+			}, nil
+		},
+	}
+
+	cmd := newStructureCommand()
+	cmd.SetArgs([]string{"msg123"})
+
+	withMockClient(mock, func() {
+		output := testutil.CaptureStdout(t, func() {
+			err := cmd.Execute()
+			testutil.NoError(t, err)
+		})
+
+		testutil.Equal(t, output, `multipart/mixed
+  [0]  multipart/related
+    [0.0]  multipart/alternative
+      [0.0.0]  text/plain  42 B
+    [0.1]  image/png  "nested.png"  500 B  inline
+`)
+	})
+}
+
+func TestStructureCommand_APIError(t *testing.T) {
+	mock := &MockGmailClient{
+		GetMessageStructureFunc: func(_ context.Context, _ string) (*gmailapi.MessagePart, error) {
+			return nil, errors.New("not found")
+		},
+	}
+
+	cmd := newStructureCommand()
+	cmd.SetArgs([]string{"msg123"})
+
+	withMockClient(mock, func() {
+		err := cmd.Execute()
+		testutil.Error(t, err)
+		testutil.Contains(t, err.Error(), "getting message structure")
+	})
+}
@@ -7,6 +7,8 @@ import (
 )
 
 func newThreadCommand() *cobra.Command {
+	var tree bool
+
 	cmd := &cobra.Command{
 		Use:   "thread <id>",
 		Short: "Read a full conversation thread",
@@ -17,8 +19,13 @@ the thread containing that message will be retrieved automatically.
 Use the search command to find message IDs (the ThreadID field can also
 be used directly).
 
+--tree shows who replied to whom instead of the messages' bodies: one line
+per message, indented under the message it replies to (worked out from the
+In-Reply-To and References headers).
+
 Examples:
-  gro mail thread 18abc123def456`,
+  gro mail thread 18abc123def456
+  gro mail thread 18abc123def456 --tree`,
 		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			client, err := newGmailClient(cmd.Context())
@@ -37,6 +44,10 @@ Examples:
 			}
 
 			fmt.Printf("Thread contains %d message(s)\n\n", len(messages))
+			if tree {
+				printThreadTree(buildThreadTree(messages))
+				return nil
+			}
 			for i, msg := range messages {
 				fmt.Printf("=== Message %d of %d ===\n", i+1, len(messages))
 				printMessageHeader(msg, MessagePrintOptions{
@@ -50,5 +61,7 @@ Examples:
 		},
 	}
 
+	cmd.Flags().BoolVar(&tree, "tree", false, "Show the reply structure instead of message bodies")
+
 	return cmd
 }
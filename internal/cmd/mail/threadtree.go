@@ -0,0 +1,101 @@
+package mail
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/open-cli-collective/google-readonly/internal/format"
+	"github.com/open-cli-collective/google-readonly/internal/gmail"
+)
+
+// threadNode is one message in a thread's reply tree
+type threadNode struct {
+	Message *gmail.Message
+	Replies []*threadNode
+}
+
+// buildThreadTree arranges a thread's messages by who replied to whom. A
+// message's parent is the one its In-Reply-To names or, failing that, the
+// latest of its References found in the thread. Messages whose parent is
+// not in the thread (the first message, or replies to a message that was
+// deleted) are roots. Siblings keep the thread's order, which is
+// chronological.
+func buildThreadTree(messages []*gmail.Message) []*threadNode {
+	nodes := make([]*threadNode, len(messages))
+	byID := make(map[string]*threadNode, len(messages))
+	for i, msg := range messages {
+		nodes[i] = &threadNode{Message: msg}
+		if id := strings.TrimSpace(msg.RFCMessageID); id != "" {
+			if _, dup := byID[id]; !dup {
+				byID[id] = nodes[i]
+			}
+		}
+	}
+
+	parents := make(map[*threadNode]*threadNode, len(nodes))
+	var roots []*threadNode
+	for _, n := range nodes {
+		parent := findParent(n, byID)
+		if parent == nil || isAncestor(n, parent, parents) {
+			roots = append(roots, n)
+			continue
+		}
+		parents[n] = parent
+		parent.Replies = append(parent.Replies, n)
+	}
+	return roots
+}
+
+// findParent returns the node n replies to, or nil when that is not in the
+// thread
+func findParent(n *threadNode, byID map[string]*threadNode) *threadNode {
+	candidates := strings.Fields(n.Message.References)
+	if inReplyTo := strings.Fields(n.Message.InReplyTo); len(inReplyTo) > 0 {
+		candidates = append(candidates, inReplyTo[0])
+	}
+	for i := len(candidates) - 1; i >= 0; i-- {
+		if p, ok := byID[candidates[i]]; ok && p != n {
+			return p
+		}
+	}
+	return nil
+}
+
+// isAncestor reports whether n is already an ancestor of node, so making n
+// node's child would close a loop. Only headers that contradict each other
+// can cause one, but a loop would hide its messages from the output.
+func isAncestor(n, node *threadNode, parents map[*threadNode]*threadNode) bool {
+	for p := node; p != nil; p = parents[p] {
+		if p == n {
+			return true
+		}
+	}
+	return false
+}
+
+// printThreadTree prints each message as one line, indented under the
+// message it replies to with the same branch glyphs as drive tree
+func printThreadTree(roots []*threadNode) {
+	for _, root := range roots {
+		fmt.Println(threadTreeLine(root.Message))
+		printThreadReplies(root, "")
+	}
+}
+
+func printThreadReplies(node *threadNode, prefix string) {
+	glyphs := format.Tree()
+	for i, reply := range node.Replies {
+		branch, next := glyphs.Branch, glyphs.Pipe
+		if i == len(node.Replies)-1 {
+			branch, next = glyphs.LastBranch, glyphs.Space
+		}
+		fmt.Printf("%s%s%s\n", prefix, branch, threadTreeLine(reply.Message))
+		printThreadReplies(reply, prefix+next)
+	}
+}
+
+// threadTreeLine summarizes a message for the tree: sender, date, subject
+// and the ID to pass to 'gro mail read'
+func threadTreeLine(msg *gmail.Message) string {
+	return fmt.Sprintf("%s  %s  %s  [%s]", msg.From, formatMessageDate(msg.Date), msg.Subject, msg.ID)
+}
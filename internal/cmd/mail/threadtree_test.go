@@ -0,0 +1,124 @@
+package mail
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/open-cli-collective/google-readonly/internal/gmail"
+	"github.com/open-cli-collective/google-readonly/internal/testutil"
+)
+
+func threadMsg(id, inReplyTo, references string) *gmail.Message {
+	return &gmail.Message{
+		ID:           id,
+		From:         "sender-" + id,
+		Subject:      "Plans",
+		Date:         "Mon, 2 Feb 2026 10:00:00 +0000",
+		RFCMessageID: "<" + id + "@mail>",
+		InReplyTo:    inReplyTo,
+		References:   references,
+	}
+}
+
+// shape renders a tree as nested IDs, e.g. "a(b(c) d)"
+func shape(nodes []*threadNode) string {
+	parts := make([]string, len(nodes))
+	for i, n := range nodes {
+		parts[i] = n.Message.ID
+		if len(n.Replies) > 0 {
+			parts[i] += "(" + shape(n.Replies) + ")"
+		}
+	}
+	return strings.Join(parts, " ")
+}
+
+func TestBuildThreadTree(t *testing.T) {
+	tests := []struct {
+		name     string
+		messages []*gmail.Message
+		want     string
+	}{
+		{
+			name: "reply chain and branches",
+			messages: []*gmail.Message{
+				threadMsg("a", "", ""),
+				threadMsg("b", "<a@mail>", "<a@mail>"),
+				threadMsg("c", "<a@mail>", "<a@mail>"),
+				threadMsg("d", "<b@mail>", "<a@mail> <b@mail>"),
+			},
+			want: "a(b(d) c)",
+		},
+		{
+			name: "falls back to the latest known reference",
+			messages: []*gmail.Message{
+				threadMsg("a", "", ""),
+				threadMsg("b", "", "<a@mail>"),
+				threadMsg("c", "<gone@mail>", "<a@mail> <b@mail> <gone@mail>"),
+			},
+			want: "a(b(c))",
+		},
+		{
+			name: "parent missing from the thread makes a root",
+			messages: []*gmail.Message{
+				threadMsg("a", "", ""),
+				threadMsg("b", "<deleted@mail>", "<deleted@mail>"),
+			},
+			want: "a b",
+		},
+		{
+			name: "contradictory headers do not loop",
+			messages: []*gmail.Message{
+				threadMsg("a", "<b@mail>", ""),
+				threadMsg("b", "<a@mail>", ""),
+			},
+			want: "b(a)",
+		},
+		{
+			name: "self reference",
+			messages: []*gmail.Message{
+				threadMsg("a", "<a@mail>", ""),
+			},
+			want: "a",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			testutil.Equal(t, shape(buildThreadTree(tt.messages)), tt.want)
+		})
+	}
+}
+
+func TestThreadCommand_Tree(t *testing.T) {
+	mock := &MockGmailClient{
+		GetThreadFunc: func(_ context.Context, _ string) ([]*gmail.Message, error) {
+			return []*gmail.Message{
+				threadMsg("a", "", ""),
+				threadMsg("b", "<a@mail>", "<a@mail>"),
+				threadMsg("c", "<b@mail>", "<a@mail> <b@mail>"),
+				threadMsg("d", "<a@mail>", "<a@mail>"),
+			}, nil
+		},
+	}
+
+	cmd := newThreadCommand()
+	cmd.SetArgs([]string{"thread123", "--tree"})
+
+	withMockClient(mock, func() {
+		output := testutil.CaptureStdout(t, func() {
+			testutil.NoError(t, cmd.Execute())
+		})
+
+		line := func(id string) string {
+			return "sender-" + id + "  Mon, 2 Feb 2026 10:00:00 +0000  Plans  [" + id + "]"
+		}
+		testutil.Contains(t, output, strings.Join([]string{
+			line("a"),
+			"├── " + line("b"),
+			"│   └── " + line("c"),
+			"└── " + line("d"),
+		}, "\n"))
+		testutil.NotContains(t, output, "=== Message")
+	})
+}
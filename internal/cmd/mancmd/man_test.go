@@ -0,0 +1,61 @@
+package mancmd
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/spf13/cobra"
+
+	"github.com/open-cli-collective/google-readonly/internal/testutil"
+)
+
+// newTree mounts the man command under a stand-in root with one nested
+// subcommand, the way root.go registers it
+func newTree() *cobra.Command {
+	root := &cobra.Command{Use: "gro", Long: "gro is a non-destructive CLI."}
+	mail := &cobra.Command{Use: "mail", Short: "Gmail commands"}
+	mail.AddCommand(&cobra.Command{
+		Use:   "search <query>",
+		Short: "Search messages",
+		Long:  "Search for Gmail messages using Gmail's search syntax.",
+		Run:   func(*cobra.Command, []string) {},
+	})
+	root.AddCommand(mail)
+	root.AddCommand(NewCommand())
+	return root
+}
+
+func TestManCommand_WritesTree(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "man1")
+	root := newTree()
+	root.SetArgs([]string{"man", "--dir", dir})
+
+	testutil.NoError(t, root.Execute())
+
+	for _, name := range []string{"gro.1", "gro-mail.1", "gro-mail-search.1"} {
+		_, err := os.Stat(filepath.Join(dir, name))
+		testutil.NoError(t, err)
+	}
+	_, err := os.Stat(filepath.Join(dir, "gro-man.1"))
+	testutil.True(t, os.IsNotExist(err))
+
+	page, err := os.ReadFile(filepath.Join(dir, "gro-mail-search.1"))
+	testutil.NoError(t, err)
+	testutil.Contains(t, string(page), ".TH \"GRO-MAIL-SEARCH\" \"1\"")
+	testutil.Contains(t, string(page), "Search for Gmail messages using Gmail's search syntax.")
+	testutil.NotContains(t, string(page), "Auto generated by spf13/cobra")
+}
+
+func TestManCommand_RequiresDir(t *testing.T) {
+	root := newTree()
+	root.SetArgs([]string{"man"})
+
+	err := root.Execute()
+	testutil.Error(t, err)
+	testutil.Contains(t, err.Error(), `"dir" not set`)
+}
+
+func TestManCommand_IsHidden(t *testing.T) {
+	testutil.True(t, NewCommand().Hidden)
+}
@@ -48,7 +48,9 @@ func NewCommand() *cobra.Command {
 func newCommandWithDeps(newClient ClientFactory) *cobra.Command {
 	var (
 		statusOnly bool
+		format     string
 		jsonOut    bool
+		fields     string
 	)
 
 	cmd := &cobra.Command{
@@ -70,29 +72,36 @@ Today the only cached resource is "drives" (shared-drive name → ID lookup).`,
   gro refresh --status
 
   # Control-plane envelope (scripts)
-  gro refresh --status --json`,
+  gro refresh --status --json
+  gro refresh --status --output yaml`,
 		Args:      cobra.OnlyValidArgs,
 		ValidArgs: validResources,
 		RunE: func(cmd *cobra.Command, args []string) error {
-			return run(cmd.Context(), cmd.OutOrStdout(), args, statusOnly, jsonOut, newClient)
+			opts, err := output.ResolveOptions(format, jsonOut, fields)
+			if err != nil {
+				return err
+			}
+			return run(cmd.Context(), cmd.OutOrStdout(), args, statusOnly, opts, newClient)
 		},
 	}
 
 	cmd.Flags().BoolVar(&statusOnly, "status", false, "Print cache freshness; no network calls")
-	cmd.Flags().BoolVarP(&jsonOut, "json", "j", false, "Emit a JSON control-plane envelope")
+	cmd.Flags().StringVarP(&format, "output", "o", output.FormatText, "Output format: text, json or yaml")
+	cmd.Flags().BoolVarP(&jsonOut, "json", "j", false, "Emit a JSON control-plane envelope (shorthand for --output json)")
+	cmd.Flags().StringVar(&fields, "fields", "", "Comma-separated fields to keep for each resource in json/yaml output")
 	return cmd
 }
 
-func run(ctx context.Context, stdout io.Writer, args []string, statusOnly, jsonOut bool, newClient ClientFactory) error {
+func run(ctx context.Context, stdout io.Writer, args []string, statusOnly bool, opts output.Options, newClient ClientFactory) error {
 	selected := args
 	if len(selected) == 0 {
 		selected = validResources
 	}
 
 	if statusOnly {
-		return runStatus(stdout, selected, jsonOut)
+		return runStatus(stdout, selected, opts)
 	}
-	return runRefresh(ctx, stdout, selected, jsonOut, newClient)
+	return runRefresh(ctx, stdout, selected, opts, newClient)
 }
 
 // statusEntry is the per-resource envelope element for --status --json.
@@ -113,7 +122,7 @@ type refreshEntry struct {
 	Error     string     `json:"error,omitempty"`
 }
 
-func runStatus(stdout io.Writer, selected []string, jsonOut bool) error {
+func runStatus(stdout io.Writer, selected []string, opts output.Options) error {
 	c, err := cache.New()
 	if err != nil {
 		return fmt.Errorf("initializing cache: %w", err)
@@ -137,8 +146,12 @@ func runStatus(stdout io.Writer, selected []string, jsonOut bool) error {
 		entries = append(entries, entry)
 	}
 
-	if jsonOut {
-		return output.JSON(stdout, map[string]any{"resources": entries})
+	if opts.Structured() {
+		projected, err := output.Project(entries, opts.Fields)
+		if err != nil {
+			return err
+		}
+		return output.Print(stdout, opts.Format, map[string]any{"resources": projected})
 	}
 	if _, err := fmt.Fprintln(stdout, "RESOURCE | FETCHED_AT | AGE | TTL | STATUS"); err != nil {
 		return err
@@ -157,17 +170,23 @@ func runStatus(stdout io.Writer, selected []string, jsonOut bool) error {
 	return nil
 }
 
-func runRefresh(ctx context.Context, stdout io.Writer, selected []string, jsonOut bool, newClient ClientFactory) error {
-	c, err := cache.New()
-	if err != nil {
-		return fmt.Errorf("initializing cache: %w", err)
+func runRefresh(ctx context.Context, stdout io.Writer, selected []string, opts output.Options, newClient ClientFactory) error {
+	// Reject a bad --fields before any network call or cache write
+	if _, err := output.Project([]refreshEntry{}, opts.Fields); err != nil {
+		return err
 	}
 
+	// The client first: it settles which identity's cache to write
 	client, err := newClient(ctx)
 	if err != nil {
 		return err
 	}
 
+	c, err := cache.New()
+	if err != nil {
+		return fmt.Errorf("initializing cache: %w", err)
+	}
+
 	entries := make([]refreshEntry, 0, len(selected))
 	var firstErr error
 	for _, name := range selected {
@@ -185,10 +204,16 @@ func runRefresh(ctx context.Context, stdout io.Writer, selected []string, jsonOu
 		entries = append(entries, entry)
 	}
 
-	if jsonOut {
-		if writeErr := output.JSON(stdout, map[string]any{"resources": entries}); writeErr != nil {
+	if opts.Structured() {
+		projected, projErr := output.Project(entries, opts.Fields)
+		if projErr != nil {
+			return projErr
+		}
+		if writeErr := output.Print(stdout, opts.Format, map[string]any{"resources": projected}); writeErr != nil {
 			return writeErr
 		}
+		// Each failed entry already carries its error in the envelope
+		return output.Reported(firstErr)
 	} else {
 		for _, e := range entries {
 			if e.Error != "" {
@@ -5,6 +5,7 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"io"
 	"strings"
 	"testing"
 
@@ -12,6 +13,7 @@ import (
 
 	"github.com/open-cli-collective/google-readonly/internal/cache"
 	"github.com/open-cli-collective/google-readonly/internal/drive"
+	"github.com/open-cli-collective/google-readonly/internal/output"
 	"github.com/open-cli-collective/google-readonly/internal/testutil"
 )
 
@@ -129,6 +131,61 @@ func TestRefresh_Status_JSONEnvelope(t *testing.T) {
 	testutil.Equal(t, env.Resources[0].Status, "uninitialized")
 }
 
+func TestRefresh_Status_YAMLEnvelope(t *testing.T) {
+	statedirtest.Hermetic(t)
+
+	cmd := newCommandWithDeps((&panickingFactory{}).factory)
+	cmd.SetArgs([]string{"--status", "--output", "yaml"})
+	var out bytes.Buffer
+	cmd.SetOut(&out)
+	cmd.SetErr(&out)
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("--status --output yaml returned error: %v", err)
+	}
+
+	testutil.Equal(t, out.String(), "resources:\n  - resource: drives\n    ttl: 24h\n    status: uninitialized\n")
+}
+
+func TestRefresh_Status_Fields(t *testing.T) {
+	statedirtest.Hermetic(t)
+
+	cmd := newCommandWithDeps((&panickingFactory{}).factory)
+	cmd.SetArgs([]string{"--status", "--json", "--fields", "status,resource"})
+	var out bytes.Buffer
+	cmd.SetOut(&out)
+	cmd.SetErr(&out)
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("--status --json --fields returned error: %v", err)
+	}
+
+	testutil.Equal(t, out.String(), "{\n  \"resources\": [\n    {\n      \"resource\": \"drives\",\n      \"status\": \"uninitialized\"\n    }\n  ]\n}\n")
+}
+
+func TestRefresh_UnknownFieldFailsBeforeFetching(t *testing.T) {
+	statedirtest.Hermetic(t)
+
+	// panickingFactory panics if the refresh gets as far as building a client
+	cmd := newCommandWithDeps((&panickingFactory{}).factory)
+	cmd.SetArgs([]string{"--json", "--fields", "resource,etag"})
+	cmd.SetOut(io.Discard)
+	cmd.SetErr(io.Discard)
+	err := cmd.Execute()
+	testutil.Error(t, err)
+	testutil.Contains(t, err.Error(), `unknown field "etag"`)
+}
+
+func TestRefresh_JSONConflictsWithYAML(t *testing.T) {
+	statedirtest.Hermetic(t)
+
+	cmd := newCommandWithDeps((&panickingFactory{}).factory)
+	cmd.SetArgs([]string{"--status", "--json", "--output", "yaml"})
+	cmd.SetOut(io.Discard)
+	cmd.SetErr(io.Discard)
+	err := cmd.Execute()
+	testutil.Error(t, err)
+	testutil.Contains(t, err.Error(), "--json cannot be combined with --output yaml")
+}
+
 func TestRefresh_JSONEnvelope_Success(t *testing.T) {
 	statedirtest.Hermetic(t)
 
@@ -229,3 +286,20 @@ func TestRefresh_FactoryConstructionError(t *testing.T) {
 		t.Fatalf("expected error to wrap factoryErr, got %q", err.Error())
 	}
 }
+
+func TestRefresh_JSONEnvelope_ListErrorIsReported(t *testing.T) {
+	statedirtest.Hermetic(t)
+
+	stub := &stubLister{err: errors.New("boom")}
+	cmd := newCommandWithDeps(func(_ context.Context) (DriveLister, error) { return stub, nil })
+	cmd.SetArgs([]string{"--json"})
+	var out bytes.Buffer
+	cmd.SetOut(&out)
+	cmd.SetErr(io.Discard)
+
+	err := cmd.Execute()
+	testutil.Error(t, err)
+	// The envelope carries the error, so the root adds no JSON error object
+	testutil.True(t, output.IsReported(err))
+	testutil.Contains(t, out.String(), `"error": "listing shared drives: boom"`)
+}
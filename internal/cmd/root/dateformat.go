@@ -0,0 +1,70 @@
+package root
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+	"golang.org/x/term"
+
+	"github.com/open-cli-collective/google-readonly/internal/config"
+	"github.com/open-cli-collective/google-readonly/internal/format"
+)
+
+// dateFormatFlag is the name of the global date-format flag
+const dateFormatFlag = "date-format"
+
+// applyDateFormat sets format.DateLayout from --date-format, falling back to
+// the date_format key in config.yml. The config is only read when config.yml
+// already exists so that commands such as `config clear --dry-run` stay free
+// of side effects.
+func applyDateFormat(cmd *cobra.Command) error {
+	value := dateFormat
+	source := "--" + dateFormatFlag
+	if f := cmd.Flag(dateFormatFlag); f == nil || !f.Changed {
+		value = configuredDateFormat()
+		source = "date_format in config.yml"
+	}
+
+	layout, err := format.ParseDateLayout(value)
+	if err != nil {
+		return fmt.Errorf("%s: %w", source, err)
+	}
+	format.DateLayout = layout
+	return nil
+}
+
+// configuredDateFormat returns date_format from config.yml, or "" if the file
+// is absent or unreadable
+func configuredDateFormat() string {
+	path, err := config.GetConfigPathNoCreate()
+	if err != nil {
+		return ""
+	}
+	if _, err := os.Stat(path); err != nil {
+		return ""
+	}
+	cfg, err := config.LoadConfig()
+	if err != nil || cfg == nil {
+		return ""
+	}
+	return cfg.DateFormat
+}
+
+// absoluteTimeFlag is the name of the global flag that turns off relative
+// times
+const absoluteTimeFlag = "absolute-time"
+
+// stdoutIsTerminal reports whether stdout is a terminal; a variable so tests
+// can pretend it is
+var stdoutIsTerminal = func() bool {
+	return term.IsTerminal(int(os.Stdout.Fd()))
+}
+
+// applyRelativeTime turns on relative times ("3 days ago") for a person at a
+// terminal. Piped output, --plain, --absolute-time and a configured date
+// format all keep absolute dates, so scripts and anyone who chose a layout
+// see what they expect. It runs after applyDateFormat.
+func applyRelativeTime() {
+	format.Relative = !absoluteTime && !plain && format.DateLayout == "" && stdoutIsTerminal()
+}
@@ -0,0 +1,68 @@
+package root
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/open-cli-collective/google-readonly/internal/auth"
+	"github.com/open-cli-collective/google-readonly/internal/exit"
+)
+
+// cobraUsagePrefixes start the errors cobra returns on its own, outside
+// flag parsing and Args checks, for a malformed command line
+var cobraUsagePrefixes = []string{
+	"unknown command ",
+	"required flag(s) ",
+	"if any flags in the group ",
+	"at least one of the flags in the group ",
+}
+
+// markUsageErrors makes every command-line mistake in cmd's tree map to
+// exit.Usage: flag parsing errors through the (inherited) flag error func,
+// and argument count errors by wrapping each command's Args check
+func markUsageErrors(cmd *cobra.Command) {
+	cmd.SetFlagErrorFunc(func(_ *cobra.Command, err error) error {
+		return exit.UsageError(err)
+	})
+	wrapArgs(cmd)
+}
+
+func wrapArgs(cmd *cobra.Command) {
+	if check := cmd.Args; check != nil {
+		cmd.Args = func(c *cobra.Command, args []string) error {
+			return exit.UsageError(check(c, args))
+		}
+	}
+	for _, sub := range cmd.Commands() {
+		wrapArgs(sub)
+	}
+}
+
+// usageError marks the errors cobra raises itself for a bad command line,
+// which no hook intercepts, as usage errors
+func usageError(err error) error {
+	if err == nil {
+		return nil
+	}
+	for _, prefix := range cobraUsagePrefixes {
+		if strings.HasPrefix(err.Error(), prefix) {
+			return exit.UsageError(err)
+		}
+	}
+	return err
+}
+
+// scopeError prefixes a 403 for a missing OAuth scope with what to do about
+// it: the raw Google message names the scope problem but not the fix.
+// Clearing first matters because init keeps a token it believes is current.
+func scopeError(err error) error {
+	if !exit.IsScopeError(err) {
+		return err
+	}
+	if auth.UsingServiceAccount() {
+		return fmt.Errorf("a service account is granted read-only scopes only, so this command is not available with --%s: %w", serviceAccountFlag, err)
+	}
+	return fmt.Errorf("your token lacks the scope for this command; run 'gro config clear' then 'gro init' to re-authenticate: %w", err)
+}
@@ -0,0 +1,129 @@
+package root
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"os/exec"
+	"strings"
+	"testing"
+
+	"github.com/open-cli-collective/cli-common/statedirtest"
+	"google.golang.org/api/googleapi"
+	"google.golang.org/api/people/v1"
+
+	"github.com/open-cli-collective/google-readonly/internal/auth"
+	"github.com/open-cli-collective/google-readonly/internal/cmd/contacts"
+	"github.com/open-cli-collective/google-readonly/internal/exit"
+	"github.com/open-cli-collective/google-readonly/internal/keychain"
+	"github.com/open-cli-collective/google-readonly/internal/testutil"
+)
+
+// exitCaseEnv selects the case TestExitCodes runs when it re-executes the
+// test binary as a stand-in gro process
+const exitCaseEnv = "GRO_TEST_EXIT_CASE"
+
+// exitCases are gro invocations whose contacts client fails with err, and
+// the status the process must exit with
+var exitCases = map[string]struct {
+	args []string
+	err  error
+	want int
+}{
+	"success":        {args: []string{"contacts", "list"}, want: exit.OK},
+	"usage args":     {args: []string{"contacts", "list", "extra"}, want: exit.Usage},
+	"usage flag":     {args: []string{"contacts", "list", "--nope"}, want: exit.Usage},
+	"usage command":  {args: []string{"nope"}, want: exit.Usage},
+	"auth no token":  {args: []string{"contacts", "list"}, err: fmt.Errorf("no OAuth token found - please run 'gro init' first: %w", keychain.ErrTokenNotFound), want: exit.Auth},
+	"auth 401":       {args: []string{"contacts", "list"}, err: &googleapi.Error{Code: http.StatusUnauthorized, Message: "Invalid Credentials"}, want: exit.Auth},
+	"auth 403 scope": {args: []string{"contacts", "list"}, err: &googleapi.Error{Code: http.StatusForbidden, Errors: []googleapi.ErrorItem{{Reason: "insufficientPermissions"}}}, want: exit.Auth},
+	"not found":      {args: []string{"contacts", "get", "people/c404"}, err: &googleapi.Error{Code: http.StatusNotFound}, want: exit.NotFound},
+	"network":        {args: []string{"contacts", "list"}, err: &url.Error{Op: "Get", URL: "https://people.googleapis.com", Err: &net.OpError{Op: "dial", Err: errors.New("connection refused")}}, want: exit.Network},
+	"quota":          {args: []string{"contacts", "list"}, err: &googleapi.Error{Code: http.StatusTooManyRequests}, want: exit.Quota},
+	"other failures": {args: []string{"contacts", "list"}, err: &googleapi.Error{Code: http.StatusBadRequest}, want: exit.Failure},
+}
+
+// failingContactsClient answers every call with err
+type failingContactsClient struct {
+	contacts.ContactsClient
+	err error
+}
+
+func (c failingContactsClient) ListContacts(context.Context, string, int64) (*people.ListConnectionsResponse, error) {
+	return &people.ListConnectionsResponse{}, c.err
+}
+
+func (c failingContactsClient) GetContact(context.Context, string) (*people.Person, error) {
+	return nil, c.err
+}
+
+func TestExitCodes(t *testing.T) {
+	if name := os.Getenv(exitCaseEnv); name != "" {
+		runExitCase(t, name)
+		return
+	}
+
+	for name, tc := range exitCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			cmd := exec.Command(os.Args[0], "-test.run=^TestExitCodes$")
+			cmd.Env = append(os.Environ(), exitCaseEnv+"="+name)
+			err := cmd.Run()
+
+			code := 0
+			var exitErr *exec.ExitError
+			if errors.As(err, &exitErr) {
+				code = exitErr.ExitCode()
+			} else {
+				testutil.NoError(t, err)
+			}
+			testutil.Equal(t, code, tc.want)
+		})
+	}
+}
+
+// runExitCase runs one case as a whole gro process: ExecuteContext exits
+// with the classified status, which the parent test reads
+func runExitCase(t *testing.T, name string) {
+	tc, ok := exitCases[name]
+	if !ok {
+		t.Fatalf("unknown exit case %q", name)
+	}
+	statedirtest.Hermetic(t)
+	contacts.ClientFactory = func(context.Context) (contacts.ContactsClient, error) {
+		return failingContactsClient{err: tc.err}, nil
+	}
+	rootCmd.SetArgs(tc.args)
+	ExecuteContext(context.Background())
+	os.Exit(exit.OK)
+}
+
+func TestScopeError(t *testing.T) {
+	scope := &googleapi.Error{
+		Code:    http.StatusForbidden,
+		Message: "Request had insufficient authentication scopes.",
+		Errors:  []googleapi.ErrorItem{{Reason: "ACCESS_TOKEN_SCOPE_INSUFFICIENT"}},
+	}
+	err := scopeError(fmt.Errorf("listing files: %w", scope))
+	testutil.Contains(t, err.Error(), "your token lacks the scope for this command")
+	testutil.Contains(t, err.Error(), "'gro init'")
+	testutil.Contains(t, err.Error(), "listing files: ")
+	testutil.True(t, errors.Is(err, scope))
+	testutil.Equal(t, exit.Code(err), exit.Auth)
+
+	forbidden := &googleapi.Error{Code: http.StatusForbidden, Errors: []googleapi.ErrorItem{{Reason: "forbidden"}}}
+	testutil.True(t, scopeError(forbidden) == error(forbidden))
+	testutil.True(t, scopeError(nil) == nil)
+
+	// A service account only ever has the read-only scopes, so re-running
+	// init would not help
+	testutil.NoError(t, auth.UseServiceAccount("key.json", "user@example.com"))
+	t.Cleanup(func() { _ = auth.UseServiceAccount("", "") })
+	err = scopeError(scope)
+	testutil.Contains(t, err.Error(), "not available with --service-account")
+	testutil.True(t, !strings.Contains(err.Error(), "gro init"))
+}
@@ -0,0 +1,42 @@
+package root
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/open-cli-collective/google-readonly/internal/exit"
+	"github.com/open-cli-collective/google-readonly/internal/output"
+)
+
+// jsonError is what a command run with --json prints on stdout when it
+// fails, so JSON consumers get a parseable answer either way
+type jsonError struct {
+	Error string `json:"error"`
+	Code  string `json:"code"`
+}
+
+// wantsJSON reports whether cmd was asked for JSON through --json or
+// --output json. Only commands with a --json flag are considered: elsewhere
+// --output names a file or directory, not a format.
+func wantsJSON(cmd *cobra.Command) bool {
+	if cmd == nil {
+		return false
+	}
+	jsonFlag := cmd.Flags().Lookup("json")
+	if jsonFlag == nil {
+		return false
+	}
+	if jsonFlag.Value.String() == "true" {
+		return true
+	}
+	outputFlag := cmd.Flags().Lookup("output")
+	return outputFlag != nil && outputFlag.Value.String() == output.FormatJSON
+}
+
+// writeJSONError prints err as a jsonError on cmd's stdout when cmd was
+// asked for JSON and has not already described the failure in its output
+func writeJSONError(cmd *cobra.Command, err error) {
+	if err == nil || !wantsJSON(cmd) || output.IsReported(err) {
+		return
+	}
+	_ = output.JSON(cmd.OutOrStdout(), jsonError{Error: err.Error(), Code: exit.Name(exit.Code(err))})
+}
@@ -0,0 +1,105 @@
+package root
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"google.golang.org/api/googleapi"
+
+	"github.com/open-cli-collective/google-readonly/internal/output"
+	"github.com/open-cli-collective/google-readonly/internal/testutil"
+)
+
+// mountJSONProbe registers a command with the control-plane --output/--json
+// flags whose RunE fails with err
+func mountJSONProbe(t *testing.T, err error) {
+	t.Helper()
+	var format string
+	var jsonOut bool
+	probe := &cobra.Command{
+		Use:  "probe-json-error",
+		RunE: func(_ *cobra.Command, _ []string) error { return err },
+	}
+	probe.Flags().StringVarP(&format, "output", "o", output.FormatText, "Output format")
+	probe.Flags().BoolVarP(&jsonOut, "json", "j", false, "Emit JSON")
+	rootCmd.AddCommand(probe)
+	rootCmd.SilenceUsage = true
+	rootCmd.SilenceErrors = true
+	t.Cleanup(func() {
+		rootCmd.RemoveCommand(probe)
+		rootCmd.SetArgs(nil)
+		rootCmd.SilenceUsage = false
+		rootCmd.SilenceErrors = false
+	})
+}
+
+func TestJSONErrorObject(t *testing.T) {
+	notFound := fmt.Errorf("getting file: %w", &googleapi.Error{Code: http.StatusNotFound, Message: "File not found"})
+
+	for _, args := range [][]string{{"--json"}, {"--output", "json"}} {
+		t.Run(args[0], func(t *testing.T) {
+			mountJSONProbe(t, notFound)
+			rootCmd.SetArgs(append([]string{"probe-json-error"}, args...))
+
+			var err error
+			out := testutil.CaptureStdout(t, func() {
+				err = runRoot(context.Background())
+			})
+			testutil.Error(t, err)
+
+			var got jsonError
+			testutil.NoError(t, json.Unmarshal([]byte(out), &got))
+			testutil.Equal(t, got.Code, "not_found")
+			testutil.Equal(t, got.Error, notFound.Error())
+		})
+	}
+}
+
+func TestJSONErrorObject_UsageError(t *testing.T) {
+	mountJSONProbe(t, nil)
+	rootCmd.SetArgs([]string{"probe-json-error", "--json", "--nope"})
+
+	out := testutil.CaptureStdout(t, func() {
+		testutil.Error(t, runRoot(context.Background()))
+	})
+
+	var got jsonError
+	testutil.NoError(t, json.Unmarshal([]byte(out), &got))
+	testutil.Equal(t, got.Code, "usage")
+}
+
+func TestJSONErrorObject_NotWritten(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		args []string
+	}{
+		{"text output", errors.New("boom"), nil},
+		{"yaml output", errors.New("boom"), []string{"--output", "yaml"}},
+		{"already reported", output.Reported(errors.New("boom")), []string{"--json"}},
+		{"success", nil, []string{"--json"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mountJSONProbe(t, tt.err)
+			rootCmd.SetArgs(append([]string{"probe-json-error"}, tt.args...))
+
+			out := testutil.CaptureStdout(t, func() {
+				_ = runRoot(context.Background())
+			})
+			testutil.Equal(t, out, "")
+		})
+	}
+}
+
+func TestWantsJSON_OutputPathIsNotAFormat(t *testing.T) {
+	cmd := &cobra.Command{Use: "download"}
+	cmd.Flags().StringP("output", "o", "", "Output file path")
+	testutil.NoError(t, cmd.Flags().Set("output", "json"))
+	testutil.False(t, wantsJSON(cmd))
+}
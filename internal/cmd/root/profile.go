@@ -0,0 +1,29 @@
+package root
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/open-cli-collective/google-readonly/internal/config"
+)
+
+// profileFlag is the name of the global profile flag
+const profileFlag = "profile"
+
+// applyProfile selects the active profile from --profile, falling back to
+// GRO_PROFILE. It must run before anything reads config.yml or the keyring,
+// since both resolve against the active profile.
+func applyProfile(cmd *cobra.Command) error {
+	value := profile
+	source := "--" + profileFlag
+	if f := cmd.Flag(profileFlag); f == nil || !f.Changed {
+		value = os.Getenv(config.ProfileEnvVar)
+		source = config.ProfileEnvVar
+	}
+	if err := config.SetProfile(value); err != nil {
+		return fmt.Errorf("%s: %w", source, err)
+	}
+	return nil
+}
@@ -5,31 +5,50 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"time"
 
-	"github.com/charmbracelet/lipgloss"
-	"github.com/muesli/termenv"
 	"github.com/spf13/cobra"
 
 	cccredstore "github.com/open-cli-collective/cli-common/credstore"
 
+	"github.com/open-cli-collective/google-readonly/internal/cache"
 	"github.com/open-cli-collective/google-readonly/internal/cmd/calendar"
+	"github.com/open-cli-collective/google-readonly/internal/cmd/completioncmd"
 	"github.com/open-cli-collective/google-readonly/internal/cmd/config"
 	"github.com/open-cli-collective/google-readonly/internal/cmd/contacts"
+	"github.com/open-cli-collective/google-readonly/internal/cmd/doctorcmd"
 	"github.com/open-cli-collective/google-readonly/internal/cmd/drive"
 	"github.com/open-cli-collective/google-readonly/internal/cmd/initcmd"
 	"github.com/open-cli-collective/google-readonly/internal/cmd/mail"
+	"github.com/open-cli-collective/google-readonly/internal/cmd/mancmd"
 	"github.com/open-cli-collective/google-readonly/internal/cmd/me"
 	"github.com/open-cli-collective/google-readonly/internal/cmd/refreshcmd"
 	"github.com/open-cli-collective/google-readonly/internal/cmd/setcred"
+	"github.com/open-cli-collective/google-readonly/internal/cmd/versioncmd"
+	"github.com/open-cli-collective/google-readonly/internal/color"
+	"github.com/open-cli-collective/google-readonly/internal/exit"
+	"github.com/open-cli-collective/google-readonly/internal/format"
 	"github.com/open-cli-collective/google-readonly/internal/keychain"
 	"github.com/open-cli-collective/google-readonly/internal/log"
 	"github.com/open-cli-collective/google-readonly/internal/migrationsink"
+	"github.com/open-cli-collective/google-readonly/internal/progress"
 	"github.com/open-cli-collective/google-readonly/internal/version"
 )
 
 var (
-	verbose bool
-	noColor bool
+	verbose      bool
+	quiet        bool
+	noColor      bool
+	noHeaders    bool
+	plain        bool
+	dateFormat   string
+	absoluteTime bool
+	profile      string
+	timeout      time.Duration
+	noCache      bool
+
+	serviceAccountKey string
+	impersonate       string
 )
 
 var rootCmd = &cobra.Command{
@@ -49,9 +68,31 @@ This will guide you through OAuth setup for Google API access.`,
 	Version: version.Version,
 	PersistentPreRunE: func(cmd *cobra.Command, _ []string) error {
 		log.Verbose = verbose
+		log.Quiet = quiet
+		progress.Disabled = quiet
+		if err := applyProfile(cmd); err != nil {
+			return err
+		}
+		if err := applyTimeout(cmd); err != nil {
+			return err
+		}
+		if err := applyServiceAccount(cmd); err != nil {
+			return err
+		}
+		if plain {
+			noColor = true
+			noHeaders = true
+		}
+		format.NoHeaders = noHeaders
+		format.Plain = plain
+		cache.NoCache = noCache
 		if noColor {
-			lipgloss.DefaultRenderer().SetColorProfile(termenv.Ascii)
+			color.Disable()
+		}
+		if err := applyDateFormat(cmd); err != nil {
+			return err
 		}
+		applyRelativeTime()
 		return WireBackendSelection(cmd)
 	},
 }
@@ -89,11 +130,12 @@ func Execute() {
 
 // ExecuteContext runs the root command with the given context. os.Exit stays
 // strictly AFTER runRoot returns so runRoot's deferred FlushMigrationNotice
-// is never skipped by the exit (it would be if the defer lived here).
+// is never skipped by the exit (it would be if the defer lived here). The
+// exit status classifies the error; see the exit package.
 func ExecuteContext(ctx context.Context) {
 	if err := runRoot(ctx); err != nil {
 		fmt.Fprintln(os.Stderr, err)
-		os.Exit(1)
+		os.Exit(exit.Code(err))
 	}
 }
 
@@ -103,10 +145,15 @@ func ExecuteContext(ctx context.Context) {
 // the signal if the one-time migration succeeded but the command then
 // failed). A JSON command consumes the record via output.JSON, so this is a
 // no-op for it; everything else gets the human stderr line. Stderr never
-// corrupts a --json stdout body.
+// corrupts a --json stdout body. A --json command that fails also gets a
+// JSON error object on stdout.
 func runRoot(ctx context.Context) error {
 	defer migrationsink.FlushMigrationNotice(os.Stderr)
-	return rootCmd.ExecuteContext(ctx)
+	defer func() { cancelTimeout() }()
+	cmd, err := rootCmd.ExecuteContextC(ctx)
+	err = usageError(timeoutError(scopeError(err)))
+	writeJSONError(cmd, err)
+	return err
 }
 
 func init() {
@@ -115,7 +162,17 @@ func init() {
 
 	// Global flags
 	rootCmd.PersistentFlags().BoolVarP(&verbose, "verbose", "v", false, "Enable verbose output for debugging")
+	rootCmd.PersistentFlags().BoolVarP(&quiet, "quiet", "q", false, "Suppress informational messages such as \"Saved to: ...\"; errors still go to stderr")
 	rootCmd.PersistentFlags().BoolVar(&noColor, "no-color", false, "Disable colored output")
+	rootCmd.PersistentFlags().BoolVar(&noHeaders, "no-headers", false, "Omit column headers from table output")
+	rootCmd.PersistentFlags().BoolVar(&plain, "plain", false, "Plain greppable output: implies --no-color and --no-headers, and drops tree and rule glyphs")
+	rootCmd.PersistentFlags().BoolVar(&absoluteTime, absoluteTimeFlag, false, "Show dates as dates, not relative times such as \"3 days ago\"")
+	rootCmd.PersistentFlags().StringVar(&dateFormat, dateFormatFlag, "", "Date format for text output: iso, us, eu, rfc822, or a Go layout")
+	rootCmd.PersistentFlags().StringVarP(&profile, profileFlag, "p", "", "Account profile to use (default $GRO_PROFILE, else \"default\")")
+	rootCmd.PersistentFlags().DurationVar(&timeout, timeoutFlag, 0, "Abort API calls after this long, e.g. 30s or 2m (default: no timeout)")
+	rootCmd.PersistentFlags().BoolVar(&noCache, "no-cache", false, "Fetch from the API instead of gro's cache (the cache is still updated)")
+	rootCmd.PersistentFlags().StringVar(&serviceAccountKey, serviceAccountFlag, "", "Service account key file for domain-wide delegation (default $GRO_SERVICE_ACCOUNT); needs --impersonate")
+	rootCmd.PersistentFlags().StringVar(&impersonate, impersonateFlag, "", "User to act as with --service-account (default $GRO_IMPERSONATE)")
 	rootCmd.PersistentFlags().String(cccredstore.BackendFlagName, "", cccredstore.BackendFlagUsage())
 
 	// Register commands
@@ -128,4 +185,10 @@ func init() {
 	rootCmd.AddCommand(contacts.NewCommand())
 	rootCmd.AddCommand(drive.NewCommand())
 	rootCmd.AddCommand(refreshcmd.NewCommand())
+	rootCmd.AddCommand(doctorcmd.NewCommand())
+	rootCmd.AddCommand(versioncmd.NewCommand())
+	rootCmd.AddCommand(completioncmd.NewCommand())
+	rootCmd.AddCommand(mancmd.NewCommand())
+
+	markUsageErrors(rootCmd)
 }
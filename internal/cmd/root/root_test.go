@@ -3,10 +3,13 @@ package root
 import (
 	"bytes"
 	"context"
+	"errors"
+	"fmt"
 	"io"
 	"os"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/charmbracelet/lipgloss"
 	"github.com/muesli/termenv"
@@ -14,7 +17,13 @@ import (
 
 	"github.com/open-cli-collective/cli-common/credstore"
 
+	"github.com/open-cli-collective/google-readonly/internal/auth"
+	"github.com/open-cli-collective/google-readonly/internal/cache"
+	"github.com/open-cli-collective/google-readonly/internal/config"
+	"github.com/open-cli-collective/google-readonly/internal/format"
+	"github.com/open-cli-collective/google-readonly/internal/log"
 	"github.com/open-cli-collective/google-readonly/internal/migrationsink"
+	"github.com/open-cli-collective/google-readonly/internal/progress"
 	"github.com/open-cli-collective/google-readonly/internal/testutil"
 )
 
@@ -50,9 +59,25 @@ func TestRootCommand(t *testing.T) {
 		testutil.SliceContains(t, names, "calendar")
 		testutil.SliceContains(t, names, "contacts")
 		testutil.SliceContains(t, names, "set-credential")
+		testutil.SliceContains(t, names, "man")
 	})
 }
 
+// TestEveryCommandHasLongHelp keeps the generated man pages useful: a page's
+// DESCRIPTION is the command's Long text
+func TestEveryCommandHasLongHelp(t *testing.T) {
+	var walk func(cmd *cobra.Command)
+	walk = func(cmd *cobra.Command) {
+		if !cmd.Hidden && strings.TrimSpace(cmd.Long) == "" {
+			t.Errorf("%s has no Long description", cmd.CommandPath())
+		}
+		for _, sub := range cmd.Commands() {
+			walk(sub)
+		}
+	}
+	walk(rootCmd)
+}
+
 // TestRunRootFlushesMigrationNoticeOnError proves the real defer placement:
 // runRoot's deferred FlushMigrationNotice fires even when the command errors
 // (Cobra would skip a PersistentPostRunE here), and before any os.Exit
@@ -188,3 +213,322 @@ func TestPersistentPreRunE_NoColorFalseLeavesRendererUntouched(t *testing.T) {
 		t.Fatalf("expected renderer untouched when noColor=false, got %v", got)
 	}
 }
+
+func TestDateFormatFlagThroughCobra(t *testing.T) {
+	probe := &cobra.Command{
+		Use:  "probe-date-format-flag-wiring",
+		RunE: func(_ *cobra.Command, _ []string) error { return nil },
+	}
+	rootCmd.AddCommand(probe)
+	t.Cleanup(func() {
+		rootCmd.RemoveCommand(probe)
+		rootCmd.SetArgs(nil)
+		dateFormat = ""
+		format.DateLayout = ""
+	})
+
+	t.Run("sets preset layout", func(t *testing.T) {
+		rootCmd.SetArgs([]string{"--date-format", "iso", "probe-date-format-flag-wiring"})
+		if err := rootCmd.Execute(); err != nil {
+			t.Fatalf("execute: %v", err)
+		}
+		testutil.Equal(t, format.DateLayout, "2006-01-02 15:04")
+	})
+
+	t.Run("rejects invalid layout", func(t *testing.T) {
+		rootCmd.SetArgs([]string{"--date-format", "german", "probe-date-format-flag-wiring"})
+		rootCmd.SilenceUsage = true
+		rootCmd.SilenceErrors = true
+		defer func() {
+			rootCmd.SilenceUsage = false
+			rootCmd.SilenceErrors = false
+		}()
+		err := rootCmd.Execute()
+		testutil.Error(t, err)
+		testutil.Contains(t, err.Error(), "--date-format")
+	})
+}
+
+func TestPlainFlagThroughCobra(t *testing.T) {
+	withRenderer(t, termenv.ANSI)
+
+	probe := &cobra.Command{
+		Use:  "probe-plain-flag-wiring",
+		RunE: func(_ *cobra.Command, _ []string) error { return nil },
+	}
+	rootCmd.AddCommand(probe)
+	t.Cleanup(func() {
+		rootCmd.RemoveCommand(probe)
+		rootCmd.SetArgs(nil)
+		noColor = false
+		noHeaders = false
+		plain = false
+		format.NoHeaders = false
+		format.Plain = false
+	})
+
+	rootCmd.SetArgs([]string{"--plain", "probe-plain-flag-wiring"})
+	if err := rootCmd.Execute(); err != nil {
+		t.Fatalf("execute: %v", err)
+	}
+
+	testutil.True(t, noColor)
+	testutil.True(t, format.NoHeaders)
+	testutil.True(t, format.Plain)
+	if got := lipgloss.DefaultRenderer().ColorProfile(); got != termenv.Ascii {
+		t.Fatalf("expected Ascii under --plain, got %v", got)
+	}
+}
+
+func TestNoHeadersFlagThroughCobra(t *testing.T) {
+	probe := &cobra.Command{
+		Use:  "probe-no-headers-flag-wiring",
+		RunE: func(_ *cobra.Command, _ []string) error { return nil },
+	}
+	rootCmd.AddCommand(probe)
+	t.Cleanup(func() {
+		rootCmd.RemoveCommand(probe)
+		rootCmd.SetArgs(nil)
+		noHeaders = false
+		format.NoHeaders = false
+	})
+
+	rootCmd.SetArgs([]string{"--no-headers", "probe-no-headers-flag-wiring"})
+	if err := rootCmd.Execute(); err != nil {
+		t.Fatalf("execute: %v", err)
+	}
+
+	testutil.True(t, format.NoHeaders)
+	testutil.False(t, format.Plain)
+}
+
+func TestNoCacheFlagThroughCobra(t *testing.T) {
+	probe := &cobra.Command{
+		Use:  "probe-no-cache-flag-wiring",
+		RunE: func(_ *cobra.Command, _ []string) error { return nil },
+	}
+	rootCmd.AddCommand(probe)
+	t.Cleanup(func() {
+		rootCmd.RemoveCommand(probe)
+		rootCmd.SetArgs(nil)
+		noCache = false
+		cache.NoCache = false
+	})
+
+	rootCmd.SetArgs([]string{"--no-cache", "probe-no-cache-flag-wiring"})
+	if err := rootCmd.Execute(); err != nil {
+		t.Fatalf("execute: %v", err)
+	}
+
+	testutil.True(t, cache.NoCache)
+}
+
+func TestQuietFlagThroughCobra(t *testing.T) {
+	probe := &cobra.Command{
+		Use: "probe-quiet-wiring",
+		RunE: func(_ *cobra.Command, _ []string) error {
+			log.Status("Saved to: report.pdf")
+			fmt.Println("result")
+			return nil
+		},
+	}
+	rootCmd.AddCommand(probe)
+	t.Cleanup(func() {
+		rootCmd.RemoveCommand(probe)
+		rootCmd.SetArgs(nil)
+		quiet = false
+		log.Quiet = false
+		progress.Disabled = false
+	})
+
+	rootCmd.SetArgs([]string{"-q", "probe-quiet-wiring"})
+	output := testutil.CaptureStdout(t, func() {
+		testutil.NoError(t, rootCmd.Execute())
+	})
+
+	testutil.Equal(t, output, "result\n")
+	testutil.True(t, progress.Disabled)
+}
+
+func TestProfileSelectionThroughCobra(t *testing.T) {
+	probe := &cobra.Command{
+		Use:  "probe-profile-wiring",
+		RunE: func(_ *cobra.Command, _ []string) error { return nil },
+	}
+	rootCmd.AddCommand(probe)
+	t.Cleanup(func() {
+		rootCmd.RemoveCommand(probe)
+		rootCmd.SetArgs(nil)
+		profile = ""
+		rootCmd.PersistentFlags().Lookup(profileFlag).Changed = false
+		_ = config.SetProfile("")
+	})
+
+	t.Run("GRO_PROFILE selects the profile", func(t *testing.T) {
+		t.Setenv(config.ProfileEnvVar, "personal")
+		rootCmd.SetArgs([]string{"probe-profile-wiring"})
+		testutil.NoError(t, rootCmd.Execute())
+		testutil.Equal(t, config.ActiveProfile(), "personal")
+	})
+
+	t.Run("--profile wins over GRO_PROFILE", func(t *testing.T) {
+		t.Setenv(config.ProfileEnvVar, "personal")
+		rootCmd.SetArgs([]string{"-p", "work", "probe-profile-wiring"})
+		testutil.NoError(t, rootCmd.Execute())
+		testutil.Equal(t, config.ActiveProfile(), "work")
+	})
+
+	t.Run("invalid name is rejected", func(t *testing.T) {
+		rootCmd.SetArgs([]string{"--profile", "../work", "probe-profile-wiring"})
+		err := rootCmd.Execute()
+		testutil.Error(t, err)
+		testutil.Contains(t, err.Error(), "--profile")
+	})
+}
+
+func TestTimeoutFlagThroughCobra(t *testing.T) {
+	var deadline time.Time
+	var hasDeadline bool
+	probe := &cobra.Command{
+		Use: "probe-timeout-wiring",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			deadline, hasDeadline = cmd.Context().Deadline()
+			if !hasDeadline {
+				return nil
+			}
+			<-cmd.Context().Done()
+			return fmt.Errorf("listing messages: %w", cmd.Context().Err())
+		},
+	}
+	rootCmd.AddCommand(probe)
+	rootCmd.SilenceUsage = true
+	rootCmd.SilenceErrors = true
+	t.Cleanup(func() {
+		rootCmd.RemoveCommand(probe)
+		rootCmd.SetArgs(nil)
+		rootCmd.SilenceUsage = false
+		rootCmd.SilenceErrors = false
+		timeout = 0
+		rootCmd.PersistentFlags().Lookup(timeoutFlag).Changed = false
+	})
+
+	t.Run("no deadline by default", func(t *testing.T) {
+		rootCmd.SetArgs([]string{"probe-timeout-wiring"})
+		testutil.NoError(t, runRoot(context.Background()))
+		testutil.False(t, hasDeadline)
+	})
+
+	t.Run("--timeout sets a deadline that reaches the command", func(t *testing.T) {
+		start := time.Now()
+		rootCmd.SetArgs([]string{"--timeout", "20ms", "probe-timeout-wiring"})
+		err := runRoot(context.Background())
+		testutil.True(t, hasDeadline)
+		testutil.True(t, deadline.Sub(start) <= time.Second)
+		testutil.Error(t, err)
+		testutil.True(t, errors.Is(err, context.DeadlineExceeded))
+		testutil.Contains(t, err.Error(), "--timeout 20ms reached")
+	})
+
+	t.Run("negative timeout is rejected", func(t *testing.T) {
+		rootCmd.SetArgs([]string{"--timeout", "-1s", "probe-timeout-wiring"})
+		err := runRoot(context.Background())
+		testutil.Error(t, err)
+		testutil.Contains(t, err.Error(), "--timeout must not be negative")
+	})
+}
+
+func TestServiceAccountFlagsThroughCobra(t *testing.T) {
+	probe := &cobra.Command{
+		Use:  "probe-service-account-wiring",
+		RunE: func(_ *cobra.Command, _ []string) error { return nil },
+	}
+	rootCmd.AddCommand(probe)
+	t.Cleanup(func() {
+		rootCmd.RemoveCommand(probe)
+		rootCmd.SetArgs(nil)
+		serviceAccountKey, impersonate = "", ""
+		rootCmd.PersistentFlags().Lookup(serviceAccountFlag).Changed = false
+		rootCmd.PersistentFlags().Lookup(impersonateFlag).Changed = false
+		_ = auth.UseServiceAccount("", "")
+	})
+	reset := func() {
+		serviceAccountKey, impersonate = "", ""
+		rootCmd.PersistentFlags().Lookup(serviceAccountFlag).Changed = false
+		rootCmd.PersistentFlags().Lookup(impersonateFlag).Changed = false
+	}
+
+	t.Run("OAuth by default", func(t *testing.T) {
+		reset()
+		t.Setenv(auth.ServiceAccountEnvVar, "")
+		t.Setenv(auth.ImpersonateEnvVar, "")
+		rootCmd.SetArgs([]string{"probe-service-account-wiring"})
+		testutil.NoError(t, rootCmd.Execute())
+		testutil.False(t, auth.UsingServiceAccount())
+	})
+
+	t.Run("flags select a service account", func(t *testing.T) {
+		reset()
+		rootCmd.SetArgs([]string{"--service-account", "key.json", "--impersonate", "user@example.com", "probe-service-account-wiring"})
+		testutil.NoError(t, rootCmd.Execute())
+		testutil.True(t, auth.UsingServiceAccount())
+	})
+
+	t.Run("environment selects a service account", func(t *testing.T) {
+		reset()
+		t.Setenv(auth.ServiceAccountEnvVar, "key.json")
+		t.Setenv(auth.ImpersonateEnvVar, "user@example.com")
+		rootCmd.SetArgs([]string{"probe-service-account-wiring"})
+		testutil.NoError(t, rootCmd.Execute())
+		testutil.True(t, auth.UsingServiceAccount())
+	})
+
+	t.Run("a key without a user is rejected", func(t *testing.T) {
+		reset()
+		t.Setenv(auth.ImpersonateEnvVar, "")
+		rootCmd.SetArgs([]string{"--service-account", "key.json", "probe-service-account-wiring"})
+		err := rootCmd.Execute()
+		testutil.Error(t, err)
+		testutil.Contains(t, err.Error(), "needs a user to impersonate")
+	})
+}
+
+func TestRelativeTimeThroughCobra(t *testing.T) {
+	probe := &cobra.Command{
+		Use:  "probe-relative-time-wiring",
+		RunE: func(_ *cobra.Command, _ []string) error { return nil },
+	}
+	rootCmd.AddCommand(probe)
+	origTTY := stdoutIsTerminal
+	t.Cleanup(func() {
+		rootCmd.RemoveCommand(probe)
+		rootCmd.SetArgs(nil)
+		stdoutIsTerminal = origTTY
+		absoluteTime = false
+		dateFormat = ""
+		format.DateLayout = ""
+		format.Relative = false
+	})
+
+	tests := []struct {
+		name string
+		tty  bool
+		args []string
+		want bool
+	}{
+		{"terminal", true, nil, true},
+		{"piped", false, nil, false},
+		{"absolute-time", true, []string{"--absolute-time"}, false},
+		{"date-format", true, []string{"--date-format", "iso"}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			stdoutIsTerminal = func() bool { return tt.tty }
+			absoluteTime, dateFormat = false, ""
+			rootCmd.SetArgs(append(tt.args, "probe-relative-time-wiring"))
+			if err := rootCmd.Execute(); err != nil {
+				t.Fatalf("execute: %v", err)
+			}
+			testutil.Equal(t, format.Relative, tt.want)
+		})
+	}
+}
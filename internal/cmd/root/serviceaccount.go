@@ -0,0 +1,33 @@
+package root
+
+import (
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/open-cli-collective/google-readonly/internal/auth"
+)
+
+// Names of the global service account flags
+const (
+	serviceAccountFlag = "service-account"
+	impersonateFlag    = "impersonate"
+)
+
+// applyServiceAccount selects service account impersonation from
+// --service-account and --impersonate, each falling back to its environment
+// variable. With neither set, commands keep using the stored OAuth token.
+func applyServiceAccount(cmd *cobra.Command) error {
+	return auth.UseServiceAccount(
+		flagOrEnv(cmd, serviceAccountFlag, serviceAccountKey, auth.ServiceAccountEnvVar),
+		flagOrEnv(cmd, impersonateFlag, impersonate, auth.ImpersonateEnvVar),
+	)
+}
+
+// flagOrEnv returns value when the flag name was given, else the variable env
+func flagOrEnv(cmd *cobra.Command, name, value, env string) string {
+	if f := cmd.Flag(name); f != nil && f.Changed {
+		return value
+	}
+	return os.Getenv(env)
+}
@@ -0,0 +1,41 @@
+package root
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/spf13/cobra"
+)
+
+// timeoutFlag is the name of the global timeout flag
+const timeoutFlag = "timeout"
+
+// cancelTimeout releases the --timeout context. runRoot calls it once the
+// command has returned.
+var cancelTimeout context.CancelFunc = func() {}
+
+// applyTimeout bounds the command's context by --timeout. Every client call
+// takes its context from cmd.Context(), so the deadline reaches the HTTP
+// layer. Zero, the default, leaves the context without a deadline.
+func applyTimeout(cmd *cobra.Command) error {
+	if timeout < 0 {
+		return fmt.Errorf("--%s must not be negative, got %s", timeoutFlag, timeout)
+	}
+	if timeout == 0 {
+		return nil
+	}
+	ctx, cancel := context.WithTimeout(cmd.Context(), timeout)
+	cancelTimeout = cancel
+	cmd.SetContext(ctx)
+	return nil
+}
+
+// timeoutError names --timeout when err is its deadline expiring, since a
+// bare "context deadline exceeded" does not say which deadline
+func timeoutError(err error) error {
+	if timeout > 0 && errors.Is(err, context.DeadlineExceeded) {
+		return fmt.Errorf("%w (--%s %s reached)", err, timeoutFlag, timeout)
+	}
+	return err
+}
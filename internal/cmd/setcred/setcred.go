@@ -16,6 +16,7 @@ import (
 	"golang.org/x/oauth2"
 
 	"github.com/open-cli-collective/google-readonly/internal/keychain"
+	"github.com/open-cli-collective/google-readonly/internal/log"
 )
 
 type options struct {
@@ -114,7 +115,7 @@ func run(opts *options) error {
 		return err
 	}
 	// Naming the key/ref is fine; the value is never printed (§1.12).
-	fmt.Printf("Stored %s in %s\n", opts.key, st.Ref())
+	log.Status("Stored %s in %s", opts.key, st.Ref())
 	return nil
 }
 
@@ -0,0 +1,58 @@
+// Package versioncmd implements `gro version`, which reports how the
+// running binary was built. It needs no credentials or config, so it works
+// on a fresh install and can be pasted into a bug report.
+package versioncmd
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/spf13/cobra"
+
+	"github.com/open-cli-collective/google-readonly/internal/output"
+	"github.com/open-cli-collective/google-readonly/internal/version"
+)
+
+// NewCommand returns the version command
+func NewCommand() *cobra.Command {
+	var (
+		format  string
+		jsonOut bool
+		fields  string
+	)
+
+	cmd := &cobra.Command{
+		Use:   "version",
+		Short: "Show gro's version and build details",
+		Long: `Show the version, git commit and build date of this gro binary, and the
+Go version and platform it was built for. Include this in bug reports.
+
+Examples:
+  gro version
+  gro version --json
+  gro version --json --fields version,commit`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			opts, err := output.ResolveOptions(format, jsonOut, fields)
+			if err != nil {
+				return err
+			}
+			return run(cmd.OutOrStdout(), version.Get(), opts)
+		},
+	}
+
+	cmd.Flags().StringVarP(&format, "output", "o", output.FormatText, "Output format: text, json or yaml")
+	cmd.Flags().BoolVarP(&jsonOut, "json", "j", false, "Emit JSON (shorthand for --output json)")
+	cmd.Flags().StringVar(&fields, "fields", "", "Comma-separated fields to keep in json/yaml output")
+
+	return cmd
+}
+
+func run(w io.Writer, info version.BuildInfo, opts output.Options) error {
+	if opts.Structured() {
+		return opts.Print(w, info)
+	}
+	_, err := fmt.Fprintf(w, "gro %s\n  commit:   %s\n  built:    %s\n  go:       %s\n  platform: %s\n",
+		info.Version, info.Commit, info.Date, info.GoVersion, info.Platform)
+	return err
+}
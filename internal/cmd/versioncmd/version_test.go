@@ -0,0 +1,59 @@
+package versioncmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/open-cli-collective/google-readonly/internal/credtest"
+	"github.com/open-cli-collective/google-readonly/internal/testutil"
+	"github.com/open-cli-collective/google-readonly/internal/version"
+)
+
+func execute(t *testing.T, args ...string) (string, error) {
+	t.Helper()
+	cmd := NewCommand()
+	cmd.SetArgs(args)
+	var out bytes.Buffer
+	cmd.SetOut(&out)
+	cmd.SetErr(&out)
+	err := cmd.Execute()
+	return out.String(), err
+}
+
+func TestVersion_Text(t *testing.T) {
+	// No OAuth client, token or config: version must not need them
+	credtest.Setup(t)
+
+	out, err := execute(t)
+	testutil.NoError(t, err)
+	info := version.Get()
+	testutil.Contains(t, out, "gro "+info.Version+"\n")
+	testutil.Contains(t, out, "commit:   "+info.Commit)
+	testutil.Contains(t, out, "go:       "+info.GoVersion)
+	testutil.Contains(t, out, "platform: "+info.Platform)
+}
+
+func TestVersion_JSON(t *testing.T) {
+	out, err := execute(t, "--json")
+	testutil.NoError(t, err)
+
+	var got version.BuildInfo
+	testutil.NoError(t, json.Unmarshal([]byte(out), &got))
+	testutil.Equal(t, got, version.Get())
+}
+
+func TestVersion_Fields(t *testing.T) {
+	out, err := execute(t, "-o", "json", "--fields", "version")
+	testutil.NoError(t, err)
+
+	var got map[string]any
+	testutil.NoError(t, json.Unmarshal([]byte(out), &got))
+	testutil.Equal(t, len(got), 1)
+	testutil.Equal(t, got["version"], any(version.Get().Version))
+}
+
+func TestVersion_FieldsNeedStructuredOutput(t *testing.T) {
+	_, err := execute(t, "--fields", "version")
+	testutil.Error(t, err)
+}
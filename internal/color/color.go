@@ -0,0 +1,61 @@
+// Package color styles human-readable output. It renders through lipgloss's
+// default renderer, which emits ANSI codes only when stdout is a terminal
+// and NO_COLOR is unset, so piped output and tests stay plain. --no-color
+// (and --plain) turn it off for the whole run via Disable.
+package color
+
+import (
+	"github.com/charmbracelet/lipgloss"
+	"github.com/muesli/termenv"
+)
+
+var (
+	boldStyle   = lipgloss.NewStyle().Bold(true)
+	greenStyle  = lipgloss.NewStyle().Foreground(lipgloss.Color("2"))
+	yellowStyle = lipgloss.NewStyle().Foreground(lipgloss.Color("3"))
+	redStyle    = lipgloss.NewStyle().Foreground(lipgloss.Color("1"))
+	folderStyle = lipgloss.NewStyle().Foreground(lipgloss.Color("4")).Bold(true)
+)
+
+// Disable turns styling off for the rest of the process
+func Disable() {
+	lipgloss.DefaultRenderer().SetColorProfile(termenv.Ascii)
+}
+
+// Bold renders s in bold, e.g. a message subject
+func Bold(s string) string {
+	return boldStyle.Render(s)
+}
+
+// Folder renders a Drive folder name
+func Folder(s string) string {
+	return folderStyle.Render(s)
+}
+
+// ResponseStatus renders a calendar response status: accepted in green,
+// tentative in yellow, declined in red, anything else unstyled
+func ResponseStatus(status string) string {
+	switch status {
+	case "accepted":
+		return greenStyle.Render(status)
+	case "tentative":
+		return yellowStyle.Render(status)
+	case "declined":
+		return redStyle.Render(status)
+	default:
+		return status
+	}
+}
+
+// Swatch renders a small block in the color hex (such as "#9fe1e7")
+// followed by hex itself. Without color the block says nothing, so only
+// the hex is returned.
+func Swatch(hex string) string {
+	if hex == "" {
+		return ""
+	}
+	if lipgloss.ColorProfile() == termenv.Ascii {
+		return hex
+	}
+	return lipgloss.NewStyle().Foreground(lipgloss.Color(hex)).Render("■") + " " + hex
+}
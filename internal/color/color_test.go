@@ -0,0 +1,52 @@
+package color
+
+import (
+	"testing"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/muesli/termenv"
+
+	"github.com/open-cli-collective/google-readonly/internal/testutil"
+)
+
+// forceANSI makes the default renderer emit colors as if stdout were a
+// terminal, restoring the detected profile afterwards
+func forceANSI(t *testing.T) {
+	t.Helper()
+	r := lipgloss.DefaultRenderer()
+	orig := r.ColorProfile()
+	r.SetColorProfile(termenv.ANSI)
+	t.Cleanup(func() { r.SetColorProfile(orig) })
+}
+
+func TestPlainWithoutTerminal(t *testing.T) {
+	// go test's stdout is not a terminal, so nothing is styled
+	testutil.Equal(t, Bold("Subject"), "Subject")
+	testutil.Equal(t, Folder("Reports"), "Reports")
+	testutil.Equal(t, ResponseStatus("accepted"), "accepted")
+	testutil.Equal(t, Swatch("#9fe1e7"), "#9fe1e7")
+}
+
+func TestStyles(t *testing.T) {
+	forceANSI(t)
+
+	testutil.Equal(t, Bold("Subject"), "\x1b[1mSubject\x1b[0m")
+	testutil.Contains(t, Folder("Reports"), "Reports")
+	testutil.Contains(t, Folder("Reports"), "\x1b[")
+	testutil.Equal(t, ResponseStatus("accepted"), "\x1b[32maccepted\x1b[0m")
+	testutil.Equal(t, ResponseStatus("tentative"), "\x1b[33mtentative\x1b[0m")
+	testutil.Equal(t, ResponseStatus("declined"), "\x1b[31mdeclined\x1b[0m")
+	testutil.Equal(t, ResponseStatus("needsAction"), "needsAction")
+	testutil.Contains(t, Swatch("#9fe1e7"), "■")
+	testutil.Contains(t, Swatch("#9fe1e7"), "\x1b[")
+	testutil.Contains(t, Swatch("#9fe1e7"), " #9fe1e7")
+	testutil.Equal(t, Swatch(""), "")
+}
+
+func TestDisable(t *testing.T) {
+	forceANSI(t)
+
+	Disable()
+	testutil.Equal(t, Bold("Subject"), "Subject")
+	testutil.Equal(t, ResponseStatus("accepted"), "accepted")
+}
@@ -83,6 +83,9 @@ type Config struct {
 	GrantedScopes []string `yaml:"granted_scopes,omitempty" json:"granted_scopes,omitempty"`
 	// Keyring carries the optional §1.4 explicit file-backend opt-in.
 	Keyring KeyringConfig `yaml:"keyring,omitempty" json:"-"`
+	// DateFormat is the default for --date-format: a preset name (iso, us,
+	// eu, rfc822) or a Go time layout. Empty keeps each command's default.
+	DateFormat string `yaml:"date_format,omitempty" json:"-"`
 }
 
 // KeyringConfig is the §1.4 backend selector. Backend == "file" forces the
@@ -106,15 +109,40 @@ const legacyCacheSubdir = "cache"
 // cli-common/docs/working-with-state.md §1.1).
 var configScope = statedir.Scope{Name: DirName}
 
-// configDirPath resolves the configuration directory WITHOUT creating it.
-// Delegated to cli-common/statedir so the per-OS dir is native everywhere.
-func configDirPath() (string, error) {
+// baseConfigDir resolves the top-level configuration directory WITHOUT
+// creating it. Delegated to cli-common/statedir so the per-OS dir is native
+// everywhere. It is also the default profile's directory.
+func baseConfigDir() (string, error) {
 	return configScope.ConfigDir()
 }
 
-// GetConfigDir returns the configuration directory, creating it if needed.
+// configDirPath resolves the active profile's configuration directory
+// WITHOUT creating it: the base dir for the default profile, and
+// <base>/profiles/<name> for a named one.
+func configDirPath() (string, error) {
+	base, err := baseConfigDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(base, profileSubdir()), nil
+}
+
+// GetConfigDir returns the active profile's configuration directory,
+// creating it if needed.
 func GetConfigDir() (string, error) {
-	return configScope.ConfigDirEnsured()
+	base, err := configScope.ConfigDirEnsured()
+	if err != nil {
+		return "", err
+	}
+	sub := profileSubdir()
+	if sub == "" {
+		return base, nil
+	}
+	dir := filepath.Join(base, sub)
+	if err := os.MkdirAll(dir, DirPerm); err != nil { //nolint:gosec // profile name validated by SetProfile
+		return "", err
+	}
+	return dir, nil
 }
 
 // GetConfigDirNoCreate resolves the configuration directory WITHOUT creating
@@ -128,13 +156,15 @@ func GetConfigDirNoCreate() (string, error) {
 // (used by `config clear --all --dry-run` and tests). os.UserCacheDir gives
 // the canonical per-OS root: Linux $XDG_CACHE_HOME or ~/.cache, macOS
 // ~/Library/Caches, Windows %LocalAppData%. We append only DirName — no
-// platform-specific suffix — to keep all three consistent.
+// platform-specific suffix — to keep all three consistent. A named profile
+// gets its own profiles/<name> subdir so cached metadata never crosses
+// accounts.
 func CacheDirPath() (string, error) {
 	base, err := os.UserCacheDir()
 	if err != nil {
 		return "", err
 	}
-	return filepath.Join(base, DirName), nil
+	return filepath.Join(base, DirName, profileSubdir()), nil
 }
 
 // GetCacheDir returns the cache directory, creating it if needed.
@@ -151,7 +181,11 @@ func GetCacheDir() (string, error) {
 
 // LegacyCacheDir resolves the pre-B2b cache directory (a "cache" subdir of the
 // config dir) WITHOUT creating anything — for the one-time relocation only.
+// Errors under a named profile: the legacy cache belongs to the default one.
 func LegacyCacheDir() (string, error) {
+	if isNamedProfile() {
+		return "", errNamedProfileNoLegacy
+	}
 	configDir, err := configDirPath()
 	if err != nil {
 		return "", err
@@ -185,8 +219,16 @@ func GetConfigPathNoCreate() (string, error) {
 func LegacyConfigJSONPath() (string, error) { return inDir(ConfigFile) }
 
 // DefaultOAuthClientPath is the expanded absolute default for
-// OAuthClientPath: <configdir>/oauth_client.json.
-func DefaultOAuthClientPath() (string, error) { return inDir(OAuthClientFile) }
+// OAuthClientPath: <configdir>/oauth_client.json. It always resolves against
+// the base config dir, so every profile shares one OAuth client JSON
+// (deployment material) unless its config.yml points elsewhere.
+func DefaultOAuthClientPath() (string, error) {
+	base, err := configScope.ConfigDirEnsured()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(base, OAuthClientFile), nil
+}
 
 func inDir(name string) (string, error) {
 	dir, err := GetConfigDir()
@@ -353,7 +395,7 @@ func loadLegacyJSON(cfg *Config) error {
 
 func (c *Config) applyDefaults() {
 	if c.CredentialRef == "" {
-		c.CredentialRef = DefaultCredentialRef
+		c.CredentialRef = DefaultCredentialRefFor(ActiveProfile())
 	}
 	if c.OAuthClientPath == "" {
 		if p, err := DefaultOAuthClientPath(); err == nil {
@@ -0,0 +1,108 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+
+	"github.com/open-cli-collective/cli-common/credstore"
+)
+
+const (
+	// DefaultProfile is the profile used when neither --profile nor
+	// GRO_PROFILE is given. It keeps the pre-profile layout: config.yml at
+	// the top of the config dir and the token under DefaultCredentialRef.
+	DefaultProfile = "default"
+	// ProfilesDir is the config subdirectory holding one directory per named
+	// profile.
+	ProfilesDir = "profiles"
+	// ProfileEnvVar selects the profile when --profile is not given.
+	ProfileEnvVar = "GRO_PROFILE"
+)
+
+// errNamedProfileNoLegacy is returned by the legacy-location resolvers under
+// a named profile. Legacy artifacts predate profiles and belong to the
+// default profile, so a named profile must never find, migrate or clear them.
+var errNamedProfileNoLegacy = errors.New("named profiles have no legacy locations")
+
+var (
+	profileMu     sync.RWMutex
+	activeProfile = DefaultProfile
+)
+
+// SetProfile selects the active profile for every config and keyring lookup
+// that follows. An empty name selects DefaultProfile. Names are limited to
+// the credential-ref segment charset (letters, digits, '-' and '_') so the
+// same name works as a directory and as the keyring profile.
+func SetProfile(name string) error {
+	if name == "" {
+		name = DefaultProfile
+	}
+	if _, err := credstore.FormatRef(DirName, name); err != nil {
+		return fmt.Errorf("invalid profile name %q: use letters, digits, '-' and '_'", name)
+	}
+	profileMu.Lock()
+	defer profileMu.Unlock()
+	activeProfile = name
+	return nil
+}
+
+// ActiveProfile returns the active profile name.
+func ActiveProfile() string {
+	profileMu.RLock()
+	defer profileMu.RUnlock()
+	return activeProfile
+}
+
+// isNamedProfile reports whether a profile other than DefaultProfile is active
+func isNamedProfile() bool {
+	return ActiveProfile() != DefaultProfile
+}
+
+// DefaultCredentialRefFor returns the credential ref a profile uses when its
+// config.yml does not set credential_ref.
+func DefaultCredentialRefFor(profile string) string {
+	if profile == DefaultProfile {
+		return DefaultCredentialRef
+	}
+	return DirName + "/" + profile
+}
+
+// profileSubdir is the active profile's path relative to the base config
+// and cache dirs: "" for the default profile, profiles/<name> otherwise.
+func profileSubdir() string {
+	if !isNamedProfile() {
+		return ""
+	}
+	return filepath.Join(ProfilesDir, ActiveProfile())
+}
+
+// ListProfiles returns the known profiles: DefaultProfile first, then every
+// directory under <configdir>/profiles in name order. Directories whose names
+// are not valid profile names are ignored. Non-creating.
+func ListProfiles() ([]string, error) {
+	base, err := baseConfigDir()
+	if err != nil {
+		return nil, err
+	}
+	entries, err := os.ReadDir(filepath.Join(base, ProfilesDir))
+	if err != nil && !os.IsNotExist(err) {
+		return nil, fmt.Errorf("reading profiles: %w", err)
+	}
+
+	var names []string
+	for _, e := range entries {
+		if !e.IsDir() || e.Name() == DefaultProfile {
+			continue
+		}
+		if _, err := credstore.FormatRef(DirName, e.Name()); err != nil {
+			continue
+		}
+		names = append(names, e.Name())
+	}
+	sort.Strings(names)
+	return append([]string{DefaultProfile}, names...), nil
+}
@@ -0,0 +1,184 @@
+package config
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// useProfile activates a profile for one test and restores the default
+func useProfile(t *testing.T, name string) {
+	t.Helper()
+	if err := SetProfile(name); err != nil {
+		t.Fatalf("SetProfile(%q): %v", name, err)
+	}
+	t.Cleanup(func() { _ = SetProfile("") })
+}
+
+func TestSetProfile(t *testing.T) {
+	t.Cleanup(func() { _ = SetProfile("") })
+
+	if err := SetProfile("work_2"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got := ActiveProfile(); got != "work_2" {
+		t.Errorf("ActiveProfile = %q, want work_2", got)
+	}
+
+	if err := SetProfile(""); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got := ActiveProfile(); got != DefaultProfile {
+		t.Errorf("ActiveProfile = %q, want %q", got, DefaultProfile)
+	}
+
+	for _, bad := range []string{"../work", "a/b", "has space", "dot.name"} {
+		if err := SetProfile(bad); err == nil {
+			t.Errorf("SetProfile(%q) succeeded, want error", bad)
+		}
+	}
+	if got := ActiveProfile(); got != DefaultProfile {
+		t.Errorf("a rejected name changed the profile to %q", got)
+	}
+}
+
+func TestNamedProfileLayout(t *testing.T) {
+	hermeticConfig(t)
+	useProfile(t, "work")
+
+	base, err := baseConfigDir()
+	if err != nil {
+		t.Fatalf("baseConfigDir: %v", err)
+	}
+
+	t.Run("config dir is namespaced and created 0700", func(t *testing.T) {
+		dir, err := GetConfigDir()
+		if err != nil {
+			t.Fatalf("GetConfigDir: %v", err)
+		}
+		if want := filepath.Join(base, ProfilesDir, "work"); dir != want {
+			t.Errorf("GetConfigDir = %q, want %q", dir, want)
+		}
+		info, err := os.Stat(dir)
+		if err != nil {
+			t.Fatalf("stat: %v", err)
+		}
+		if info.Mode().Perm() != os.FileMode(0700) {
+			t.Errorf("perm = %v, want 0700", info.Mode().Perm())
+		}
+	})
+
+	t.Run("cache dir is namespaced", func(t *testing.T) {
+		dir, err := CacheDirPath()
+		if err != nil {
+			t.Fatalf("CacheDirPath: %v", err)
+		}
+		if !strings.HasSuffix(dir, filepath.Join(DirName, ProfilesDir, "work")) {
+			t.Errorf("CacheDirPath = %q, want a profiles/work suffix", dir)
+		}
+	})
+
+	t.Run("OAuth client JSON is shared", func(t *testing.T) {
+		p, err := DefaultOAuthClientPath()
+		if err != nil {
+			t.Fatalf("DefaultOAuthClientPath: %v", err)
+		}
+		if want := filepath.Join(base, OAuthClientFile); p != want {
+			t.Errorf("DefaultOAuthClientPath = %q, want %q", p, want)
+		}
+	})
+
+	t.Run("default credential ref uses the profile", func(t *testing.T) {
+		cfg, err := LoadConfig()
+		if err != nil {
+			t.Fatalf("LoadConfig: %v", err)
+		}
+		if cfg.CredentialRef != "google-readonly/work" {
+			t.Errorf("CredentialRef = %q, want google-readonly/work", cfg.CredentialRef)
+		}
+	})
+
+	t.Run("config.yml is written to the profile dir", func(t *testing.T) {
+		if err := SaveConfig(&Config{DateFormat: "iso"}); err != nil {
+			t.Fatalf("SaveConfig: %v", err)
+		}
+		if _, err := os.Stat(filepath.Join(base, ProfilesDir, "work", ConfigFileYAML)); err != nil {
+			t.Errorf("profile config.yml missing: %v", err)
+		}
+		if _, err := os.Stat(filepath.Join(base, ConfigFileYAML)); !os.IsNotExist(err) {
+			t.Errorf("default config.yml must be untouched (stat err=%v)", err)
+		}
+	})
+
+	t.Run("legacy locations are not visible", func(t *testing.T) {
+		if _, err := OldHandRolledConfigDir(); !errors.Is(err, errNamedProfileNoLegacy) {
+			t.Errorf("OldHandRolledConfigDir err = %v", err)
+		}
+		if _, err := LegacyCacheDir(); !errors.Is(err, errNamedProfileNoLegacy) {
+			t.Errorf("LegacyCacheDir err = %v", err)
+		}
+		reloc, err := DetectConfigRelocation()
+		if err != nil {
+			t.Fatalf("DetectConfigRelocation: %v", err)
+		}
+		if reloc.Kind != relocNone {
+			t.Errorf("relocation kind = %v, want relocNone", reloc.Kind)
+		}
+	})
+}
+
+func TestDefaultProfileLayoutUnchanged(t *testing.T) {
+	hermeticConfig(t)
+	useProfile(t, "")
+
+	base, err := baseConfigDir()
+	if err != nil {
+		t.Fatalf("baseConfigDir: %v", err)
+	}
+	dir, err := GetConfigDir()
+	if err != nil {
+		t.Fatalf("GetConfigDir: %v", err)
+	}
+	if dir != base {
+		t.Errorf("GetConfigDir = %q, want %q", dir, base)
+	}
+	if got := DefaultCredentialRefFor(DefaultProfile); got != DefaultCredentialRef {
+		t.Errorf("DefaultCredentialRefFor(default) = %q, want %q", got, DefaultCredentialRef)
+	}
+}
+
+func TestListProfiles(t *testing.T) {
+	hermeticConfig(t)
+
+	got, err := ListProfiles()
+	if err != nil {
+		t.Fatalf("ListProfiles: %v", err)
+	}
+	if len(got) != 1 || got[0] != DefaultProfile {
+		t.Errorf("fresh install = %v, want [default]", got)
+	}
+
+	base, err := baseConfigDir()
+	if err != nil {
+		t.Fatalf("baseConfigDir: %v", err)
+	}
+	for _, name := range []string{"work", "personal", "bad.name"} {
+		if err := os.MkdirAll(filepath.Join(base, ProfilesDir, name), DirPerm); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := os.WriteFile(filepath.Join(base, ProfilesDir, "stray.txt"), nil, TokenPerm); err != nil {
+		t.Fatal(err)
+	}
+
+	got, err = ListProfiles()
+	if err != nil {
+		t.Fatalf("ListProfiles: %v", err)
+	}
+	want := []string{DefaultProfile, "personal", "work"}
+	if strings.Join(got, ",") != strings.Join(want, ",") {
+		t.Errorf("ListProfiles = %v, want %v", got, want)
+	}
+}
@@ -47,8 +47,13 @@ type SharedRelocation struct {
 // oldHandRolledConfigDir reproduces the prior pre-MON-5371 resolver:
 // $XDG_CONFIG_HOME if set, else $HOME/.config; then "/google-readonly". Same
 // shape on Linux/macOS/Windows (the deliberate "no %APPDATA% branch"). A
-// missing HOME is an error (matches the original behavior).
+// missing HOME is an error (matches the original behavior). Under a named
+// profile there is nothing to relocate, so it errors and every caller treats
+// the old location as absent.
 func oldHandRolledConfigDir() (string, error) {
+	if isNamedProfile() {
+		return "", errNamedProfileNoLegacy
+	}
 	configHome := os.Getenv("XDG_CONFIG_HOME")
 	if configHome == "" {
 		home, err := os.UserHomeDir()
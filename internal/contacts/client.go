@@ -4,6 +4,7 @@ package contacts
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"google.golang.org/api/option"
 	"google.golang.org/api/people/v1"
@@ -36,7 +37,7 @@ func NewClient(ctx context.Context) (*Client, error) {
 // ListContacts retrieves contacts from the user's account
 func (c *Client) ListContacts(ctx context.Context, pageToken string, pageSize int64) (*people.ListConnectionsResponse, error) {
 	call := c.service.People.Connections.List("people/me").
-		PersonFields("names,emailAddresses,phoneNumbers,organizations,addresses,biographies,photos").
+		PersonFields("names,emailAddresses,phoneNumbers,organizations,addresses,biographies,urls,photos,memberships").
 		PageSize(pageSize).
 		SortOrder("LAST_NAME_ASCENDING")
 
@@ -52,6 +53,25 @@ func (c *Client) ListContacts(ctx context.Context, pageToken string, pageSize in
 	return resp, nil
 }
 
+// ListOtherContacts retrieves the auto-collected "Other Contacts", which
+// Google keeps apart from the user's connections
+func (c *Client) ListOtherContacts(ctx context.Context, pageToken string, pageSize int64) (*people.ListOtherContactsResponse, error) {
+	call := c.service.OtherContacts.List().
+		ReadMask("names,emailAddresses").
+		PageSize(pageSize)
+
+	if pageToken != "" {
+		call = call.PageToken(pageToken)
+	}
+
+	resp, err := call.Context(ctx).Do()
+	if err != nil {
+		return nil, fmt.Errorf("listing other contacts: %w", err)
+	}
+
+	return resp, nil
+}
+
 // SearchContacts searches for contacts matching a query
 func (c *Client) SearchContacts(ctx context.Context, query string, pageSize int64) (*people.SearchResponse, error) {
 	resp, err := c.service.People.SearchContacts().
@@ -114,23 +134,58 @@ func (c *Client) RemoveFromGroup(ctx context.Context, groupResourceName string,
 	return err
 }
 
-// ResolveGroupName finds a contact group by name and returns its resource name
+// ResolveGroupName finds a contact group by name and returns its resource
+// name. An exact name match wins; otherwise the name is compared
+// case-insensitively with each group's name and formatted name, and more
+// than one such match is an error.
 func (c *Client) ResolveGroupName(ctx context.Context, name string) (string, error) {
-	resp, err := c.service.ContactGroups.List().
-		PageSize(100).
-		GroupFields("name,groupType").
-		Context(ctx).
-		Do()
-	if err != nil {
-		return "", fmt.Errorf("listing groups: %w", err)
+	var groups []*people.ContactGroup
+	pageToken := ""
+	for {
+		call := c.service.ContactGroups.List().
+			PageSize(1000).
+			GroupFields("name,groupType")
+		if pageToken != "" {
+			call = call.PageToken(pageToken)
+		}
+
+		resp, err := call.Context(ctx).Do()
+		if err != nil {
+			return "", fmt.Errorf("listing groups: %w", err)
+		}
+		groups = append(groups, resp.ContactGroups...)
+		if resp.NextPageToken == "" {
+			break
+		}
+		pageToken = resp.NextPageToken
 	}
 
-	for _, g := range resp.ContactGroups {
+	return matchGroupName(groups, name)
+}
+
+// matchGroupName picks the group called name out of groups
+func matchGroupName(groups []*people.ContactGroup, name string) (string, error) {
+	var matches []*people.ContactGroup
+	for _, g := range groups {
 		if g.Name == name {
 			return g.ResourceName, nil
 		}
+		if strings.EqualFold(g.Name, name) || strings.EqualFold(g.FormattedName, name) {
+			matches = append(matches, g)
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		return "", fmt.Errorf("group not found: %s", name)
+	case 1:
+		return matches[0].ResourceName, nil
+	}
+	candidates := make([]string, len(matches))
+	for i, g := range matches {
+		candidates[i] = fmt.Sprintf("%s (%s)", g.Name, g.ResourceName)
 	}
-	return "", fmt.Errorf("group not found: %s", name)
+	return "", fmt.Errorf("group name %q is ambiguous, matches: %s", name, strings.Join(candidates, ", "))
 }
 
 // SearchContactIDs searches contacts and returns only resource names.
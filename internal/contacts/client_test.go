@@ -2,6 +2,8 @@ package contacts
 
 import (
 	"testing"
+
+	"google.golang.org/api/people/v1"
 )
 
 func TestClientStructure(t *testing.T) {
@@ -14,3 +16,46 @@ func TestClientStructure(t *testing.T) {
 		}
 	})
 }
+
+func TestMatchGroupName(t *testing.T) {
+	t.Parallel()
+	groups := []*people.ContactGroup{
+		{ResourceName: "contactGroups/starred", Name: "starred", FormattedName: "Starred"},
+		{ResourceName: "contactGroups/a1", Name: "Friends"},
+		{ResourceName: "contactGroups/a2", Name: "Work"},
+		{ResourceName: "contactGroups/a3", Name: "WORK"},
+	}
+
+	tests := []struct {
+		name    string
+		query   string
+		want    string
+		wantErr string
+	}{
+		{name: "exact name", query: "Friends", want: "contactGroups/a1"},
+		{name: "case-insensitive name", query: "friends", want: "contactGroups/a1"},
+		{name: "formatted name", query: "Starred", want: "contactGroups/starred"},
+		{name: "exact wins over case-insensitive", query: "Work", want: "contactGroups/a2"},
+		{name: "ambiguous", query: "work", wantErr: `group name "work" is ambiguous, matches: Work (contactGroups/a2), WORK (contactGroups/a3)`},
+		{name: "not found", query: "Family", wantErr: "group not found: Family"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := matchGroupName(groups, tt.query)
+			if tt.wantErr != "" {
+				if err == nil || err.Error() != tt.wantErr {
+					t.Errorf("got error %v, want %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
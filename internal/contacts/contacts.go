@@ -1,6 +1,8 @@
 package contacts
 
 import (
+	"slices"
+
 	"google.golang.org/api/people/v1"
 )
 
@@ -17,6 +19,7 @@ type Contact struct {
 	Biography     string         `json:"biography,omitempty"`
 	Birthday      string         `json:"birthday,omitempty"`
 	PhotoURL      string         `json:"photoUrl,omitempty"`
+	Groups        []string       `json:"groups,omitempty"`
 }
 
 // Name represents a contact name
@@ -188,6 +191,13 @@ func ParseContact(p *people.Person) *Contact {
 		contact.PhotoURL = p.Photos[0].Url
 	}
 
+	// Parse contact group memberships
+	for _, m := range p.Memberships {
+		if m.ContactGroupMembership != nil {
+			contact.Groups = append(contact.Groups, m.ContactGroupMembership.ContactGroupResourceName)
+		}
+	}
+
 	return contact
 }
 
@@ -263,6 +273,39 @@ func (c *Contact) GetPrimaryPhone() string {
 	return ""
 }
 
+// HasEmail reports whether any of the contact's email addresses equals email
+// once both are normalized with NormalizeEmail
+func (c *Contact) HasEmail(email string) bool {
+	want := NormalizeEmail(email)
+	for _, e := range c.Emails {
+		if NormalizeEmail(e.Value) == want {
+			return true
+		}
+	}
+	return false
+}
+
+// HasPhone reports whether any of the contact's phone numbers equals phone
+// once both are normalized with NormalizePhone
+func (c *Contact) HasPhone(phone string) bool {
+	want := NormalizePhone(phone)
+	if want == "" {
+		return false
+	}
+	for _, p := range c.Phones {
+		if NormalizePhone(p.Value) == want {
+			return true
+		}
+	}
+	return false
+}
+
+// InGroup reports whether the contact is a member of the contact group with
+// the given resource name
+func (c *Contact) InGroup(groupResourceName string) bool {
+	return slices.Contains(c.Groups, groupResourceName)
+}
+
 // GetOrganization returns the first organization name
 func (c *Contact) GetOrganization() string {
 	if len(c.Organizations) > 0 {
@@ -250,6 +250,30 @@ func TestParseContact(t *testing.T) {
 		}
 	})
 
+	t.Run("parses group memberships", func(t *testing.T) {
+		t.Parallel()
+		p := &people.Person{
+			ResourceName: "people/c108",
+			Memberships: []*people.Membership{
+				{ContactGroupMembership: &people.ContactGroupMembership{ContactGroupResourceName: "contactGroups/myContacts"}},
+				{DomainMembership: &people.DomainMembership{InViewerDomain: true}},
+				{ContactGroupMembership: &people.ContactGroupMembership{ContactGroupResourceName: "contactGroups/abc"}},
+			},
+		}
+
+		contact := ParseContact(p)
+
+		if len(contact.Groups) != 2 {
+			t.Fatalf("got length %d, want %d", len(contact.Groups), 2)
+		}
+		if !contact.InGroup("contactGroups/abc") {
+			t.Errorf("expected contact to be in contactGroups/abc")
+		}
+		if contact.InGroup("contactGroups/other") {
+			t.Errorf("expected contact not to be in contactGroups/other")
+		}
+	})
+
 	t.Run("handles nil person", func(t *testing.T) {
 		t.Parallel()
 		contact := ParseContact(nil)
@@ -406,6 +430,30 @@ func TestContactGetPrimaryPhone(t *testing.T) {
 	})
 }
 
+func TestContactHasEmailAndPhone(t *testing.T) {
+	t.Parallel()
+	c := &Contact{
+		Emails: []Email{{Value: "Jane.Doe@Example.com"}},
+		Phones: []Phone{{Value: "+1 (555) 123-4567"}},
+	}
+
+	if !c.HasEmail("jane.doe@example.com") {
+		t.Errorf("expected HasEmail to ignore case")
+	}
+	if c.HasEmail("doe@example.com") {
+		t.Errorf("expected HasEmail to reject a partial address")
+	}
+	if !c.HasPhone("+1-555-123-4567") {
+		t.Errorf("expected HasPhone to ignore punctuation")
+	}
+	if c.HasPhone("123-4567") {
+		t.Errorf("expected HasPhone to reject a partial number")
+	}
+	if c.HasPhone("") {
+		t.Errorf("expected HasPhone to reject an empty number")
+	}
+}
+
 func TestContactGetOrganization(t *testing.T) {
 	t.Parallel()
 	t.Run("returns organization name", func(t *testing.T) {
@@ -0,0 +1,121 @@
+package contacts
+
+import (
+	"fmt"
+)
+
+// csvGroup is a repeated contact field exported as numbered columns, e.g.
+// "E-mail 1 - Type", "E-mail 1 - Value", "E-mail 2 - Type", ...
+type csvGroup struct {
+	label   string
+	noun    string
+	columns []string
+	count   func(c *Contact) int
+	values  func(c *Contact, i int) []string
+}
+
+// csvNameColumns are the single-valued columns that lead each row
+var csvNameColumns = []string{
+	"Name", "Given Name", "Additional Name", "Family Name", "Name Prefix", "Name Suffix", "Notes",
+}
+
+// csvGroups follows the column layout of a Google Contacts CSV export
+var csvGroups = []csvGroup{
+	{
+		label:   "E-mail",
+		noun:    "email",
+		columns: []string{"Type", "Value"},
+		count:   func(c *Contact) int { return len(c.Emails) },
+		values: func(c *Contact, i int) []string {
+			return []string{c.Emails[i].Type, c.Emails[i].Value}
+		},
+	},
+	{
+		label:   "Phone",
+		noun:    "phone",
+		columns: []string{"Type", "Value"},
+		count:   func(c *Contact) int { return len(c.Phones) },
+		values: func(c *Contact, i int) []string {
+			return []string{c.Phones[i].Type, c.Phones[i].Value}
+		},
+	},
+	{
+		label:   "Address",
+		noun:    "address",
+		columns: []string{"Type", "Formatted", "City", "Region", "Postal Code", "Country"},
+		count:   func(c *Contact) int { return len(c.Addresses) },
+		values: func(c *Contact, i int) []string {
+			a := c.Addresses[i]
+			return []string{a.Type, a.FormattedValue, a.City, a.Region, a.PostalCode, a.Country}
+		},
+	},
+	{
+		label:   "Organization",
+		noun:    "organization",
+		columns: []string{"Type", "Name", "Title", "Department"},
+		count:   func(c *Contact) int { return len(c.Organizations) },
+		values: func(c *Contact, i int) []string {
+			o := c.Organizations[i]
+			return []string{o.Type, o.Name, o.Title, o.Department}
+		},
+	},
+	{
+		label:   "Website",
+		noun:    "website",
+		columns: []string{"Type", "Value"},
+		count:   func(c *Contact) int { return len(c.URLs) },
+		values: func(c *Contact, i int) []string {
+			return []string{c.URLs[i].Type, c.URLs[i].Value}
+		},
+	},
+}
+
+// CSVHeader returns the header row for CSVRecord, with maxColumns numbered
+// columns for each repeated field
+func CSVHeader(maxColumns int) []string {
+	header := append([]string(nil), csvNameColumns...)
+	for _, g := range csvGroups {
+		for i := 1; i <= maxColumns; i++ {
+			for _, col := range g.columns {
+				header = append(header, fmt.Sprintf("%s %d - %s", g.label, i, col))
+			}
+		}
+	}
+	return header
+}
+
+// CSVRecord maps a contact onto the CSVHeader columns. Values beyond
+// maxColumns of a repeated field are left out; CSVOverflow reports them.
+func CSVRecord(c *Contact, maxColumns int) []string {
+	var name Name
+	if len(c.Names) > 0 {
+		name = c.Names[0]
+	}
+	record := []string{
+		c.DisplayName, name.GivenName, name.MiddleName, name.FamilyName,
+		name.HonorificPrefix, name.HonorificSuffix, c.Biography,
+	}
+	for _, g := range csvGroups {
+		n := g.count(c)
+		for i := range maxColumns {
+			if i < n {
+				record = append(record, g.values(c, i)...)
+			} else {
+				record = append(record, make([]string, len(g.columns))...)
+			}
+		}
+	}
+	return record
+}
+
+// CSVOverflow describes the values of c that CSVRecord leaves out at
+// maxColumns, e.g. "2 email(s)". It is empty when everything fits.
+func CSVOverflow(c *Contact, maxColumns int) []string {
+	var dropped []string
+	for _, g := range csvGroups {
+		if n := g.count(c); n > maxColumns {
+			dropped = append(dropped, fmt.Sprintf("%d %s(s)", n-maxColumns, g.noun))
+		}
+	}
+	return dropped
+}
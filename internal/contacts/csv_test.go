@@ -0,0 +1,100 @@
+package contacts
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestCSVHeader(t *testing.T) {
+	t.Parallel()
+	header := CSVHeader(2)
+
+	for _, col := range []string{
+		"Name", "Given Name", "Family Name", "Notes",
+		"E-mail 1 - Type", "E-mail 1 - Value", "E-mail 2 - Value",
+		"Phone 1 - Value", "Phone 2 - Value",
+		"Address 1 - Formatted", "Organization 1 - Name", "Organization 2 - Title",
+		"Website 2 - Value",
+	} {
+		if !slices.Contains(header, col) {
+			t.Errorf("expected header to contain %q", col)
+		}
+	}
+	if slices.Contains(header, "E-mail 3 - Value") {
+		t.Errorf("expected no third email column")
+	}
+	if got, want := len(header), len(csvNameColumns)+2*(2+2+6+4+2); got != want {
+		t.Errorf("got %d columns, want %d", got, want)
+	}
+}
+
+func TestCSVRecord(t *testing.T) {
+	t.Parallel()
+	c := &Contact{
+		DisplayName: "Jane Q. Doe",
+		Names:       []Name{{GivenName: "Jane", MiddleName: "Q.", FamilyName: "Doe"}},
+		Emails: []Email{
+			{Value: "jane@work.com", Type: "work"},
+			{Value: "jane@home.com", Type: "home"},
+			{Value: "jane@old.com"},
+		},
+		Phones:        []Phone{{Value: "+1-555-0100", Type: "mobile"}},
+		Organizations: []Organization{{Name: "Acme", Title: "CTO"}},
+		Biography:     "Met at the conference",
+	}
+
+	header := CSVHeader(2)
+	record := CSVRecord(c, 2)
+	if len(record) != len(header) {
+		t.Fatalf("record has %d fields, header %d", len(record), len(header))
+	}
+
+	row := make(map[string]string, len(header))
+	for i, col := range header {
+		row[col] = record[i]
+	}
+	want := map[string]string{
+		"Name":                        "Jane Q. Doe",
+		"Given Name":                  "Jane",
+		"Additional Name":             "Q.",
+		"Family Name":                 "Doe",
+		"Notes":                       "Met at the conference",
+		"E-mail 1 - Type":             "work",
+		"E-mail 1 - Value":            "jane@work.com",
+		"E-mail 2 - Value":            "jane@home.com",
+		"Phone 1 - Type":              "mobile",
+		"Phone 1 - Value":             "+1-555-0100",
+		"Phone 2 - Value":             "",
+		"Organization 1 - Name":       "Acme",
+		"Organization 1 - Title":      "CTO",
+		"Organization 2 - Name":       "",
+		"Address 1 - Formatted":       "",
+		"Website 1 - Value":           "",
+		"Organization 1 - Department": "",
+	}
+	for col, v := range want {
+		if row[col] != v {
+			t.Errorf("%s: got %q, want %q", col, row[col], v)
+		}
+	}
+
+	if got := CSVOverflow(c, 2); !slices.Equal(got, []string{"1 email(s)"}) {
+		t.Errorf("got overflow %v", got)
+	}
+	if got := CSVOverflow(c, 3); len(got) != 0 {
+		t.Errorf("expected no overflow at 3 columns, got %v", got)
+	}
+}
+
+func TestCSVRecord_NoName(t *testing.T) {
+	t.Parallel()
+	record := CSVRecord(&Contact{ResourceName: "people/c1"}, 1)
+	if len(record) != len(CSVHeader(1)) {
+		t.Fatalf("record has %d fields, header %d", len(record), len(CSVHeader(1)))
+	}
+	for i, v := range record {
+		if v != "" {
+			t.Errorf("field %d: got %q, want empty", i, v)
+		}
+	}
+}
@@ -0,0 +1,129 @@
+package contacts
+
+import (
+	"strings"
+)
+
+// DuplicateCluster is a set of contacts that share at least one normalized
+// email address or phone number and so likely describe the same person
+type DuplicateCluster struct {
+	// Shared lists the normalized values seen on more than one member
+	Shared   []string
+	Contacts []*Contact
+}
+
+// NormalizeEmail trims and lowercases an email address so differently cased
+// copies of the same address compare equal
+func NormalizeEmail(email string) string {
+	return strings.ToLower(strings.TrimSpace(email))
+}
+
+// NormalizePhone strips spaces and punctuation from a phone number, keeping
+// only its digits and a leading +. It returns "" when no digits remain.
+func NormalizePhone(phone string) string {
+	phone = strings.TrimSpace(phone)
+	var b strings.Builder
+	if strings.HasPrefix(phone, "+") {
+		b.WriteByte('+')
+	}
+	digits := 0
+	for _, r := range phone {
+		if r >= '0' && r <= '9' {
+			b.WriteRune(r)
+			digits++
+		}
+	}
+	if digits == 0 {
+		return ""
+	}
+	return b.String()
+}
+
+// matchKeys returns the distinct normalized emails and phones of a contact
+func matchKeys(c *Contact) []string {
+	var keys []string
+	seen := map[string]bool{}
+	add := func(k string) {
+		if k != "" && !seen[k] {
+			seen[k] = true
+			keys = append(keys, k)
+		}
+	}
+	for _, e := range c.Emails {
+		add(NormalizeEmail(e.Value))
+	}
+	for _, p := range c.Phones {
+		add(NormalizePhone(p.Value))
+	}
+	return keys
+}
+
+// FindDuplicates groups contacts that share a normalized email or phone.
+// Matches are transitive: if A shares an email with B and B a phone with C,
+// all three form one cluster. Contacts with no match are left out. Clusters
+// and their members keep the order of the input.
+func FindDuplicates(list []*Contact) []DuplicateCluster {
+	parent := make([]int, len(list))
+	for i := range parent {
+		parent[i] = i
+	}
+	find := func(i int) int {
+		for parent[i] != i {
+			parent[i] = parent[parent[i]]
+			i = parent[i]
+		}
+		return i
+	}
+	union := func(a, b int) {
+		ra, rb := find(a), find(b)
+		if ra == rb {
+			return
+		}
+		// The lower index stays the root so clusters follow input order
+		if rb < ra {
+			ra, rb = rb, ra
+		}
+		parent[rb] = ra
+	}
+
+	var keys []string
+	owners := map[string][]int{}
+	for i, c := range list {
+		for _, k := range matchKeys(c) {
+			if _, ok := owners[k]; !ok {
+				keys = append(keys, k)
+			}
+			owners[k] = append(owners[k], i)
+		}
+	}
+	for _, k := range keys {
+		for _, i := range owners[k][1:] {
+			union(owners[k][0], i)
+		}
+	}
+
+	shared := map[int][]string{}
+	for _, k := range keys {
+		if len(owners[k]) > 1 {
+			root := find(owners[k][0])
+			shared[root] = append(shared[root], k)
+		}
+	}
+
+	var clusters []DuplicateCluster
+	index := map[int]int{}
+	for i, c := range list {
+		root := find(i)
+		if _, ok := shared[root]; !ok {
+			continue
+		}
+		n, ok := index[root]
+		if !ok {
+			n = len(clusters)
+			index[root] = n
+			clusters = append(clusters, DuplicateCluster{Shared: shared[root]})
+		}
+		clusters[n].Contacts = append(clusters[n].Contacts, c)
+	}
+	return clusters
+}
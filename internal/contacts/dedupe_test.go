@@ -0,0 +1,122 @@
+package contacts
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestNormalizeEmail(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		in   string
+		want string
+	}{
+		{"john@example.com", "john@example.com"},
+		{"John.Doe@Example.COM", "john.doe@example.com"},
+		{"  jane@example.com ", "jane@example.com"},
+		{"", ""},
+	}
+	for _, tt := range tests {
+		if got := NormalizeEmail(tt.in); got != tt.want {
+			t.Errorf("NormalizeEmail(%q) = %q, want %q", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestNormalizePhone(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		in   string
+		want string
+	}{
+		{"+1-555-123-4567", "+15551234567"},
+		{"+1 (555) 123 4567", "+15551234567"},
+		{"555.123.4567", "5551234567"},
+		{" (555) 123-4567 ", "5551234567"},
+		{"ext. -", ""},
+		{"", ""},
+	}
+	for _, tt := range tests {
+		if got := NormalizePhone(tt.in); got != tt.want {
+			t.Errorf("NormalizePhone(%q) = %q, want %q", tt.in, got, tt.want)
+		}
+	}
+}
+
+func resourceNames(cluster DuplicateCluster) []string {
+	names := make([]string, len(cluster.Contacts))
+	for i, c := range cluster.Contacts {
+		names[i] = c.ResourceName
+	}
+	return names
+}
+
+func TestFindDuplicates(t *testing.T) {
+	t.Parallel()
+
+	t.Run("groups by email and phone", func(t *testing.T) {
+		t.Parallel()
+		list := []*Contact{
+			{ResourceName: "people/c1", Emails: []Email{{Value: "John@Example.com"}}},
+			{ResourceName: "people/c2", Phones: []Phone{{Value: "555-0100"}}},
+			{ResourceName: "people/c3", Emails: []Email{{Value: "john@example.com"}}},
+			{ResourceName: "people/c4", Emails: []Email{{Value: "solo@example.com"}}},
+			{ResourceName: "people/c5", Phones: []Phone{{Value: "(555) 0100"}}},
+		}
+
+		clusters := FindDuplicates(list)
+		if len(clusters) != 2 {
+			t.Fatalf("got %d clusters, want 2", len(clusters))
+		}
+		if got := resourceNames(clusters[0]); !slices.Equal(got, []string{"people/c1", "people/c3"}) {
+			t.Errorf("cluster 0 = %v", got)
+		}
+		if !slices.Equal(clusters[0].Shared, []string{"john@example.com"}) {
+			t.Errorf("cluster 0 shared = %v", clusters[0].Shared)
+		}
+		if got := resourceNames(clusters[1]); !slices.Equal(got, []string{"people/c2", "people/c5"}) {
+			t.Errorf("cluster 1 = %v", got)
+		}
+		if !slices.Equal(clusters[1].Shared, []string{"5550100"}) {
+			t.Errorf("cluster 1 shared = %v", clusters[1].Shared)
+		}
+	})
+
+	t.Run("matches are transitive", func(t *testing.T) {
+		t.Parallel()
+		list := []*Contact{
+			{ResourceName: "people/a", Emails: []Email{{Value: "a@example.com"}}},
+			{ResourceName: "people/c", Phones: []Phone{{Value: "+1 555 0199"}}},
+			{ResourceName: "people/b", Emails: []Email{{Value: "a@example.com"}}, Phones: []Phone{{Value: "+1-555-0199"}}},
+		}
+
+		clusters := FindDuplicates(list)
+		if len(clusters) != 1 {
+			t.Fatalf("got %d clusters, want 1", len(clusters))
+		}
+		if got := resourceNames(clusters[0]); !slices.Equal(got, []string{"people/a", "people/c", "people/b"}) {
+			t.Errorf("cluster = %v", got)
+		}
+		if !slices.Equal(clusters[0].Shared, []string{"a@example.com", "+15550199"}) {
+			t.Errorf("shared = %v", clusters[0].Shared)
+		}
+	})
+
+	t.Run("repeated value on one contact is not a duplicate", func(t *testing.T) {
+		t.Parallel()
+		list := []*Contact{
+			{ResourceName: "people/c1", Emails: []Email{{Value: "x@example.com"}, {Value: "X@example.com"}}},
+			{ResourceName: "people/c2", Emails: []Email{{Value: "y@example.com"}}},
+		}
+		if clusters := FindDuplicates(list); len(clusters) != 0 {
+			t.Errorf("got %d clusters, want 0", len(clusters))
+		}
+	})
+
+	t.Run("empty input", func(t *testing.T) {
+		t.Parallel()
+		if clusters := FindDuplicates(nil); len(clusters) != 0 {
+			t.Errorf("got %d clusters, want 0", len(clusters))
+		}
+	})
+}
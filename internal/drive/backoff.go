@@ -0,0 +1,55 @@
+package drive
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+
+	"google.golang.org/api/googleapi"
+)
+
+// rateLimitBackoff is how long to wait before each retry of a rate-limited
+// call; its length is the number of retries. A var so tests need not sleep.
+var rateLimitBackoff = []time.Duration{time.Second, 2 * time.Second, 4 * time.Second, 8 * time.Second}
+
+// withBackoff runs call, retrying it with exponential backoff while Drive
+// answers that the request rate is too high. Any other error, or the last
+// rate-limit error once the retries run out, is returned as is.
+func withBackoff(ctx context.Context, call func() error) error {
+	err := call()
+	for _, wait := range rateLimitBackoff {
+		if !IsRateLimited(err) {
+			return err
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(wait):
+		}
+		err = call()
+	}
+	return err
+}
+
+// IsRateLimited reports whether err is Drive refusing a request for quota
+// reasons: HTTP 429, or a 403 whose reason is rateLimitExceeded or
+// userRateLimitExceeded.
+func IsRateLimited(err error) bool {
+	var apiErr *googleapi.Error
+	if !errors.As(err, &apiErr) {
+		return false
+	}
+	if apiErr.Code == http.StatusTooManyRequests {
+		return true
+	}
+	if apiErr.Code != http.StatusForbidden {
+		return false
+	}
+	for _, e := range apiErr.Errors {
+		if e.Reason == "rateLimitExceeded" || e.Reason == "userRateLimitExceeded" {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,79 @@
+package drive
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"google.golang.org/api/googleapi"
+)
+
+func TestIsRateLimited(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{name: "429", err: &googleapi.Error{Code: 429}, want: true},
+		{name: "403 rate limit", err: &googleapi.Error{Code: 403, Errors: []googleapi.ErrorItem{{Reason: "userRateLimitExceeded"}}}, want: true},
+		{name: "wrapped", err: fmt.Errorf("listing: %w", &googleapi.Error{Code: 429}), want: true},
+		{name: "403 forbidden", err: &googleapi.Error{Code: 403, Errors: []googleapi.ErrorItem{{Reason: "insufficientFilePermissions"}}}, want: false},
+		{name: "plain error", err: errors.New("boom"), want: false},
+		{name: "nil", err: nil, want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			if got := IsRateLimited(tt.err); got != tt.want {
+				t.Errorf("IsRateLimited() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestWithBackoff(t *testing.T) {
+	orig := rateLimitBackoff
+	rateLimitBackoff = []time.Duration{0, 0}
+	t.Cleanup(func() { rateLimitBackoff = orig })
+	limited := &googleapi.Error{Code: 429}
+
+	t.Run("retries until the call succeeds", func(t *testing.T) {
+		calls := 0
+		err := withBackoff(context.Background(), func() error {
+			calls++
+			if calls < 2 {
+				return limited
+			}
+			return nil
+		})
+		if err != nil || calls != 2 {
+			t.Fatalf("err=%v calls=%d, want nil after 2 calls", err, calls)
+		}
+	})
+
+	t.Run("gives up after the last retry", func(t *testing.T) {
+		calls := 0
+		err := withBackoff(context.Background(), func() error {
+			calls++
+			return limited
+		})
+		if !IsRateLimited(err) || calls != 3 {
+			t.Fatalf("err=%v calls=%d, want the rate-limit error after 3 calls", err, calls)
+		}
+	})
+
+	t.Run("other errors are not retried", func(t *testing.T) {
+		calls := 0
+		err := withBackoff(context.Background(), func() error {
+			calls++
+			return errors.New("boom")
+		})
+		if err == nil || calls != 1 {
+			t.Fatalf("err=%v calls=%d, want an error after 1 call", err, calls)
+		}
+	})
+}
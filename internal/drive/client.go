@@ -2,7 +2,9 @@
 package drive
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
 	"fmt"
 	"io"
 
@@ -35,13 +37,27 @@ func NewClient(ctx context.Context) (*Client, error) {
 }
 
 // fileFields defines the fields to request from the Drive API
-const fileFields = "id,name,mimeType,size,createdTime,modifiedTime,parents,owners,webViewLink,shared,driveId"
+const fileFields = "id,name,mimeType,size,createdTime,modifiedTime,parents,owners,lastModifyingUser(displayName,emailAddress),webViewLink,shared,trashed,driveId,md5Checksum"
+
+// fileDetailFields adds the download and export links, which only a single
+// file lookup needs, to fileFields
+const fileDetailFields = fileFields + ",webContentLink,exportLinks"
 
 // ListFiles returns files matching the query (searches My Drive only for backwards compatibility)
 func (c *Client) ListFiles(ctx context.Context, query string, pageSize int64) ([]*File, error) {
+	return c.ListFilesOrdered(ctx, query, pageSize, "modifiedTime desc")
+}
+
+// ListFilesOrdered returns files matching the query sorted by orderBy, a
+// Drive API sort expression such as "modifiedTime desc" or "folder,name".
+// An empty orderBy leaves the order to the API.
+func (c *Client) ListFilesOrdered(ctx context.Context, query string, pageSize int64, orderBy string) ([]*File, error) {
 	call := c.service.Files.List().
-		Fields("files(" + fileFields + ")").
-		OrderBy("modifiedTime desc")
+		Fields("files(" + fileFields + ")")
+
+	if orderBy != "" {
+		call = call.OrderBy(orderBy)
+	}
 
 	if query != "" {
 		call = call.Q(query)
@@ -105,7 +121,7 @@ func (c *Client) ListFilesWithScope(ctx context.Context, query string, pageSize
 // GetFile retrieves a single file by ID (supports files in shared drives)
 func (c *Client) GetFile(ctx context.Context, fileID string) (*File, error) {
 	f, err := c.service.Files.Get(fileID).
-		Fields(fileFields).
+		Fields(fileDetailFields).
 		SupportsAllDrives(true).
 		Context(ctx).
 		Do()
@@ -115,6 +131,32 @@ func (c *Client) GetFile(ctx context.Context, fileID string) (*File, error) {
 	return ParseFile(f), nil
 }
 
+// GetFileMetadata returns every metadata field Drive has for a file
+// (fields=*), decoded as generic JSON instead of projected onto File. Numbers
+// are kept as json.Number so large values print exactly.
+func (c *Client) GetFileMetadata(ctx context.Context, fileID string) (map[string]any, error) {
+	f, err := c.service.Files.Get(fileID).
+		Fields("*").
+		SupportsAllDrives(true).
+		Context(ctx).
+		Do()
+	if err != nil {
+		return nil, fmt.Errorf("getting file metadata: %w", err)
+	}
+
+	data, err := f.MarshalJSON()
+	if err != nil {
+		return nil, fmt.Errorf("encoding file metadata: %w", err)
+	}
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	var meta map[string]any
+	if err := dec.Decode(&meta); err != nil {
+		return nil, fmt.Errorf("decoding file metadata: %w", err)
+	}
+	return meta, nil
+}
+
 // DownloadFile downloads a regular (non-Google Workspace) file
 func (c *Client) DownloadFile(ctx context.Context, fileID string) ([]byte, error) {
 	resp, err := c.service.Files.Get(fileID).
@@ -133,6 +175,26 @@ func (c *Client) DownloadFile(ctx context.Context, fileID string) ([]byte, error
 	return data, nil
 }
 
+// DownloadFileTo streams the content of a regular (non-Google Workspace) file
+// to w and returns the number of bytes written. Unlike DownloadFile it does
+// not hold the file in memory.
+func (c *Client) DownloadFileTo(ctx context.Context, fileID string, w io.Writer) (int64, error) {
+	resp, err := c.service.Files.Get(fileID).
+		SupportsAllDrives(true).
+		Context(ctx).
+		Download()
+	if err != nil {
+		return 0, fmt.Errorf("downloading file: %w", err)
+	}
+	defer resp.Body.Close()
+
+	n, err := io.Copy(w, resp.Body)
+	if err != nil {
+		return n, fmt.Errorf("copying file content: %w", err)
+	}
+	return n, nil
+}
+
 // ExportFile exports a Google Workspace file to the specified MIME type
 func (c *Client) ExportFile(ctx context.Context, fileID string, mimeType string) ([]byte, error) {
 	resp, err := c.service.Files.Export(fileID, mimeType).Context(ctx).Download()
@@ -148,6 +210,84 @@ func (c *Client) ExportFile(ctx context.Context, fileID string, mimeType string)
 	return data, nil
 }
 
+// permissionFields defines the fields to request for each permission
+const permissionFields = "id,type,role,emailAddress,domain,displayName,permissionDetails(inherited)"
+
+// ListPermissions returns the sharing permissions of a file (supports files
+// in shared drives). Rate-limited pages are retried with backoff, since
+// audits call it once per file.
+func (c *Client) ListPermissions(ctx context.Context, fileID string) ([]*Permission, error) {
+	var permissions []*Permission
+	pageToken := ""
+
+	for {
+		call := c.service.Permissions.List(fileID).
+			Fields("nextPageToken,permissions(" + permissionFields + ")").
+			SupportsAllDrives(true).
+			PageSize(100)
+
+		if pageToken != "" {
+			call = call.PageToken(pageToken)
+		}
+
+		var resp *drive.PermissionList
+		err := withBackoff(ctx, func() error {
+			var err error
+			resp, err = call.Context(ctx).Do()
+			return err
+		})
+		if err != nil {
+			return nil, fmt.Errorf("listing permissions: %w", err)
+		}
+
+		for _, p := range resp.Permissions {
+			permissions = append(permissions, ParsePermission(p))
+		}
+
+		pageToken = resp.NextPageToken
+		if pageToken == "" {
+			break
+		}
+	}
+
+	return permissions, nil
+}
+
+// revisionFields defines the fields to request for each revision
+const revisionFields = "id,modifiedTime,lastModifyingUser(displayName,emailAddress),size,mimeType,keepForever,originalFilename"
+
+// ListRevisions returns all revisions of a file, oldest first
+func (c *Client) ListRevisions(ctx context.Context, fileID string) ([]*Revision, error) {
+	var revisions []*Revision
+	pageToken := ""
+
+	for {
+		call := c.service.Revisions.List(fileID).
+			Fields("nextPageToken,revisions(" + revisionFields + ")").
+			PageSize(1000)
+
+		if pageToken != "" {
+			call = call.PageToken(pageToken)
+		}
+
+		resp, err := call.Context(ctx).Do()
+		if err != nil {
+			return nil, fmt.Errorf("listing revisions: %w", err)
+		}
+
+		for _, r := range resp.Revisions {
+			revisions = append(revisions, ParseRevision(r))
+		}
+
+		pageToken = resp.NextPageToken
+		if pageToken == "" {
+			break
+		}
+	}
+
+	return revisions, nil
+}
+
 // StarFile stars a file in Drive
 func (c *Client) StarFile(ctx context.Context, fileID string) error {
 	_, err := c.service.Files.Update(fileID, &drive.File{
@@ -0,0 +1,194 @@
+package drive
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"google.golang.org/api/drive/v3"
+	"google.golang.org/api/option"
+)
+
+func TestListFilesOrdered_APIWiring(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name    string
+		orderBy string
+	}{
+		{"explicit order", "modifiedTime desc"},
+		{"api default order", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			var gotQuery, gotOrderBy, gotPageSize string
+			ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				gotQuery = r.URL.Query().Get("q")
+				gotOrderBy = r.URL.Query().Get("orderBy")
+				gotPageSize = r.URL.Query().Get("pageSize")
+				w.Header().Set("Content-Type", "application/json")
+				_ = json.NewEncoder(w).Encode(&drive.FileList{
+					Files: []*drive.File{{Id: "file1", Name: "report.pdf"}},
+				})
+			}))
+			defer ts.Close()
+
+			ctx := context.Background()
+			svc, err := drive.NewService(ctx,
+				option.WithEndpoint(ts.URL),
+				option.WithoutAuthentication(),
+				option.WithHTTPClient(ts.Client()),
+			)
+			if err != nil {
+				t.Fatalf("NewService: %v", err)
+			}
+			c := &Client{service: svc}
+
+			files, err := c.ListFilesOrdered(ctx, "trashed = false", 20, tt.orderBy)
+			if err != nil {
+				t.Fatalf("ListFilesOrdered: %v", err)
+			}
+			if gotQuery != "trashed = false" {
+				t.Errorf("q = %q, want %q", gotQuery, "trashed = false")
+			}
+			if gotOrderBy != tt.orderBy {
+				t.Errorf("orderBy = %q, want %q", gotOrderBy, tt.orderBy)
+			}
+			if gotPageSize != "20" {
+				t.Errorf("pageSize = %q, want 20", gotPageSize)
+			}
+			if len(files) != 1 || files[0].ID != "file1" {
+				t.Errorf("unexpected files: %+v", files)
+			}
+		})
+	}
+}
+
+func TestDownloadFileTo_Streams(t *testing.T) {
+	t.Parallel()
+	payload := bytes.Repeat([]byte("0123456789abcdef"), 256*1024) // 4 MiB
+
+	var gotAlt string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotAlt = r.URL.Query().Get("alt")
+		w.Header().Set("Content-Type", "application/octet-stream")
+		_, _ = w.Write(payload)
+	}))
+	defer ts.Close()
+
+	ctx := context.Background()
+	svc, err := drive.NewService(ctx,
+		option.WithEndpoint(ts.URL),
+		option.WithoutAuthentication(),
+		option.WithHTTPClient(ts.Client()),
+	)
+	if err != nil {
+		t.Fatalf("NewService: %v", err)
+	}
+	c := &Client{service: svc}
+
+	var buf bytes.Buffer
+	n, err := c.DownloadFileTo(ctx, "file1", &buf)
+	if err != nil {
+		t.Fatalf("DownloadFileTo: %v", err)
+	}
+	if gotAlt != "media" {
+		t.Errorf("alt = %q, want media", gotAlt)
+	}
+	if n != int64(len(payload)) {
+		t.Errorf("n = %d, want %d", n, len(payload))
+	}
+	if !bytes.Equal(buf.Bytes(), payload) {
+		t.Error("streamed content does not match source")
+	}
+}
+
+func TestGetFileMetadata_AllFields(t *testing.T) {
+	t.Parallel()
+	var gotFields string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotFields = r.URL.Query().Get("fields")
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{
+			"id": "file1",
+			"name": "plan.docx",
+			"version": "12345678901234567",
+			"capabilities": {"canEdit": false, "canDownload": true},
+			"exportLinks": {"application/pdf": "https://docs.google.com/export?format=pdf"}
+		}`))
+	}))
+	defer ts.Close()
+
+	ctx := context.Background()
+	svc, err := drive.NewService(ctx,
+		option.WithEndpoint(ts.URL),
+		option.WithoutAuthentication(),
+		option.WithHTTPClient(ts.Client()),
+	)
+	if err != nil {
+		t.Fatalf("NewService: %v", err)
+	}
+	c := &Client{service: svc}
+
+	meta, err := c.GetFileMetadata(ctx, "file1")
+	if err != nil {
+		t.Fatalf("GetFileMetadata: %v", err)
+	}
+	if gotFields != "*" {
+		t.Errorf("fields = %q, want *", gotFields)
+	}
+	caps, ok := meta["capabilities"].(map[string]any)
+	if !ok || caps["canDownload"] != true {
+		t.Errorf("capabilities = %#v", meta["capabilities"])
+	}
+	links, ok := meta["exportLinks"].(map[string]any)
+	if !ok || links["application/pdf"] == nil {
+		t.Errorf("exportLinks = %#v", meta["exportLinks"])
+	}
+	if got := fmt.Sprint(meta["version"]); got != "12345678901234567" {
+		t.Errorf("version = %s, want it printed exactly", got)
+	}
+}
+
+func TestGetFile_RequestsLinks(t *testing.T) {
+	t.Parallel()
+	var gotFields string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotFields = r.URL.Query().Get("fields")
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{
+			"id": "doc1",
+			"name": "Plan",
+			"webViewLink": "https://docs.google.com/document/d/doc1/edit",
+			"exportLinks": {"application/pdf": "https://docs.google.com/feeds/download/documents/export/Export?id=doc1&exportFormat=pdf"}
+		}`))
+	}))
+	defer ts.Close()
+
+	ctx := context.Background()
+	svc, err := drive.NewService(ctx,
+		option.WithEndpoint(ts.URL),
+		option.WithoutAuthentication(),
+		option.WithHTTPClient(ts.Client()),
+	)
+	if err != nil {
+		t.Fatalf("NewService: %v", err)
+	}
+	c := &Client{service: svc}
+
+	f, err := c.GetFile(ctx, "doc1")
+	if err != nil {
+		t.Fatalf("GetFile: %v", err)
+	}
+	if gotFields != fileDetailFields {
+		t.Errorf("fields = %q, want %q", gotFields, fileDetailFields)
+	}
+	if f.ExportLinks["application/pdf"] == "" {
+		t.Errorf("ExportLinks = %#v, want a PDF link", f.ExportLinks)
+	}
+}
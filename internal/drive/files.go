@@ -20,7 +20,14 @@ type File struct {
 	Owners       []string  `json:"owners,omitempty"`
 	WebViewLink  string    `json:"webViewLink,omitempty"`
 	Shared       bool      `json:"shared"`
+	Trashed      bool      `json:"trashed,omitempty"`
 	DriveID      string    `json:"driveId,omitempty"` // Shared drive ID if file is in a shared drive
+
+	LastModifiedBy string `json:"lastModifiedBy,omitempty"` // Email (or name if no email) of the last modifier
+	MD5            string `json:"md5Checksum,omitempty"`    // Content checksum; empty for Google Workspace files
+
+	WebContentLink string            `json:"webContentLink,omitempty"` // Direct download link; regular files only
+	ExportLinks    map[string]string `json:"exportLinks,omitempty"`    // Export MIME type to link; Google Workspace files only
 }
 
 // SharedDrive represents a Google Shared Drive (formerly Team Drive)
@@ -46,7 +53,12 @@ func ParseFile(f *drive.File) *File {
 		Parents:     f.Parents,
 		WebViewLink: f.WebViewLink,
 		Shared:      f.Shared,
+		Trashed:     f.Trashed,
 		DriveID:     f.DriveId,
+		MD5:         f.Md5Checksum,
+
+		WebContentLink: f.WebContentLink,
+		ExportLinks:    f.ExportLinks,
 	}
 
 	// Parse timestamps
@@ -61,6 +73,13 @@ func ParseFile(f *drive.File) *File {
 		}
 	}
 
+	if f.LastModifyingUser != nil {
+		file.LastModifiedBy = f.LastModifyingUser.EmailAddress
+		if file.LastModifiedBy == "" {
+			file.LastModifiedBy = f.LastModifyingUser.DisplayName
+		}
+	}
+
 	// Extract owner emails
 	if len(f.Owners) > 0 {
 		file.Owners = make([]string, 0, len(f.Owners))
@@ -23,6 +23,7 @@ func TestParseFile(t *testing.T) {
 			Parents:      []string{"parent1"},
 			WebViewLink:  "https://drive.google.com/file/d/123",
 			Shared:       true,
+			Trashed:      true,
 		}
 
 		result := ParseFile(f)
@@ -54,6 +55,27 @@ func TestParseFile(t *testing.T) {
 		if !result.Shared {
 			t.Error("got false, want true")
 		}
+		if !result.Trashed {
+			t.Error("Trashed: got false, want true")
+		}
+	})
+
+	t.Run("parses download and export links", func(t *testing.T) {
+		t.Parallel()
+		f := &drive.File{
+			Id:             "123",
+			WebContentLink: "https://drive.google.com/uc?id=123&export=download",
+			ExportLinks:    map[string]string{"application/pdf": "https://docs.google.com/export?id=123&exportFormat=pdf"},
+		}
+
+		result := ParseFile(f)
+
+		if result.WebContentLink != f.WebContentLink {
+			t.Errorf("got %q, want %q", result.WebContentLink, f.WebContentLink)
+		}
+		if !reflect.DeepEqual(result.ExportLinks, f.ExportLinks) {
+			t.Errorf("got %v, want %v", result.ExportLinks, f.ExportLinks)
+		}
 	})
 
 	t.Run("parses file with owners", func(t *testing.T) {
@@ -76,6 +98,59 @@ func TestParseFile(t *testing.T) {
 		}
 	})
 
+	t.Run("parses last modifying user", func(t *testing.T) {
+		t.Parallel()
+		f := &drive.File{
+			Id:   "123",
+			Name: "edited.txt",
+			LastModifyingUser: &drive.User{
+				DisplayName:  "Alice",
+				EmailAddress: "alice@example.com",
+			},
+		}
+
+		result := ParseFile(f)
+
+		if result.LastModifiedBy != "alice@example.com" {
+			t.Errorf("got %q, want %q", result.LastModifiedBy, "alice@example.com")
+		}
+	})
+
+	t.Run("falls back to modifier display name", func(t *testing.T) {
+		t.Parallel()
+		f := &drive.File{
+			Id:                "123",
+			Name:              "edited.txt",
+			LastModifyingUser: &drive.User{DisplayName: "Bob"},
+		}
+
+		result := ParseFile(f)
+
+		if result.LastModifiedBy != "Bob" {
+			t.Errorf("got %q, want %q", result.LastModifiedBy, "Bob")
+		}
+	})
+
+	t.Run("handles missing last modifying user", func(t *testing.T) {
+		t.Parallel()
+		result := ParseFile(&drive.File{Id: "123", Name: "untouched.txt"})
+
+		if result.LastModifiedBy != "" {
+			t.Errorf("got %q, want empty", result.LastModifiedBy)
+		}
+	})
+
+	t.Run("parses md5 checksum", func(t *testing.T) {
+		t.Parallel()
+		f := &drive.File{Id: "123", Md5Checksum: "9e107d9d372bb6826bd81d3542a419d6"}
+
+		result := ParseFile(f)
+
+		if result.MD5 != "9e107d9d372bb6826bd81d3542a419d6" {
+			t.Errorf("got %v, want %v", result.MD5, "9e107d9d372bb6826bd81d3542a419d6")
+		}
+	})
+
 	t.Run("handles empty timestamps", func(t *testing.T) {
 		t.Parallel()
 		f := &drive.File{
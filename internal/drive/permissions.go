@@ -0,0 +1,55 @@
+package drive
+
+import (
+	"google.golang.org/api/drive/v3"
+)
+
+// Permission represents a simplified Drive sharing permission
+type Permission struct {
+	ID          string `json:"id"`
+	Type        string `json:"type"` // user, group, domain, or anyone
+	Role        string `json:"role"`
+	Email       string `json:"email,omitempty"`
+	Domain      string `json:"domain,omitempty"`
+	DisplayName string `json:"displayName,omitempty"`
+	Inherited   bool   `json:"inherited,omitempty"` // Granted on a parent folder or shared drive
+}
+
+// ParsePermission converts a Drive API permission to our simplified Permission
+func ParsePermission(p *drive.Permission) *Permission {
+	perm := &Permission{
+		ID:          p.Id,
+		Type:        p.Type,
+		Role:        p.Role,
+		Email:       p.EmailAddress,
+		Domain:      p.Domain,
+		DisplayName: p.DisplayName,
+	}
+
+	// permissionDetails is only populated for shared drive items
+	for _, d := range p.PermissionDetails {
+		if d.Inherited {
+			perm.Inherited = true
+			break
+		}
+	}
+
+	return perm
+}
+
+// Grantee returns who the permission applies to: the email for users and
+// groups, the domain for domain grants, and "anyone" for link sharing
+func (p *Permission) Grantee() string {
+	switch {
+	case p.Type == "anyone":
+		return "anyone"
+	case p.Email != "":
+		return p.Email
+	case p.Domain != "":
+		return p.Domain
+	case p.DisplayName != "":
+		return p.DisplayName
+	default:
+		return "-"
+	}
+}
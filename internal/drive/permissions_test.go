@@ -0,0 +1,101 @@
+package drive
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"google.golang.org/api/drive/v3"
+	"google.golang.org/api/option"
+)
+
+func TestParsePermission(t *testing.T) {
+	t.Parallel()
+
+	t.Run("user permission", func(t *testing.T) {
+		t.Parallel()
+		p := ParsePermission(&drive.Permission{
+			Id:           "perm1",
+			Type:         "user",
+			Role:         "writer",
+			EmailAddress: "alice@example.com",
+			DisplayName:  "Alice",
+		})
+		if p.Type != "user" || p.Role != "writer" || p.Grantee() != "alice@example.com" {
+			t.Errorf("unexpected permission: %+v", p)
+		}
+		if p.Inherited {
+			t.Error("Inherited = true, want false")
+		}
+	})
+
+	t.Run("domain permission", func(t *testing.T) {
+		t.Parallel()
+		p := ParsePermission(&drive.Permission{Type: "domain", Role: "reader", Domain: "example.com"})
+		if p.Grantee() != "example.com" {
+			t.Errorf("Grantee() = %q, want example.com", p.Grantee())
+		}
+	})
+
+	t.Run("anyone permission", func(t *testing.T) {
+		t.Parallel()
+		p := ParsePermission(&drive.Permission{Type: "anyone", Role: "reader"})
+		if p.Grantee() != "anyone" {
+			t.Errorf("Grantee() = %q, want anyone", p.Grantee())
+		}
+	})
+
+	t.Run("inherited permission", func(t *testing.T) {
+		t.Parallel()
+		p := ParsePermission(&drive.Permission{
+			Type:              "group",
+			Role:              "organizer",
+			EmailAddress:      "team@example.com",
+			PermissionDetails: []*drive.PermissionPermissionDetails{{Inherited: true}},
+		})
+		if !p.Inherited {
+			t.Error("Inherited = false, want true")
+		}
+	})
+}
+
+func TestListPermissions_APIWiring(t *testing.T) {
+	t.Parallel()
+	var gotPath, gotAllDrives string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotPath = r.URL.Path
+		gotAllDrives = r.URL.Query().Get("supportsAllDrives")
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(&drive.PermissionList{
+			Permissions: []*drive.Permission{{Id: "perm1", Type: "user", Role: "owner", EmailAddress: "owner@example.com"}},
+		})
+	}))
+	defer ts.Close()
+
+	ctx := context.Background()
+	svc, err := drive.NewService(ctx,
+		option.WithEndpoint(ts.URL),
+		option.WithoutAuthentication(),
+		option.WithHTTPClient(ts.Client()),
+	)
+	if err != nil {
+		t.Fatalf("NewService: %v", err)
+	}
+	c := &Client{service: svc}
+
+	perms, err := c.ListPermissions(ctx, "file123")
+	if err != nil {
+		t.Fatalf("ListPermissions: %v", err)
+	}
+	if gotPath != "/files/file123/permissions" {
+		t.Errorf("path = %q, want /files/file123/permissions", gotPath)
+	}
+	if gotAllDrives != "true" {
+		t.Errorf("supportsAllDrives = %q, want true", gotAllDrives)
+	}
+	if len(perms) != 1 || perms[0].Email != "owner@example.com" {
+		t.Errorf("unexpected permissions: %+v", perms)
+	}
+}
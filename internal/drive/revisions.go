@@ -0,0 +1,62 @@
+package drive
+
+import (
+	"errors"
+	"time"
+
+	"google.golang.org/api/drive/v3"
+	"google.golang.org/api/googleapi"
+)
+
+// Revision represents a simplified Drive file revision
+type Revision struct {
+	ID               string    `json:"id"`
+	ModifiedTime     time.Time `json:"modifiedTime"`
+	LastModifiedBy   string    `json:"lastModifiedBy,omitempty"`
+	Size             int64     `json:"size,omitempty"`
+	MimeType         string    `json:"mimeType,omitempty"`
+	KeepForever      bool      `json:"keepForever,omitempty"`
+	OriginalFilename string    `json:"originalFilename,omitempty"`
+}
+
+// ParseRevision converts a Drive API revision to our simplified Revision
+func ParseRevision(r *drive.Revision) *Revision {
+	rev := &Revision{
+		ID:               r.Id,
+		Size:             r.Size,
+		MimeType:         r.MimeType,
+		KeepForever:      r.KeepForever,
+		OriginalFilename: r.OriginalFilename,
+	}
+
+	if r.ModifiedTime != "" {
+		if t, err := time.Parse(time.RFC3339, r.ModifiedTime); err == nil {
+			rev.ModifiedTime = t
+		}
+	}
+
+	if r.LastModifyingUser != nil {
+		rev.LastModifiedBy = r.LastModifyingUser.EmailAddress
+		if rev.LastModifiedBy == "" {
+			rev.LastModifiedBy = r.LastModifyingUser.DisplayName
+		}
+	}
+
+	return rev
+}
+
+// IsRevisionsNotSupported reports whether err is the Drive API's rejection
+// of revisions.list for a file that has no revision history (folders,
+// shortcuts, and some shared-drive items).
+func IsRevisionsNotSupported(err error) bool {
+	var apiErr *googleapi.Error
+	if !errors.As(err, &apiErr) {
+		return false
+	}
+	for _, e := range apiErr.Errors {
+		if e.Reason == "revisionsNotSupported" {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,123 @@
+package drive
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"google.golang.org/api/drive/v3"
+	"google.golang.org/api/googleapi"
+	"google.golang.org/api/option"
+)
+
+func TestParseRevision(t *testing.T) {
+	t.Parallel()
+	r := &drive.Revision{
+		Id:           "rev1",
+		ModifiedTime: "2024-01-15T10:30:00Z",
+		Size:         2048,
+		MimeType:     "application/pdf",
+		KeepForever:  true,
+		LastModifyingUser: &drive.User{
+			DisplayName:  "Alice",
+			EmailAddress: "alice@example.com",
+		},
+	}
+
+	rev := ParseRevision(r)
+
+	if rev.ID != "rev1" {
+		t.Errorf("ID = %q, want rev1", rev.ID)
+	}
+	if !rev.ModifiedTime.Equal(time.Date(2024, 1, 15, 10, 30, 0, 0, time.UTC)) {
+		t.Errorf("ModifiedTime = %v", rev.ModifiedTime)
+	}
+	if rev.LastModifiedBy != "alice@example.com" {
+		t.Errorf("LastModifiedBy = %q, want alice@example.com", rev.LastModifiedBy)
+	}
+	if rev.Size != 2048 || !rev.KeepForever {
+		t.Errorf("Size/KeepForever = %d/%v", rev.Size, rev.KeepForever)
+	}
+}
+
+func TestParseRevision_DisplayNameFallback(t *testing.T) {
+	t.Parallel()
+	rev := ParseRevision(&drive.Revision{
+		Id:                "rev2",
+		LastModifyingUser: &drive.User{DisplayName: "Bob"},
+	})
+	if rev.LastModifiedBy != "Bob" {
+		t.Errorf("LastModifiedBy = %q, want Bob", rev.LastModifiedBy)
+	}
+}
+
+func TestIsRevisionsNotSupported(t *testing.T) {
+	t.Parallel()
+	notSupported := &googleapi.Error{
+		Code:   403,
+		Errors: []googleapi.ErrorItem{{Reason: "revisionsNotSupported"}},
+	}
+
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{name: "not supported", err: notSupported, want: true},
+		{name: "wrapped", err: fmt.Errorf("listing revisions: %w", notSupported), want: true},
+		{name: "other API error", err: &googleapi.Error{Code: 404, Errors: []googleapi.ErrorItem{{Reason: "notFound"}}}, want: false},
+		{name: "plain error", err: errors.New("boom"), want: false},
+		{name: "nil", err: nil, want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			if got := IsRevisionsNotSupported(tt.err); got != tt.want {
+				t.Errorf("IsRevisionsNotSupported() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestListRevisions_Pagination(t *testing.T) {
+	t.Parallel()
+	var paths []string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		paths = append(paths, r.URL.Path)
+		resp := &drive.RevisionList{Revisions: []*drive.Revision{{Id: "rev1"}, {Id: "rev2"}}, NextPageToken: "page2"}
+		if r.URL.Query().Get("pageToken") == "page2" {
+			resp = &drive.RevisionList{Revisions: []*drive.Revision{{Id: "rev3"}}}
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(resp)
+	}))
+	defer ts.Close()
+
+	ctx := context.Background()
+	svc, err := drive.NewService(ctx,
+		option.WithEndpoint(ts.URL),
+		option.WithoutAuthentication(),
+		option.WithHTTPClient(ts.Client()),
+	)
+	if err != nil {
+		t.Fatalf("NewService: %v", err)
+	}
+	c := &Client{service: svc}
+
+	revisions, err := c.ListRevisions(ctx, "file123")
+	if err != nil {
+		t.Fatalf("ListRevisions: %v", err)
+	}
+	if len(paths) != 2 || paths[0] != "/files/file123/revisions" {
+		t.Errorf("paths = %v, want two requests to /files/file123/revisions", paths)
+	}
+	if len(revisions) != 3 || revisions[2].ID != "rev3" {
+		t.Errorf("got %d revisions, want rev1..rev3", len(revisions))
+	}
+}
@@ -0,0 +1,173 @@
+// Package exit defines gro's process exit codes and maps errors onto them,
+// so scripts can tell an auth failure from a missing resource or a flaky
+// network without parsing stderr.
+package exit
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/http"
+	"slices"
+	"strings"
+
+	"golang.org/x/oauth2"
+	"google.golang.org/api/googleapi"
+
+	"github.com/open-cli-collective/google-readonly/internal/auth"
+	"github.com/open-cli-collective/google-readonly/internal/keychain"
+)
+
+// Exit codes. Failure covers every error not classified more precisely.
+const (
+	OK       = 0
+	Failure  = 1
+	Usage    = 2
+	Auth     = 3
+	NotFound = 4
+	Network  = 5
+	Quota    = 6
+)
+
+// names are the stable identifiers of the exit codes, for machine-readable
+// error output
+var names = map[int]string{
+	OK:       "ok",
+	Failure:  "error",
+	Usage:    "usage",
+	Auth:     "auth",
+	NotFound: "not_found",
+	Network:  "network",
+	Quota:    "quota",
+}
+
+// Name returns the identifier of an exit code, such as "not_found", or
+// "error" for a code it does not know
+func Name(code int) string {
+	if name, ok := names[code]; ok {
+		return name
+	}
+	return names[Failure]
+}
+
+// quotaReasons are the googleapi error reasons that mean a rate limit or
+// quota ran out rather than a permission problem
+var quotaReasons = []string{"rateLimitExceeded", "userRateLimitExceeded", "quotaExceeded", "dailyLimitExceeded"}
+
+// scopeReasons are the googleapi error reasons that mean the token lacks a
+// scope. Older APIs report insufficientPermissions, newer ones the
+// ACCESS_TOKEN_SCOPE_INSUFFICIENT error-info reason.
+var scopeReasons = []string{"insufficientPermissions", "ACCESS_TOKEN_SCOPE_INSUFFICIENT"}
+
+// usageError marks an error as a mistake on the command line
+type usageError struct {
+	err error
+}
+
+func (e *usageError) Error() string { return e.err.Error() }
+func (e *usageError) Unwrap() error { return e.err }
+
+// UsageError marks err as a command-line usage mistake, so Code maps it to
+// Usage. A nil err stays nil.
+func UsageError(err error) error {
+	if err == nil {
+		return nil
+	}
+	return &usageError{err: err}
+}
+
+// Code returns the exit code for err: OK for nil, Failure when err fits no
+// other class
+func Code(err error) int {
+	if err == nil {
+		return OK
+	}
+
+	var usage *usageError
+	if errors.As(err, &usage) {
+		return Usage
+	}
+	if IsAuthError(err) || isCredentialError(err) {
+		return Auth
+	}
+
+	var apiErr *googleapi.Error
+	if errors.As(err, &apiErr) {
+		switch {
+		case apiErr.Code == http.StatusNotFound:
+			return NotFound
+		case apiErr.Code == http.StatusTooManyRequests || isQuotaError(apiErr):
+			return Quota
+		case apiErr.Code == http.StatusForbidden:
+			return Auth
+		case apiErr.Code >= http.StatusInternalServerError:
+			return Network
+		}
+	}
+
+	var netErr net.Error
+	if errors.As(err, &netErr) || errors.Is(err, context.DeadlineExceeded) {
+		return Network
+	}
+	return Failure
+}
+
+// IsAuthError reports whether err is Google rejecting the stored token as
+// invalid, expired or revoked
+func IsAuthError(err error) bool {
+	if err == nil {
+		return false
+	}
+	var apiErr *googleapi.Error
+	if errors.As(err, &apiErr) {
+		return apiErr.Code == http.StatusUnauthorized
+	}
+	errStr := err.Error()
+	return strings.Contains(errStr, "401") &&
+		(strings.Contains(errStr, "Invalid Credentials") ||
+			strings.Contains(errStr, "invalid_grant") ||
+			strings.Contains(errStr, "Token has been expired or revoked"))
+}
+
+// IsScopeError reports whether err is a 403 caused by the token lacking an
+// OAuth scope the request needs, as opposed to a 403 for an API that is not
+// enabled or a file the user cannot see. Only scope errors are fixed by
+// re-authenticating.
+func IsScopeError(err error) bool {
+	var apiErr *googleapi.Error
+	if !errors.As(err, &apiErr) || apiErr.Code != http.StatusForbidden {
+		return false
+	}
+	for _, item := range apiErr.Errors {
+		if slices.Contains(scopeReasons, item.Reason) {
+			return true
+		}
+	}
+	msg := strings.ToLower(apiErr.Message)
+	return strings.Contains(msg, "insufficient authentication scopes") ||
+		strings.Contains(msg, "access_token_scope_insufficient")
+}
+
+// isCredentialError reports whether err means gro has no usable credentials
+// at all: no stored token, no refresh token, no grant for the service, or a
+// token endpoint that refused to issue one
+func isCredentialError(err error) bool {
+	if errors.Is(err, keychain.ErrTokenNotFound) ||
+		errors.Is(err, auth.ErrNoRefreshToken) ||
+		errors.Is(err, auth.ErrNotAuthorized) {
+		return true
+	}
+	var rErr *oauth2.RetrieveError
+	return errors.As(err, &rErr)
+}
+
+// isQuotaError reports whether a googleapi error is a rate limit or quota
+// rejection, which Google often sends as 403 rather than 429
+func isQuotaError(apiErr *googleapi.Error) bool {
+	for _, item := range apiErr.Errors {
+		if slices.Contains(quotaReasons, item.Reason) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,127 @@
+package exit
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"golang.org/x/oauth2"
+	"google.golang.org/api/googleapi"
+
+	"github.com/open-cli-collective/google-readonly/internal/auth"
+	"github.com/open-cli-collective/google-readonly/internal/keychain"
+	"github.com/open-cli-collective/google-readonly/internal/testutil"
+)
+
+func TestIsAuthError(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name     string
+		err      error
+		expected bool
+	}{
+		{"nil error", nil, false},
+		{"generic error", errors.New("something went wrong"), false},
+		{"network error", errors.New("connection refused"), false},
+		{"googleapi 401", &googleapi.Error{Code: http.StatusUnauthorized, Message: "Invalid Credentials"}, true},
+		{"googleapi 403", &googleapi.Error{Code: http.StatusForbidden, Message: "Access denied"}, false},
+		{"googleapi 404", &googleapi.Error{Code: http.StatusNotFound, Message: "Not found"}, false},
+		{"text 401 + Invalid Credentials", errors.New("googleapi: Error 401: Invalid Credentials"), true},
+		{"text 401 + invalid_grant", errors.New("oauth2: 401 invalid_grant: Token has been expired"), true},
+		{"text Token has been expired or revoked", errors.New("401: Token has been expired or revoked"), true},
+		{"text 401 alone", errors.New("HTTP 401 response"), false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			testutil.Equal(t, IsAuthError(tt.err), tt.expected)
+		})
+	}
+}
+
+func TestIsScopeError(t *testing.T) {
+	t.Parallel()
+	scoped := func(reason string) error {
+		return &googleapi.Error{Code: http.StatusForbidden, Errors: []googleapi.ErrorItem{{Reason: reason}}}
+	}
+	tests := []struct {
+		name     string
+		err      error
+		expected bool
+	}{
+		{"nil error", nil, false},
+		{"generic error", errors.New("insufficientPermissions"), false},
+		{"insufficientPermissions", scoped("insufficientPermissions"), true},
+		{"ACCESS_TOKEN_SCOPE_INSUFFICIENT", scoped("ACCESS_TOKEN_SCOPE_INSUFFICIENT"), true},
+		{"wrapped", fmt.Errorf("listing files: %w", scoped("insufficientPermissions")), true},
+		{"message only", &googleapi.Error{Code: http.StatusForbidden, Message: "Request had insufficient authentication scopes."}, true},
+		{"other 403", scoped("forbidden"), false},
+		{"rate limit", scoped("userRateLimitExceeded"), false},
+		{"401 with scope reason", &googleapi.Error{Code: http.StatusUnauthorized, Errors: []googleapi.ErrorItem{{Reason: "insufficientPermissions"}}}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			testutil.Equal(t, IsScopeError(tt.err), tt.expected)
+		})
+	}
+}
+
+func TestCode(t *testing.T) {
+	t.Parallel()
+	wrap := func(err error) error { return fmt.Errorf("listing files: %w", err) }
+	dnsErr := &url.Error{Op: "Get", URL: "https://www.googleapis.com", Err: &net.DNSError{Err: "no such host", Name: "www.googleapis.com"}}
+
+	tests := []struct {
+		name string
+		err  error
+		want int
+	}{
+		{"nil", nil, OK},
+		{"unclassified", errors.New("boom"), Failure},
+		{"usage", UsageError(errors.New(`unknown flag: --nope`)), Usage},
+		{"wrapped usage", wrap(UsageError(errors.New("accepts 1 arg(s)"))), Usage},
+		{"401", wrap(&googleapi.Error{Code: http.StatusUnauthorized}), Auth},
+		{"no token", wrap(keychain.ErrTokenNotFound), Auth},
+		{"no refresh token", auth.ErrNoRefreshToken, Auth},
+		{"no gmail scope", wrap(&auth.NotAuthorizedError{Domain: "mail"}), Auth},
+		{"token endpoint refusal", wrap(&oauth2.RetrieveError{ErrorCode: "invalid_grant"}), Auth},
+		{"403 permission", wrap(&googleapi.Error{Code: http.StatusForbidden, Errors: []googleapi.ErrorItem{{Reason: "insufficientPermissions"}}}), Auth},
+		{"404", wrap(&googleapi.Error{Code: http.StatusNotFound}), NotFound},
+		{"429", wrap(&googleapi.Error{Code: http.StatusTooManyRequests}), Quota},
+		{"403 rate limit", wrap(&googleapi.Error{Code: http.StatusForbidden, Errors: []googleapi.ErrorItem{{Reason: "userRateLimitExceeded"}}}), Quota},
+		{"503", wrap(&googleapi.Error{Code: http.StatusServiceUnavailable}), Network},
+		{"dns failure", wrap(dnsErr), Network},
+		{"deadline", wrap(context.DeadlineExceeded), Network},
+		{"400", wrap(&googleapi.Error{Code: http.StatusBadRequest}), Failure},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			testutil.Equal(t, Code(tt.err), tt.want)
+		})
+	}
+}
+
+func TestUsageError(t *testing.T) {
+	t.Parallel()
+	testutil.NoError(t, UsageError(nil))
+
+	base := errors.New("unknown flag: --nope")
+	err := UsageError(base)
+	testutil.Equal(t, err.Error(), base.Error())
+	testutil.True(t, errors.Is(err, base))
+}
+
+func TestName(t *testing.T) {
+	t.Parallel()
+	testutil.Equal(t, Name(NotFound), "not_found")
+	testutil.Equal(t, Name(Auth), "auth")
+	testutil.Equal(t, Name(Failure), "error")
+	testutil.Equal(t, Name(OK), "ok")
+	testutil.Equal(t, Name(42), "error")
+}
@@ -0,0 +1,34 @@
+package fileutil
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+)
+
+// SafeJoin joins name onto destDir, refusing any name that would land
+// outside it. Names of downloaded files come from other people (attachment
+// filenames, shared Drive items), so an absolute path or a ".." component is
+// an error rather than something to resolve. destDir must be absolute.
+func SafeJoin(destDir, name string) (string, error) {
+	cleanName := filepath.Clean(name)
+
+	if filepath.IsAbs(cleanName) {
+		return "", fmt.Errorf("invalid file name: absolute path not allowed")
+	}
+
+	for _, part := range strings.Split(cleanName, string(filepath.Separator)) {
+		if part == ".." {
+			return "", fmt.Errorf("invalid file name: path traversal not allowed")
+		}
+	}
+
+	// A last check on the joined path, so the destination directory itself
+	// (a name of ".") is refused as well
+	outputPath := filepath.Join(destDir, cleanName)
+	if !strings.HasPrefix(outputPath, destDir+string(filepath.Separator)) {
+		return "", fmt.Errorf("invalid file name: path escapes destination directory")
+	}
+
+	return outputPath, nil
+}
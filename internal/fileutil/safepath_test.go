@@ -0,0 +1,47 @@
+package fileutil
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/open-cli-collective/google-readonly/internal/testutil"
+)
+
+func TestSafeJoin(t *testing.T) {
+	t.Parallel()
+	destDir := "/tmp/downloads"
+
+	tests := []struct {
+		name    string
+		file    string
+		want    string
+		wantErr string
+	}{
+		{name: "simple filename", file: "report.pdf", want: "report.pdf"},
+		{name: "filename with spaces", file: "my report.pdf", want: "my report.pdf"},
+		{name: "filename in subdirectory", file: "attachments/report.pdf", want: "attachments/report.pdf"},
+		{name: "hidden file", file: ".hidden", want: ".hidden"},
+		{name: "dot in filename", file: "report.v2.pdf", want: "report.v2.pdf"},
+		{name: "traversal that cleans away", file: "foo/../bar", want: "bar"},
+		{name: "path traversal with ..", file: "../../../etc/passwd", wantErr: "path traversal not allowed"},
+		{name: "path traversal at start", file: "../secret.txt", wantErr: "path traversal not allowed"},
+		{name: "path traversal in middle", file: "subdir/../../../etc/passwd", wantErr: "path traversal not allowed"},
+		{name: "double dot only", file: "..", wantErr: "path traversal not allowed"},
+		{name: "absolute path", file: "/etc/passwd", wantErr: "absolute path not allowed"},
+		{name: "current directory", file: ".", wantErr: "path escapes destination directory"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := SafeJoin(destDir, tt.file)
+			if tt.wantErr != "" {
+				testutil.Error(t, err)
+				testutil.Contains(t, err.Error(), tt.wantErr)
+				return
+			}
+			testutil.NoError(t, err)
+			testutil.Equal(t, got, filepath.Join(destDir, tt.want))
+		})
+	}
+}
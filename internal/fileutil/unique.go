@@ -0,0 +1,38 @@
+// Package fileutil provides helpers for writing downloaded files to disk.
+package fileutil
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// UniquePath returns the path for name inside dir, numbered so it does not
+// collide with an existing file: "report.pdf" becomes "report (1).pdf", then
+// "report (2).pdf", and so on. A name without an extension, or one that is
+// only an extension such as ".env", gets the number appended.
+func UniquePath(dir, name string) string {
+	path := filepath.Join(dir, name)
+	if !exists(path) {
+		return path
+	}
+
+	ext := filepath.Ext(name)
+	base := strings.TrimSuffix(name, ext)
+	if base == "" {
+		base, ext = name, ""
+	}
+	for i := 1; ; i++ {
+		path = filepath.Join(dir, fmt.Sprintf("%s (%d)%s", base, i, ext))
+		if !exists(path) {
+			return path
+		}
+	}
+}
+
+// exists reports whether anything, including a dangling symlink, is at path
+func exists(path string) bool {
+	_, err := os.Lstat(path)
+	return err == nil
+}
@@ -0,0 +1,50 @@
+package fileutil
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/open-cli-collective/google-readonly/internal/testutil"
+)
+
+// touch creates empty files named names in dir
+func touch(t *testing.T, dir string, names ...string) {
+	t.Helper()
+	for _, name := range names {
+		testutil.NoError(t, os.WriteFile(filepath.Join(dir, name), nil, 0o600))
+	}
+}
+
+func TestUniquePath(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name     string
+		existing []string
+		file     string
+		want     string
+	}{
+		{"free name is kept", nil, "report.pdf", "report.pdf"},
+		{"collision with extension", []string{"report.pdf"}, "report.pdf", "report (1).pdf"},
+		{"skips taken numbers", []string{"report.pdf", "report (1).pdf", "report (2).pdf"}, "report.pdf", "report (3).pdf"},
+		{"only the last extension moves", []string{"backup.tar.gz"}, "backup.tar.gz", "backup.tar (1).gz"},
+		{"collision without extension", []string{"README"}, "README", "README (1)"},
+		{"dotfile has no extension", []string{".env"}, ".env", ".env (1)"},
+		{"other files do not count", []string{"report.txt"}, "report.pdf", "report.pdf"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			dir := t.TempDir()
+			touch(t, dir, tt.existing...)
+			testutil.Equal(t, UniquePath(dir, tt.file), filepath.Join(dir, tt.want))
+		})
+	}
+}
+
+func TestUniquePath_DanglingSymlink(t *testing.T) {
+	t.Parallel()
+	dir := t.TempDir()
+	testutil.NoError(t, os.Symlink(filepath.Join(dir, "missing"), filepath.Join(dir, "link.txt")))
+	testutil.Equal(t, UniquePath(dir, "link.txt"), filepath.Join(dir, "link (1).txt"))
+}
@@ -0,0 +1,69 @@
+package format
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+)
+
+// DateLayout is the Go time layout used by Date for text output. Empty means
+// each caller keeps its own default layout. Set once at startup from
+// --date-format or the date_format config key; JSON output never uses it.
+var DateLayout string
+
+// datePresets are the named layouts accepted by ParseDateLayout
+var datePresets = map[string]string{
+	"iso":    "2006-01-02 15:04",
+	"us":     "01/02/2006 3:04 PM",
+	"eu":     "02/01/2006 15:04",
+	"rfc822": time.RFC822Z,
+}
+
+// ParseDateLayout resolves a --date-format value to a Go time layout. The
+// value is either a preset name (iso, us, eu, rfc822) or a Go reference
+// layout such as "2006-01-02". An empty value returns an empty layout.
+func ParseDateLayout(value string) (string, error) {
+	if value == "" {
+		return "", nil
+	}
+	if layout, ok := datePresets[strings.ToLower(value)]; ok {
+		return layout, nil
+	}
+
+	// A layout with no reference elements formats every time as itself.
+	probe := time.Date(2001, 2, 3, 4, 5, 6, 0, time.UTC)
+	if probe.Format(value) == value {
+		return "", fmt.Errorf("invalid date format %q (use %s, or a Go layout such as 2006-01-02)",
+			value, strings.Join(DatePresetNames(), ", "))
+	}
+	return value, nil
+}
+
+// ParseDate parses a date in YYYY-MM-DD form, as the calendar and mail date
+// flags take it. The result is midnight UTC.
+func ParseDate(value string) (time.Time, error) {
+	t, err := time.Parse("2006-01-02", value)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid date format (use YYYY-MM-DD): %w", err)
+	}
+	return t, nil
+}
+
+// DatePresetNames returns the preset names accepted by ParseDateLayout, sorted
+func DatePresetNames() []string {
+	names := make([]string, 0, len(datePresets))
+	for name := range datePresets {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Date formats t with DateLayout, or with defaultLayout if none is configured
+func Date(t time.Time, defaultLayout string) string {
+	if DateLayout != "" {
+		return t.Format(DateLayout)
+	}
+	return t.Format(defaultLayout)
+}
@@ -0,0 +1,150 @@
+package format
+
+import (
+	"testing"
+	"time"
+
+	"github.com/open-cli-collective/google-readonly/internal/testutil"
+)
+
+func TestParseDateLayout(t *testing.T) {
+	t.Parallel()
+	instant := time.Date(2024, 3, 7, 14, 5, 0, 0, time.UTC)
+
+	tests := []struct {
+		value    string
+		expected string
+	}{
+		{"iso", "2024-03-07 14:05"},
+		{"us", "03/07/2024 2:05 PM"},
+		{"eu", "07/03/2024 14:05"},
+		{"rfc822", "07 Mar 24 14:05 +0000"},
+		{"ISO", "2024-03-07 14:05"},
+		{"2006/01/02", "2024/03/07"},
+		{"Jan 2 15:04", "Mar 7 14:05"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			t.Parallel()
+			layout, err := ParseDateLayout(tt.value)
+			testutil.NoError(t, err)
+			testutil.Equal(t, instant.Format(layout), tt.expected)
+		})
+	}
+
+	t.Run("empty keeps defaults", func(t *testing.T) {
+		t.Parallel()
+		layout, err := ParseDateLayout("")
+		testutil.NoError(t, err)
+		testutil.Equal(t, layout, "")
+	})
+
+	t.Run("rejects values with no layout elements", func(t *testing.T) {
+		t.Parallel()
+		_, err := ParseDateLayout("german")
+		testutil.Error(t, err)
+		testutil.Contains(t, err.Error(), "iso, rfc822, us")
+	})
+}
+
+func TestDate(t *testing.T) {
+	instant := time.Date(2024, 3, 7, 14, 5, 0, 0, time.UTC)
+
+	t.Run("uses default layout when unset", func(t *testing.T) {
+		testutil.Equal(t, Date(instant, "2006-01-02"), "2024-03-07")
+	})
+
+	t.Run("uses configured layout", func(t *testing.T) {
+		DateLayout = "02/01/2006"
+		defer func() { DateLayout = "" }()
+		testutil.Equal(t, Date(instant, "2006-01-02"), "07/03/2024")
+	})
+}
+
+func TestParseDate(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name    string
+		input   string
+		wantErr bool
+		want    time.Time
+	}{
+		{
+			name:    "valid date",
+			input:   "2026-01-24",
+			wantErr: false,
+			want:    time.Date(2026, 1, 24, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:    "valid date leap year",
+			input:   "2024-02-29",
+			wantErr: false,
+			want:    time.Date(2024, 2, 29, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:    "valid date year start",
+			input:   "2026-01-01",
+			wantErr: false,
+			want:    time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:    "valid date year end",
+			input:   "2026-12-31",
+			wantErr: false,
+			want:    time.Date(2026, 12, 31, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:    "invalid format - slash separator",
+			input:   "2026/01/24",
+			wantErr: true,
+		},
+		{
+			name:    "invalid format - wrong order",
+			input:   "24-01-2026",
+			wantErr: true,
+		},
+		{
+			name:    "invalid format - missing leading zero",
+			input:   "2026-1-24",
+			wantErr: true,
+		},
+		{
+			name:    "invalid date - month 13",
+			input:   "2026-13-01",
+			wantErr: true,
+		},
+		{
+			name:    "invalid date - day 32",
+			input:   "2026-01-32",
+			wantErr: true,
+		},
+		{
+			name:    "invalid format - empty",
+			input:   "",
+			wantErr: true,
+		},
+		{
+			name:    "invalid format - text",
+			input:   "tomorrow",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			result, err := ParseDate(tt.input)
+
+			if tt.wantErr {
+				testutil.Error(t, err)
+				testutil.Contains(t, err.Error(), "invalid date format")
+			} else {
+				testutil.NoError(t, err)
+				testutil.Equal(t, result.Year(), tt.want.Year())
+				testutil.Equal(t, result.Month(), tt.want.Month())
+				testutil.Equal(t, result.Day(), tt.want.Day())
+			}
+		})
+	}
+}
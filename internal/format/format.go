@@ -1,7 +1,12 @@
 // Package format provides shared formatting utilities for consistent output.
 package format
 
-import "fmt"
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
 
 // Truncate shortens a string to maxLen characters, adding "..." if truncated.
 // If the string is already within maxLen, it is returned unchanged.
@@ -28,3 +33,42 @@ func Size(bytes int64) string {
 	}
 	return fmt.Sprintf("%.1f %cB", float64(bytes)/float64(div), "KMGTPE"[exp])
 }
+
+// sizeUnits maps the unit suffixes ParseSize accepts to their multiplier.
+// Like Size, every unit is a power of 1024.
+var sizeUnits = map[string]int64{
+	"":  1,
+	"B": 1,
+	"K": 1 << 10, "KB": 1 << 10, "KIB": 1 << 10,
+	"M": 1 << 20, "MB": 1 << 20, "MIB": 1 << 20,
+	"G": 1 << 30, "GB": 1 << 30, "GIB": 1 << 30,
+	"T": 1 << 40, "TB": 1 << 40, "TIB": 1 << 40,
+}
+
+// ParseSize is the inverse of Size: it parses a human-readable size such as
+// "100KB", "1.5 MB", "2g" or "512" (bytes) into a byte count. Units are
+// case-insensitive and 1024-based.
+func ParseSize(s string) (int64, error) {
+	trimmed := strings.TrimSpace(s)
+	end := strings.IndexFunc(trimmed, func(r rune) bool {
+		return (r < '0' || r > '9') && r != '.'
+	})
+	if end < 0 {
+		end = len(trimmed)
+	}
+
+	num, unit := trimmed[:end], strings.ToUpper(strings.TrimSpace(trimmed[end:]))
+	mult, ok := sizeUnits[unit]
+	if num == "" || !ok {
+		return 0, fmt.Errorf("invalid size %q: use a number with an optional unit such as 500KB or 1.5MB", s)
+	}
+	n, err := strconv.ParseFloat(num, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid size %q: %w", s, err)
+	}
+	bytes := n * float64(mult)
+	if bytes > math.MaxInt64 {
+		return 0, fmt.Errorf("invalid size %q: too large", s)
+	}
+	return int64(math.Round(bytes)), nil
+}
@@ -56,3 +56,47 @@ func TestSize(t *testing.T) {
 		})
 	}
 }
+
+func TestParseSize(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		input    string
+		expected int64
+		wantErr  bool
+	}{
+		{"512", 512, false},
+		{"512B", 512, false},
+		{"100KB", 100 * 1024, false},
+		{"100kb", 100 * 1024, false},
+		{"100k", 100 * 1024, false},
+		{"1.5 MB", 1572864, false},
+		{"2MiB", 2 * 1024 * 1024, false},
+		{"1G", 1073741824, false},
+		{" 1TB ", 1099511627776, false},
+		{"", 0, true},
+		{"MB", 0, true},
+		{"10XB", 0, true},
+		{"1.2.3MB", 0, true},
+		{"-5KB", 0, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			t.Parallel()
+			got, err := ParseSize(tt.input)
+			if tt.wantErr {
+				testutil.Error(t, err)
+				return
+			}
+			testutil.NoError(t, err)
+			testutil.Equal(t, got, tt.expected)
+		})
+	}
+
+	t.Run("round-trips Size", func(t *testing.T) {
+		t.Parallel()
+		got, err := ParseSize(Size(2621440))
+		testutil.NoError(t, err)
+		testutil.Equal(t, got, int64(2621440))
+	})
+}
@@ -0,0 +1,25 @@
+package format
+
+// NoHeaders suppresses the column header row of tabular output. Set once at
+// startup from --no-headers (or --plain).
+var NoHeaders bool
+
+// Plain replaces decorative glyphs such as tree branches and horizontal
+// rules with plain indentation. Set once at startup from --plain.
+var Plain bool
+
+// TreeGlyphs are the prefixes that draw a tree. Branch and LastBranch lead
+// a child's line; Pipe and Space continue the lines below a child that is
+// not, or is, the last of its siblings.
+type TreeGlyphs struct {
+	Branch, LastBranch, Pipe, Space string
+}
+
+// Tree returns the box-drawing glyphs for a tree, or two-space indentation
+// per level under --plain
+func Tree() TreeGlyphs {
+	if Plain {
+		return TreeGlyphs{Branch: "  ", LastBranch: "  ", Pipe: "  ", Space: "  "}
+	}
+	return TreeGlyphs{Branch: "├── ", LastBranch: "└── ", Pipe: "│   ", Space: "    "}
+}
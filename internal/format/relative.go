@@ -0,0 +1,54 @@
+package format
+
+import (
+	"fmt"
+	"time"
+)
+
+// Relative makes list views show times relative to now, such as
+// "3 days ago", via RelativeTime. Set once at startup when stdout is a terminal, unless
+// --absolute-time, --plain or a date format asks for absolute dates.
+var Relative bool
+
+// now is the clock RelativeTime measures from; tests pin it
+var now = time.Now
+
+// RelativeTime describes t relative to now: "just now" within a minute,
+// then "5 minutes ago", "2 hours ago", "3 days ago", "2 weeks ago" or
+// "4 months ago", and "in 2 hours" and so on for future times. A time a year
+// or more away is shown as its date instead, since "14 months ago" says less
+// than the date does.
+func RelativeTime(t time.Time) string {
+	d := now().Sub(t)
+	future := d < 0
+	if future {
+		d = -d
+	}
+
+	var n int
+	var unit string
+	switch {
+	case d < time.Minute:
+		return "just now"
+	case d < time.Hour:
+		n, unit = int(d/time.Minute), "minute"
+	case d < 24*time.Hour:
+		n, unit = int(d/time.Hour), "hour"
+	case d < 7*24*time.Hour:
+		n, unit = int(d/(24*time.Hour)), "day"
+	case d < 30*24*time.Hour:
+		n, unit = int(d/(7*24*time.Hour)), "week"
+	case d < 365*24*time.Hour:
+		n, unit = int(d/(30*24*time.Hour)), "month"
+	default:
+		return Date(t, "2006-01-02")
+	}
+
+	if n != 1 {
+		unit += "s"
+	}
+	if future {
+		return fmt.Sprintf("in %d %s", n, unit)
+	}
+	return fmt.Sprintf("%d %s ago", n, unit)
+}
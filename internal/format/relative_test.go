@@ -0,0 +1,46 @@
+package format
+
+import (
+	"testing"
+	"time"
+
+	"github.com/open-cli-collective/google-readonly/internal/testutil"
+)
+
+func TestRelativeTime(t *testing.T) {
+	at := time.Date(2026, 3, 15, 12, 0, 0, 0, time.UTC)
+	orig := now
+	now = func() time.Time { return at }
+	t.Cleanup(func() { now = orig })
+
+	tests := []struct {
+		name string
+		t    time.Time
+		want string
+	}{
+		{"just now", at.Add(-20 * time.Second), "just now"},
+		{"just ahead", at.Add(30 * time.Second), "just now"},
+		{"one minute", at.Add(-time.Minute), "1 minute ago"},
+		{"minutes", at.Add(-45 * time.Minute), "45 minutes ago"},
+		{"hours", at.Add(-3 * time.Hour), "3 hours ago"},
+		{"future hours", at.Add(2*time.Hour + 10*time.Minute), "in 2 hours"},
+		{"one day", at.Add(-26 * time.Hour), "1 day ago"},
+		{"days", at.AddDate(0, 0, -3), "3 days ago"},
+		{"weeks", at.AddDate(0, 0, -15), "2 weeks ago"},
+		{"months", at.AddDate(0, 0, -100), "3 months ago"},
+		{"future days", at.AddDate(0, 0, 5), "in 5 days"},
+		{"over a year", at.AddDate(-1, 0, -1), "2025-03-14"},
+		{"over a year ahead", at.AddDate(2, 0, 0), "2028-03-15"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			testutil.Equal(t, RelativeTime(tt.t), tt.want)
+		})
+	}
+
+	t.Run("an old date follows the date format", func(t *testing.T) {
+		DateLayout = "02/01/2006"
+		defer func() { DateLayout = "" }()
+		testutil.Equal(t, RelativeTime(at.AddDate(-2, 0, 0)), "15/03/2024")
+	})
+}
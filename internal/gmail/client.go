@@ -12,6 +12,20 @@ import (
 	"github.com/open-cli-collective/google-readonly/internal/auth"
 )
 
+// LabelCache persists the label list between runs. GetLabels returns nil
+// when there is nothing fresh to use.
+type LabelCache interface {
+	GetLabels() ([]*gmail.Label, error)
+	SetLabels(labels []*gmail.Label) error
+}
+
+// PageTally counts the items of a long listing as its pages arrive. Done is
+// called once the listing ends.
+type PageTally interface {
+	Add(n int)
+	Done()
+}
+
 // Client wraps the Gmail API service
 type Client struct {
 	service      *gmail.Service
@@ -20,6 +34,10 @@ type Client struct {
 	labelsByName map[string]string // display name -> label ID
 	labelsLoaded bool
 	labelsMu     sync.RWMutex
+	// labelCache persists the label list between runs; nil disables it
+	labelCache LabelCache
+	// newTally starts a PageTally for each unbounded listing; nil disables it
+	newTally func(noun string) PageTally
 }
 
 // NewClient creates a new Gmail client with OAuth2 authentication
@@ -40,7 +58,36 @@ func NewClient(ctx context.Context) (*Client, error) {
 	}, nil
 }
 
-// FetchLabels retrieves and caches all labels from the Gmail account
+// SetLabelCache makes FetchLabels read from and write to lc
+func (c *Client) SetLabelCache(lc LabelCache) {
+	c.labelsMu.Lock()
+	defer c.labelsMu.Unlock()
+	c.labelCache = lc
+}
+
+// SetPageTally makes ListAllMessageIDs and CountMessages report each page
+// they fetch to a tally started by newTally
+func (c *Client) SetPageTally(newTally func(noun string) PageTally) {
+	c.newTally = newTally
+}
+
+// pageTally starts a tally for a listing of noun, or one that ignores
+// everything when none was set
+func (c *Client) pageTally(noun string) PageTally {
+	if c.newTally == nil {
+		return noTally{}
+	}
+	return c.newTally(noun)
+}
+
+// noTally is the PageTally of a client without SetPageTally
+type noTally struct{}
+
+func (noTally) Add(int) {}
+func (noTally) Done()   {}
+
+// FetchLabels loads all labels of the Gmail account, from the label cache
+// when one is set and fresh, and from the API otherwise
 func (c *Client) FetchLabels(ctx context.Context) error {
 	// Check with read lock first to avoid unnecessary API calls
 	c.labelsMu.RLock()
@@ -59,20 +106,51 @@ func (c *Client) FetchLabels(ctx context.Context) error {
 		return nil
 	}
 
+	if c.labelCache != nil {
+		// A cache read error is treated like a miss: the API has the answer
+		if cached, _ := c.labelCache.GetLabels(); cached != nil {
+			c.setLabels(cached)
+			return nil
+		}
+	}
+
+	return c.fetchLabelsLocked(ctx)
+}
+
+// RefreshLabels fetches labels from the API even when the label cache is
+// fresh, and rewrites the cache with the result
+func (c *Client) RefreshLabels(ctx context.Context) error {
+	c.labelsMu.Lock()
+	defer c.labelsMu.Unlock()
+
+	return c.fetchLabelsLocked(ctx)
+}
+
+// fetchLabelsLocked lists labels from the API and stores them in memory and
+// in the label cache. The caller must hold labelsMu for writing.
+func (c *Client) fetchLabelsLocked(ctx context.Context) error {
 	resp, err := c.service.Users.Labels.List(c.userID).Context(ctx).Do()
 	if err != nil {
 		return fmt.Errorf("fetching labels: %w", err)
 	}
 
+	c.setLabels(resp.Labels)
+	if c.labelCache != nil {
+		_ = c.labelCache.SetLabels(resp.Labels) // Ignore cache write errors
+	}
+	return nil
+}
+
+// setLabels replaces the in-memory label maps. The caller must hold
+// labelsMu for writing.
+func (c *Client) setLabels(labels []*gmail.Label) {
 	c.labels = make(map[string]*gmail.Label)
 	c.labelsByName = make(map[string]string)
-	for _, label := range resp.Labels {
+	for _, label := range labels {
 		c.labels[label.Id] = label
 		c.labelsByName[label.Name] = label.Id
 	}
 	c.labelsLoaded = true
-
-	return nil
 }
 
 // GetLabelName resolves a label ID to its display name
@@ -0,0 +1,30 @@
+package gmail
+
+import (
+	"context"
+	"fmt"
+)
+
+// Header is one header of a message, as it appears in the source
+type Header struct {
+	Name  string
+	Value string
+}
+
+// GetMessageHeaders returns every header of a message in source order,
+// including repeated ones such as Received
+func (c *Client) GetMessageHeaders(ctx context.Context, messageID string) ([]Header, error) {
+	msg, err := c.service.Users.Messages.Get(c.userID, messageID).Format("metadata").Context(ctx).Do()
+	if err != nil {
+		return nil, fmt.Errorf("getting message: %w", err)
+	}
+	if msg.Payload == nil {
+		return nil, nil
+	}
+
+	headers := make([]Header, 0, len(msg.Payload.Headers))
+	for _, h := range msg.Payload.Headers {
+		headers = append(headers, Header{Name: h.Name, Value: h.Value})
+	}
+	return headers, nil
+}
@@ -0,0 +1,58 @@
+package gmail
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"google.golang.org/api/gmail/v1"
+)
+
+func TestGetMessageHeaders(t *testing.T) {
+	t.Parallel()
+	var gotFormat string
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		gotFormat = r.URL.Query().Get("format")
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(&gmail.Message{Id: "msg1", Payload: &gmail.MessagePart{
+			Headers: []*gmail.MessagePartHeader{
+				{Name: "Received", Value: "from mx2.example.com"},
+				{Name: "Received", Value: "from mx1.example.com"},
+				{Name: "Subject", Value: "Hi"},
+			},
+		}})
+	})
+
+	headers, err := c.GetMessageHeaders(context.Background(), "msg1")
+	if err != nil {
+		t.Fatalf("GetMessageHeaders: %v", err)
+	}
+	if gotFormat != "metadata" {
+		t.Errorf("format = %q, want metadata", gotFormat)
+	}
+	want := []Header{
+		{Name: "Received", Value: "from mx2.example.com"},
+		{Name: "Received", Value: "from mx1.example.com"},
+		{Name: "Subject", Value: "Hi"},
+	}
+	if len(headers) != len(want) {
+		t.Fatalf("got %d headers, want %d", len(headers), len(want))
+	}
+	for i := range want {
+		if headers[i] != want[i] {
+			t.Errorf("headers[%d] = %+v, want %+v", i, headers[i], want[i])
+		}
+	}
+}
+
+func TestGetMessageHeaders_Error(t *testing.T) {
+	t.Parallel()
+	c := newTestClient(t, func(w http.ResponseWriter, _ *http.Request) {
+		http.Error(w, `{"error":{"code":404,"message":"Not Found"}}`, http.StatusNotFound)
+	})
+
+	if _, err := c.GetMessageHeaders(context.Background(), "missing"); err == nil {
+		t.Fatal("expected an error")
+	}
+}
@@ -0,0 +1,162 @@
+package gmail
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	clicache "github.com/open-cli-collective/cli-common/cache"
+	"github.com/open-cli-collective/cli-common/statedirtest"
+	"google.golang.org/api/gmail/v1"
+
+	"github.com/open-cli-collective/google-readonly/internal/cache"
+)
+
+// newLabelCacheClient returns a Client backed by a hermetic label cache
+// whose API serves one "Work" label, and a counter of label list calls
+func newLabelCacheClient(t *testing.T) (*Client, *cache.Cache, *int) {
+	t.Helper()
+	statedirtest.Hermetic(t)
+	lc, err := cache.New()
+	if err != nil {
+		t.Fatalf("cache.New: %v", err)
+	}
+
+	calls := 0
+	c := newTestClient(t, func(w http.ResponseWriter, _ *http.Request) {
+		calls++
+		resp := &gmail.ListLabelsResponse{Labels: []*gmail.Label{
+			{Id: "Label_1", Name: "Work", Type: "user"},
+		}}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(resp)
+	})
+	c.SetLabelCache(lc)
+	return c, lc, &calls
+}
+
+// writeLabelsEnvelope seeds the labels cache with labels fetched at fetchedAt
+func writeLabelsEnvelope(t *testing.T, lc *cache.Cache, fetchedAt time.Time, labels []*gmail.Label) {
+	t.Helper()
+	err := clicache.WriteEnvelope(clicache.Locator{Root: lc.GetDir(), InstanceKey: "default"}, clicache.Envelope[[]*gmail.Label]{
+		Resource:  "labels",
+		Instance:  "default",
+		FetchedAt: fetchedAt,
+		TTL:       "1h",
+		Version:   clicache.Version,
+		Data:      labels,
+	})
+	if err != nil {
+		t.Fatalf("WriteEnvelope: %v", err)
+	}
+}
+
+func TestFetchLabels_CacheMissWritesCache(t *testing.T) {
+	c, lc, calls := newLabelCacheClient(t)
+
+	if err := c.FetchLabels(context.Background()); err != nil {
+		t.Fatalf("FetchLabels: %v", err)
+	}
+	if *calls != 1 {
+		t.Errorf("API calls = %d, want 1", *calls)
+	}
+
+	cached, err := lc.GetLabels()
+	if err != nil {
+		t.Fatalf("GetLabels: %v", err)
+	}
+	if len(cached) != 1 || cached[0].Name != "Work" {
+		t.Errorf("cached = %v, want the fetched Work label", cached)
+	}
+}
+
+func TestFetchLabels_CacheHit(t *testing.T) {
+	c, lc, calls := newLabelCacheClient(t)
+	writeLabelsEnvelope(t, lc, time.Now().UTC(), []*gmail.Label{
+		{Id: "Label_9", Name: "Cached", Type: "user", MessagesUnread: 3},
+	})
+
+	if err := c.FetchLabels(context.Background()); err != nil {
+		t.Fatalf("FetchLabels: %v", err)
+	}
+	if *calls != 0 {
+		t.Errorf("API calls = %d, want 0 on a fresh cache", *calls)
+	}
+	if got := c.GetLabelName("Label_9"); got != "Cached" {
+		t.Errorf("GetLabelName = %q, want Cached", got)
+	}
+	id, err := c.GetLabelID(context.Background(), "Cached")
+	if err != nil || id != "Label_9" {
+		t.Errorf("GetLabelID = %q, %v; want Label_9", id, err)
+	}
+	if labels := c.GetLabels(); len(labels) != 1 || labels[0].MessagesUnread != 3 {
+		t.Errorf("GetLabels = %v, want the cached label with its counts", labels)
+	}
+}
+
+func TestFetchLabels_CacheExpired(t *testing.T) {
+	c, lc, calls := newLabelCacheClient(t)
+	writeLabelsEnvelope(t, lc, time.Now().UTC().Add(-2*time.Hour), []*gmail.Label{
+		{Id: "Label_9", Name: "Old"},
+	})
+
+	if err := c.FetchLabels(context.Background()); err != nil {
+		t.Fatalf("FetchLabels: %v", err)
+	}
+	if *calls != 1 {
+		t.Errorf("API calls = %d, want 1 after the TTL", *calls)
+	}
+	if got := c.GetLabelName("Label_1"); got != "Work" {
+		t.Errorf("GetLabelName = %q, want Work from the API", got)
+	}
+}
+
+func TestFetchLabels_CorruptCacheRefetches(t *testing.T) {
+	c, lc, calls := newLabelCacheClient(t)
+	path := filepath.Join(lc.GetDir(), "default", "labels.json")
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(path, []byte("not valid json"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := c.FetchLabels(context.Background()); err != nil {
+		t.Fatalf("FetchLabels: %v", err)
+	}
+	if *calls != 1 {
+		t.Errorf("API calls = %d, want 1", *calls)
+	}
+
+	// The refetch repairs the cache
+	cached, err := lc.GetLabels()
+	if err != nil || len(cached) != 1 {
+		t.Errorf("cached = %v, %v; want the refetched label", cached, err)
+	}
+}
+
+func TestRefreshLabels_BypassesFreshCache(t *testing.T) {
+	c, lc, calls := newLabelCacheClient(t)
+	writeLabelsEnvelope(t, lc, time.Now().UTC(), []*gmail.Label{
+		{Id: "Label_9", Name: "Cached"},
+	})
+
+	if err := c.RefreshLabels(context.Background()); err != nil {
+		t.Fatalf("RefreshLabels: %v", err)
+	}
+	if *calls != 1 {
+		t.Errorf("API calls = %d, want 1", *calls)
+	}
+	if got := c.GetLabelName("Label_9"); got != "Label_9" {
+		t.Errorf("GetLabelName = %q, want the stale cached label gone", got)
+	}
+
+	cached, err := lc.GetLabels()
+	if err != nil || len(cached) != 1 || cached[0].Name != "Work" {
+		t.Errorf("cached = %v, %v; want the cache rewritten", cached, err)
+	}
+}
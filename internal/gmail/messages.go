@@ -49,6 +49,7 @@ type Attachment struct {
 	AttachmentID string `json:"attachmentId,omitempty"`
 	PartID       string `json:"partId"`
 	IsInline     bool   `json:"isInline"`
+	ContentID    string `json:"contentId,omitempty"` // Content-ID header without angle brackets; HTML refers to it as cid:<id>
 }
 
 // SearchMessages searches for messages matching the query.
@@ -63,10 +64,55 @@ func (c *Client) SearchMessages(ctx context.Context, query string, maxResults in
 	if err != nil {
 		return nil, 0, fmt.Errorf("searching messages: %w", err)
 	}
+	messages, skipped := c.fetchListed(ctx, resp.Messages)
+	return messages, skipped, nil
+}
+
+// ListLabelMessages returns the messages carrying the label with labelID,
+// newest first, with the same single page and metadata as SearchMessages.
+// Filtering by ID rather than a label: query sidesteps Gmail's quoting of
+// names with spaces or slashes.
+func (c *Client) ListLabelMessages(ctx context.Context, labelID string, maxResults int64) ([]*Message, int, error) {
+	call := c.service.Users.Messages.List(c.userID).LabelIds(labelID)
+	if maxResults > 0 {
+		call = call.MaxResults(maxResults)
+	}
+
+	resp, err := call.Context(ctx).Do()
+	if err != nil {
+		return nil, 0, fmt.Errorf("listing messages: %w", err)
+	}
+	messages, skipped := c.fetchListed(ctx, resp.Messages)
+	return messages, skipped, nil
+}
+
+// ListLabelMessageIDs returns only the IDs of the messages carrying the
+// label with labelID (no metadata fetch)
+func (c *Client) ListLabelMessageIDs(ctx context.Context, labelID string, maxResults int64) ([]string, error) {
+	call := c.service.Users.Messages.List(c.userID).LabelIds(labelID)
+	if maxResults > 0 {
+		call = call.MaxResults(maxResults)
+	}
+
+	resp, err := call.Context(ctx).Do()
+	if err != nil {
+		return nil, fmt.Errorf("listing message IDs: %w", err)
+	}
+
+	ids := make([]string, 0, len(resp.Messages))
+	for _, msg := range resp.Messages {
+		ids = append(ids, msg.Id)
+	}
+	return ids, nil
+}
 
+// fetchListed fetches the metadata of messages from a list response. A
+// message that cannot be fetched is skipped and counted rather than failing
+// the whole listing.
+func (c *Client) fetchListed(ctx context.Context, listed []*gmail.Message) ([]*Message, int) {
 	var messages []*Message
 	var skipped int
-	for _, msg := range resp.Messages {
+	for _, msg := range listed {
 		m, err := c.GetMessage(ctx, msg.Id, false)
 		if err != nil {
 			skipped++
@@ -80,7 +126,7 @@ func (c *Client) SearchMessages(ctx context.Context, query string, maxResults in
 		log.Warn("skipped %d message(s) due to fetch errors (use -v for details)", skipped)
 	}
 
-	return messages, skipped, nil
+	return messages, skipped
 }
 
 // SearchMessageIDs returns only message IDs matching the query (no metadata fetch).
@@ -106,6 +152,28 @@ func (c *Client) SearchMessageIDs(ctx context.Context, query string, maxResults
 	return ids, nil
 }
 
+// SearchThreadIDs returns the IDs of threads matching the query (no metadata
+// fetch). maxResults bounds the number of threads, not messages, so one busy
+// thread cannot crowd out other matches. Like SearchMessageIDs it returns a
+// single page of results.
+func (c *Client) SearchThreadIDs(ctx context.Context, query string, maxResults int64) ([]string, error) {
+	call := c.service.Users.Threads.List(c.userID).Q(query)
+	if maxResults > 0 {
+		call = call.MaxResults(maxResults)
+	}
+
+	resp, err := call.Context(ctx).Do()
+	if err != nil {
+		return nil, fmt.Errorf("searching thread IDs: %w", err)
+	}
+
+	ids := make([]string, 0, len(resp.Threads))
+	for _, thread := range resp.Threads {
+		ids = append(ids, thread.Id)
+	}
+	return ids, nil
+}
+
 // GetMessage retrieves a single message by ID
 func (c *Client) GetMessage(ctx context.Context, messageID string, includeBody bool) (*Message, error) {
 	format := "metadata"
@@ -251,10 +319,11 @@ func extractAttachments(payload *gmail.MessagePart, partPath string) []*Attachme
 	// Check if this part is an attachment
 	if isAttachment(payload) {
 		att := &Attachment{
-			Filename: payload.Filename,
-			MimeType: payload.MimeType,
-			PartID:   partPath,
-			IsInline: isInlineAttachment(payload),
+			Filename:  payload.Filename,
+			MimeType:  payload.MimeType,
+			PartID:    partPath,
+			IsInline:  isInlineAttachment(payload),
+			ContentID: contentID(payload),
 		}
 		if payload.Body != nil {
 			att.Size = payload.Body.Size
@@ -265,11 +334,7 @@ func extractAttachments(payload *gmail.MessagePart, partPath string) []*Attachme
 
 	// Recursively check nested parts
 	for i, part := range payload.Parts {
-		childPath := fmt.Sprintf("%d", i)
-		if partPath != "" {
-			childPath = fmt.Sprintf("%s.%d", partPath, i)
-		}
-		attachments = append(attachments, extractAttachments(part, childPath)...)
+		attachments = append(attachments, extractAttachments(part, childPartPath(partPath, i))...)
 	}
 
 	return attachments
@@ -305,6 +370,17 @@ func isInlineAttachment(part *gmail.MessagePart) bool {
 	return false
 }
 
+// contentID returns a part's Content-ID header with its angle brackets
+// removed, or "" when the part has none
+func contentID(part *gmail.MessagePart) string {
+	for _, header := range part.Headers {
+		if strings.EqualFold(header.Name, "Content-ID") {
+			return strings.Trim(strings.TrimSpace(header.Value), "<>")
+		}
+	}
+	return ""
+}
+
 func extractBody(payload *gmail.MessagePart) string {
 	body, _ := extractBodyWithKind(payload)
 	return body
@@ -1,11 +1,16 @@
 package gmail
 
 import (
+	"context"
 	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
 	"sort"
 	"testing"
 
 	"google.golang.org/api/gmail/v1"
+	"google.golang.org/api/option"
 )
 
 func TestParseMessage(t *testing.T) {
@@ -576,6 +581,39 @@ func TestExtractAttachments(t *testing.T) {
 		}
 	})
 
+	t.Run("parses Content-ID without angle brackets", func(t *testing.T) {
+		t.Parallel()
+		payload := &gmail.MessagePart{
+			MimeType: "multipart/related",
+			Parts: []*gmail.MessagePart{
+				{MimeType: "text/html"},
+				{
+					Filename: "logo.png",
+					MimeType: "image/png",
+					Headers: []*gmail.MessagePartHeader{
+						{Name: "Content-Disposition", Value: "inline; filename=\"logo.png\""},
+						{Name: "Content-Id", Value: " <logo@example.com> "},
+					},
+				},
+				{Filename: "report.pdf", MimeType: "application/pdf"},
+			},
+		}
+
+		attachments := extractAttachments(payload, "")
+		if len(attachments) != 2 {
+			t.Fatalf("got length %d, want %d", len(attachments), 2)
+		}
+		if attachments[0].ContentID != "logo@example.com" {
+			t.Errorf("got %q, want %q", attachments[0].ContentID, "logo@example.com")
+		}
+		if attachments[0].PartID != "1" {
+			t.Errorf("got part %q, want %q", attachments[0].PartID, "1")
+		}
+		if attachments[1].ContentID != "" {
+			t.Errorf("got %q, want no Content-ID", attachments[1].ContentID)
+		}
+	})
+
 	t.Run("handles nested multipart with multiple attachments", func(t *testing.T) {
 		t.Parallel()
 		payload := &gmail.MessagePart{
@@ -1000,3 +1038,85 @@ func TestParseMessageWithLabels(t *testing.T) {
 		}
 	})
 }
+
+func TestSearchThreadIDs_APIWiring(t *testing.T) {
+	t.Parallel()
+	var gotPath, gotQuery, gotMax string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotPath = r.URL.Path
+		gotQuery = r.URL.Query().Get("q")
+		gotMax = r.URL.Query().Get("maxResults")
+		resp := &gmail.ListThreadsResponse{
+			Threads: []*gmail.Thread{{Id: "t1"}, {Id: "t2"}, {Id: "t3"}},
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(resp)
+	}))
+	defer ts.Close()
+
+	ctx := context.Background()
+	svc, err := gmail.NewService(ctx,
+		option.WithEndpoint(ts.URL),
+		option.WithoutAuthentication(),
+		option.WithHTTPClient(ts.Client()),
+	)
+	if err != nil {
+		t.Fatalf("NewService: %v", err)
+	}
+	c := &Client{service: svc, userID: "me"}
+
+	ids, err := c.SearchThreadIDs(ctx, "from:alice@example.com", 3)
+	if err != nil {
+		t.Fatalf("SearchThreadIDs: %v", err)
+	}
+	if gotPath != "/gmail/v1/users/me/threads" {
+		t.Errorf("path = %q, want /gmail/v1/users/me/threads", gotPath)
+	}
+	if gotQuery != "from:alice@example.com" {
+		t.Errorf("q = %q, want %q", gotQuery, "from:alice@example.com")
+	}
+	if gotMax != "3" {
+		t.Errorf("maxResults = %q, want 3", gotMax)
+	}
+	want := []string{"t1", "t2", "t3"}
+	if len(ids) != len(want) {
+		t.Fatalf("got %v, want %v", ids, want)
+	}
+	for i := range want {
+		if ids[i] != want[i] {
+			t.Errorf("ids[%d] = %q, want %q", i, ids[i], want[i])
+		}
+	}
+}
+
+func TestListLabelMessageIDs_APIWiring(t *testing.T) {
+	t.Parallel()
+	var gotPath, gotLabels, gotQuery, gotMax string
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		gotPath = r.URL.Path
+		gotLabels = r.URL.Query().Get("labelIds")
+		gotQuery = r.URL.Query().Get("q")
+		gotMax = r.URL.Query().Get("maxResults")
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(&gmail.ListMessagesResponse{
+			Messages: []*gmail.Message{{Id: "m1"}, {Id: "m2"}},
+		})
+	})
+
+	ids, err := c.ListLabelMessageIDs(context.Background(), "Label_7", 5)
+	if err != nil {
+		t.Fatalf("ListLabelMessageIDs: %v", err)
+	}
+	if gotPath != "/gmail/v1/users/me/messages" {
+		t.Errorf("path = %q", gotPath)
+	}
+	if gotLabels != "Label_7" || gotQuery != "" {
+		t.Errorf("labelIds = %q, q = %q; want the label ID and no query", gotLabels, gotQuery)
+	}
+	if gotMax != "5" {
+		t.Errorf("maxResults = %q, want 5", gotMax)
+	}
+	if len(ids) != 2 || ids[0] != "m1" || ids[1] != "m2" {
+		t.Errorf("ids = %v", ids)
+	}
+}
@@ -0,0 +1,103 @@
+package gmail
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+)
+
+// RawMessage is a message in its original RFC 5322 form, as stored by Gmail
+type RawMessage struct {
+	ID           string
+	ThreadID     string
+	InternalDate int64 // Milliseconds since the epoch when Gmail received the message
+	Raw          []byte
+}
+
+// GetRawMessage retrieves a message's full RFC 5322 source
+func (c *Client) GetRawMessage(ctx context.Context, messageID string) (*RawMessage, error) {
+	msg, err := c.service.Users.Messages.Get(c.userID, messageID).Format("raw").Context(ctx).Do()
+	if err != nil {
+		return nil, fmt.Errorf("getting raw message: %w", err)
+	}
+
+	data, err := base64.URLEncoding.DecodeString(msg.Raw)
+	if err != nil {
+		return nil, fmt.Errorf("decoding raw message: %w", err)
+	}
+
+	return &RawMessage{
+		ID:           msg.Id,
+		ThreadID:     msg.ThreadId,
+		InternalDate: msg.InternalDate,
+		Raw:          data,
+	}, nil
+}
+
+// ListAllMessageIDs returns the IDs of every message matching the query,
+// following pagination. Unlike SearchMessageIDs there is no result cap, so
+// callers should use a query that bounds the result set.
+func (c *Client) ListAllMessageIDs(ctx context.Context, query string) ([]string, error) {
+	var ids []string
+	pageToken := ""
+	tally := c.pageTally("messages")
+	defer tally.Done()
+
+	for {
+		call := c.service.Users.Messages.List(c.userID).Q(query).MaxResults(500)
+		if pageToken != "" {
+			call = call.PageToken(pageToken)
+		}
+
+		resp, err := call.Context(ctx).Do()
+		if err != nil {
+			return nil, fmt.Errorf("listing message IDs: %w", err)
+		}
+
+		for _, msg := range resp.Messages {
+			ids = append(ids, msg.Id)
+		}
+		tally.Add(len(resp.Messages))
+
+		pageToken = resp.NextPageToken
+		if pageToken == "" {
+			break
+		}
+	}
+
+	return ids, nil
+}
+
+// CountMessages returns the exact number of messages matching the query.
+// It pages through message IDs like ListAllMessageIDs but asks only for the
+// IDs and keeps none of them, so no message is ever fetched. Gmail's
+// resultSizeEstimate is not used because it is often far off.
+func (c *Client) CountMessages(ctx context.Context, query string) (int64, error) {
+	var count int64
+	pageToken := ""
+	tally := c.pageTally("messages")
+	defer tally.Done()
+
+	for {
+		call := c.service.Users.Messages.List(c.userID).Q(query).MaxResults(500).
+			Fields("nextPageToken", "messages/id")
+		if pageToken != "" {
+			call = call.PageToken(pageToken)
+		}
+
+		resp, err := call.Context(ctx).Do()
+		if err != nil {
+			return 0, fmt.Errorf("counting messages: %w", err)
+		}
+
+		count += int64(len(resp.Messages))
+		tally.Add(len(resp.Messages))
+
+		pageToken = resp.NextPageToken
+		if pageToken == "" {
+			break
+		}
+	}
+
+	return count, nil
+}
@@ -0,0 +1,177 @@
+package gmail
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"google.golang.org/api/gmail/v1"
+	"google.golang.org/api/option"
+)
+
+// newTestClient returns a Client whose service talks to handler
+func newTestClient(t *testing.T, handler http.HandlerFunc) *Client {
+	t.Helper()
+	ts := httptest.NewServer(handler)
+	t.Cleanup(ts.Close)
+
+	svc, err := gmail.NewService(context.Background(),
+		option.WithEndpoint(ts.URL),
+		option.WithoutAuthentication(),
+		option.WithHTTPClient(ts.Client()),
+	)
+	if err != nil {
+		t.Fatalf("NewService: %v", err)
+	}
+	return &Client{service: svc, userID: "me"}
+}
+
+func TestGetRawMessage(t *testing.T) {
+	t.Parallel()
+	source := "From: alice@example.com\r\nSubject: Hi\r\n\r\nHello\r\n"
+	var gotFormat string
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		gotFormat = r.URL.Query().Get("format")
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(&gmail.Message{
+			Id:           "msg1",
+			ThreadId:     "thread1",
+			InternalDate: 1700000000000,
+			Raw:          base64.URLEncoding.EncodeToString([]byte(source)),
+		})
+	})
+
+	msg, err := c.GetRawMessage(context.Background(), "msg1")
+	if err != nil {
+		t.Fatalf("GetRawMessage: %v", err)
+	}
+	if gotFormat != "raw" {
+		t.Errorf("format = %q, want raw", gotFormat)
+	}
+	if msg.ID != "msg1" || msg.ThreadID != "thread1" || msg.InternalDate != 1700000000000 {
+		t.Errorf("unexpected metadata: %+v", msg)
+	}
+	if string(msg.Raw) != source {
+		t.Errorf("Raw = %q, want %q", msg.Raw, source)
+	}
+}
+
+func TestListAllMessageIDs(t *testing.T) {
+	t.Parallel()
+	var queries []string
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		queries = append(queries, r.URL.Query().Get("q"))
+		resp := &gmail.ListMessagesResponse{
+			Messages:      []*gmail.Message{{Id: "m1"}, {Id: "m2"}},
+			NextPageToken: "page2",
+		}
+		if r.URL.Query().Get("pageToken") == "page2" {
+			resp = &gmail.ListMessagesResponse{Messages: []*gmail.Message{{Id: "m3"}}}
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(resp)
+	})
+
+	ids, err := c.ListAllMessageIDs(context.Background(), "label:Archive")
+	if err != nil {
+		t.Fatalf("ListAllMessageIDs: %v", err)
+	}
+	if len(queries) != 2 || queries[1] != "label:Archive" {
+		t.Errorf("queries = %v, want two pages of label:Archive", queries)
+	}
+	if len(ids) != 3 || ids[2] != "m3" {
+		t.Errorf("ids = %v, want [m1 m2 m3]", ids)
+	}
+}
+
+// recordingTally remembers what a listing reported to it
+type recordingTally struct {
+	noun  string
+	pages []int
+	done  bool
+}
+
+func (r *recordingTally) Add(n int) { r.pages = append(r.pages, n) }
+func (r *recordingTally) Done()     { r.done = true }
+
+func TestListAllMessageIDs_PageTally(t *testing.T) {
+	t.Parallel()
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		resp := &gmail.ListMessagesResponse{
+			Messages:      []*gmail.Message{{Id: "m1"}, {Id: "m2"}},
+			NextPageToken: "page2",
+		}
+		if r.URL.Query().Get("pageToken") == "page2" {
+			resp = &gmail.ListMessagesResponse{Messages: []*gmail.Message{{Id: "m3"}}}
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(resp)
+	})
+	tally := &recordingTally{}
+	c.SetPageTally(func(noun string) PageTally {
+		tally.noun = noun
+		return tally
+	})
+
+	if _, err := c.ListAllMessageIDs(context.Background(), "label:Archive"); err != nil {
+		t.Fatalf("ListAllMessageIDs: %v", err)
+	}
+	if tally.noun != "messages" {
+		t.Errorf("noun = %q, want messages", tally.noun)
+	}
+	if len(tally.pages) != 2 || tally.pages[0] != 2 || tally.pages[1] != 1 {
+		t.Errorf("pages = %v, want [2 1]", tally.pages)
+	}
+	if !tally.done {
+		t.Error("tally was not marked done")
+	}
+}
+
+func TestCountMessages(t *testing.T) {
+	t.Parallel()
+	var pages, fields []string
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		pages = append(pages, r.URL.Query().Get("pageToken"))
+		fields = append(fields, r.URL.Query().Get("fields"))
+		resp := &gmail.ListMessagesResponse{
+			Messages:           []*gmail.Message{{Id: "m1"}, {Id: "m2"}},
+			NextPageToken:      "page2",
+			ResultSizeEstimate: 201,
+		}
+		if r.URL.Query().Get("pageToken") == "page2" {
+			resp = &gmail.ListMessagesResponse{Messages: []*gmail.Message{{Id: "m3"}}}
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(resp)
+	})
+
+	n, err := c.CountMessages(context.Background(), "is:unread")
+	if err != nil {
+		t.Fatalf("CountMessages: %v", err)
+	}
+	if n != 3 {
+		t.Errorf("count = %d, want 3 (exact, not the estimate)", n)
+	}
+	if len(pages) != 2 || pages[1] != "page2" {
+		t.Errorf("pages = %v, want the first page then page2", pages)
+	}
+	if fields[0] != "nextPageToken,messages/id" {
+		t.Errorf("fields = %q, want only IDs and the page token", fields[0])
+	}
+}
+
+func TestCountMessages_Error(t *testing.T) {
+	t.Parallel()
+	c := newTestClient(t, func(w http.ResponseWriter, _ *http.Request) {
+		http.Error(w, `{"error":{"code":400,"message":"Invalid query"}}`, http.StatusBadRequest)
+	})
+
+	_, err := c.CountMessages(context.Background(), "is:unread")
+	if err == nil || !strings.Contains(err.Error(), "counting messages") {
+		t.Errorf("err = %v, want a counting messages error", err)
+	}
+}
@@ -0,0 +1,73 @@
+package gmail
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"google.golang.org/api/gmail/v1"
+)
+
+// MessagePart describes one node of a message's MIME tree, without content
+type MessagePart struct {
+	PartID      string         `json:"partId"`
+	MimeType    string         `json:"mimeType"`
+	Filename    string         `json:"filename,omitempty"`
+	Size        int64          `json:"size"`
+	Disposition string         `json:"disposition,omitempty"`
+	Parts       []*MessagePart `json:"parts,omitempty"`
+}
+
+// GetMessageStructure returns the MIME part tree of a message. Part IDs use
+// the same paths as Attachment.PartID, with the root part as "".
+func (c *Client) GetMessageStructure(ctx context.Context, messageID string) (*MessagePart, error) {
+	msg, err := c.service.Users.Messages.Get(c.userID, messageID).Format("full").Context(ctx).Do()
+	if err != nil {
+		return nil, fmt.Errorf("getting message: %w", err)
+	}
+	if msg.Payload == nil {
+		return nil, fmt.Errorf("message %s has no payload", messageID)
+	}
+
+	return parseStructure(msg.Payload, ""), nil
+}
+
+// parseStructure recursively converts a payload into a MessagePart tree
+func parseStructure(payload *gmail.MessagePart, partPath string) *MessagePart {
+	part := &MessagePart{
+		PartID:      partPath,
+		MimeType:    payload.MimeType,
+		Filename:    payload.Filename,
+		Disposition: partDisposition(payload),
+	}
+	if payload.Body != nil {
+		part.Size = payload.Body.Size
+	}
+
+	for i, child := range payload.Parts {
+		part.Parts = append(part.Parts, parseStructure(child, childPartPath(partPath, i)))
+	}
+
+	return part
+}
+
+// childPartPath returns the path of the i-th child of the part at partPath
+// (e.g., "0.1" for the second child of "0")
+func childPartPath(partPath string, i int) string {
+	if partPath == "" {
+		return fmt.Sprintf("%d", i)
+	}
+	return fmt.Sprintf("%s.%d", partPath, i)
+}
+
+// partDisposition returns the disposition type from a part's
+// Content-Disposition header ("attachment", "inline"), or "" if absent
+func partDisposition(part *gmail.MessagePart) string {
+	for _, header := range part.Headers {
+		if strings.EqualFold(header.Name, "Content-Disposition") {
+			disposition, _, _ := strings.Cut(header.Value, ";")
+			return strings.ToLower(strings.TrimSpace(disposition))
+		}
+	}
+	return ""
+}
@@ -0,0 +1,101 @@
+package gmail
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"google.golang.org/api/gmail/v1"
+)
+
+// nestedPayload is the deeply nested fixture from the extractAttachments
+// tests, with a Content-Disposition header on the attachment
+func nestedPayload() *gmail.MessagePart {
+	return &gmail.MessagePart{
+		MimeType: "multipart/mixed",
+		Parts: []*gmail.MessagePart{
+			{
+				MimeType: "multipart/related",
+				Parts: []*gmail.MessagePart{
+					{
+						MimeType: "multipart/alternative",
+						Parts: []*gmail.MessagePart{
+							{MimeType: "text/plain", Body: &gmail.MessagePartBody{Size: 42}},
+						},
+					},
+					{
+						Filename: "nested.png",
+						MimeType: "image/png",
+						Headers: []*gmail.MessagePartHeader{
+							{Name: "Content-Disposition", Value: `Inline; filename="nested.png"`},
+						},
+						Body: &gmail.MessagePartBody{Size: 500},
+					},
+				},
+			},
+		},
+	}
+}
+
+func TestParseStructure(t *testing.T) {
+	t.Parallel()
+	root := parseStructure(nestedPayload(), "")
+
+	if root.PartID != "" || root.MimeType != "multipart/mixed" {
+		t.Errorf("root = %+v", root)
+	}
+
+	related := root.Parts[0]
+	if related.PartID != "0" || len(related.Parts) != 2 {
+		t.Fatalf("related = %+v", related)
+	}
+
+	text := related.Parts[0].Parts[0]
+	if text.PartID != "0.0.0" || text.MimeType != "text/plain" || text.Size != 42 {
+		t.Errorf("text part = %+v", text)
+	}
+
+	image := related.Parts[1]
+	if image.PartID != "0.1" {
+		t.Errorf("image PartID = %q, want 0.1", image.PartID)
+	}
+	if image.Filename != "nested.png" || image.Size != 500 || image.Disposition != "inline" {
+		t.Errorf("image part = %+v", image)
+	}
+}
+
+func TestParseStructure_MatchesAttachmentPaths(t *testing.T) {
+	t.Parallel()
+	payload := nestedPayload()
+	attachments := extractAttachments(payload, "")
+	if len(attachments) != 1 {
+		t.Fatalf("got %d attachments, want 1", len(attachments))
+	}
+
+	part := parseStructure(payload, "").Parts[0].Parts[1]
+	if part.PartID != attachments[0].PartID {
+		t.Errorf("structure PartID %q != attachment PartID %q", part.PartID, attachments[0].PartID)
+	}
+}
+
+func TestGetMessageStructure(t *testing.T) {
+	t.Parallel()
+	var gotFormat string
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		gotFormat = r.URL.Query().Get("format")
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(&gmail.Message{Id: "msg1", Payload: nestedPayload()})
+	})
+
+	root, err := c.GetMessageStructure(context.Background(), "msg1")
+	if err != nil {
+		t.Fatalf("GetMessageStructure: %v", err)
+	}
+	if gotFormat != "full" {
+		t.Errorf("format = %q, want full", gotFormat)
+	}
+	if root.MimeType != "multipart/mixed" || len(root.Parts) != 1 {
+		t.Errorf("unexpected root: %+v", root)
+	}
+}
@@ -45,11 +45,15 @@ type Store struct {
 	service string
 	profile string
 	ref     string
+	// upgradeFromFile enables adopting a token stranded in the file backend
+	// (see upgrade.go). Set for stores opened by Open.
+	upgradeFromFile bool
 }
 
 // Open resolves the authoritative credential_ref from config.yml (§1.3 — the
 // service/profile are parsed, never assumed), opens the backing credstore,
-// and runs the one-time legacy migration (§1.8) before returning. Used by all
+// and runs the one-time legacy migration (§1.8) before returning. The store
+// also adopts a token left in the file backend after a backend upgrade. Used by all
 // real API commands AND `config test` (the smoke check must surface
 // migration/conflicts exactly as a real command would). A legacy-vs-keyring
 // conflict surfaces here as a §1.8 error.
@@ -71,7 +75,20 @@ func open(overwrite, runMigration bool) (*Store, error) {
 	if err != nil {
 		return nil, err
 	}
-	return openWith(cfg, overwrite, runMigration)
+	// Legacy artifacts predate profiles and belong to the default profile;
+	// migrating them under a named profile would move the default account's
+	// token into the wrong ref.
+	// The backend upgrade is per-ref, so it still applies to named profiles.
+	upgrade := runMigration
+	if config.ActiveProfile() != config.DefaultProfile {
+		runMigration = false
+	}
+	s, err := openWith(cfg, overwrite, runMigration)
+	if err != nil {
+		return nil, err
+	}
+	s.upgradeFromFile = upgrade
+	return s, nil
 }
 
 // OpenRef opens a store against an explicit ref instead of config.yml's
@@ -126,7 +143,7 @@ func openWith(cfg *config.Config, overwrite, runMigration bool) (*Store, error)
 		return nil, err
 	}
 
-	s := &Store{cs: cs, service: service, profile: profile, ref: cfg.CredentialRef}
+	s := &Store{cs: cs, service: service, profile: profile, ref: cfg.CredentialRef, upgradeFromFile: runMigration}
 
 	if runMigration {
 		if err := migrateLegacyOverwrite(s, cfg, overwrite); err != nil {
@@ -157,11 +174,19 @@ func (s *Store) Service() string { return s.service }
 func (s *Store) Backend() (credstore.Backend, credstore.Source) { return s.cs.Backend() }
 
 // Token returns the OAuth token from the keyring. ErrTokenNotFound (an
-// errors.Is-matchable wrapper of credstore.ErrNotFound) when unset.
+// errors.Is-matchable wrapper of credstore.ErrNotFound) when unset. A token
+// stranded in the file backend is adopted first (see upgrade.go).
 func (s *Store) Token() (*oauth2.Token, error) {
 	v, err := s.cs.Get(s.profile, KeyOAuthToken)
 	if errors.Is(err, credstore.ErrNotFound) || (err == nil && v == "") {
-		return nil, ErrTokenNotFound
+		moved, aerr := s.adoptFileToken()
+		if aerr != nil {
+			return nil, aerr
+		}
+		if !moved {
+			return nil, ErrTokenNotFound
+		}
+		v, err = s.cs.Get(s.profile, KeyOAuthToken)
 	}
 	if err != nil {
 		// Never embed the value; naming ref/key/op is allowed (§1.12).
@@ -189,6 +214,13 @@ func (s *Store) SetToken(tok *oauth2.Token) error {
 	if err := s.cs.Set(s.profile, KeyOAuthToken, string(data), credstore.WithOverwrite()); err != nil {
 		return fmt.Errorf("store %s at %s: %w", KeyOAuthToken, s.ref, err)
 	}
+	// The token just written supersedes any copy stranded in the file
+	// backend, which would otherwise linger on disk.
+	if path := s.strandedFileToken(); path != "" {
+		if err := secureDelete(path); err != nil {
+			return fmt.Errorf("stored %s at %s but could not remove the stale file keyring copy: %w", KeyOAuthToken, s.ref, err)
+		}
+	}
 	return nil
 }
 
@@ -216,11 +248,17 @@ func (s *Store) DeleteToken() error {
 // temporarily inaccessible) is surfaced, not folded into "absent": callers
 // that gate re-auth/overwrite on this must not mistake an error for "no
 // token" and clobber a token that is actually present.
+//
+// Like Token, a token stranded in the file backend is adopted first, so
+// `config test` and `init` see it rather than asking for re-authentication.
 func (s *Store) HasToken() (bool, error) {
 	ok, err := s.cs.Exists(s.profile, KeyOAuthToken)
 	if err != nil {
 		return false, fmt.Errorf("check %s at %s: %w", KeyOAuthToken, s.ref, err)
 	}
+	if !ok {
+		return s.adoptFileToken()
+	}
 	return ok, nil
 }
 
@@ -0,0 +1,106 @@
+package keychain
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/open-cli-collective/cli-common/credstore"
+
+	"github.com/open-cli-collective/google-readonly/internal/migrationsink"
+)
+
+// Backend upgrade: on Linux the auto selection falls back to the encrypted
+// file backend while no Secret Service is running. Once one appears (or the
+// user selects an OS keyring explicitly), selection moves to it and the
+// token written earlier is stranded in the file. Stores opened for real
+// commands (Open) move such a token into the current backend the first time
+// it is missing there, then zero and remove the file copy, reusing
+// secureDelete from the legacy token.json migration.
+
+// upgradesFromFile reports whether kind should adopt a token stranded in the
+// file backend. The in-memory backend never does: adopting into it would
+// destroy the only persistent copy when the process exits. A var so tests can
+// stand memory in for an OS keyring.
+var upgradesFromFile = func(kind credstore.Backend) bool {
+	return kind != credstore.BackendFile && kind != credstore.BackendMemory
+}
+
+// fileBackendItemPath is where credstore's encrypted-file backend keeps the
+// token for profile: $XDG_DATA_HOME/<service>/keyring/<profile>%2F<key>, with
+// ~/.local/share standing in for an unset XDG_DATA_HOME. credstore does not
+// export the layout; TestFileBackendItemPathMatchesCredstore pins it.
+func fileBackendItemPath(service, profile string) (string, error) {
+	base := os.Getenv("XDG_DATA_HOME")
+	if base == "" {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return "", err
+		}
+		base = filepath.Join(home, ".local", "share")
+	}
+	return filepath.Join(base, service, "keyring", profile+"%2F"+KeyOAuthToken), nil
+}
+
+// strandedFileToken returns the path of a file-backend token this store
+// should adopt or clear, or "" when there is none. It only stats the file, so
+// it never needs the file passphrase.
+func (s *Store) strandedFileToken() string {
+	if !s.upgradeFromFile {
+		return ""
+	}
+	if kind, _ := s.cs.Backend(); !upgradesFromFile(kind) {
+		return ""
+	}
+	path, err := fileBackendItemPath(s.service, s.profile)
+	if err != nil {
+		return ""
+	}
+	if _, err := os.Stat(path); err != nil {
+		return ""
+	}
+	return path
+}
+
+// adoptFileToken moves a token stranded in the file backend into this store's
+// backend and securely deletes the file copy. It reports whether a token was
+// moved. Callers invoke it only when the current backend has no token, so an
+// existing token is never overwritten.
+func (s *Store) adoptFileToken() (bool, error) {
+	path := s.strandedFileToken()
+	if path == "" {
+		return false, nil
+	}
+
+	fs, err := credstore.Open(s.service, &credstore.Options{
+		AllowedKeys:    allowedKeys,
+		Backend:        credstore.BackendFile,
+		FilePassphrase: passphraseFunc(s.service),
+	})
+	if err != nil {
+		return false, fmt.Errorf("a token for %s is in the file keyring but it could not be opened to move it: %w", s.ref, err)
+	}
+	defer func() { _ = fs.Close() }()
+
+	v, err := fs.Get(s.profile, KeyOAuthToken)
+	if err != nil {
+		return false, fmt.Errorf("read %s for %s from the file keyring: %w", KeyOAuthToken, s.ref, err)
+	}
+	if v == "" {
+		return false, nil
+	}
+	if err := s.cs.Set(s.profile, KeyOAuthToken, v); err != nil {
+		return false, fmt.Errorf("move %s into keyring %s: %w", KeyOAuthToken, s.ref, err)
+	}
+	if err := secureDelete(path); err != nil {
+		return true, fmt.Errorf("moved %s into keyring %s but could not remove the file copy: %w", KeyOAuthToken, s.ref, err)
+	}
+
+	kind, _ := s.cs.Backend()
+	human := fmt.Sprintf("gro: moved %s from the file keyring into the %s keyring %s (file copy removed)",
+		KeyOAuthToken, kind, s.ref)
+	change := credstore.MigrationJSONEntry(KeyOAuthToken, "keyring:file",
+		fmt.Sprintf("keyring:%s/%s/%s", s.service, s.profile, KeyOAuthToken))
+	migrationsink.Record(credstore.NewMigrationBlock(change), human)
+	return true, nil
+}
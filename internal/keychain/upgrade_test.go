@@ -0,0 +1,182 @@
+package keychain
+
+import (
+	"os"
+	"testing"
+
+	"github.com/open-cli-collective/cli-common/credstore"
+	"golang.org/x/oauth2"
+
+	"github.com/open-cli-collective/google-readonly/internal/config"
+	"github.com/open-cli-collective/google-readonly/internal/credtest"
+	"github.com/open-cli-collective/google-readonly/internal/migrationsink"
+)
+
+const backendEnv = "GOOGLE_READONLY_KEYRING_BACKEND"
+
+// seedFileToken stores tok through the encrypted-file backend (credtest's
+// default) and returns the on-disk path of the item.
+func seedFileToken(t *testing.T, tok *oauth2.Token) string {
+	t.Helper()
+	st, err := openWith(testCfg(), false, false)
+	if err != nil {
+		t.Fatalf("open file backend: %v", err)
+	}
+	defer func() { _ = st.Close() }()
+	if kind, _ := st.Backend(); kind != credstore.BackendFile {
+		t.Fatalf("precondition: want file backend, got %s", kind)
+	}
+	if err := st.SetToken(tok); err != nil {
+		t.Fatalf("seed file token: %v", err)
+	}
+	path, err := fileBackendItemPath(st.Service(), "default")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+// upgradeToMemory switches selection to the in-memory backend and lets it
+// stand in for an OS keyring that has just become available.
+func upgradeToMemory(t *testing.T) {
+	t.Helper()
+	t.Setenv(backendEnv, string(credstore.BackendMemory))
+	orig := upgradesFromFile
+	upgradesFromFile = func(kind credstore.Backend) bool { return kind != credstore.BackendFile }
+	t.Cleanup(func() { upgradesFromFile = orig })
+}
+
+func TestFileBackendItemPathMatchesCredstore(t *testing.T) {
+	credtest.Setup(t)
+	path := seedFileToken(t, &oauth2.Token{AccessToken: "AAA"})
+	if _, err := os.Stat(path); err != nil {
+		t.Fatalf("credstore's file backend did not write %s: %v", path, err)
+	}
+}
+
+func TestBackendUpgradeAdoptsFileToken(t *testing.T) {
+	t.Run("Token moves the token and removes the file", func(t *testing.T) {
+		credtest.Setup(t)
+		path := seedFileToken(t, &oauth2.Token{AccessToken: "AAA", RefreshToken: "RRR"})
+		upgradeToMemory(t)
+
+		st, err := openWith(testCfg(), false, true)
+		if err != nil {
+			t.Fatalf("open upgraded backend: %v", err)
+		}
+		defer func() { _ = st.Close() }()
+
+		tok, err := st.Token()
+		if err != nil || tok.AccessToken != "AAA" || tok.RefreshToken != "RRR" {
+			t.Fatalf("token not adopted: %+v err=%v", tok, err)
+		}
+		if _, err := os.Stat(path); !os.IsNotExist(err) {
+			t.Fatalf("file copy must be removed after the move (stat err=%v)", err)
+		}
+		if _, human := migrationsink.Take(); human == "" {
+			t.Error("the move must record a migration notice")
+		}
+
+		// Now served from the new backend without the file.
+		if h, herr := st.HasToken(); herr != nil || !h {
+			t.Fatalf("token missing after the move (has=%v err=%v)", h, herr)
+		}
+	})
+
+	t.Run("HasToken adopts too", func(t *testing.T) {
+		credtest.Setup(t)
+		path := seedFileToken(t, &oauth2.Token{AccessToken: "AAA"})
+		upgradeToMemory(t)
+
+		st, err := openWith(testCfg(), false, true)
+		if err != nil {
+			t.Fatalf("open upgraded backend: %v", err)
+		}
+		defer func() { _ = st.Close() }()
+
+		if h, herr := st.HasToken(); herr != nil || !h {
+			t.Fatalf("HasToken must adopt the file token (has=%v err=%v)", h, herr)
+		}
+		if _, err := os.Stat(path); !os.IsNotExist(err) {
+			t.Fatalf("file copy must be removed after the move (stat err=%v)", err)
+		}
+	})
+
+	t.Run("SetToken removes the stale file copy", func(t *testing.T) {
+		credtest.Setup(t)
+		path := seedFileToken(t, &oauth2.Token{AccessToken: "AAA"})
+		upgradeToMemory(t)
+
+		st, err := openWith(testCfg(), false, true)
+		if err != nil {
+			t.Fatalf("open upgraded backend: %v", err)
+		}
+		defer func() { _ = st.Close() }()
+
+		if err := st.SetToken(&oauth2.Token{AccessToken: "BBB"}); err != nil {
+			t.Fatalf("SetToken: %v", err)
+		}
+		if _, err := os.Stat(path); !os.IsNotExist(err) {
+			t.Fatalf("stale file copy must be removed (stat err=%v)", err)
+		}
+		if tok, err := st.Token(); err != nil || tok.AccessToken != "BBB" {
+			t.Fatalf("the new token must win: %+v err=%v", tok, err)
+		}
+	})
+
+	t.Run("no-migrate stores leave the file alone", func(t *testing.T) {
+		credtest.Setup(t)
+		path := seedFileToken(t, &oauth2.Token{AccessToken: "AAA"})
+		upgradeToMemory(t)
+
+		st, err := openWith(testCfg(), false, false)
+		if err != nil {
+			t.Fatalf("open upgraded backend: %v", err)
+		}
+		defer func() { _ = st.Close() }()
+
+		if h, herr := st.HasToken(); herr != nil || h {
+			t.Fatalf("OpenNoMigrate-style stores must not adopt (has=%v err=%v)", h, herr)
+		}
+		if _, err := os.Stat(path); err != nil {
+			t.Fatalf("file copy must be kept (stat err=%v)", err)
+		}
+	})
+
+	t.Run("file backend itself never adopts", func(t *testing.T) {
+		credtest.Setup(t)
+		path := seedFileToken(t, &oauth2.Token{AccessToken: "AAA"})
+
+		st, err := openWith(testCfg(), false, true)
+		if err != nil {
+			t.Fatalf("open: %v", err)
+		}
+		defer func() { _ = st.Close() }()
+
+		if tok, err := st.Token(); err != nil || tok.AccessToken != "AAA" {
+			t.Fatalf("file-backend token: %+v err=%v", tok, err)
+		}
+		if _, err := os.Stat(path); err != nil {
+			t.Fatalf("the file backend's own token must stay (stat err=%v)", err)
+		}
+	})
+
+	t.Run("memory backend does not adopt by default", func(t *testing.T) {
+		credtest.Setup(t)
+		path := seedFileToken(t, &oauth2.Token{AccessToken: "AAA"})
+		t.Setenv(backendEnv, string(credstore.BackendMemory))
+
+		st, err := openWith(&config.Config{CredentialRef: config.DefaultCredentialRef}, false, true)
+		if err != nil {
+			t.Fatalf("open: %v", err)
+		}
+		defer func() { _ = st.Close() }()
+
+		if h, herr := st.HasToken(); herr != nil || h {
+			t.Fatalf("memory must not adopt (has=%v err=%v)", h, herr)
+		}
+		if _, err := os.Stat(path); err != nil {
+			t.Fatalf("file copy must be kept (stat err=%v)", err)
+		}
+	})
+}
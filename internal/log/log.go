@@ -1,6 +1,6 @@
 // Package log provides simple structured logging with verbosity levels.
-// Debug messages are only shown when Verbose is true.
-// All other messages always print to stderr.
+// Debug messages are only shown when Verbose is true. Status and Info
+// messages are dropped when Quiet is true. Warnings and errors always print.
 package log
 
 import (
@@ -12,6 +12,10 @@ import (
 // Set this via the root command's --verbose flag.
 var Verbose bool
 
+// Quiet suppresses Status and Info messages.
+// Set this via the root command's --quiet flag.
+var Quiet bool
+
 // Debug prints a debug message to stderr if Verbose is true.
 // Format follows fmt.Printf conventions.
 func Debug(format string, args ...any) {
@@ -21,9 +25,22 @@ func Debug(format string, args ...any) {
 	fmt.Fprintf(os.Stderr, "[DEBUG] "+format+"\n", args...)
 }
 
-// Info prints an informational message to stderr.
+// Status prints a line to stdout about what a command did, such as
+// "Saved to: report.pdf", unless Quiet is true. A command's actual output
+// does not go through Status. Format follows fmt.Printf conventions.
+func Status(format string, args ...any) {
+	if Quiet {
+		return
+	}
+	fmt.Fprintf(os.Stdout, format+"\n", args...)
+}
+
+// Info prints an informational message to stderr unless Quiet is true.
 // Format follows fmt.Printf conventions.
 func Info(format string, args ...any) {
+	if Quiet {
+		return
+	}
 	fmt.Fprintf(os.Stderr, format+"\n", args...)
 }
 
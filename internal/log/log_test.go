@@ -125,3 +125,47 @@ func TestError(t *testing.T) {
 		t.Errorf("expected %q to contain %q", output, "error occurred: failure")
 	}
 }
+
+func TestStatus(t *testing.T) {
+	oldStdout := os.Stdout
+	r, w, _ := os.Pipe()
+	os.Stdout = w
+
+	Status("Saved to: %s", "report.pdf")
+
+	w.Close()
+	os.Stdout = oldStdout
+
+	var buf bytes.Buffer
+	buf.ReadFrom(r)
+	output := buf.String()
+
+	if output != "Saved to: report.pdf\n" {
+		t.Errorf("got %q, want %q", output, "Saved to: report.pdf\n")
+	}
+}
+
+func TestStatusAndInfo_WhenQuiet(t *testing.T) {
+	oldStdout, oldStderr := os.Stdout, os.Stderr
+	r, w, _ := os.Pipe()
+	os.Stdout, os.Stderr = w, w
+
+	oldQuiet := Quiet
+	Quiet = true
+	defer func() { Quiet = oldQuiet }()
+
+	Status("should not appear")
+	Info("should not appear")
+	Warn("still shown")
+
+	w.Close()
+	os.Stdout, os.Stderr = oldStdout, oldStderr
+
+	var buf bytes.Buffer
+	buf.ReadFrom(r)
+	output := buf.String()
+
+	if output != "[WARN] still shown\n" {
+		t.Errorf("got %q, want only the warning", output)
+	}
+}
@@ -80,6 +80,7 @@ func TestConfigShowNeverLeaks(t *testing.T) {
 	seed(t)
 	for _, args := range [][]string{
 		{"show"}, {"show", "--json"}, {"show", "--verbose"}, {"show", "--json", "--verbose"},
+		{"show", "--output", "yaml", "--verbose"},
 	} {
 		cmd := cfgcmd.NewCommand()
 		var so, se bytes.Buffer
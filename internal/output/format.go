@@ -0,0 +1,126 @@
+package output
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/open-cli-collective/google-readonly/internal/migrationsink"
+)
+
+// Formats accepted by --output on the control-plane commands
+const (
+	FormatText = "text"
+	FormatJSON = "json"
+	FormatYAML = "yaml"
+)
+
+// ResolveFormat returns the format selected by --output and its --json
+// shortcut. --json means --output json, so combining it with any other
+// explicit --output value is an error.
+func ResolveFormat(format string, jsonOut bool) (string, error) {
+	switch format {
+	case "", FormatText, FormatJSON, FormatYAML:
+	default:
+		return "", fmt.Errorf("invalid --output %q: must be %s, %s or %s", format, FormatText, FormatJSON, FormatYAML)
+	}
+	if jsonOut {
+		if format != "" && format != FormatText && format != FormatJSON {
+			return "", fmt.Errorf("--json cannot be combined with --output %s", format)
+		}
+		return FormatJSON, nil
+	}
+	if format == "" {
+		return FormatText, nil
+	}
+	return format, nil
+}
+
+// Print writes data to w in a structured format: FormatJSON or FormatYAML.
+// Text rendering is per command, so FormatText is rejected here.
+func Print(w io.Writer, format string, data any) error {
+	switch format {
+	case FormatJSON:
+		return JSON(w, data)
+	case FormatYAML:
+		return YAML(w, data)
+	default:
+		return fmt.Errorf("output format %q is not a structured format", format)
+	}
+}
+
+// YAML encodes data as YAML to the given writer. The data goes through
+// encoding/json first so field names, omitempty and ordering follow the
+// same json tags as JSON output, and a pending §1.8 migration block is
+// spliced in the same way.
+func YAML(w io.Writer, data any) error {
+	body, err := json.Marshal(data)
+	if err != nil {
+		return err
+	}
+	if mig, _ := migrationsink.Take(); mig != nil {
+		body = spliceMigration(body, mig)
+	}
+
+	// JSON is valid YAML; decoding it as a node keeps key order
+	var doc yaml.Node
+	if err := yaml.Unmarshal(body, &doc); err != nil {
+		return err
+	}
+	blockStyle(&doc)
+
+	enc := yaml.NewEncoder(w)
+	enc.SetIndent(2)
+	if err := enc.Encode(&doc); err != nil {
+		return err
+	}
+	return enc.Close()
+}
+
+// blockStyle clears the flow and quoting styles a node inherits from its
+// JSON source, so it is emitted as ordinary block YAML. The encoder still
+// quotes strings that would otherwise read back as another type.
+func blockStyle(n *yaml.Node) {
+	n.Style = 0
+	for _, c := range n.Content {
+		blockStyle(c)
+	}
+}
+
+// Options is how a control-plane command renders its envelope: the format
+// chosen by --output/--json and the --fields projection
+type Options struct {
+	Format string
+	Fields []string
+}
+
+// ResolveOptions combines ResolveFormat with a comma-separated --fields
+// value. --fields only shapes structured output, so it is rejected with text.
+func ResolveOptions(format string, jsonOut bool, fields string) (Options, error) {
+	f, err := ResolveFormat(format, jsonOut)
+	if err != nil {
+		return Options{}, err
+	}
+	opts := Options{Format: f, Fields: ParseFields(fields)}
+	if len(opts.Fields) > 0 && f == FormatText {
+		return Options{}, fmt.Errorf("--fields requires --output %s or %s", FormatJSON, FormatYAML)
+	}
+	return opts, nil
+}
+
+// Structured reports whether the envelope is printed as JSON or YAML rather
+// than the command's own text
+func (o Options) Structured() bool {
+	return o.Format != "" && o.Format != FormatText
+}
+
+// Print projects data to o.Fields and writes it in o.Format
+func (o Options) Print(w io.Writer, data any) error {
+	projected, err := Project(data, o.Fields)
+	if err != nil {
+		return err
+	}
+	return Print(w, o.Format, projected)
+}
@@ -0,0 +1,140 @@
+package output
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/open-cli-collective/google-readonly/internal/testutil"
+)
+
+func TestResolveFormat(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name    string
+		format  string
+		jsonOut bool
+		want    string
+		wantErr string
+	}{
+		{name: "default is text", format: "", want: FormatText},
+		{name: "explicit text", format: "text", want: FormatText},
+		{name: "yaml", format: "yaml", want: FormatYAML},
+		{name: "json", format: "json", want: FormatJSON},
+		{name: "--json alone", jsonOut: true, want: FormatJSON},
+		{name: "--json with default --output", format: "text", jsonOut: true, want: FormatJSON},
+		{name: "--json with --output json", format: "json", jsonOut: true, want: FormatJSON},
+		{name: "--json with --output yaml", format: "yaml", jsonOut: true, wantErr: "--json cannot be combined with --output yaml"},
+		{name: "unknown format", format: "xml", wantErr: `invalid --output "xml"`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := ResolveFormat(tt.format, tt.jsonOut)
+			if tt.wantErr != "" {
+				testutil.Error(t, err)
+				testutil.Contains(t, err.Error(), tt.wantErr)
+				return
+			}
+			testutil.NoError(t, err)
+			testutil.Equal(t, got, tt.want)
+		})
+	}
+}
+
+func TestYAML(t *testing.T) {
+	t.Parallel()
+	type entry struct {
+		Resource  string     `json:"resource"`
+		Count     int        `json:"count"`
+		Valid     bool       `json:"valid"`
+		Note      string     `json:"note,omitempty"`
+		Version   string     `json:"version"`
+		UpdatedAt *time.Time `json:"updated_at,omitempty"`
+	}
+	at := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	data := map[string]any{"resources": []entry{
+		{Resource: "drives", Count: 3, Valid: true, Version: "true", UpdatedAt: &at},
+	}}
+
+	var buf bytes.Buffer
+	testutil.NoError(t, YAML(&buf, data))
+
+	// json tags name the keys in declaration order, omitempty drops Note,
+	// and a string that reads as a bool stays quoted
+	testutil.Equal(t, buf.String(), `resources:
+  - resource: drives
+    count: 3
+    valid: true
+    version: "true"
+    updated_at: "2026-01-02T03:04:05Z"
+`)
+}
+
+func TestPrint(t *testing.T) {
+	t.Parallel()
+	data := struct {
+		Name string `json:"name"`
+	}{"test"}
+
+	t.Run("json", func(t *testing.T) {
+		t.Parallel()
+		var buf bytes.Buffer
+		testutil.NoError(t, Print(&buf, FormatJSON, data))
+		testutil.Equal(t, buf.String(), "{\n  \"name\": \"test\"\n}\n")
+	})
+
+	t.Run("yaml", func(t *testing.T) {
+		t.Parallel()
+		var buf bytes.Buffer
+		testutil.NoError(t, Print(&buf, FormatYAML, data))
+		testutil.Equal(t, buf.String(), "name: test\n")
+	})
+
+	t.Run("text is not structured", func(t *testing.T) {
+		t.Parallel()
+		var buf bytes.Buffer
+		testutil.Error(t, Print(&buf, FormatText, data))
+	})
+}
+
+func TestResolveOptions(t *testing.T) {
+	t.Parallel()
+
+	opts, err := ResolveOptions("yaml", false, "id, name")
+	testutil.NoError(t, err)
+	testutil.Equal(t, opts.Format, FormatYAML)
+	testutil.Len(t, opts.Fields, 2)
+	testutil.True(t, opts.Structured())
+
+	opts, err = ResolveOptions("", true, "")
+	testutil.NoError(t, err)
+	testutil.Equal(t, opts.Format, FormatJSON)
+	testutil.Len(t, opts.Fields, 0)
+
+	_, err = ResolveOptions("text", false, "id")
+	testutil.Error(t, err)
+	testutil.Contains(t, err.Error(), "--fields requires --output json or yaml")
+
+	opts, err = ResolveOptions("", false, "")
+	testutil.NoError(t, err)
+	testutil.False(t, opts.Structured())
+}
+
+func TestOptionsPrint(t *testing.T) {
+	t.Parallel()
+	data := struct {
+		Name  string `json:"name"`
+		Count int    `json:"count"`
+	}{"test", 2}
+
+	var buf bytes.Buffer
+	testutil.NoError(t, Options{Format: FormatJSON, Fields: []string{"count"}}.Print(&buf, data))
+	testutil.Equal(t, buf.String(), "{\n  \"count\": 2\n}\n")
+
+	buf.Reset()
+	err := Options{Format: FormatJSON, Fields: []string{"size"}}.Print(&buf, data)
+	testutil.Error(t, err)
+	testutil.Equal(t, buf.Len(), 0)
+}
@@ -5,6 +5,7 @@ package output
 import (
 	"bytes"
 	"encoding/json"
+	"errors"
 	"io"
 	"os"
 
@@ -43,3 +44,29 @@ func JSON(w io.Writer, data any) error {
 func JSONStdout(data any) error {
 	return JSON(os.Stdout, data)
 }
+
+// reportedError marks an error the command already described in the
+// structured output it printed
+type reportedError struct {
+	err error
+}
+
+func (e *reportedError) Error() string { return e.err.Error() }
+func (e *reportedError) Unwrap() error { return e.err }
+
+// Reported marks err as already described in the JSON or YAML a command
+// printed, such as an envelope with a per-item "error" field, so the root
+// command does not print a second JSON error object after it. A nil err
+// stays nil.
+func Reported(err error) error {
+	if err == nil {
+		return nil
+	}
+	return &reportedError{err: err}
+}
+
+// IsReported reports whether err, or an error it wraps, was marked by Reported
+func IsReported(err error) bool {
+	var r *reportedError
+	return errors.As(err, &r)
+}
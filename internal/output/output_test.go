@@ -2,6 +2,8 @@ package output
 
 import (
 	"bytes"
+	"errors"
+	"fmt"
 	"strings"
 	"testing"
 
@@ -77,3 +79,14 @@ func TestJSON_error(t *testing.T) {
 	err := JSON(&buf, data)
 	testutil.Error(t, err)
 }
+
+func TestReported(t *testing.T) {
+	testutil.NoError(t, Reported(nil))
+	testutil.False(t, IsReported(errors.New("boom")))
+
+	base := errors.New("boom")
+	err := fmt.Errorf("refreshing drives: %w", Reported(base))
+	testutil.True(t, IsReported(err))
+	testutil.True(t, errors.Is(err, base))
+	testutil.Equal(t, err.Error(), "refreshing drives: boom")
+}
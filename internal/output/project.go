@@ -0,0 +1,185 @@
+package output
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+)
+
+// ParseFields splits a comma-separated --fields value into field names,
+// dropping blanks. An empty value yields nil, meaning no projection.
+func ParseFields(s string) []string {
+	var fields []string
+	for _, f := range strings.Split(s, ",") {
+		if f = strings.TrimSpace(f); f != "" {
+			fields = append(fields, f)
+		}
+	}
+	return fields
+}
+
+// Project keeps only the named top-level fields of data, matched against
+// JSON tag names. A struct (or pointer to one) is projected directly; a
+// slice or array is projected element by element. Requesting a field the
+// type does not have is an error that lists the available names. The result
+// marshals with the kept fields in declaration order and honors omitempty.
+// With no fields, data is returned unchanged.
+func Project(data any, fields []string) (any, error) {
+	if len(fields) == 0 {
+		return data, nil
+	}
+	v := reflect.ValueOf(data)
+	for v.Kind() == reflect.Pointer {
+		if v.IsNil() {
+			return data, nil
+		}
+		v = v.Elem()
+	}
+
+	switch v.Kind() {
+	case reflect.Slice, reflect.Array:
+		elem := v.Type().Elem()
+		for elem.Kind() == reflect.Pointer {
+			elem = elem.Elem()
+		}
+		if err := checkFields(elem, fields); err != nil {
+			return nil, err
+		}
+		items := make([]projection, 0, v.Len())
+		for i := range v.Len() {
+			items = append(items, projectStruct(v.Index(i), fields))
+		}
+		return items, nil
+	case reflect.Struct:
+		if err := checkFields(v.Type(), fields); err != nil {
+			return nil, err
+		}
+		return projectStruct(v, fields), nil
+	default:
+		return nil, fmt.Errorf("--fields is not supported for %s output", v.Kind())
+	}
+}
+
+// jsonField is one exported struct field as encoding/json sees it
+type jsonField struct {
+	index     int
+	name      string
+	omitEmpty bool
+}
+
+// jsonFields lists t's fields under their JSON names, skipping unexported
+// fields and those tagged "-"
+func jsonFields(t reflect.Type) []jsonField {
+	var out []jsonField
+	for i := range t.NumField() {
+		sf := t.Field(i)
+		if !sf.IsExported() {
+			continue
+		}
+		tag := sf.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+		name, opts, _ := strings.Cut(tag, ",")
+		if name == "" {
+			name = sf.Name
+		}
+		out = append(out, jsonField{
+			index:     i,
+			name:      name,
+			omitEmpty: slices.Contains(strings.Split(opts, ","), "omitempty"),
+		})
+	}
+	return out
+}
+
+// checkFields reports the first requested field t does not have
+func checkFields(t reflect.Type, fields []string) error {
+	if t.Kind() != reflect.Struct {
+		return fmt.Errorf("--fields is not supported for %s output", t.Kind())
+	}
+	known := jsonFields(t)
+	names := make([]string, len(known))
+	for i, f := range known {
+		names[i] = f.name
+	}
+	for _, f := range fields {
+		if !slices.Contains(names, f) {
+			return fmt.Errorf("unknown field %q (available: %s)", f, strings.Join(names, ", "))
+		}
+	}
+	return nil
+}
+
+// projection is a struct reduced to a subset of its fields
+type projection []projectedField
+
+type projectedField struct {
+	name  string
+	value any
+}
+
+func projectStruct(v reflect.Value, fields []string) projection {
+	for v.Kind() == reflect.Pointer {
+		if v.IsNil() {
+			return nil
+		}
+		v = v.Elem()
+	}
+	p := projection{}
+	for _, f := range jsonFields(v.Type()) {
+		if !slices.Contains(fields, f.name) {
+			continue
+		}
+		fv := v.Field(f.index)
+		if f.omitEmpty && isEmptyValue(fv) {
+			continue
+		}
+		p = append(p, projectedField{name: f.name, value: fv.Interface()})
+	}
+	return p
+}
+
+// isEmptyValue mirrors encoding/json's omitempty test: empty containers and
+// strings, false, zero numbers and nil pointers or interfaces. Structs are
+// never empty.
+func isEmptyValue(v reflect.Value) bool {
+	switch v.Kind() {
+	case reflect.Array, reflect.Map, reflect.Slice, reflect.String:
+		return v.Len() == 0
+	case reflect.Struct:
+		return false
+	default:
+		return v.IsZero()
+	}
+}
+
+// MarshalJSON writes the kept fields as an object in declaration order
+func (p projection) MarshalJSON() ([]byte, error) {
+	if p == nil {
+		return []byte("null"), nil
+	}
+	var buf bytes.Buffer
+	buf.WriteByte('{')
+	for i, f := range p {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		key, err := json.Marshal(f.name)
+		if err != nil {
+			return nil, err
+		}
+		val, err := json.Marshal(f.value)
+		if err != nil {
+			return nil, err
+		}
+		buf.Write(key)
+		buf.WriteByte(':')
+		buf.Write(val)
+	}
+	buf.WriteByte('}')
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,103 @@
+package output
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/open-cli-collective/google-readonly/internal/testutil"
+)
+
+type projectItem struct {
+	ID      string   `json:"id"`
+	Subject string   `json:"subject"`
+	From    string   `json:"from,omitempty"`
+	Labels  []string `json:"labels,omitempty"`
+	secret  string
+	Skipped string `json:"-"`
+	NoTag   int
+}
+
+func marshal(t *testing.T, v any) string {
+	t.Helper()
+	b, err := json.Marshal(v)
+	testutil.NoError(t, err)
+	return string(b)
+}
+
+func TestParseFields(t *testing.T) {
+	t.Parallel()
+	testutil.Equal(t, len(ParseFields("")), 0)
+	testutil.Equal(t, strings.Join(ParseFields(" id, subject ,,from"), "|"), "id|subject|from")
+}
+
+func TestProject(t *testing.T) {
+	t.Parallel()
+	items := []*projectItem{
+		{ID: "m1", Subject: "Hello", From: "a@example.com", secret: "x", Skipped: "y", NoTag: 3},
+		{ID: "m2", Subject: "Bye"},
+	}
+
+	t.Run("slice keeps fields in declaration order", func(t *testing.T) {
+		t.Parallel()
+		got, err := Project(items, []string{"from", "id"})
+		testutil.NoError(t, err)
+		// omitempty drops m2's empty from, as in unprojected output
+		testutil.Equal(t, marshal(t, got), `[{"id":"m1","from":"a@example.com"},{"id":"m2"}]`)
+
+		got, err = Project(items, []string{"from"})
+		testutil.NoError(t, err)
+		testutil.Equal(t, marshal(t, got), `[{"from":"a@example.com"},{}]`)
+	})
+
+	t.Run("single struct", func(t *testing.T) {
+		t.Parallel()
+		got, err := Project(items[0], []string{"subject", "NoTag"})
+		testutil.NoError(t, err)
+		testutil.Equal(t, marshal(t, got), `{"subject":"Hello","NoTag":3}`)
+	})
+
+	t.Run("empty slice", func(t *testing.T) {
+		t.Parallel()
+		got, err := Project([]projectItem{}, []string{"id"})
+		testutil.NoError(t, err)
+		testutil.Equal(t, marshal(t, got), `[]`)
+	})
+
+	t.Run("no fields leaves data unchanged", func(t *testing.T) {
+		t.Parallel()
+		got, err := Project(items, nil)
+		testutil.NoError(t, err)
+		testutil.Equal(t, marshal(t, got), marshal(t, items))
+	})
+
+	t.Run("unknown field lists available names", func(t *testing.T) {
+		t.Parallel()
+		_, err := Project(items, []string{"id", "body"})
+		testutil.Error(t, err)
+		testutil.Contains(t, err.Error(), `unknown field "body"`)
+		testutil.Contains(t, err.Error(), "available: id, subject, from, labels, NoTag")
+	})
+
+	t.Run("unexported and dash-tagged fields are not selectable", func(t *testing.T) {
+		t.Parallel()
+		_, err := Project(items, []string{"Skipped"})
+		testutil.Error(t, err)
+	})
+
+	t.Run("non-struct data is rejected", func(t *testing.T) {
+		t.Parallel()
+		_, err := Project(map[string]int{"a": 1}, []string{"a"})
+		testutil.Error(t, err)
+	})
+
+	t.Run("projected YAML keeps order", func(t *testing.T) {
+		t.Parallel()
+		got, err := Project(items[0], []string{"subject", "id"})
+		testutil.NoError(t, err)
+		var buf bytes.Buffer
+		testutil.NoError(t, YAML(&buf, got))
+		testutil.Equal(t, buf.String(), "id: m1\nsubject: Hello\n")
+	})
+}
@@ -6,15 +6,13 @@ package people
 
 import (
 	"context"
-	"errors"
 	"fmt"
-	"strings"
 
-	"google.golang.org/api/googleapi"
 	"google.golang.org/api/option"
 	peopleapi "google.golang.org/api/people/v1"
 
 	"github.com/open-cli-collective/google-readonly/internal/auth"
+	"github.com/open-cli-collective/google-readonly/internal/exit"
 )
 
 // Profile is the subset of People API data we surface for `gro me`.
@@ -100,25 +98,5 @@ func pickPrimaryEmail(p *peopleapi.Person) string {
 // project-not-permitted, etc.). Distinguishing these matters because only
 // scope errors should suggest `gro init` re-auth.
 func IsInsufficientScopeError(err error) bool {
-	if err == nil {
-		return false
-	}
-	var apiErr *googleapi.Error
-	if !errors.As(err, &apiErr) {
-		return false
-	}
-	if apiErr.Code != 403 {
-		return false
-	}
-	for _, e := range apiErr.Errors {
-		if e.Reason == "insufficientPermissions" || e.Reason == "ACCESS_TOKEN_SCOPE_INSUFFICIENT" {
-			return true
-		}
-	}
-	msg := strings.ToLower(apiErr.Message)
-	if strings.Contains(msg, "insufficient authentication scopes") ||
-		strings.Contains(msg, "access_token_scope_insufficient") {
-		return true
-	}
-	return false
+	return exit.IsScopeError(err)
 }
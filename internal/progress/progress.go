@@ -0,0 +1,111 @@
+// Package progress reports the progress of large downloads and long
+// pagination loops on stderr, redrawing a single line in place.
+package progress
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"sync"
+
+	"golang.org/x/term"
+
+	"github.com/open-cli-collective/google-readonly/internal/format"
+)
+
+// Threshold is the smallest download, in bytes, that gets a progress line.
+// Anything smaller finishes before a progress line would be worth reading.
+const Threshold = 10 << 20
+
+// Disabled turns progress reporting off even on a terminal
+var Disabled bool
+
+// interactive reports whether stdout and stderr are both terminals; a
+// variable so tests can stand in for one
+var interactive = func() bool {
+	return term.IsTerminal(int(os.Stdout.Fd())) && term.IsTerminal(int(os.Stderr.Fd()))
+}
+
+// Track wraps w so the bytes written through it are reported against total
+// as "name: 12.0 MB / 40.0 MB (30%)" on stderr. done clears the line and
+// must be called once the transfer ends. When total is unknown or below
+// Threshold, progress is disabled, or the output is not interactive, w is
+// returned unchanged and done does nothing.
+func Track(w io.Writer, name string, total int64) (io.Writer, func()) {
+	if Disabled || total < Threshold || !interactive() {
+		return w, func() {}
+	}
+	c := &counter{w: w, out: os.Stderr, name: name, total: total, percent: -1}
+	return c, c.done
+}
+
+// counter passes writes through to w and redraws the progress line on out
+// whenever the whole percentage changes
+type counter struct {
+	w       io.Writer
+	out     io.Writer
+	name    string
+	total   int64
+	written int64
+	percent int64
+}
+
+func (c *counter) Write(p []byte) (int, error) {
+	n, err := c.w.Write(p)
+	c.written += int64(n)
+	if pct := min(c.written*100/c.total, 100); pct != c.percent {
+		c.percent = pct
+		fmt.Fprintf(c.out, "\r%s: %s / %s (%d%%)", c.name,
+			format.Size(c.written), format.Size(c.total), pct)
+	}
+	return n, err
+}
+
+// done erases the progress line so later output starts on a clean line
+func (c *counter) done() {
+	if c.percent >= 0 {
+		fmt.Fprint(c.out, "\r\033[K")
+	}
+}
+
+// Count starts a running tally of items fetched by a pagination loop,
+// reported as "Fetched 500 messages..." on stderr after each page. Done
+// clears the line and must be called once the loop ends. When progress is
+// disabled or the output is not interactive, the tally reports nothing.
+func Count(noun string) *Tally {
+	if Disabled || !interactive() {
+		return &Tally{}
+	}
+	return &Tally{out: os.Stderr, noun: noun}
+}
+
+// Tally is a running count of fetched items. It is safe for concurrent use.
+type Tally struct {
+	mu    sync.Mutex
+	out   io.Writer // nil when the tally reports nothing
+	noun  string
+	count int
+	shown bool
+}
+
+// Add records n more fetched items and redraws the status line
+func (t *Tally) Add(n int) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.count += n
+	if t.out == nil {
+		return
+	}
+	t.shown = true
+	fmt.Fprintf(t.out, "\r\033[KFetched %d %s...", t.count, t.noun)
+}
+
+// Done erases the status line so later output starts on a clean line
+func (t *Tally) Done() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.shown {
+		fmt.Fprint(t.out, "\r\033[K")
+		t.shown = false
+	}
+}
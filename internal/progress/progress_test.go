@@ -0,0 +1,134 @@
+package progress
+
+import (
+	"bytes"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/open-cli-collective/google-readonly/internal/testutil"
+)
+
+// withInteractive makes Track see (or not see) a terminal for one test
+func withInteractive(t *testing.T, on bool) {
+	t.Helper()
+	orig := interactive
+	interactive = func() bool { return on }
+	t.Cleanup(func() { interactive = orig })
+}
+
+func TestCounter(t *testing.T) {
+	var dst, out bytes.Buffer
+	c := &counter{w: &dst, out: &out, name: "video.mp4", total: 4 << 20, percent: -1}
+
+	chunk := bytes.Repeat([]byte("x"), 1<<20)
+	for range 4 {
+		n, err := c.Write(chunk)
+		testutil.NoError(t, err)
+		testutil.Equal(t, n, len(chunk))
+	}
+	c.done()
+
+	testutil.Equal(t, dst.Len(), 4<<20)
+	lines := strings.Split(out.String(), "\r")
+	testutil.Equal(t, lines[1], "video.mp4: 1.0 MB / 4.0 MB (25%)")
+	testutil.Equal(t, lines[4], "video.mp4: 4.0 MB / 4.0 MB (100%)")
+	testutil.Equal(t, lines[5], "\033[K")
+}
+
+func TestCounter_RedrawsOnlyOnPercentChange(t *testing.T) {
+	var out bytes.Buffer
+	c := &counter{w: &bytes.Buffer{}, out: &out, name: "f", total: 1000, percent: -1}
+	for range 100 {
+		_, _ = c.Write([]byte("x"))
+	}
+	// 0% through 10%
+	testutil.Equal(t, strings.Count(out.String(), "\r"), 11)
+}
+
+func TestCounter_DoneWithoutWrites(t *testing.T) {
+	var out bytes.Buffer
+	c := &counter{w: &bytes.Buffer{}, out: &out, name: "f", total: 1000, percent: -1}
+	c.done()
+	testutil.Equal(t, out.String(), "")
+}
+
+func TestTrack(t *testing.T) {
+	var dst bytes.Buffer
+
+	t.Run("small downloads pass through", func(t *testing.T) {
+		withInteractive(t, true)
+		w, done := Track(&dst, "f", Threshold-1)
+		defer done()
+		testutil.True(t, w == &dst)
+	})
+
+	t.Run("unknown size passes through", func(t *testing.T) {
+		withInteractive(t, true)
+		w, _ := Track(&dst, "f", 0)
+		testutil.True(t, w == &dst)
+	})
+
+	t.Run("not a terminal passes through", func(t *testing.T) {
+		withInteractive(t, false)
+		w, _ := Track(&dst, "f", Threshold)
+		testutil.True(t, w == &dst)
+	})
+
+	t.Run("disabled passes through", func(t *testing.T) {
+		withInteractive(t, true)
+		Disabled = true
+		defer func() { Disabled = false }()
+		w, _ := Track(&dst, "f", Threshold)
+		testutil.True(t, w == &dst)
+	})
+
+	t.Run("large download on a terminal is tracked", func(t *testing.T) {
+		withInteractive(t, true)
+		w, _ := Track(&dst, "f", Threshold)
+		_, ok := w.(*counter)
+		testutil.True(t, ok)
+	})
+}
+
+func TestTally(t *testing.T) {
+	var out bytes.Buffer
+	tally := &Tally{out: &out, noun: "contacts"}
+	tally.Add(500)
+	tally.Add(120)
+	tally.Done()
+
+	testutil.Equal(t, out.String(),
+		"\r\033[KFetched 500 contacts..."+
+			"\r\033[KFetched 620 contacts..."+
+			"\r\033[K")
+}
+
+func TestTally_DoneWithoutAdds(t *testing.T) {
+	var out bytes.Buffer
+	tally := &Tally{out: &out, noun: "files"}
+	tally.Done()
+	testutil.Equal(t, out.String(), "")
+}
+
+func TestCount(t *testing.T) {
+	t.Run("not a terminal reports nothing", func(t *testing.T) {
+		withInteractive(t, false)
+		tally := Count("messages")
+		tally.Add(10)
+		tally.Done()
+		testutil.True(t, tally.out == nil)
+	})
+
+	t.Run("disabled reports nothing", func(t *testing.T) {
+		withInteractive(t, true)
+		Disabled = true
+		defer func() { Disabled = false }()
+		testutil.True(t, Count("messages").out == nil)
+	})
+
+	t.Run("terminal reports to stderr", func(t *testing.T) {
+		withInteractive(t, true)
+		testutil.True(t, Count("messages").out == os.Stderr)
+	})
+}
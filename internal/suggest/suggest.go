@@ -0,0 +1,90 @@
+// Package suggest finds the names a user probably meant when one they typed
+// matches nothing, for "did you mean" hints.
+package suggest
+
+import (
+	"sort"
+	"strings"
+)
+
+// maxSuggestions caps how many close names Closest returns
+const maxSuggestions = 3
+
+// Closest returns up to three of candidates nearest to name by Levenshtein
+// distance, nearest first and alphabetically among equals. The comparison
+// ignores letter case. A candidate is only close enough when its distance is
+// at most a third of name's length (and at least 2), so a short typo finds
+// its label but an unrelated word finds nothing.
+func Closest(name string, candidates []string) []string {
+	target := strings.ToLower(name)
+	limit := max(len([]rune(target))/3, 2)
+
+	type match struct {
+		name string
+		dist int
+	}
+	var matches []match
+	seen := map[string]bool{}
+	for _, c := range candidates {
+		if seen[c] {
+			continue
+		}
+		seen[c] = true
+		if d := Distance(target, strings.ToLower(c)); d <= limit {
+			matches = append(matches, match{c, d})
+		}
+	}
+	sort.Slice(matches, func(i, j int) bool {
+		if matches[i].dist != matches[j].dist {
+			return matches[i].dist < matches[j].dist
+		}
+		return matches[i].name < matches[j].name
+	})
+
+	var names []string
+	for i := 0; i < len(matches) && i < maxSuggestions; i++ {
+		names = append(names, matches[i].name)
+	}
+	return names
+}
+
+// DidYouMean renders names as a hint to append to an error, such as
+// "did you mean 'Work/Urgent'?" or "did you mean 'Work' or 'Word'?". It is
+// empty when there are no names.
+func DidYouMean(names []string) string {
+	if len(names) == 0 {
+		return ""
+	}
+	quoted := make([]string, len(names))
+	for i, n := range names {
+		quoted[i] = "'" + n + "'"
+	}
+	if len(quoted) == 1 {
+		return "did you mean " + quoted[0] + "?"
+	}
+	return "did you mean " + strings.Join(quoted[:len(quoted)-1], ", ") + " or " + quoted[len(quoted)-1] + "?"
+}
+
+// Distance is the Levenshtein distance between a and b: the fewest
+// single-rune insertions, deletions and substitutions turning one into the
+// other
+func Distance(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+	prev := make([]int, len(rb)+1)
+	cur := make([]int, len(rb)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(ra); i++ {
+		cur[0] = i
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			cur[j] = min(prev[j]+1, cur[j-1]+1, prev[j-1]+cost)
+		}
+		prev, cur = cur, prev
+	}
+	return prev[len(rb)]
+}
@@ -0,0 +1,59 @@
+package suggest
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/open-cli-collective/google-readonly/internal/testutil"
+)
+
+func TestDistance(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want int
+	}{
+		{"", "", 0},
+		{"work", "work", 0},
+		{"", "abc", 3},
+		{"kitten", "sitting", 3},
+		{"Work/Urgnet", "Work/Urgent", 2},
+		{"reçu", "recu", 1},
+	}
+	for _, tt := range tests {
+		testutil.Equal(t, Distance(tt.a, tt.b), tt.want)
+		testutil.Equal(t, Distance(tt.b, tt.a), tt.want)
+	}
+}
+
+func TestClosest(t *testing.T) {
+	labels := []string{"Work", "Work/Urgent", "Word", "Personal", "Receipts", "INBOX"}
+
+	tests := []struct {
+		name string
+		want string
+	}{
+		{"Work/Urgnet", "Work/Urgent"},
+		{"wrok", "Work"},
+		{"wrk", "Work,Word"},
+		{"persnal", "Personal"},
+		{"inbx", "INBOX"},
+		{"Travel", ""},
+		{"x", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			testutil.Equal(t, strings.Join(Closest(tt.name, labels), ","), tt.want)
+		})
+	}
+
+	t.Run("caps the number of suggestions", func(t *testing.T) {
+		testutil.Len(t, Closest("ab", []string{"aa", "ac", "ad", "ae", "ab1"}), 3)
+	})
+}
+
+func TestDidYouMean(t *testing.T) {
+	testutil.Equal(t, DidYouMean(nil), "")
+	testutil.Equal(t, DidYouMean([]string{"Work/Urgent"}), "did you mean 'Work/Urgent'?")
+	testutil.Equal(t, DidYouMean([]string{"Work", "Word"}), "did you mean 'Work' or 'Word'?")
+	testutil.Equal(t, DidYouMean([]string{"a", "b", "c"}), "did you mean 'a', 'b' or 'c'?")
+}
@@ -0,0 +1,205 @@
+// Package table renders the aligned text tables of list views. Columns can
+// be capped at a maximum width, right-aligned, and narrowed to fit the
+// terminal; a cell that does not fit its column is cut short with "...".
+// Widths are measured in terminal cells, so wide characters and color
+// escapes do not throw the alignment off.
+package table
+
+import (
+	"io"
+	"os"
+	"strings"
+
+	"github.com/charmbracelet/x/ansi"
+	"golang.org/x/term"
+
+	"github.com/open-cli-collective/google-readonly/internal/format"
+)
+
+// Align is the side of its column a cell is pushed to
+type Align int
+
+const (
+	// Left pads cells on the right; the default
+	Left Align = iota
+	// Right pads cells on the left, for sizes and counts
+	Right
+)
+
+// gap is the number of spaces between two columns
+const gap = 2
+
+// ellipsis marks a truncated cell
+const ellipsis = "..."
+
+// flatten turns the characters that would break a row into spaces
+var flatten = strings.NewReplacer("\t", " ", "\r\n", " ", "\n", " ", "\r", " ")
+
+// minShrinkWidth is the narrowest a Shrink column is made to fit the
+// terminal; past that the row is left to wrap
+const minShrinkWidth = 10
+
+// Column describes one column of a Table
+type Column struct {
+	Header string
+	// MaxWidth caps the column's width; longer cells are truncated. Zero
+	// means no cap.
+	MaxWidth int
+	Align    Align
+	// Shrink lets the column be narrowed when the table is wider than its
+	// Width. Free-text columns such as names shrink; IDs, which must stay
+	// whole to be pasted into other commands, should not.
+	Shrink bool
+}
+
+// Table is a set of rows laid out under a row of column headers
+type Table struct {
+	Columns []Column
+	// NoHeaders leaves out the header row
+	NoHeaders bool
+	// Width is the widest a row may be, usually the terminal's width. Zero
+	// means rows may be as wide as their content.
+	Width int
+
+	rows [][]string
+}
+
+// terminalWidth returns the width of the terminal on stdout, or 0 when
+// stdout is not a terminal. It is a variable so tests can fake a terminal.
+var terminalWidth = func() int {
+	fd := int(os.Stdout.Fd())
+	if !term.IsTerminal(fd) {
+		return 0
+	}
+	w, _, err := term.GetSize(fd)
+	if err != nil {
+		return 0
+	}
+	return w
+}
+
+// New returns a table for stdout: the header row follows --no-headers and,
+// on a terminal, rows are kept within the terminal's width. Piped output is
+// never narrowed, so scripts see whole values.
+func New(columns ...Column) *Table {
+	return &Table{
+		Columns:   columns,
+		NoHeaders: format.NoHeaders,
+		Width:     terminalWidth(),
+	}
+}
+
+// AddRow appends a row. Missing trailing cells are left blank and extra
+// ones dropped. Tabs and line breaks become spaces so a cell stays on its
+// line.
+func (t *Table) AddRow(cells ...string) {
+	row := make([]string, len(t.Columns))
+	for i := range row {
+		if i < len(cells) {
+			row[i] = flatten.Replace(cells[i])
+		}
+	}
+	t.rows = append(t.rows, row)
+}
+
+// Len returns the number of rows added
+func (t *Table) Len() int {
+	return len(t.rows)
+}
+
+// Render writes the table to w
+func (t *Table) Render(w io.Writer) error {
+	widths := t.widths()
+
+	var b strings.Builder
+	if !t.NoHeaders {
+		headers := make([]string, len(t.Columns))
+		for i, c := range t.Columns {
+			headers[i] = c.Header
+		}
+		t.writeRow(&b, headers, widths)
+	}
+	for _, row := range t.rows {
+		t.writeRow(&b, row, widths)
+	}
+
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// Print renders the table to stdout. Write errors are ignored: they mean
+// stdout is closed, and there is nothing useful left to do.
+func (t *Table) Print() {
+	_ = t.Render(os.Stdout)
+}
+
+// widths returns the width of each column: the widest cell (and header),
+// capped at MaxWidth, then with Shrink columns narrowed, widest first, until
+// the row fits Width
+func (t *Table) widths() []int {
+	widths := make([]int, len(t.Columns))
+	for i, c := range t.Columns {
+		if !t.NoHeaders {
+			widths[i] = ansi.StringWidth(c.Header)
+		}
+		for _, row := range t.rows {
+			widths[i] = max(widths[i], ansi.StringWidth(row[i]))
+		}
+		if c.MaxWidth > 0 {
+			widths[i] = min(widths[i], c.MaxWidth)
+		}
+	}
+
+	if t.Width <= 0 || len(widths) == 0 {
+		return widths
+	}
+	total := gap * (len(widths) - 1)
+	for _, w := range widths {
+		total += w
+	}
+	for ; total > t.Width; total-- {
+		widest := -1
+		for i, c := range t.Columns {
+			if c.Shrink && widths[i] > minShrinkWidth && (widest < 0 || widths[i] > widths[widest]) {
+				widest = i
+			}
+		}
+		if widest < 0 {
+			break
+		}
+		widths[widest]--
+	}
+	return widths
+}
+
+// writeRow writes one line of cells padded to widths. Trailing blanks are
+// trimmed, so a short last cell leaves no spaces at the end of the line.
+func (t *Table) writeRow(b *strings.Builder, cells []string, widths []int) {
+	var line strings.Builder
+	for i, cell := range cells {
+		if i > 0 {
+			line.WriteString(strings.Repeat(" ", gap))
+		}
+		cell = truncate(cell, widths[i])
+		pad := strings.Repeat(" ", widths[i]-ansi.StringWidth(cell))
+		if t.Columns[i].Align == Right {
+			line.WriteString(pad + cell)
+		} else {
+			line.WriteString(cell + pad)
+		}
+	}
+	b.WriteString(strings.TrimRight(line.String(), " "))
+	b.WriteString("\n")
+}
+
+// truncate shortens s to width cells, ending it with an ellipsis when there
+// is room for one
+func truncate(s string, width int) string {
+	if ansi.StringWidth(s) <= width {
+		return s
+	}
+	if width <= len(ellipsis) {
+		return ansi.Truncate(s, width, "")
+	}
+	return ansi.Truncate(s, width, ellipsis)
+}
@@ -0,0 +1,115 @@
+package table
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/open-cli-collective/google-readonly/internal/testutil"
+)
+
+func render(t *testing.T, tbl *Table) []string {
+	t.Helper()
+	var b strings.Builder
+	testutil.NoError(t, tbl.Render(&b))
+	return strings.Split(strings.TrimSuffix(b.String(), "\n"), "\n")
+}
+
+func TestRender_Alignment(t *testing.T) {
+	tbl := &Table{Columns: []Column{
+		{Header: "NAME"},
+		{Header: "SIZE", Align: Right},
+		{Header: "TYPE"},
+	}}
+	tbl.AddRow("report.pdf", "1.2 MB", "PDF")
+	tbl.AddRow("a", "12 B", "Text")
+
+	testutil.Equal(t, strings.Join(render(t, tbl), "\n"), strings.Join([]string{
+		"NAME          SIZE  TYPE",
+		"report.pdf  1.2 MB  PDF",
+		"a             12 B  Text",
+	}, "\n"))
+}
+
+func TestRender_MaxWidthTruncates(t *testing.T) {
+	tbl := &Table{Columns: []Column{
+		{Header: "NAME", MaxWidth: 10},
+		{Header: "ID"},
+	}}
+	tbl.AddRow("Quarterly planning notes", "abc")
+	tbl.AddRow("Short", "def")
+
+	lines := render(t, tbl)
+	testutil.Equal(t, lines[1], "Quarter...  abc")
+	testutil.Equal(t, lines[2], "Short       def")
+}
+
+func TestRender_ShrinksToWidth(t *testing.T) {
+	tbl := &Table{
+		Columns: []Column{
+			{Header: "ID"},
+			{Header: "NAME", Shrink: true},
+			{Header: "OWNER", Shrink: true},
+		},
+		Width: 40,
+	}
+	tbl.AddRow("1a2b3c4d5e", "A very long document name that wraps", "someone@example.com")
+
+	lines := render(t, tbl)
+	for _, l := range lines {
+		if len(l) > 40 {
+			t.Errorf("line %q is %d wide, want at most 40", l, len(l))
+		}
+	}
+	// IDs are never shrunk; the widest shrinkable column gives way first,
+	// until the shrinkable columns are even
+	testutil.Equal(t, lines[1], "1a2b3c4d5e  A very lon...  someone@ex...")
+}
+
+func TestRender_ShrinkStopsAtMinimum(t *testing.T) {
+	tbl := &Table{
+		Columns: []Column{{Header: "ID"}, {Header: "NAME", Shrink: true}},
+		Width:   5,
+	}
+	tbl.AddRow("0123456789abcdef", "A long file name")
+
+	lines := render(t, tbl)
+	testutil.Equal(t, lines[1], "0123456789abcdef  A long ...")
+}
+
+func TestRender_NoHeaders(t *testing.T) {
+	tbl := &Table{Columns: []Column{{Header: "NAME"}, {Header: "TYPE"}}, NoHeaders: true}
+	tbl.AddRow("x", "y")
+
+	testutil.Equal(t, strings.Join(render(t, tbl), "\n"), "x  y")
+}
+
+func TestAddRow(t *testing.T) {
+	tbl := &Table{Columns: []Column{{Header: "A"}, {Header: "B"}}}
+	tbl.AddRow("one\ttwo\nthree")
+	tbl.AddRow("x", "y", "dropped")
+
+	testutil.Equal(t, tbl.Len(), 2)
+	lines := render(t, tbl)
+	testutil.Equal(t, lines[1], "one two three")
+	testutil.Equal(t, lines[2], "x              y")
+}
+
+func TestRender_WideCharacters(t *testing.T) {
+	tbl := &Table{Columns: []Column{{Header: "NAME"}, {Header: "ID"}}}
+	tbl.AddRow("日本語", "1")
+	tbl.AddRow("abc", "2")
+
+	lines := render(t, tbl)
+	testutil.Equal(t, lines[1], "日本語  1")
+	testutil.Equal(t, lines[2], "abc     2")
+}
+
+func TestNew(t *testing.T) {
+	orig := terminalWidth
+	terminalWidth = func() int { return 120 }
+	t.Cleanup(func() { terminalWidth = orig })
+
+	tbl := New(Column{Header: "NAME"})
+	testutil.Equal(t, tbl.Width, 120)
+	testutil.Len(t, tbl.Columns, 1)
+}
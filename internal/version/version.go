@@ -2,6 +2,11 @@
 // Variables are set via ldflags during build (see Makefile).
 package version
 
+import (
+	"runtime"
+	"runtime/debug"
+)
+
 // Build-time variables set via ldflags
 var (
 	// Version is the semantic version (from git tag or "dev")
@@ -16,5 +21,48 @@ var (
 
 // Info returns a formatted version string
 func Info() string {
-	return Version + " (commit: " + Commit + ", built: " + Date + ")"
+	info := Get()
+	return info.Version + " (commit: " + info.Commit + ", built: " + info.Date + ")"
+}
+
+// BuildInfo describes the running binary, for `gro version` and bug reports
+type BuildInfo struct {
+	Version   string `json:"version"`
+	Commit    string `json:"commit"`
+	Date      string `json:"date"`
+	GoVersion string `json:"goVersion"`
+	Platform  string `json:"platform"`
+}
+
+// Get returns the running binary's build information. A binary built
+// without ldflags, as `go install` does, falls back to the module version
+// and VCS stamp the Go toolchain embeds.
+func Get() BuildInfo {
+	info := BuildInfo{
+		Version:   Version,
+		Commit:    Commit,
+		Date:      Date,
+		GoVersion: runtime.Version(),
+		Platform:  runtime.GOOS + "/" + runtime.GOARCH,
+	}
+	if bi, ok := debug.ReadBuildInfo(); ok {
+		info = withBuildInfo(info, bi)
+	}
+	return info
+}
+
+// withBuildInfo fills the fields ldflags left at their defaults from bi
+func withBuildInfo(info BuildInfo, bi *debug.BuildInfo) BuildInfo {
+	if info.Version == "dev" && bi.Main.Version != "" && bi.Main.Version != "(devel)" {
+		info.Version = bi.Main.Version
+	}
+	for _, s := range bi.Settings {
+		switch {
+		case s.Key == "vcs.revision" && info.Commit == "unknown":
+			info.Commit = s.Value
+		case s.Key == "vcs.time" && info.Date == "unknown":
+			info.Date = s.Value
+		}
+	}
+	return info
 }
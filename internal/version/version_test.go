@@ -0,0 +1,48 @@
+package version
+
+import (
+	"runtime/debug"
+	"strings"
+	"testing"
+)
+
+func TestGet(t *testing.T) {
+	info := Get()
+	if !strings.HasPrefix(info.GoVersion, "go") {
+		t.Errorf("GoVersion = %q", info.GoVersion)
+	}
+	if !strings.Contains(info.Platform, "/") {
+		t.Errorf("Platform = %q, want os/arch", info.Platform)
+	}
+}
+
+func TestWithBuildInfo(t *testing.T) {
+	bi := &debug.BuildInfo{
+		Main: debug.Module{Version: "v1.4.0"},
+		Settings: []debug.BuildSetting{
+			{Key: "vcs.revision", Value: "abc123"},
+			{Key: "vcs.time", Value: "2026-03-01T10:00:00Z"},
+		},
+	}
+
+	t.Run("fills defaults", func(t *testing.T) {
+		got := withBuildInfo(BuildInfo{Version: "dev", Commit: "unknown", Date: "unknown"}, bi)
+		if got.Version != "v1.4.0" || got.Commit != "abc123" || got.Date != "2026-03-01T10:00:00Z" {
+			t.Errorf("got %+v", got)
+		}
+	})
+
+	t.Run("ldflags win", func(t *testing.T) {
+		got := withBuildInfo(BuildInfo{Version: "1.5.0", Commit: "def456", Date: "2026-04-01"}, bi)
+		if got.Version != "1.5.0" || got.Commit != "def456" || got.Date != "2026-04-01" {
+			t.Errorf("got %+v", got)
+		}
+	})
+
+	t.Run("devel build keeps dev", func(t *testing.T) {
+		got := withBuildInfo(BuildInfo{Version: "dev"}, &debug.BuildInfo{Main: debug.Module{Version: "(devel)"}})
+		if got.Version != "dev" {
+			t.Errorf("Version = %q, want dev", got.Version)
+		}
+	})
+}
@@ -0,0 +1,107 @@
+package zip
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+)
+
+// Format is an archive format ExtractArchive can unpack
+type Format int
+
+// Archive formats. FormatUnknown is not an archive gro extracts.
+const (
+	FormatUnknown Format = iota
+	FormatZip
+	FormatTar
+	FormatTarGz
+)
+
+// archiveExts maps file name suffixes to their format, longest first so
+// .tar.gz is not mistaken for a plain .gz
+var archiveExts = []struct {
+	ext    string
+	format Format
+}{
+	{".tar.gz", FormatTarGz},
+	{".tgz", FormatTarGz},
+	{".tar", FormatTar},
+	{".zip", FormatZip},
+}
+
+// zipMagic starts a zip file's first local file header
+var zipMagic = []byte("PK\x03\x04")
+
+// tarMagicOffset is where a POSIX/GNU tar header stores "ustar"
+const tarMagicOffset = 257
+
+// FormatFromName returns the archive format a file name's extension
+// implies, ignoring case
+func FormatFromName(name string) Format {
+	lower := strings.ToLower(name)
+	for _, a := range archiveExts {
+		if strings.HasSuffix(lower, a.ext) {
+			return a.format
+		}
+	}
+	return FormatUnknown
+}
+
+// TrimExt removes the archive extension from name, so "logs.tar.gz"
+// becomes "logs". A name without one is returned unchanged.
+func TrimExt(name string) string {
+	lower := strings.ToLower(name)
+	for _, a := range archiveExts {
+		if strings.HasSuffix(lower, a.ext) {
+			return name[:len(name)-len(a.ext)]
+		}
+	}
+	return name
+}
+
+// Detect returns the format of the archive at path from its leading bytes,
+// falling back to its extension when the content is not recognized
+func Detect(path string) (Format, error) {
+	f, err := os.Open(path) //nolint:gosec // Caller chooses the archive to inspect
+	if err != nil {
+		return FormatUnknown, fmt.Errorf("opening archive: %w", err)
+	}
+	defer f.Close()
+
+	head := make([]byte, tarMagicOffset+len("ustar"))
+	n, err := io.ReadFull(f, head)
+	if err != nil && !errors.Is(err, io.ErrUnexpectedEOF) && !errors.Is(err, io.EOF) {
+		return FormatUnknown, fmt.Errorf("reading archive: %w", err)
+	}
+	head = head[:n]
+
+	switch {
+	case bytes.HasPrefix(head, zipMagic):
+		return FormatZip, nil
+	case bytes.HasPrefix(head, gzipMagic):
+		return FormatTarGz, nil
+	case len(head) >= tarMagicOffset+len("ustar") && string(head[tarMagicOffset:]) == "ustar":
+		return FormatTar, nil
+	}
+	return FormatFromName(path), nil
+}
+
+// ExtractArchive extracts a zip, tar or gzip-compressed tar archive to
+// destDir, choosing the extractor by Detect
+func ExtractArchive(path, destDir string, opts Options) error {
+	format, err := Detect(path)
+	if err != nil {
+		return err
+	}
+	switch format {
+	case FormatZip:
+		return Extract(path, destDir, opts)
+	case FormatTar, FormatTarGz:
+		return ExtractTar(path, destDir, opts)
+	default:
+		return fmt.Errorf("not a zip or tar archive: %s", path)
+	}
+}
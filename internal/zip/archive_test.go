@@ -0,0 +1,107 @@
+package zip
+
+import (
+	"archive/tar"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/open-cli-collective/google-readonly/internal/testutil"
+)
+
+func TestFormatFromName(t *testing.T) {
+	tests := []struct {
+		name string
+		want Format
+	}{
+		{"archive.zip", FormatZip},
+		{"ARCHIVE.ZIP", FormatZip},
+		{"logs.tar", FormatTar},
+		{"logs.tar.gz", FormatTarGz},
+		{"logs.TGZ", FormatTarGz},
+		{"notes.gz", FormatUnknown},
+		{"report.pdf", FormatUnknown},
+		{"tar", FormatUnknown},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			testutil.Equal(t, FormatFromName(tt.name), tt.want)
+		})
+	}
+}
+
+func TestTrimExt(t *testing.T) {
+	testutil.Equal(t, TrimExt("logs.tar.gz"), "logs")
+	testutil.Equal(t, TrimExt("Logs.TGZ"), "Logs")
+	testutil.Equal(t, TrimExt("bundle.tar"), "bundle")
+	testutil.Equal(t, TrimExt("archive (1).zip"), "archive (1)")
+	testutil.Equal(t, TrimExt("report.pdf"), "report.pdf")
+}
+
+func TestDetect(t *testing.T) {
+	entries := []tarEntry{{name: "a.txt", typeflag: tar.TypeReg, content: "a"}}
+
+	t.Run("zip by content", func(t *testing.T) {
+		zipPath := createTestZip(t, map[string][]byte{"a.txt": []byte("a")})
+		defer os.Remove(zipPath)
+		renamed := zipPath + ".bin"
+		testutil.NoError(t, os.Rename(zipPath, renamed))
+		defer os.Remove(renamed)
+
+		got, err := Detect(renamed)
+		testutil.NoError(t, err)
+		testutil.Equal(t, got, FormatZip)
+	})
+
+	t.Run("tar.gz by content", func(t *testing.T) {
+		got, err := Detect(createTestTar(t, "misnamed.zip", true, entries))
+		testutil.NoError(t, err)
+		testutil.Equal(t, got, FormatTarGz)
+	})
+
+	t.Run("plain tar by content", func(t *testing.T) {
+		got, err := Detect(createTestTar(t, "attachment.dat", false, entries))
+		testutil.NoError(t, err)
+		testutil.Equal(t, got, FormatTar)
+	})
+
+	t.Run("falls back to the extension", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "empty.tar")
+		testutil.NoError(t, os.WriteFile(path, nil, 0600))
+
+		got, err := Detect(path)
+		testutil.NoError(t, err)
+		testutil.Equal(t, got, FormatTar)
+	})
+
+	t.Run("missing file", func(t *testing.T) {
+		_, err := Detect(filepath.Join(t.TempDir(), "nope.zip"))
+		testutil.Error(t, err)
+	})
+}
+
+func TestExtractArchive(t *testing.T) {
+	t.Run("dispatches tar.gz", func(t *testing.T) {
+		destDir := t.TempDir()
+		testutil.NoError(t, ExtractArchive(createTestTar(t, "b.tgz", true, nestedEntries), destDir, DefaultOptions()))
+		_, err := os.Stat(filepath.Join(destDir, "project", "src", "main.go"))
+		testutil.NoError(t, err)
+	})
+
+	t.Run("dispatches zip", func(t *testing.T) {
+		zipPath := createTestZip(t, map[string][]byte{"dir/a.txt": []byte("a")})
+		defer os.Remove(zipPath)
+		destDir := t.TempDir()
+		testutil.NoError(t, ExtractArchive(zipPath, destDir, DefaultOptions()))
+		_, err := os.Stat(filepath.Join(destDir, "dir", "a.txt"))
+		testutil.NoError(t, err)
+	})
+
+	t.Run("rejects other files", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "report.pdf")
+		testutil.NoError(t, os.WriteFile(path, []byte("%PDF-1.7"), 0600))
+		err := ExtractArchive(path, t.TempDir(), DefaultOptions())
+		testutil.Error(t, err)
+		testutil.Contains(t, err.Error(), "not a zip or tar archive")
+	})
+}
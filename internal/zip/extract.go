@@ -1,10 +1,12 @@
-// Package zip provides secure zip archive extraction with path traversal protection.
+// Package zip provides secure zip and tar archive extraction with path
+// traversal protection.
 package zip
 
 import (
 	"archive/zip"
 	"fmt"
 	"io"
+	"math"
 	"os"
 	"path/filepath"
 	"strings"
@@ -19,23 +21,34 @@ const (
 	MaxFiles = 1000
 	// MaxDepth is the maximum nesting depth for extracted directories
 	MaxDepth = 10
+	// MaxCompressionRatio is the most an archive entry may expand relative
+	// to its compressed size
+	MaxCompressionRatio = 100
 )
 
+// ratioFloor is how much an entry may always expand to, whatever its
+// compression ratio, so small highly compressible files still extract
+const ratioFloor = 1024 * 1024
+
 // Options configures zip extraction behavior
 type Options struct {
 	MaxFileSize  int64
 	MaxTotalSize int64
 	MaxFiles     int
 	MaxDepth     int
+	// MaxCompressionRatio caps decompressed size over compressed size for
+	// each entry beyond the first megabyte; 0 disables the check
+	MaxCompressionRatio int64
 }
 
 // DefaultOptions returns safe default extraction options
 func DefaultOptions() Options {
 	return Options{
-		MaxFileSize:  MaxFileSize,
-		MaxTotalSize: MaxTotalSize,
-		MaxFiles:     MaxFiles,
-		MaxDepth:     MaxDepth,
+		MaxFileSize:         MaxFileSize,
+		MaxTotalSize:        MaxTotalSize,
+		MaxFiles:            MaxFiles,
+		MaxDepth:            MaxDepth,
+		MaxCompressionRatio: MaxCompressionRatio,
 	}
 }
 
@@ -84,6 +97,11 @@ func validateZip(r *zip.Reader, opts Options) error {
 			return fmt.Errorf("file %s exceeds max size: %d bytes",
 				f.Name, f.UncompressedSize64)
 		}
+		// archive/zip fails any entry that decompresses past its declared
+		// size, so checking the declared sizes bounds the real ones
+		if limit := ratioLimit(int64(f.CompressedSize64), opts); limit > 0 && f.UncompressedSize64 > uint64(limit) { //nolint:gosec // sizes of a readable zip fit in int64
+			return ratioError(f.Name, opts)
+		}
 		totalSize += f.UncompressedSize64
 	}
 
@@ -96,18 +114,76 @@ func validateZip(r *zip.Reader, opts Options) error {
 }
 
 func extractFile(f *zip.File, destDir string, opts Options, totalSize *int64) error {
+	destPath, err := entryPath(destDir, f.Name, opts)
+	if err != nil {
+		return err
+	}
+
+	if f.FileInfo().IsDir() {
+		return fs.MkdirAll(destPath, f.Mode())
+	}
+
+	rc, err := f.Open()
+	if err != nil {
+		return err
+	}
+	defer rc.Close()
+
+	return writeEntry(rc, destPath, f.Name, f.Mode(), opts, totalSize)
+}
+
+// ratioLimit returns how many bytes an entry of compressed size may
+// decompress to under opts.MaxCompressionRatio, or 0 when the ratio is not
+// limited
+func ratioLimit(compressed int64, opts Options) int64 {
+	if opts.MaxCompressionRatio <= 0 {
+		return 0
+	}
+	if compressed > math.MaxInt64/opts.MaxCompressionRatio {
+		return math.MaxInt64
+	}
+	return max(compressed*opts.MaxCompressionRatio, ratioFloor)
+}
+
+func ratioError(name string, opts Options) error {
+	return fmt.Errorf("%s exceeds max compression ratio %d:1 (possible zip bomb)",
+		name, opts.MaxCompressionRatio)
+}
+
+// ratioReader fails once more than remaining bytes have been read from r
+type ratioReader struct {
+	r         io.Reader
+	remaining int64
+	name      string
+	opts      Options
+}
+
+func (rr *ratioReader) Read(p []byte) (int, error) {
+	n, err := rr.r.Read(p)
+	rr.remaining -= int64(n)
+	if rr.remaining < 0 {
+		return n, ratioError(rr.name, rr.opts)
+	}
+	return n, err
+}
+
+// entryPath validates an archive entry name and returns where it extracts
+// to inside destDir, which must be absolute. Absolute names, names that
+// climb out of destDir and names nested deeper than opts.MaxDepth are
+// rejected.
+func entryPath(destDir, entryName string, opts Options) (string, error) {
 	// Security: Prevent path traversal attacks
-	name := filepath.Clean(f.Name)
+	name := filepath.Clean(entryName)
 
 	// Reject absolute paths and paths starting with ..
 	if filepath.IsAbs(name) || strings.HasPrefix(name, ".."+string(os.PathSeparator)) || name == ".." {
-		return fmt.Errorf("invalid file path in zip: %s", f.Name)
+		return "", fmt.Errorf("invalid file path in archive: %s", entryName)
 	}
 
 	// Check nesting depth
 	depth := strings.Count(name, string(os.PathSeparator))
 	if depth > opts.MaxDepth {
-		return fmt.Errorf("file path too deep: %s (depth %d, max %d)",
+		return "", fmt.Errorf("file path too deep: %s (depth %d, max %d)",
 			name, depth, opts.MaxDepth)
 	}
 
@@ -116,48 +192,48 @@ func extractFile(f *zip.File, destDir string, opts Options, totalSize *int64) er
 	// Security: Ensure the destination is within destDir (handles symlink attacks)
 	cleanDest := filepath.Clean(destPath)
 	if !strings.HasPrefix(cleanDest, destDir+string(os.PathSeparator)) && cleanDest != destDir {
-		return fmt.Errorf("path traversal detected: %s", f.Name)
+		return "", fmt.Errorf("path traversal detected: %s", entryName)
 	}
 
-	if f.FileInfo().IsDir() {
-		return fs.MkdirAll(destPath, f.Mode())
-	}
+	return destPath, nil
+}
 
+// writeEntry copies one file's content from r to destPath, enforcing the
+// per-file and total size limits while it writes. totalSize accumulates
+// across the entries of one archive.
+func writeEntry(r io.Reader, destPath, entryName string, mode os.FileMode, opts Options, totalSize *int64) error {
 	// Create parent directories
 	if err := fs.MkdirAll(filepath.Dir(destPath), 0755); err != nil {
 		return err
 	}
 
-	// Extract file with size limit
-	rc, err := f.Open()
-	if err != nil {
-		return err
-	}
-	defer rc.Close()
-
-	outFile, err := fs.OpenFile(destPath, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, f.Mode())
+	outFile, err := fs.OpenFile(destPath, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, mode)
 	if err != nil {
 		return err
 	}
 	defer outFile.Close()
 
-	// Use LimitedReader to enforce size limits during extraction
-	limitedReader := &io.LimitedReader{R: rc, N: opts.MaxFileSize + 1}
+	// Use LimitedReader to enforce size limits during extraction, stopping
+	// at whichever of the per-file and remaining total budgets is smaller
+	limit := min(opts.MaxFileSize, opts.MaxTotalSize-*totalSize)
+	limitedReader := &io.LimitedReader{R: r, N: limit + 1}
 	written, err := io.Copy(outFile, limitedReader)
 	if err != nil {
+		// Best-effort cleanup; main error is the failed copy
+		_ = fs.Remove(destPath)
 		return err
 	}
 
-	if written > opts.MaxFileSize {
+	if written > limit {
 		// Best-effort cleanup; main error is size limit violation
 		_ = fs.Remove(destPath)
-		return fmt.Errorf("file %s exceeds max size during extraction", f.Name)
+		if written > opts.MaxFileSize {
+			return fmt.Errorf("file %s exceeds max size during extraction", entryName)
+		}
+		return fmt.Errorf("total extracted size exceeds limit")
 	}
 
 	*totalSize += written
-	if *totalSize > opts.MaxTotalSize {
-		return fmt.Errorf("total extracted size exceeds limit")
-	}
 
 	return nil
 }
@@ -2,8 +2,12 @@ package zip
 
 import (
 	"archive/zip"
+	"bytes"
+	"compress/flate"
 	"errors"
+	"hash/crc32"
 	"io"
+	"math"
 	"os"
 	"path/filepath"
 	"testing"
@@ -219,12 +223,107 @@ func TestExtractSecurityLimits(t *testing.T) {
 	})
 }
 
+// createForgedZip writes one deflated entry whose header declares a
+// decompressed size of declared bytes while it really holds content
+func createForgedZip(t *testing.T, name string, content []byte, declared uint64) string {
+	t.Helper()
+
+	var compressed bytes.Buffer
+	fw, err := flate.NewWriter(&compressed, flate.BestCompression)
+	testutil.NoError(t, err)
+	_, err = fw.Write(content)
+	testutil.NoError(t, err)
+	testutil.NoError(t, fw.Close())
+
+	path := filepath.Join(t.TempDir(), "forged.zip")
+	f, err := os.Create(path)
+	testutil.NoError(t, err)
+	defer f.Close()
+
+	w := zip.NewWriter(f)
+	raw, err := w.CreateRaw(&zip.FileHeader{
+		Name:               name,
+		Method:             zip.Deflate,
+		CRC32:              crc32.ChecksumIEEE(content),
+		CompressedSize64:   uint64(compressed.Len()),
+		UncompressedSize64: declared,
+	})
+	testutil.NoError(t, err)
+	_, err = raw.Write(compressed.Bytes())
+	testutil.NoError(t, err)
+	testutil.NoError(t, w.Close())
+	return path
+}
+
+func TestExtractCompressionRatio(t *testing.T) {
+	t.Run("rejects high-ratio entry from its header", func(t *testing.T) {
+		zipPath := createTestZip(t, map[string][]byte{
+			"bomb.bin": make([]byte, 8*1024*1024),
+		})
+		defer os.Remove(zipPath)
+
+		destDir := t.TempDir()
+		err := Extract(zipPath, destDir, DefaultOptions())
+		testutil.Error(t, err)
+		testutil.Contains(t, err.Error(), "bomb.bin exceeds max compression ratio 100:1")
+
+		_, err = os.Stat(filepath.Join(destDir, "bomb.bin"))
+		testutil.True(t, os.IsNotExist(err))
+	})
+
+	t.Run("rejects entry larger than its forged header", func(t *testing.T) {
+		zipPath := createForgedZip(t, "bomb.bin", make([]byte, 8*1024*1024), 1000)
+
+		destDir := t.TempDir()
+		err := Extract(zipPath, destDir, DefaultOptions())
+		testutil.Error(t, err)
+		testutil.True(t, errors.Is(err, zip.ErrFormat))
+
+		_, err = os.Stat(filepath.Join(destDir, "bomb.bin"))
+		testutil.True(t, os.IsNotExist(err))
+	})
+
+	t.Run("allows small compressible entry", func(t *testing.T) {
+		zipPath := createTestZip(t, map[string][]byte{
+			"zeros.bin": make([]byte, 512*1024),
+		})
+		defer os.Remove(zipPath)
+
+		destDir := t.TempDir()
+		testutil.NoError(t, Extract(zipPath, destDir, DefaultOptions()))
+
+		info, err := os.Stat(filepath.Join(destDir, "zeros.bin"))
+		testutil.NoError(t, err)
+		testutil.Equal(t, info.Size(), int64(512*1024))
+	})
+
+	t.Run("zero ratio disables the check", func(t *testing.T) {
+		zipPath := createTestZip(t, map[string][]byte{
+			"bomb.bin": make([]byte, 8*1024*1024),
+		})
+		defer os.Remove(zipPath)
+
+		opts := DefaultOptions()
+		opts.MaxCompressionRatio = 0
+		testutil.NoError(t, Extract(zipPath, t.TempDir(), opts))
+	})
+}
+
+func TestRatioLimit(t *testing.T) {
+	opts := DefaultOptions()
+	testutil.Equal(t, ratioLimit(10, opts), int64(ratioFloor))
+	testutil.Equal(t, ratioLimit(1024*1024, opts), int64(100*1024*1024))
+	testutil.Equal(t, ratioLimit(math.MaxInt64, opts), int64(math.MaxInt64))
+	testutil.Equal(t, ratioLimit(10, Options{}), int64(0))
+}
+
 func TestDefaultOptions(t *testing.T) {
 	opts := DefaultOptions()
 	testutil.Equal(t, opts.MaxFileSize, int64(MaxFileSize))
 	testutil.Equal(t, opts.MaxTotalSize, int64(MaxTotalSize))
 	testutil.Equal(t, opts.MaxFiles, MaxFiles)
 	testutil.Equal(t, opts.MaxDepth, MaxDepth)
+	testutil.Equal(t, opts.MaxCompressionRatio, int64(MaxCompressionRatio))
 }
 
 func TestValidateZip(t *testing.T) {
@@ -0,0 +1,91 @@
+package zip
+
+import (
+	"archive/tar"
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+)
+
+// gzipMagic starts every gzip stream
+var gzipMagic = []byte{0x1f, 0x8b}
+
+// ExtractTar safely extracts a tar archive, gzip-compressed or not, to the
+// destination directory. Entry names go through the same traversal and
+// depth checks as zip entries. A tar is read as a stream, so the file count
+// and sizes are enforced as entries are reached rather than up front, and
+// the compression ratio applies to the gzip stream as a whole.
+// Symlinks, hard links and device entries are skipped: a link could point
+// outside destDir.
+func ExtractTar(tarPath, destDir string, opts Options) error {
+	f, err := os.Open(tarPath) //nolint:gosec // Caller chooses the archive to extract
+	if err != nil {
+		return fmt.Errorf("opening tar: %w", err)
+	}
+	defer f.Close()
+
+	var r io.Reader = bufio.NewReader(f)
+	if head, _ := r.(*bufio.Reader).Peek(len(gzipMagic)); bytes.Equal(head, gzipMagic) {
+		gz, err := gzip.NewReader(r)
+		if err != nil {
+			return fmt.Errorf("opening gzip: %w", err)
+		}
+		defer gz.Close()
+		r = gz
+		if info, err := f.Stat(); err == nil {
+			if limit := ratioLimit(info.Size(), opts); limit > 0 {
+				r = &ratioReader{r: gz, remaining: limit, name: filepath.Base(tarPath), opts: opts}
+			}
+		}
+	}
+
+	destDir, err = filepath.Abs(destDir)
+	if err != nil {
+		return fmt.Errorf("resolving destination path: %w", err)
+	}
+	if err := fs.MkdirAll(destDir, 0755); err != nil {
+		return fmt.Errorf("creating destination: %w", err)
+	}
+
+	tr := tar.NewReader(r)
+	var totalSize int64
+	for files := 0; ; files++ {
+		hdr, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("reading tar: %w", err)
+		}
+		if files >= opts.MaxFiles {
+			return fmt.Errorf("tar contains too many files (max %d)", opts.MaxFiles)
+		}
+		if err := extractTarEntry(tr, hdr, destDir, opts, &totalSize); err != nil {
+			return err
+		}
+	}
+}
+
+func extractTarEntry(tr *tar.Reader, hdr *tar.Header, destDir string, opts Options, totalSize *int64) error {
+	destPath, err := entryPath(destDir, hdr.Name, opts)
+	if err != nil {
+		return err
+	}
+
+	switch hdr.Typeflag {
+	case tar.TypeDir:
+		return fs.MkdirAll(destPath, hdr.FileInfo().Mode().Perm()|0700)
+	case tar.TypeReg:
+		if hdr.Size > opts.MaxFileSize {
+			return fmt.Errorf("file %s exceeds max size: %d bytes", hdr.Name, hdr.Size)
+		}
+		return writeEntry(tr, destPath, hdr.Name, hdr.FileInfo().Mode().Perm(), opts, totalSize)
+	default:
+		return nil
+	}
+}
@@ -0,0 +1,199 @@
+package zip
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/open-cli-collective/google-readonly/internal/testutil"
+)
+
+// tarEntry is one header, and for regular files the content, of a test tar
+type tarEntry struct {
+	name     string
+	typeflag byte
+	content  string
+	linkname string
+}
+
+// createTestTar writes entries, in order, to a tar in a temp dir, gzip
+// compressed when compress is set
+func createTestTar(t *testing.T, name string, compress bool, entries []tarEntry) string {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), name)
+	f, err := os.Create(path)
+	testutil.NoError(t, err)
+	defer f.Close()
+
+	var w io.Writer = f
+	if compress {
+		gz := gzip.NewWriter(f)
+		defer func() { testutil.NoError(t, gz.Close()) }()
+		w = gz
+	}
+
+	tw := tar.NewWriter(w)
+	for _, e := range entries {
+		hdr := &tar.Header{Name: e.name, Typeflag: e.typeflag, Mode: 0644, Linkname: e.linkname}
+		switch e.typeflag {
+		case tar.TypeDir:
+			hdr.Mode = 0755
+		case tar.TypeReg:
+			hdr.Size = int64(len(e.content))
+		}
+		testutil.NoError(t, tw.WriteHeader(hdr))
+		if e.typeflag == tar.TypeReg {
+			_, err := tw.Write([]byte(e.content))
+			testutil.NoError(t, err)
+		}
+	}
+	testutil.NoError(t, tw.Close())
+	return path
+}
+
+// nestedEntries is a small tree with nested directories
+var nestedEntries = []tarEntry{
+	{name: "project/", typeflag: tar.TypeDir},
+	{name: "project/README.md", typeflag: tar.TypeReg, content: "# Project"},
+	{name: "project/src/", typeflag: tar.TypeDir},
+	{name: "project/src/main.go", typeflag: tar.TypeReg, content: "package main"},
+	{name: "project/src/internal/util/util.go", typeflag: tar.TypeReg, content: "package util"},
+}
+
+func TestExtractTar(t *testing.T) {
+	for _, tc := range []struct {
+		name     string
+		file     string
+		compress bool
+	}{
+		{"tar.gz", "bundle.tar.gz", true},
+		{"tgz", "bundle.tgz", true},
+		{"plain tar", "bundle.tar", false},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			tarPath := createTestTar(t, tc.file, tc.compress, nestedEntries)
+			destDir := t.TempDir()
+
+			testutil.NoError(t, ExtractTar(tarPath, destDir, DefaultOptions()))
+
+			for path, want := range map[string]string{
+				"project/README.md":                 "# Project",
+				"project/src/main.go":               "package main",
+				"project/src/internal/util/util.go": "package util",
+			} {
+				data, err := os.ReadFile(filepath.Join(destDir, path))
+				testutil.NoError(t, err)
+				testutil.Equal(t, string(data), want)
+			}
+		})
+	}
+}
+
+func TestExtractTarSecurity(t *testing.T) {
+	t.Run("rejects path traversal", func(t *testing.T) {
+		tarPath := createTestTar(t, "evil.tar.gz", true, []tarEntry{
+			{name: "ok.txt", typeflag: tar.TypeReg, content: "fine"},
+			{name: "../../escape.txt", typeflag: tar.TypeReg, content: "malicious"},
+		})
+		parent := t.TempDir()
+		destDir := filepath.Join(parent, "a", "b")
+
+		err := ExtractTar(tarPath, destDir, DefaultOptions())
+		testutil.Error(t, err)
+		testutil.Contains(t, err.Error(), "invalid file path")
+
+		_, err = os.Stat(filepath.Join(parent, "escape.txt"))
+		testutil.True(t, os.IsNotExist(err))
+	})
+
+	t.Run("rejects gzip stream exceeding compression ratio", func(t *testing.T) {
+		tarPath := createTestTar(t, "bomb.tar.gz", true, []tarEntry{
+			{name: "bomb.bin", typeflag: tar.TypeReg, content: string(make([]byte, 8*1024*1024))},
+		})
+
+		err := ExtractTar(tarPath, t.TempDir(), DefaultOptions())
+		testutil.Error(t, err)
+		testutil.Contains(t, err.Error(), "bomb.tar.gz exceeds max compression ratio 100:1")
+	})
+
+	t.Run("rejects absolute paths", func(t *testing.T) {
+		tarPath := createTestTar(t, "evil.tar", false, []tarEntry{
+			{name: "/etc/passwd", typeflag: tar.TypeReg, content: "malicious"},
+		})
+
+		err := ExtractTar(tarPath, t.TempDir(), DefaultOptions())
+		testutil.Error(t, err)
+	})
+
+	t.Run("skips symlinks", func(t *testing.T) {
+		tarPath := createTestTar(t, "links.tar", false, []tarEntry{
+			{name: "passwd", typeflag: tar.TypeSymlink, linkname: "/etc/passwd"},
+			{name: "data.txt", typeflag: tar.TypeReg, content: "data"},
+		})
+		destDir := t.TempDir()
+
+		testutil.NoError(t, ExtractTar(tarPath, destDir, DefaultOptions()))
+
+		_, err := os.Lstat(filepath.Join(destDir, "passwd"))
+		testutil.True(t, os.IsNotExist(err))
+		_, err = os.Stat(filepath.Join(destDir, "data.txt"))
+		testutil.NoError(t, err)
+	})
+
+	t.Run("rejects too many files", func(t *testing.T) {
+		tarPath := createTestTar(t, "many.tar", false, nestedEntries)
+		opts := DefaultOptions()
+		opts.MaxFiles = 2
+
+		err := ExtractTar(tarPath, t.TempDir(), opts)
+		testutil.Error(t, err)
+		testutil.Contains(t, err.Error(), "too many files")
+	})
+
+	t.Run("rejects file exceeding max size", func(t *testing.T) {
+		tarPath := createTestTar(t, "large.tar.gz", true, []tarEntry{
+			{name: "large.txt", typeflag: tar.TypeReg, content: string(make([]byte, 1000))},
+		})
+		opts := DefaultOptions()
+		opts.MaxFileSize = 100
+
+		err := ExtractTar(tarPath, t.TempDir(), opts)
+		testutil.Error(t, err)
+		testutil.Contains(t, err.Error(), "exceeds max size")
+	})
+
+	t.Run("rejects total size exceeding limit", func(t *testing.T) {
+		tarPath := createTestTar(t, "total.tar", false, []tarEntry{
+			{name: "file1.txt", typeflag: tar.TypeReg, content: string(make([]byte, 600))},
+			{name: "file2.txt", typeflag: tar.TypeReg, content: string(make([]byte, 600))},
+		})
+		opts := DefaultOptions()
+		opts.MaxTotalSize = 1000
+
+		err := ExtractTar(tarPath, t.TempDir(), opts)
+		testutil.Error(t, err)
+		testutil.Contains(t, err.Error(), "exceeds limit")
+	})
+
+	t.Run("rejects path too deep", func(t *testing.T) {
+		tarPath := createTestTar(t, "deep.tar", false, nestedEntries)
+		opts := DefaultOptions()
+		opts.MaxDepth = 2
+
+		err := ExtractTar(tarPath, t.TempDir(), opts)
+		testutil.Error(t, err)
+		testutil.Contains(t, err.Error(), "too deep")
+	})
+}
+
+func TestExtractTarCorrupt(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "broken.tar.gz")
+	testutil.NoError(t, os.WriteFile(path, []byte{0x1f, 0x8b, 0x00}, 0600))
+
+	err := ExtractTar(path, t.TempDir(), DefaultOptions())
+	testutil.Error(t, err)
+}